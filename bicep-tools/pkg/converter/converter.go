@@ -88,6 +88,10 @@ func Convert(provider *manifest.ResourceProvider) (*ConversionResult, error) {
 			// Create cross-file type reference for this resource (to types.json)
 			typeRef := types.CrossFileTypeReference{Ref: i, RelativePath: "types.json"}
 			typeIndex.Resources[resourceTypeWithoutVersion][apiVersion] = typeRef
+		} else if functionType, ok := t.(*types.ResourceFunctionType); ok {
+			// Create cross-file type reference for this resource function (to types.json)
+			typeRef := types.CrossFileTypeReference{Ref: i, RelativePath: "types.json"}
+			typeIndex.AddResourceFunction(functionType.ResourceType, functionType.ApiVersion, functionType.Name, typeRef)
 		}
 	}
 
@@ -181,9 +185,78 @@ func addResourceTypeForAPIVersion(
 		nil,
 	)
 
+	// Create a resource function for each custom action that Bicep can call. Bicep only allows resource functions
+	// whose name starts with "list", since they must not change the resource. Other actions can still be invoked
+	// through the API.
+	for actionName, action := range apiVersion.Actions {
+		if !isResourceFunctionName(actionName) {
+			continue
+		}
+
+		err := addResourceFunctionForAction(provider, resourceTypeName, apiVersionName, actionName, &action, typeFactory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create action %s: %w", actionName, err)
+		}
+	}
+
 	return typeFactory.GetReference(resourceTypeRef), nil
 }
 
+// addResourceFunctionForAction creates a resource function type for a custom action, so that the action
+// can be invoked from Bicep like a list* function (e.g. myResource.listSecrets()).
+func addResourceFunctionForAction(
+	provider *manifest.ResourceProvider,
+	resourceTypeName string,
+	apiVersionName string,
+	actionName string,
+	action *manifest.Action,
+	typeFactory *factory.TypeFactory,
+) error {
+	// Actions without an output schema return an empty object
+	output := action.Output
+	if output == nil {
+		output = &manifest.Schema{Type: "object"}
+	}
+
+	outputRef, err := addSchemaType(output, resourceTypeName+toPascalCase(actionName)+"Output", typeFactory)
+	if err != nil {
+		return fmt.Errorf("failed to create output type: %w", err)
+	}
+
+	var inputRef types.ITypeReference
+	if action.Input != nil {
+		inputRef, err = addSchemaType(action.Input, resourceTypeName+toPascalCase(actionName)+"Input", typeFactory)
+		if err != nil {
+			return fmt.Errorf("failed to create input type: %w", err)
+		}
+	}
+
+	functionType := typeFactory.CreateResourceFunctionType(
+		actionName,
+		fmt.Sprintf("%s/%s", provider.Namespace, resourceTypeName),
+		apiVersionName,
+		outputRef,
+		inputRef,
+	)
+	typeFactory.GetReference(functionType)
+
+	return nil
+}
+
+// isResourceFunctionName returns true if Bicep can call an action with this name as a resource function.
+func isResourceFunctionName(actionName string) bool {
+	return strings.HasPrefix(strings.ToLower(actionName), "list")
+}
+
+// toPascalCase upper-cases the first letter of a camelCased name.
+func toPascalCase(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
 // addSchemaType converts a manifest schema to a Bicep type
 // Equivalent to TypeScript function addSchemaType()
 func addSchemaType(schema *manifest.Schema, name string, typeFactory *factory.TypeFactory) (types.ITypeReference, error) {
@@ -193,6 +266,11 @@ func addSchemaType(schema *manifest.Schema, name string, typeFactory *factory.Ty
 // addSchemaTypeInternal converts a manifest schema to a Bicep type with additional context.
 // inPlatformOptions indicates whether we are currently traversing within a platformOptions property.
 func addSchemaTypeInternal(schema *manifest.Schema, name string, typeFactory *factory.TypeFactory, inPlatformOptions bool) (types.ITypeReference, error) {
+	// Handle oneOf and anyOf variants before the type, which describes what the variants have in common
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		return addVariantSchemaType(schema, name, typeFactory, inPlatformOptions)
+	}
+
 	// Handle empty schema type (default to object, matching TypeScript behavior)
 	schemaType := schema.Type
 	if schemaType == "" {
@@ -301,6 +379,59 @@ func addSchemaTypeInternal(schema *manifest.Schema, name string, typeFactory *fa
 	}
 }
 
+// addVariantSchemaType converts a schema with oneOf or anyOf variants to a Bicep type.
+//
+// With a discriminator, the variants become the elements of a discriminated object type, keyed by the value each
+// variant declares for the discriminator property. The properties of the schema itself are shared by all elements.
+// Without a discriminator, the variants become the members of a union type.
+func addVariantSchemaType(schema *manifest.Schema, name string, typeFactory *factory.TypeFactory, inPlatformOptions bool) (types.ITypeReference, error) {
+	variants := schema.Variants()
+
+	if schema.Discriminator == nil {
+		var variantRefs []types.ITypeReference
+		for i := range variants {
+			variantRef, err := addSchemaTypeInternal(&variants[i], fmt.Sprintf("%sOption%d", name, i+1), typeFactory, inPlatformOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to add variant %d: %w", i, err)
+			}
+			variantRefs = append(variantRefs, variantRef)
+		}
+		return typeFactory.GetReference(typeFactory.CreateUnionType(variantRefs)), nil
+	}
+
+	propertyName := schema.Discriminator.PropertyName
+	baseProperties, err := addObjectPropertiesInternal(schema, typeFactory, inPlatformOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add object properties: %w", err)
+	}
+
+	elements := map[string]types.ITypeReference{}
+	for i := range variants {
+		value, ok := variants[i].DiscriminatorValue(propertyName)
+		if !ok {
+			return nil, fmt.Errorf("variant %d of '%s' must declare the discriminator property '%s' with a single-value enum", i, name, propertyName)
+		}
+
+		elementProperties, err := addObjectPropertiesInternal(&variants[i], typeFactory, inPlatformOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add properties of variant '%s': %w", value, err)
+		}
+
+		// The discriminator property of each element is the literal value that selects it
+		discriminatorProperty := elementProperties[propertyName]
+		discriminatorProperty.Type = typeFactory.GetReference(typeFactory.CreateStringLiteralType(value))
+		discriminatorProperty.Flags |= types.TypePropertyFlagsRequired
+		elementProperties[propertyName] = discriminatorProperty
+
+		elementType := typeFactory.CreateObjectType(name+toPascalCase(value), nil, nil, nil)
+		elementType.Properties = elementProperties
+		elements[value] = typeFactory.GetReference(elementType)
+	}
+
+	discriminatedType := typeFactory.CreateDiscriminatedObjectType(name, propertyName, baseProperties, elements)
+	return typeFactory.GetReference(discriminatedType), nil
+}
+
 // addObjectPropertiesInternal converts manifest schema properties to Bicep object properties with context tracking
 func addObjectPropertiesInternal(schema *manifest.Schema, typeFactory *factory.TypeFactory, inPlatformOptions bool) (map[string]types.ObjectTypeProperty, error) {
 	result := make(map[string]types.ObjectTypeProperty)
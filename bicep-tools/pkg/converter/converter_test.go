@@ -1284,3 +1284,188 @@ func TestAddSchemaType_ArrayOfSensitiveObjects(t *testing.T) {
 		t.Error("Expected array item object to be marked as sensitive")
 	}
 }
+
+func TestConvert_WithActions(t *testing.T) {
+	provider := &manifest.ResourceProvider{
+		Namespace: "Applications.Test",
+		Types: map[string]manifest.ResourceType{
+			"testResources": {
+				APIVersions: map[string]manifest.APIVersion{
+					"2021-01-01": {
+						Schema: manifest.Schema{
+							Type: "object",
+							Properties: map[string]manifest.Schema{
+								"a": {Type: "string"},
+							},
+						},
+						Actions: map[string]manifest.Action{
+							"listSecrets": {
+								Output: &manifest.Schema{
+									Type: "object",
+									Properties: map[string]manifest.Schema{
+										"password": {Type: "string"},
+									},
+								},
+							},
+							"rotate": {
+								Input: &manifest.Schema{
+									Type: "object",
+									Properties: map[string]manifest.Schema{
+										"reason": {Type: "string"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := Convert(provider)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if strings.Count(result.TypesContent, `"$type": "ResourceFunctionType"`) != 1 {
+		t.Errorf("Expected types content to contain 1 resource function, got: %s", result.TypesContent)
+	}
+
+	if !strings.Contains(result.TypesContent, `"name": "listSecrets"`) {
+		t.Error("Expected types content to contain the 'listSecrets' function")
+	}
+
+	if !strings.Contains(result.IndexContent, `"resourceFunctions"`) {
+		t.Errorf("Expected index content to contain resource functions, got: %s", result.IndexContent)
+	}
+
+	// Bicep can only call actions whose name starts with "list", so no function is created for the others.
+	if strings.Contains(result.TypesContent, `"name": "rotate"`) || strings.Contains(result.IndexContent, `"rotate"`) {
+		t.Error("Expected no 'rotate' function to be created")
+	}
+}
+
+func TestAddSchemaType_OneOfWithDiscriminator(t *testing.T) {
+	schema := &manifest.Schema{
+		Type: "object",
+		Properties: map[string]manifest.Schema{
+			"size": {Type: "string"},
+		},
+		Discriminator: &manifest.Discriminator{PropertyName: "kind"},
+		OneOf: []manifest.Schema{
+			{
+				Type: "object",
+				Properties: map[string]manifest.Schema{
+					"kind":   {Type: "string", Enum: []string{"azure"}},
+					"region": {Type: "string"},
+				},
+				Required: []string{"kind"},
+			},
+			{
+				Type: "object",
+				Properties: map[string]manifest.Schema{
+					"kind":   {Type: "string", Enum: []string{"aws"}},
+					"bucket": {Type: "string"},
+				},
+				Required: []string{"kind"},
+			},
+		},
+	}
+	typeFactory := factory.NewTypeFactory()
+
+	result, err := addSchemaType(schema, "storage", typeFactory)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	allTypes := typeFactory.GetTypes()
+	typeRef, ok := result.(types.TypeReference)
+	if !ok {
+		t.Fatal("Expected result to be a TypeReference")
+	}
+	addedType, ok := allTypes[typeRef.Ref].(*types.DiscriminatedObjectType)
+	if !ok {
+		t.Fatalf("Expected result to be a DiscriminatedObjectType, got: %T", allTypes[typeRef.Ref])
+	}
+
+	if addedType.Name != "storage" {
+		t.Errorf("Expected name 'storage', got '%s'", addedType.Name)
+	}
+	if addedType.Discriminator != "kind" {
+		t.Errorf("Expected discriminator 'kind', got '%s'", addedType.Discriminator)
+	}
+	if _, ok := addedType.BaseProperties["size"]; !ok {
+		t.Error("Expected base properties to include 'size'")
+	}
+	if len(addedType.Elements) != 2 {
+		t.Fatalf("Expected 2 elements, got %d", len(addedType.Elements))
+	}
+
+	elementRef, ok := addedType.Elements["azure"].(types.TypeReference)
+	if !ok {
+		t.Fatal("Expected element 'azure' to be a TypeReference")
+	}
+	element, ok := allTypes[elementRef.Ref].(*types.ObjectType)
+	if !ok {
+		t.Fatal("Expected element 'azure' to be an ObjectType")
+	}
+	if element.Name != "storageAzure" {
+		t.Errorf("Expected element name 'storageAzure', got '%s'", element.Name)
+	}
+	if _, ok := element.Properties["region"]; !ok {
+		t.Error("Expected element 'azure' to include 'region'")
+	}
+
+	kind := element.Properties["kind"]
+	if kind.Flags&types.TypePropertyFlagsRequired == 0 {
+		t.Error("Expected discriminator property to be required")
+	}
+	kindRef, ok := kind.Type.(types.TypeReference)
+	if !ok {
+		t.Fatal("Expected discriminator property type to be a TypeReference")
+	}
+	literal, ok := allTypes[kindRef.Ref].(*types.StringLiteralType)
+	if !ok {
+		t.Fatal("Expected discriminator property to be a StringLiteralType")
+	}
+	if literal.Value != "azure" {
+		t.Errorf("Expected discriminator literal 'azure', got '%s'", literal.Value)
+	}
+}
+
+func TestAddSchemaType_AnyOfWithoutDiscriminator(t *testing.T) {
+	schema := &manifest.Schema{
+		AnyOf: []manifest.Schema{
+			{Type: "string"},
+			{Type: "integer"},
+		},
+	}
+	typeFactory := factory.NewTypeFactory()
+
+	result, err := addSchemaType(schema, "port", typeFactory)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	allTypes := typeFactory.GetTypes()
+	typeRef, ok := result.(types.TypeReference)
+	if !ok {
+		t.Fatal("Expected result to be a TypeReference")
+	}
+	addedType, ok := allTypes[typeRef.Ref].(*types.UnionType)
+	if !ok {
+		t.Fatalf("Expected result to be a UnionType, got: %T", allTypes[typeRef.Ref])
+	}
+	if len(addedType.Elements) != 2 {
+		t.Fatalf("Expected 2 union elements, got %d", len(addedType.Elements))
+	}
+
+	firstRef := addedType.Elements[0].(types.TypeReference)
+	if _, ok := allTypes[firstRef.Ref].(*types.StringType); !ok {
+		t.Errorf("Expected first union element to be a StringType, got: %T", allTypes[firstRef.Ref])
+	}
+	secondRef := addedType.Elements[1].(types.TypeReference)
+	if _, ok := allTypes[secondRef.Ref].(*types.IntegerType); !ok {
+		t.Errorf("Expected second union element to be an IntegerType, got: %T", allTypes[secondRef.Ref])
+	}
+}
@@ -23,8 +23,17 @@ type ResourceType struct {
 // APIVersion represents a specific API version of a resource type
 // Equivalent to TypeScript interface APIVersion
 type APIVersion struct {
-	Schema       Schema   `yaml:"schema" json:"schema"`
-	Capabilities []string `yaml:"capabilities,omitempty" json:"capabilities,omitempty"`
+	Schema       Schema            `yaml:"schema" json:"schema"`
+	Capabilities []string          `yaml:"capabilities,omitempty" json:"capabilities,omitempty"`
+	Actions      map[string]Action `yaml:"actions,omitempty" json:"actions,omitempty"`
+}
+
+// Action represents a custom action that can be invoked on resources of a resource type.
+// The handler of the action is not needed to generate types, so it is not represented here.
+type Action struct {
+	Description *string `yaml:"description,omitempty" json:"description,omitempty"`
+	Input       *Schema `yaml:"input,omitempty" json:"input,omitempty"`
+	Output      *Schema `yaml:"output,omitempty" json:"output,omitempty"`
 }
 
 // Schema represents the schema definition for a resource type
@@ -39,6 +48,33 @@ type Schema struct {
 	Items                *Schema           `yaml:"items,omitempty" json:"items,omitempty"`
 	Enum                 []string          `yaml:"enum,omitempty" json:"enum,omitempty"`
 	IsSensitive          *bool             `yaml:"x-radius-sensitive,omitempty" json:"x-radius-sensitive,omitempty"`
+	OneOf                []Schema          `yaml:"oneOf,omitempty" json:"oneOf,omitempty"`
+	AnyOf                []Schema          `yaml:"anyOf,omitempty" json:"anyOf,omitempty"`
+	Discriminator        *Discriminator    `yaml:"discriminator,omitempty" json:"discriminator,omitempty"`
+}
+
+// Discriminator identifies the property whose value selects one of the oneOf or anyOf variants of a schema.
+// Each variant declares its value for the property as a single-value enum.
+type Discriminator struct {
+	PropertyName string `yaml:"propertyName" json:"propertyName"`
+}
+
+// Variants returns the oneOf and anyOf variants of the schema.
+func (s *Schema) Variants() []Schema {
+	variants := []Schema{}
+	variants = append(variants, s.OneOf...)
+	variants = append(variants, s.AnyOf...)
+	return variants
+}
+
+// DiscriminatorValue returns the value a variant declares for the discriminator property.
+func (s *Schema) DiscriminatorValue(propertyName string) (string, bool) {
+	property, ok := s.Properties[propertyName]
+	if !ok || len(property.Enum) != 1 {
+		return "", false
+	}
+
+	return property.Enum[0], true
 }
 
 // ParseManifest parses a YAML manifest string into a ResourceProvider struct
@@ -97,7 +133,25 @@ func (rt *ResourceType) Validate(typeName string) error {
 
 // Validate performs validation on an API version
 func (av *APIVersion) Validate(typeName, apiVersion string) error {
-	return av.Schema.Validate(fmt.Sprintf("%s@%s", typeName, apiVersion))
+	context := fmt.Sprintf("%s@%s", typeName, apiVersion)
+	if err := av.Schema.Validate(context); err != nil {
+		return err
+	}
+
+	for actionName, action := range av.Actions {
+		if action.Input != nil {
+			if err := action.Input.Validate(fmt.Sprintf("%s.actions.%s.input", context, actionName)); err != nil {
+				return err
+			}
+		}
+		if action.Output != nil {
+			if err := action.Output.Validate(fmt.Sprintf("%s.actions.%s.output", context, actionName)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 // Validate performs validation on a schema
@@ -144,5 +198,36 @@ func (s *Schema) Validate(context string) error {
 		}
 	}
 
+	// Validate oneOf and anyOf variants
+	if len(s.OneOf) > 0 && len(s.AnyOf) > 0 {
+		return fmt.Errorf("oneOf and anyOf cannot be combined in %s", context)
+	}
+
+	variants := s.Variants()
+	if s.Discriminator != nil && len(variants) == 0 {
+		return fmt.Errorf("discriminator requires oneOf or anyOf in %s", context)
+	}
+
+	discriminatorValues := map[string]bool{}
+	for i := range variants {
+		variantContext := fmt.Sprintf("%s(variant %d)", context, i)
+		if err := variants[i].Validate(variantContext); err != nil {
+			return err
+		}
+
+		if s.Discriminator == nil {
+			continue
+		}
+
+		value, ok := variants[i].DiscriminatorValue(s.Discriminator.PropertyName)
+		if !ok {
+			return fmt.Errorf("variant must declare the discriminator property '%s' with a single-value enum in %s", s.Discriminator.PropertyName, variantContext)
+		}
+		if discriminatorValues[value] {
+			return fmt.Errorf("discriminator value '%s' is declared by more than one variant in %s", value, context)
+		}
+		discriminatorValues[value] = true
+	}
+
 	return nil
 }
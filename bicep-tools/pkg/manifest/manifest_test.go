@@ -562,3 +562,188 @@ types:
 		t.Error("Expected credentials object to be marked as sensitive")
 	}
 }
+
+func TestParseManifest_WithActions(t *testing.T) {
+	testdataPath := filepath.Join("../../internal/testdata", "valid-with-actions.yaml")
+	yamlContent, err := os.ReadFile(testdataPath)
+	if err != nil {
+		t.Fatalf("Failed to read test file %s: %v", testdataPath, err)
+	}
+	result, err := ParseManifest(string(yamlContent))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if err := result.Validate(); err != nil {
+		t.Fatalf("Expected no validation error, got: %v", err)
+	}
+
+	actions := result.Types["testResources"].APIVersions["2025-01-01-preview"].Actions
+	if len(actions) != 2 {
+		t.Fatalf("Expected 2 actions, got: %d", len(actions))
+	}
+
+	listSecrets, exists := actions["listSecrets"]
+	if !exists {
+		t.Fatal("Expected action 'listSecrets' to exist")
+	}
+	if listSecrets.Input != nil {
+		t.Errorf("Expected action 'listSecrets' to have no input, got: %v", listSecrets.Input)
+	}
+	if listSecrets.Output == nil || listSecrets.Output.Properties["password"].Type != "string" {
+		t.Errorf("Expected action 'listSecrets' output to have a string 'password' property, got: %v", listSecrets.Output)
+	}
+
+	rotate, exists := actions["rotate"]
+	if !exists {
+		t.Fatal("Expected action 'rotate' to exist")
+	}
+	if rotate.Input == nil || rotate.Input.Properties["reason"].Type != "string" {
+		t.Errorf("Expected action 'rotate' input to have a string 'reason' property, got: %v", rotate.Input)
+	}
+}
+
+func TestAPIVersion_Validate_InvalidActionSchema(t *testing.T) {
+	apiVersion := APIVersion{
+		Schema: Schema{Type: "object"},
+		Actions: map[string]Action{
+			"rotate": {
+				Input: &Schema{Type: "invalid"},
+			},
+		},
+	}
+
+	err := apiVersion.Validate("testResources", "2025-01-01-preview")
+	if err == nil {
+		t.Fatal("Expected validation error, got nil")
+	}
+	if !strings.Contains(err.Error(), "actions.rotate.input") {
+		t.Errorf("Expected error to mention the action input, got: %v", err)
+	}
+}
+
+func TestParseManifest_WithOneOfDiscriminator(t *testing.T) {
+	input := `
+namespace: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema:
+          type: object
+          properties:
+            storage:
+              type: object
+              discriminator:
+                propertyName: kind
+              oneOf:
+                - type: object
+                  properties:
+                    kind:
+                      type: string
+                      enum: [azure]
+                    region:
+                      type: string
+                  required: [kind]
+                - type: object
+                  properties:
+                    kind:
+                      type: string
+                      enum: [aws]
+                    bucket:
+                      type: string
+                  required: [kind]
+`
+
+	result, err := ParseManifest(input)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	storage := result.Types["testResources"].APIVersions["2025-01-01-preview"].Schema.Properties["storage"]
+	if storage.Discriminator == nil || storage.Discriminator.PropertyName != "kind" {
+		t.Fatalf("Expected discriminator on property 'kind', got: %v", storage.Discriminator)
+	}
+	if len(storage.OneOf) != 2 {
+		t.Fatalf("Expected 2 oneOf variants, got %d", len(storage.OneOf))
+	}
+
+	value, ok := storage.OneOf[1].DiscriminatorValue("kind")
+	if !ok || value != "aws" {
+		t.Errorf("Expected discriminator value 'aws', got '%s' (%v)", value, ok)
+	}
+}
+
+func TestSchema_Validate_Variants(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        Schema
+		expectedError string
+	}{
+		{
+			name: "oneOf and anyOf combined",
+			schema: Schema{
+				OneOf: []Schema{{Type: "string"}},
+				AnyOf: []Schema{{Type: "integer"}},
+			},
+			expectedError: "oneOf and anyOf cannot be combined",
+		},
+		{
+			name: "discriminator without variants",
+			schema: Schema{
+				Type:          "object",
+				Discriminator: &Discriminator{PropertyName: "kind"},
+			},
+			expectedError: "discriminator requires oneOf or anyOf",
+		},
+		{
+			name: "invalid variant",
+			schema: Schema{
+				OneOf: []Schema{{Type: "invalid"}},
+			},
+			expectedError: "test(variant 0)",
+		},
+		{
+			name: "variant without discriminator value",
+			schema: Schema{
+				Discriminator: &Discriminator{PropertyName: "kind"},
+				OneOf: []Schema{
+					{Type: "object", Properties: map[string]Schema{"kind": {Type: "string"}}},
+				},
+			},
+			expectedError: "single-value enum",
+		},
+		{
+			name: "duplicate discriminator value",
+			schema: Schema{
+				Discriminator: &Discriminator{PropertyName: "kind"},
+				OneOf: []Schema{
+					{Type: "object", Properties: map[string]Schema{"kind": {Type: "string", Enum: []string{"azure"}}}},
+					{Type: "object", Properties: map[string]Schema{"kind": {Type: "string", Enum: []string{"azure"}}}},
+				},
+			},
+			expectedError: "declared by more than one variant",
+		},
+		{
+			name: "valid anyOf",
+			schema: Schema{
+				AnyOf: []Schema{{Type: "string"}, {Type: "integer"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate("test")
+			if tt.expectedError == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+				t.Errorf("Expected error containing '%s', got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
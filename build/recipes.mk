@@ -22,6 +22,16 @@ TERRAFORM_MODULE_CONFIGMAP_NAME=tf-module-server-content
 
 ##@ Recipes
 
+.PHONY: publish-bicep-recipes
+publish-bicep-recipes: ## Publishes the Radius recipes to <BICEP_RECIPE_REGISTRY> with version <BICEP_RECIPE_TAG_VERSION>
+	@if [ -z "$(BICEP_RECIPE_REGISTRY)" ]; then echo "Error: BICEP_RECIPE_REGISTRY must be set to a valid OCI registry"; exit 1; fi
+
+	@echo "$(ARROW) Publishing Bicep recipes from ./deploy/recipes/kubernetes..."
+	./.github/scripts/publish-recipes.sh \
+		./deploy/recipes/kubernetes \
+		${BICEP_RECIPE_REGISTRY}/recipes/kubernetes \
+		${BICEP_RECIPE_TAG_VERSION}
+
 .PHONY: publish-test-bicep-recipes
 publish-test-bicep-recipes: ## Publishes test recipes to <BICEP_RECIPE_REGISTRY> with version <BICEP_RECIPE_TAG_VERSION>
 	@if [ -z "$(BICEP_RECIPE_REGISTRY)" ]; then echo "Error: BICEP_RECIPE_REGISTRY must be set to a valid OCI registry"; exit 1; fi
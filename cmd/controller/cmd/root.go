@@ -43,6 +43,10 @@ var rootCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		configFilePath := cmd.Flag("config-file").Value.String()
 		tlsCertDir := cmd.Flag("cert-dir").Value.String()
+		gitSourceWebhookPort, err := cmd.Flags().GetInt("gitsource-webhook-port")
+		if err != nil {
+			return err
+		}
 
 		options, err := hostoptions.NewHostOptionsFromEnvironment(configFilePath)
 		if err != nil {
@@ -63,7 +67,7 @@ var rootCmd = &cobra.Command{
 		logger.Info("Loaded options", "configfile", configFilePath)
 
 		services := []hosting.Service{
-			&controller.Service{Options: options, TLSCertDir: tlsCertDir},
+			&controller.Service{Options: options, TLSCertDir: tlsCertDir, GitSourceWebhookPort: gitSourceWebhookPort},
 		}
 
 		if options.Config.TracerProvider.Enabled {
@@ -79,6 +83,7 @@ func Execute() {
 	// Let users override the configuration via `--config-file`.
 	rootCmd.Flags().String("config-file", fmt.Sprintf("controller-%s.yaml", hostoptions.Environment()), "The service configuration file.")
 	rootCmd.Flags().String("cert-dir", "/var/tls/cert", "The directory containing the TLS certificates.")
+	rootCmd.Flags().Int("gitsource-webhook-port", 0, "The port of the server receiving push webhooks for GitSources. Set to 0 to disable the server.")
 
 	cobra.CheckErr(rootCmd.ExecuteContext(context.Background()))
 }
@@ -17,37 +17,105 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/radius-project/radius/cmd/rad/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/resourcetypedocs"
 	"github.com/spf13/cobra/doc"
 )
 
 func main() {
+	if len(os.Args) == 4 && os.Args[1] == "resource-types" {
+		err := generateResourceTypeDocs(os.Args[2], os.Args[3])
+		if err != nil {
+			log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+		}
+		return
+	}
+
 	if len(os.Args) != 2 {
-		log.Fatal("usage: go run cmd/docgen/main.go <output directory>") //nolint:forbidigo // this is OK inside the main function.
+		log.Fatal("usage: go run cmd/docgen/main.go <output directory>\n       go run cmd/docgen/main.go resource-types <manifest file or directory> <output directory>") //nolint:forbidigo // this is OK inside the main function.
 	}
 
 	output := os.Args[1]
+	err := ensureDirectory(output)
+	if err != nil {
+		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+	}
+
+	err = doc.GenMarkdownTreeCustom(cmd.RootCmd, output, frontmatter, link)
+	if err != nil {
+		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+	}
+}
+
+func ensureDirectory(output string) error {
 	_, err := os.Stat(output)
 	if os.IsNotExist(err) {
-		err = os.Mkdir(output, 0755)
+		return os.Mkdir(output, 0755)
+	}
+
+	return err
+}
+
+// generateResourceTypeDocs writes a Markdown reference page for each resource type in the resource type
+// definition file, or in each resource type definition file in the directory, at input.
+func generateResourceTypeDocs(input string, output string) error {
+	files := []string{input}
+	info, err := os.Stat(input)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		files = []string{}
+		entries, err := os.ReadDir(input)
 		if err != nil {
-			log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+			return err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && slices.Contains([]string{".yaml", ".yml", ".json"}, filepath.Ext(entry.Name())) {
+				files = append(files, filepath.Join(input, entry.Name()))
+			}
 		}
-	} else if err != nil {
-		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
 	}
 
-	err = doc.GenMarkdownTreeCustom(cmd.RootCmd, output, frontmatter, link)
+	err = ensureDirectory(output)
 	if err != nil {
-		log.Fatal(err) //nolint:forbidigo // this is OK inside the main function.
+		return err
+	}
+
+	for _, file := range files {
+		resourceProvider, err := manifest.ValidateManifest(context.Background(), file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		for _, resourceType := range common.ResourceTypesForManifest(resourceProvider) {
+			page := resourcetypedocs.NewPage(resourceType, nil)
+			content, err := resourcetypedocs.Render(page, resourcetypedocs.FormatMarkdown)
+			if err != nil {
+				return err
+			}
+
+			filename := resourcetypedocs.FileName(page, resourcetypedocs.FormatMarkdown)
+			content = append([]byte(resourceTypeFrontmatter(page, filename)), content...)
+			err = os.WriteFile(filepath.Join(output, filename), content, 0644)
+			if err != nil {
+				return err
+			}
+		}
 	}
+
+	return nil
 }
 
 const template = `---
@@ -68,6 +136,22 @@ func frontmatter(filename string) string {
 	return fmt.Sprintf(template, command, command, base, url, command)
 }
 
+const resourceTypeTemplate = `---
+type: docs
+title: "%s reference"
+linkTitle: "%s"
+slug: %s
+url: %s
+description: "Details on the %s resource type"
+---
+`
+
+func resourceTypeFrontmatter(page resourcetypedocs.Page, filename string) string {
+	base := strings.TrimSuffix(filename, path.Ext(filename))
+	url := "/reference/resource-types/" + base + "/"
+	return fmt.Sprintf(resourceTypeTemplate, page.Name, page.TypeName, base, url, page.Name)
+}
+
 func link(name string) string {
 	base := strings.TrimSuffix(name, path.Ext(name))
 	return "{{< ref " + strings.ToLower(base) + ".md >}}"
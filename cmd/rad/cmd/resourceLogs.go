@@ -28,19 +28,22 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/deployment"
 	"github.com/spf13/cobra"
 )
 
 var resourceLogsCmd = &cobra.Command{
 	Use:   "logs [resource]",
-	Short: "Read logs from a running containers resource",
-	Long: `Reads logs from a running resource. Currently only supports the resource type 'Applications.Core/containers'.
+	Short: "Read logs from a running containers or jobs resource",
+	Long: `Reads logs from a running resource. Currently only supports the resource types 'Applications.Core/containers' and 'Radius.Compute/jobs'.
 This command allows you to access logs of a deployed application and output those logs to the local console.
 
 'rad resource logs' will output all currently available logs for the resource and then exit.
 
 'rad resource logs' will output logs from the resource's primary container. In scenarios like Dapr where multiple containers are in use, the '--container \<name\>' option can specify the desired container.
 
+For 'Radius.Compute/jobs', logs are read from the pods of all runs of the job that have started, including completed and failed runs.
+
 Specify the '--follow' option to stream additional logs as they are emitted by the resource. When following, press CTRL+C to exit the command and terminate the stream.`,
 	Example: `# read logs from the 'webapp' resource of the current default app
 rad resource logs Applications.Core/containers webapp
@@ -52,7 +55,10 @@ rad resource logs Applications.Core/containers orders --application icecream-sto
 rad resource logs Applications.Core/containers orders --application icecream-store --follow
 
 # read logs from the 'daprd' sidecar container of the 'orders' resource of the 'icecream-store' application
-rad resource logs Applications.Core/containers orders --application icecream-store --container daprd`,
+rad resource logs Applications.Core/containers orders --application icecream-store --container daprd
+
+# read logs from the runs of the 'migrate' job of the 'icecream-store' application
+rad resource logs Radius.Compute/jobs migrate --application icecream-store`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		workspace, err := cli.RequireWorkspace(cmd, ConfigFromContext(cmd.Context()), DirectoryConfigFromContext(cmd.Context()))
 		if err != nil {
@@ -74,8 +80,8 @@ rad resource logs Applications.Core/containers orders --application icecream-sto
 		if err != nil {
 			return err
 		}
-		if !strings.EqualFold(resourceType, ContainerType) {
-			return fmt.Errorf("only %s is supported", ContainerType)
+		if !strings.EqualFold(resourceType, ContainerType) && !strings.EqualFold(resourceType, deployment.JobType) {
+			return fmt.Errorf("only %s and %s are supported", ContainerType, deployment.JobType)
 		}
 		follow, err := cmd.Flags().GetBool("follow")
 		if err != nil {
@@ -94,10 +100,11 @@ rad resource logs Applications.Core/containers orders --application icecream-sto
 		}
 
 		streams, err := client.Logs(cmd.Context(), clients.LogsOptions{
-			Application: application,
-			Resource:    resourceName,
-			Follow:      follow,
-			Container:   container})
+			Application:  application,
+			ResourceType: resourceType,
+			Resource:     resourceName,
+			Follow:       follow,
+			Container:    container})
 		if err != nil {
 			return err
 		}
@@ -32,8 +32,10 @@ import (
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_exec "github.com/radius-project/radius/pkg/cli/cmd/app/exec"
 	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
+	app_logs "github.com/radius-project/radius/pkg/cli/cmd/app/logs"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
@@ -67,16 +69,20 @@ import (
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_exec "github.com/radius-project/radius/pkg/cli/cmd/resource/exec"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_update "github.com/radius-project/radius/pkg/cli/cmd/resource/update"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
 	resourceprovider_list "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/list"
 	resourceprovider_show "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/show"
 	resourcetype_create "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/create"
 	resourcetype_delete "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/delete"
+	resourcetype_docs "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/docs"
 	resourcetype_list "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/list"
 	resourcetype_show "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/show"
+	resourcetype_validate "github.com/radius-project/radius/pkg/cli/cmd/resourcetype/validate"
 	"github.com/radius-project/radius/pkg/cli/cmd/rollback"
 	rollback_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/rollback/kubernetes"
 	"github.com/radius-project/radius/pkg/cli/cmd/run"
@@ -102,6 +108,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/sdk"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -240,6 +247,10 @@ func init() {
 	// Must set the default logger to use controller-runtime.
 	runtimelog.SetLogger(zap.New())
 
+	// Show warnings returned by Radius, such as the use of deprecated resource types, on stderr so they don't
+	// interfere with structured output.
+	sdk.SetWarningHandler(sdk.NewWarningWriter(os.Stderr))
+
 	RootCmd.PersistentFlags().StringVar(&ConfigHolder.ConfigFilePath, "config", "", "config file (default \"$HOME/.rad/config.yaml\")")
 
 	outputDescription := fmt.Sprintf("output format (supported formats are %s)", strings.Join(output.SupportedFormats(), ", "))
@@ -291,6 +302,12 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceUpdateCmd, _ := resource_update.NewCommand(framework)
+	resourceCmd.AddCommand(resourceUpdateCmd)
+
+	resourceExecCmd, _ := resource_exec.NewCommand(framework)
+	resourceCmd.AddCommand(resourceExecCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -315,6 +332,12 @@ func initSubCommands() {
 	resourceTypeCreateCmd, _ := resourcetype_create.NewCommand(framework)
 	resourceTypeCmd.AddCommand(resourceTypeCreateCmd)
 
+	resourceTypeValidateCmd, _ := resourcetype_validate.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeValidateCmd)
+
+	resourceTypeDocsCmd, _ := resourcetype_docs.NewCommand(framework)
+	resourceTypeCmd.AddCommand(resourceTypeDocsCmd)
+
 	listRecipeCmd, _ := recipe_list.NewCommand(framework)
 	recipeCmd.AddCommand(listRecipeCmd)
 
@@ -412,6 +435,12 @@ func initSubCommands() {
 	appGraphCmd, _ := app_graph.NewCommand(framework)
 	applicationCmd.AddCommand(appGraphCmd)
 
+	appLogsCmd, _ := app_logs.NewCommand(framework)
+	applicationCmd.AddCommand(appLogsCmd)
+
+	appExecCmd, _ := app_exec.NewCommand(framework)
+	applicationCmd.AddCommand(appExecCmd)
+
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
 	previewEnvSwitchCmd, _ := env_switch_preview.NewCommand(framework)
 	wirePreviewSubcommand(envSwitchCmd, previewEnvSwitchCmd)
@@ -105,6 +105,10 @@ var (
 	// TraceparentHeader is W3C trace parent header.
 	TraceparentHeader = "Traceparent"
 
+	// WarningHeader is the standard http header Warning used to return warnings, such as the use of a deprecated API version,
+	// with a response. Values use the format '299 - "<message>"'.
+	WarningHeader = "Warning"
+
 	// IfMatch HTTP request header makes a request conditional. The resource is returned only if the
 	// condition (tag or wildcard in this case)in the If-Match is met.
 	// https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/Addendum.md#etags-for-resources
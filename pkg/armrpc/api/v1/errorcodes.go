@@ -61,4 +61,7 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when an upstream service called to carry out the current operation fails or returns an invalid response.
+	CodeBadGateway = "BadGateway"
 )
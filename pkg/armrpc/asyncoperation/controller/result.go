@@ -17,6 +17,8 @@ limitations under the License.
 package controller
 
 import (
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 )
 
@@ -25,6 +27,10 @@ type Result struct {
 	// Requeue tells the Controller to requeue the reconcile key. Defaults to false.
 	Requeue bool
 
+	// RequeueAfter tells the worker to run the operation again after the duration instead of completing it. The
+	// worker and the lease on the resource are released while the operation waits. Defaults to zero.
+	RequeueAfter time.Duration
+
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
@@ -39,6 +45,11 @@ func NewCanceledResult(message string) Result {
 	return r
 }
 
+// NewRequeueAfterResult creates a new Result object that runs the operation again after the given duration.
+func NewRequeueAfterResult(after time.Duration) Result {
+	return Result{RequeueAfter: after}
+}
+
 // NewFailedResult creates a new Result object with the given error details and sets the failed flag to true.
 func NewFailedResult(err v1.ErrorDetails) Result {
 	r := Result{}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"runtime/debug"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/lease"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/components/trace"
@@ -58,6 +60,9 @@ const (
 
 	// defaultDequeueInterval is the default duration for the dequeue interval.
 	defaultDequeueInterval = time.Duration(200) * time.Millisecond
+
+	// defaultLeaseRetryDelay is the default delay before a message waiting for the lease on its resource is retried.
+	defaultLeaseRetryDelay = time.Duration(5) * time.Second
 )
 
 // Options configures AsyncRequestProcessorWorker
@@ -79,6 +84,13 @@ type Options struct {
 
 	// DequeueIntervalDuration is the duration for the dequeue interval.
 	DequeueIntervalDuration time.Duration
+
+	// LeaseRetryDelay is the delay before a message waiting for the lease on its resource is retried.
+	LeaseRetryDelay time.Duration
+
+	// LeaseHolder identifies the worker in the leases it acquires on resources. It must be unique across
+	// all replicas. The default is the hostname with a random suffix.
+	LeaseHolder string
 }
 
 // AsyncRequestProcessWorker is the worker to process async requests.
@@ -115,6 +127,12 @@ func New(
 	if options.DequeueIntervalDuration == time.Duration(0) {
 		options.DequeueIntervalDuration = defaultDequeueInterval
 	}
+	if options.LeaseRetryDelay == time.Duration(0) {
+		options.LeaseRetryDelay = defaultLeaseRetryDelay
+	}
+	if options.LeaseHolder == "" {
+		options.LeaseHolder = defaultLeaseHolder()
+	}
 
 	return &AsyncRequestProcessWorker{
 		options:      options,
@@ -185,6 +203,25 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
+			// Only one worker across all replicas may mutate the resource at a time. The lease expires together with
+			// the message lock, so it is released automatically if this worker crashes. If another worker holds the
+			// lease, the message is requeued so that waiting for the lease doesn't count against the retry budget.
+			leases := lease.NewManager(asyncCtrl.DatabaseClient(), w.options.LeaseHolder)
+			resourceLease, err := leases.Acquire(reqCtx, op.ResourceID, w.getLeaseDuration(msgreq.NextVisibleAt))
+			if errors.Is(err, &lease.ErrHeld{}) {
+				opLogger.Info("resource is being processed by another worker, the message will be requeued.", "reason", err.Error())
+				w.requeueMessage(reqCtx, msgreq, w.options.LeaseRetryDelay)
+				return
+			} else if err != nil {
+				opLogger.Error(err, "failed to acquire the lease on the resource.")
+				return
+			}
+			defer func() {
+				if err := leases.Release(context.WithoutCancel(reqCtx), resourceLease); err != nil {
+					opLogger.Error(err, "failed to release the lease on the resource.")
+				}
+			}()
+
 			if msgreq.DequeueCount > w.options.MaxOperationRetryCount {
 				errMsg := fmt.Sprintf("exceeded max retry count to process async operation message: %d", msgreq.DequeueCount)
 				opLogger.Error(nil, errMsg)
@@ -196,9 +233,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			// TODO: Handle the edge cases:
-			// 1. The same message is delivered twice in multiple instances.
-			// 2. provisioningState is not matched between resource and operationStatuses
+			// TODO: Handle the edge case where provisioningState is not matched between resource and operationStatuses
 
 			dup, err := w.isDuplicated(reqCtx, op.ResourceID, op.OperationID)
 			if err != nil {
@@ -214,7 +249,7 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 				return
 			}
 
-			w.runOperation(reqCtx, msgreq, asyncCtrl)
+			w.runOperation(reqCtx, msgreq, asyncCtrl, leases, resourceLease)
 		}(msg)
 	}
 
@@ -222,7 +257,25 @@ func (w *AsyncRequestProcessWorker) Start(ctx context.Context) error {
 	return nil
 }
 
-func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller) {
+// requeueMessage enqueues a copy of the message, which becomes visible after the delay, and finishes the original
+// message. The copy starts with a new dequeue count. If the copy can't be enqueued, the original message is left in
+// the queue and is redelivered once its lock expires.
+func (w *AsyncRequestProcessWorker) requeueMessage(ctx context.Context, message *queue.Message, delay time.Duration) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if err := w.requestQueue.Enqueue(ctx, queue.NewMessage(message.Data), queue.WithVisibleAfter(delay)); err != nil {
+		logger.Error(err, "failed to requeue the message.")
+		return
+	}
+
+	if err := w.requestQueue.FinishMessage(ctx, message); err != nil {
+		logger.Error(err, "failed to finish the requeued message.")
+	}
+}
+
+// runOperation runs the controller for the message. The lease on the resource, if any, is renewed every time the
+// message lock is extended.
+func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *queue.Message, asyncCtrl ctrl.Controller, leases *lease.Manager, resourceLease *lease.Lease) {
 	ctx, span := trace.StartConsumerSpan(ctx, "worker.runOperation receive", trace.BackendTracerName)
 	defer span.End()
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -279,7 +332,11 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
-			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
+			if result.RequeueAfter > 0 && result.Error == nil {
+				w.requeueOperation(ctx, message, asyncReq, result.RequeueAfter, asyncCtrl.DatabaseClient())
+			} else {
+				w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
+			}
 		}
 		trace.SetAsyncResultStatus(result, span)
 	}()
@@ -295,6 +352,18 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			} else {
 				logger.Info("Extended message lock duration.", "nextVisibleTime", message.NextVisibleAt.UTC().String())
 				metrics.DefaultAsyncOperationMetrics.RecordExtendedAsyncOperation(ctx, asyncReq)
+
+				if resourceLease != nil {
+					err := leases.Renew(ctx, resourceLease, w.getLeaseDuration(message.NextVisibleAt))
+					if errors.Is(err, lease.ErrLost) {
+						// Another worker took over the resource, so this operation must stop mutating it.
+						logger.Error(err, "Cancelling async operation because the lease on the resource was lost.")
+						opCancel()
+						return
+					} else if err != nil {
+						logger.Error(err, "fails to renew the lease on the resource")
+					}
+				}
 			}
 			messageExtendAfter = w.getMessageExtendDuration(message.NextVisibleAt)
 
@@ -302,10 +371,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			logger.Info("Cancelling async operation.")
 
 			opCancel()
-			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
-			result := ctrl.NewCanceledResult(errMessage)
-			result.Error.Target = asyncReq.ResourceID
-			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
+			w.completeOperation(ctx, message, timedOutResult(asyncReq), asyncCtrl.DatabaseClient())
 			return
 
 		case <-ctx.Done():
@@ -323,6 +389,41 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// requeueOperation runs the operation again after the delay requested by the controller. The worker and the lease on
+// the resource are released while the operation waits, and waiting doesn't count against the retry budget. The
+// operation is canceled instead if it would run longer than its timeout.
+func (w *AsyncRequestProcessWorker) requeueOperation(ctx context.Context, message *queue.Message, asyncReq *ctrl.Request, delay time.Duration, sc database.Client) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	rID, err := resources.ParseResource(asyncReq.ResourceID)
+	if err != nil {
+		logger.Error(err, "failed to parse resource ID")
+		return
+	}
+
+	status, err := w.sm.Get(ctx, rID, asyncReq.OperationID)
+	if err != nil {
+		logger.Error(err, "failed to get operationstatus", "operationID", asyncReq.OperationID.String())
+		return
+	}
+
+	if time.Since(status.StartTime)+delay > asyncReq.Timeout() {
+		w.completeOperation(ctx, message, timedOutResult(asyncReq), sc)
+		return
+	}
+
+	logger.Info("Operation will be run again.", "requeueAfter", delay)
+	w.requeueMessage(ctx, message, delay)
+}
+
+// timedOutResult returns the result of an operation that has run longer than its timeout.
+func timedOutResult(asyncReq *ctrl.Request) ctrl.Result {
+	errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
+	result := ctrl.NewCanceledResult(errMessage)
+	result.Error.Target = asyncReq.ResourceID
+	return result
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -413,6 +514,23 @@ func (w *AsyncRequestProcessWorker) getMessageExtendDuration(visibleAt time.Time
 	return d
 }
 
+// getLeaseDuration returns the duration of the lease on the resource so that it expires together with the message lock.
+func (w *AsyncRequestProcessWorker) getLeaseDuration(visibleAt time.Time) time.Duration {
+	d := time.Until(visibleAt)
+	if d < w.options.MinMessageLockDuration {
+		return w.options.MinMessageLockDuration
+	}
+	return d
+}
+
+func defaultLeaseHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return uuid.NewString()
+	}
+	return hostname + "-" + uuid.NewString()
+}
+
 func updateResourceState(ctx context.Context, sc database.Client, id string, state v1.ProvisioningState) error {
 	obj, err := sc.Get(ctx, id)
 	if err != nil {
@@ -18,6 +18,7 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/components/database"
 	inmemorystore "github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/components/database/lease"
 	"github.com/radius-project/radius/pkg/components/queue"
 	"github.com/radius-project/radius/pkg/components/queue/inmemory"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
@@ -205,7 +207,9 @@ func TestStart_MaxDequeueCount(t *testing.T) {
 		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
-	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	// The lease on the resource is saved before the resource is marked as failed.
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	tCtx.mockSC.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Eq(v1.ProvisioningStateFailed), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	expectedDequeueCount := 2
@@ -266,6 +270,7 @@ func TestStart_MaxConcurrency(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSC.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
@@ -342,6 +347,7 @@ func TestStart_RunOperation(t *testing.T) {
 			return newTestResourceObject(), nil
 		}).AnyTimes()
 	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSC.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
 	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
@@ -401,6 +407,182 @@ func TestStart_RunOperation(t *testing.T) {
 	require.Equal(t, 1, testMessage.DequeueCount)
 }
 
+func TestStart_ConcurrentWorkersOnSameResource(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, 500*time.Millisecond)
+	defer mctrl.Finish()
+
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// The workers share the queue and the database in the same way as replicas do.
+	databaseClient := inmemorystore.NewClient()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	resource := newTestResourceObject()
+	resource.ID = resourceID
+	err := databaseClient.Save(tCtx.ctx, resource)
+	require.NoError(t, err)
+
+	opts := ctrl.Options{
+		DatabaseClient: databaseClient,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return nil
+		},
+	}
+
+	running := atomic.NewInt32(0)
+	maxRunning := atomic.NewInt32(0)
+	completed := atomic.NewInt32(0)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			cnt := running.Inc()
+			for {
+				current := maxRunning.Load()
+				if cnt <= current || maxRunning.CompareAndSwap(current, cnt) {
+					break
+				}
+			}
+			time.Sleep(100 * time.Millisecond)
+			running.Dec()
+			completed.Inc()
+			return ctrl.Result{}, nil
+		},
+	}
+
+	registry := NewControllerRegistry()
+	err = registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+
+	workerCnt := 2
+	done := make(chan error, workerCnt)
+	for i := range workerCnt {
+		worker := New(Options{
+			DequeueIntervalDuration: defaultTestDequeueInterval,
+			LeaseRetryDelay:         10 * time.Millisecond,
+			LeaseHolder:             fmt.Sprintf("worker-%d", i),
+		}, tCtx.mockSM, tCtx.testQueue, registry)
+
+		go func() {
+			done <- worker.Start(ctx)
+		}()
+	}
+
+	// Queue several operations on the same resource so that both workers pick them up at the same time.
+	testMessageCnt := 3
+	opTimeout := ctrl.DefaultAsyncOperationTimeout
+	for range testMessageCnt {
+		testMessage := queue.NewMessage(&ctrl.Request{
+			OperationID:      uuid.New(),
+			OperationType:    "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			ResourceID:       resourceID,
+			CorrelationID:    uuid.NewString(),
+			OperationTimeout: &opTimeout,
+		})
+		err = tCtx.testQueue.Enqueue(ctx, testMessage)
+		require.NoError(t, err)
+	}
+
+	tCtx.drainQueueOrAssert(t)
+
+	// Cancelling worker loops.
+	cancel()
+	for range workerCnt {
+		require.NoError(t, <-done)
+	}
+
+	require.Equal(t, int32(testMessageCnt), completed.Load())
+	require.Equal(t, int32(1), maxRunning.Load(), "operations on the same resource must not run concurrently")
+
+	// The lease is released once all operations are done.
+	require.Eventually(t, func() bool {
+		_, err := lease.NewManager(databaseClient, "test").Acquire(tCtx.ctx, resourceID, time.Minute)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestStart_WaitingForLeaseDoesNotConsumeRetries(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, 2*time.Second)
+	defer mctrl.Finish()
+
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	databaseClient := inmemorystore.NewClient()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0"
+	resource := newTestResourceObject()
+	resource.ID = resourceID
+	err := databaseClient.Save(tCtx.ctx, resource)
+	require.NoError(t, err)
+
+	opts := ctrl.Options{
+		DatabaseClient: databaseClient,
+		GetDeploymentProcessor: func() deployment.DeploymentProcessor {
+			return nil
+		},
+	}
+
+	// While the first operation runs, the second operation on the same resource waits for the lease more times
+	// than the retry count allows.
+	completed := atomic.NewInt32(0)
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			if completed.Load() == 0 {
+				time.Sleep(300 * time.Millisecond)
+			}
+			completed.Inc()
+			return ctrl.Result{}, nil
+		},
+	}
+
+	registry := NewControllerRegistry()
+	err = registry.Register(
+		testResourceType, v1.OperationPut,
+		func(opts ctrl.Options) (ctrl.Controller, error) {
+			return testCtrl, nil
+		}, opts)
+	require.NoError(t, err)
+
+	ctx, cancel := tCtx.cancellable(time.Duration(0))
+
+	worker := New(Options{
+		DequeueIntervalDuration: defaultTestDequeueInterval,
+		MaxOperationRetryCount:  1,
+		LeaseRetryDelay:         10 * time.Millisecond,
+	}, tCtx.mockSM, tCtx.testQueue, registry)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- worker.Start(ctx)
+	}()
+
+	opTimeout := ctrl.DefaultAsyncOperationTimeout
+	for range 2 {
+		testMessage := queue.NewMessage(&ctrl.Request{
+			OperationID:      uuid.New(),
+			OperationType:    "APPLICATIONS.CORE/ENVIRONMENTS|PUT",
+			ResourceID:       resourceID,
+			CorrelationID:    uuid.NewString(),
+			OperationTimeout: &opTimeout,
+		})
+		err = tCtx.testQueue.Enqueue(ctx, testMessage)
+		require.NoError(t, err)
+	}
+
+	tCtx.drainQueueOrAssert(t)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	require.Equal(t, int32(2), completed.Load(), "the operation waiting for the lease must run")
+}
+
 func TestRunOperation_Successfully(t *testing.T) {
 	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
 	defer mctrl.Finish()
@@ -433,7 +615,7 @@ func TestRunOperation_Successfully(t *testing.T) {
 
 	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
 	require.NoError(t, err)
-	worker.runOperation(context.Background(), msg, testCtrl)
+	worker.runOperation(context.Background(), msg, testCtrl, nil, nil)
 
 	// Ensure that message is finished.
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
@@ -479,10 +661,19 @@ func TestRunOperation_ExtendMessageLock(t *testing.T) {
 	old := msg.NextVisibleAt
 	require.NoError(t, err)
 
-	worker.runOperation(context.Background(), msg, testCtrl)
+	req := &ctrl.Request{}
+	require.NoError(t, json.Unmarshal(msg.Data, req))
+
+	leases := lease.NewManager(inmemorystore.NewClient(), "test-worker")
+	resourceLease, err := leases.Acquire(tCtx.ctx, req.ResourceID, worker.getLeaseDuration(msg.NextVisibleAt))
+	require.NoError(t, err)
+	oldExpiry := resourceLease.ExpiresAt
+
+	worker.runOperation(context.Background(), msg, testCtrl, leases, resourceLease)
 
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 	require.Greater(t, msg.NextVisibleAt.UnixNano(), old.UnixNano(), "message lock is extended")
+	require.Greater(t, resourceLease.ExpiresAt.UnixNano(), oldExpiry.UnixNano(), "lease is renewed")
 }
 
 func TestRunOperation_CancelContext(t *testing.T) {
@@ -522,7 +713,7 @@ func TestRunOperation_CancelContext(t *testing.T) {
 	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
 	require.NoError(t, err)
 
-	worker.runOperation(ctx, msg, testCtrl)
+	worker.runOperation(ctx, msg, testCtrl, nil, nil)
 
 	<-done
 	cancel()
@@ -573,12 +764,79 @@ func TestRunOperation_Timeout(t *testing.T) {
 
 	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
 	require.NoError(t, err)
-	worker.runOperation(context.Background(), msg, testCtrl)
+	worker.runOperation(context.Background(), msg, testCtrl, nil, nil)
 	<-done
 
 	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
 }
 
+func TestRunOperation_RequeueAfter(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	// The operation is neither completed nor failed while it waits.
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(testOperationStatus, nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewRequeueAfterResult(time.Minute), nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl, nil, nil)
+
+	// The message is replaced by a copy that becomes visible after the delay, with a new dequeue count.
+	require.Equal(t, 1, tCtx.internalQ.Len(), "message is requeued")
+	_, err = tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.ErrorIs(t, err, queue.ErrMessageNotFound, "requeued message is not visible yet")
+}
+
+func TestRunOperation_RequeueAfterTimeout(t *testing.T) {
+	tCtx, mctrl := newTestContext(t, defaultTestLockTime)
+	defer mctrl.Finish()
+
+	startedStatus := &manager.Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			Status:    v1.ProvisioningStateUpdating,
+			StartTime: time.Now().UTC().Add(-time.Hour),
+		},
+	}
+
+	tCtx.mockSC.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return newTestResourceObject(), nil
+		}).AnyTimes()
+	tCtx.mockSC.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	tCtx.mockSM.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(startedStatus, nil).Times(1)
+	tCtx.mockSM.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), v1.ProvisioningStateCanceled, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	testMessage := genTestMessage(uuid.New(), ctrl.DefaultAsyncOperationTimeout)
+	err := tCtx.testQueue.Enqueue(tCtx.ctx, testMessage)
+	require.NoError(t, err)
+	worker := New(Options{}, tCtx.mockSM, tCtx.testQueue, nil)
+
+	testCtrl := &testAsyncController{
+		BaseController: ctrl.NewBaseAsyncController(ctrl.Options{DatabaseClient: tCtx.mockSC}),
+		fn: func(ctx context.Context) (ctrl.Result, error) {
+			return ctrl.NewRequeueAfterResult(time.Minute), nil
+		},
+	}
+
+	msg, err := tCtx.testQueue.Dequeue(tCtx.ctx, queue.QueueClientConfig{})
+	require.NoError(t, err)
+	worker.runOperation(context.Background(), msg, testCtrl, nil, nil)
+
+	require.Equal(t, 0, tCtx.internalQ.Len(), "message is finished")
+}
+
 func TestRunOperation_PanicController(t *testing.T) {
 	tCtx, _ := newTestContext(t, defaultTestLockTime)
 
@@ -606,7 +864,7 @@ func TestRunOperation_PanicController(t *testing.T) {
 	require.NoError(t, err)
 
 	require.NotPanics(t, func() {
-		worker.runOperation(tCtx.ctx, msg, testCtrl)
+		worker.runOperation(tCtx.ctx, msg, testCtrl, nil, nil)
 	})
 
 	require.Equal(t, 1, tCtx.internalQ.Len(), "ensure that message is not finished")
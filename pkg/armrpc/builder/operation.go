@@ -76,6 +76,10 @@ type ResourceOption[P interface {
 	// ResponseConverter is the response converter.
 	ResponseConverter v1.ConvertToAPIModel[T]
 
+	// PatchBaseConverter converts the existing resource to the versioned model that PATCH requests are merged into.
+	// Set this when ResponseConverter omits write-only properties such as secrets. This is optional.
+	PatchBaseConverter v1.ConvertToAPIModel[T]
+
 	// ListPlane defines the operation for listing resources by plane scope.
 	ListPlane Operation[T]
 
@@ -288,6 +292,7 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 		ro := controller.ResourceOptions[T]{
 			RequestConverter:         r.RequestConverter,
 			ResponseConverter:        r.ResponseConverter,
+			PatchBaseConverter:       r.PatchBaseConverter,
 			UpdateFilters:            r.Patch.UpdateFilters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Patch.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Patch.AsyncOperationRetryAfter),
@@ -295,11 +300,11 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 
 		if r.Patch.AsyncJobController == nil {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultSyncPatch[P, T](opt, ro)
 			}
 		} else {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultAsyncPatch[P, T](opt, ro)
 			}
 		}
 	}
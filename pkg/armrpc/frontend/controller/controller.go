@@ -100,6 +100,11 @@ type ResourceOptions[T any] struct {
 	// ResponseConverter is the response converter.
 	ResponseConverter v1.ConvertToAPIModel[T]
 
+	// PatchBaseConverter converts the existing resource to the versioned model that a PATCH request is merged into.
+	// It must keep the write-only properties, such as secrets, that ResponseConverter omits, otherwise a PATCH
+	// request that doesn't mention them removes them from the stored resource. ResponseConverter is used if nil.
+	PatchBaseConverter v1.ConvertToAPIModel[T]
+
 	// DeleteFilters is a slice of filters that execute prior to deleting a resource.
 	DeleteFilters []DeleteFilter[T]
 
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// MergePatchContentType is the content type of a JSON merge patch document (RFC 7396).
+	MergePatchContentType = "application/merge-patch+json"
+)
+
+// readOnlyMergePatchFields are the top-level fields of a resource that are never merged from the existing resource,
+// because they are owned by the server.
+var readOnlyMergePatchFields = []string{"systemData"}
+
+// readOnlyMergePatchProperties are the fields of a resource's properties that are never merged from the existing
+// resource, because they are owned by the server.
+var readOnlyMergePatchProperties = []string{"provisioningState", "status"}
+
+// ApplyMergePatch applies the JSON merge patch document to the original JSON document as described in RFC 7396 and
+// returns the merged document.
+//
+// Objects in the patch are merged recursively, null values remove the corresponding field and every other value
+// (including arrays) replaces the original value.
+func ApplyMergePatch(original []byte, patch []byte) ([]byte, error) {
+	var target any
+	if len(original) > 0 {
+		if err := json.Unmarshal(original, &target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal original document: %w", err)
+		}
+	}
+
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal merge patch document: %w", err)
+	}
+
+	return json.Marshal(mergePatch(target, p))
+}
+
+func mergePatch(target any, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+
+	for key, value := range p {
+		if value == nil {
+			delete(t, key)
+			continue
+		}
+
+		t[key] = mergePatch(t[key], value)
+	}
+
+	return t
+}
+
+// removeReadOnlyFields removes the server-owned fields from the JSON representation of a resource so that a merge
+// patch produces the same document as an equivalent PUT request.
+func removeReadOnlyFields(resource map[string]any) {
+	for _, field := range readOnlyMergePatchFields {
+		delete(resource, field)
+	}
+
+	properties, ok := resource["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, field := range readOnlyMergePatchProperties {
+		delete(properties, field)
+	}
+}
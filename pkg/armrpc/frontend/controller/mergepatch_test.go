@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		patch    string
+		expected string
+	}{
+		{
+			name:     "replace value",
+			original: `{"a":"b"}`,
+			patch:    `{"a":"c"}`,
+			expected: `{"a":"c"}`,
+		},
+		{
+			name:     "add value",
+			original: `{"a":"b"}`,
+			patch:    `{"b":"c"}`,
+			expected: `{"a":"b","b":"c"}`,
+		},
+		{
+			name:     "remove value",
+			original: `{"a":"b","b":"c"}`,
+			patch:    `{"a":null}`,
+			expected: `{"b":"c"}`,
+		},
+		{
+			name:     "merge nested object",
+			original: `{"properties":{"a":"b","c":{"d":"e","f":"g"}}}`,
+			patch:    `{"properties":{"c":{"f":null,"h":"i"}}}`,
+			expected: `{"properties":{"a":"b","c":{"d":"e","h":"i"}}}`,
+		},
+		{
+			name:     "replace array",
+			original: `{"a":[{"b":"c"}]}`,
+			patch:    `{"a":[1]}`,
+			expected: `{"a":[1]}`,
+		},
+		{
+			name:     "replace scalar with object",
+			original: `{"a":"b"}`,
+			patch:    `{"a":{"b":"c","d":null}}`,
+			expected: `{"a":{"b":"c"}}`,
+		},
+		{
+			name:     "non-object patch replaces document",
+			original: `{"a":"b"}`,
+			patch:    `["c"]`,
+			expected: `["c"]`,
+		},
+		{
+			name:     "empty original",
+			original: ``,
+			patch:    `{"a":"b"}`,
+			expected: `{"a":"b"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := ApplyMergePatch([]byte(tt.original), []byte(tt.patch))
+			require.NoError(t, err)
+			require.JSONEq(t, tt.expected, string(merged))
+		})
+	}
+
+	t.Run("invalid patch", func(t *testing.T) {
+		_, err := ApplyMergePatch([]byte(`{}`), []byte(`{`))
+		require.Error(t, err)
+	})
+}
+
+func TestRemoveReadOnlyFields(t *testing.T) {
+	resource := map[string]any{
+		"name":       "resource0",
+		"systemData": map[string]any{"createdBy": "fake"},
+		"properties": map[string]any{
+			"provisioningState": "Succeeded",
+			"status":            map[string]any{"outputResources": []any{}},
+			"application":       "app0",
+		},
+	}
+
+	removeReadOnlyFields(resource)
+
+	require.Equal(t, map[string]any{
+		"name":       "resource0",
+		"properties": map[string]any{"application": "app0"},
+	}, resource)
+}
@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -89,6 +90,57 @@ func (c *Operation[P, T]) GetResourceFromRequest(ctx context.Context, req *http.
 	return dm, nil
 }
 
+// GetResourceFromMergePatch is the helper to apply the JSON merge patch document (RFC 7396) in the request body to the
+// existing resource. The existing resource is converted to the versioned model of the request by PatchBaseConverter (or
+// ResponseConverter), server-owned fields are removed, and the merged document is converted back to the datamodel, so
+// the result is validated by the same request converter as a PUT request.
+func (c *Operation[P, T]) GetResourceFromMergePatch(ctx context.Context, req *http.Request, oldResource *T) (*T, error) {
+	patch, err := ReadMergePatchBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(patch, &document); err != nil || document == nil {
+		return nil, v1.NewClientErrInvalidRequest("the request body must be a JSON merge patch object")
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	convert := c.resourceOptions.PatchBaseConverter
+	if convert == nil {
+		convert = c.resourceOptions.ResponseConverter
+	}
+
+	versioned, err := convert(oldResource, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	original := map[string]any{}
+	if err := json.Unmarshal(b, &original); err != nil {
+		return nil, err
+	}
+	removeReadOnlyFields(original)
+
+	b, err = json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := ApplyMergePatch(b, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.resourceOptions.RequestConverter(merged, serviceCtx.APIVersion)
+}
+
 // GetResource is the helper to get the resource via database client.
 func (c *Operation[P, T]) GetResource(ctx context.Context, id resources.ID) (out *T, etag string, err error) {
 	etag = ""
@@ -251,3 +303,8 @@ func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	}
 	return b.resourceOptions.AsyncOperationTimeout
 }
+
+// AsyncOperationRetryAfter returns the value of the Retry-After header of async responses, or 0 to use the default.
+func (b *Operation[P, T]) AsyncOperationRetryAfter() time.Duration {
+	return b.resourceOptions.AsyncOperationRetryAfter
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -52,6 +53,17 @@ var (
 // is "application/json". It returns the body as a byte array or an error if the content type is not supported
 // or an error occurs while reading the body.
 func ReadJSONBody(r *http.Request) ([]byte, error) {
+	return readBody(r, "application/json")
+}
+
+// ReadMergePatchBody extracts the content from a PATCH request - it reads the body of the request if the content type
+// is "application/merge-patch+json" or "application/json". It returns the body as a byte array or an error if the
+// content type is not supported or an error occurs while reading the body.
+func ReadMergePatchBody(r *http.Request) ([]byte, error) {
+	return readBody(r, MergePatchContentType, "application/json")
+}
+
+func readBody(r *http.Request, contentTypes ...string) ([]byte, error) {
 	defer r.Body.Close()
 
 	contentType := strings.ToLower(strings.TrimSpace(r.Header.Get(ContentTypeHeaderKey)))
@@ -59,7 +71,7 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 		contentType = contentType[0:i]
 	}
 
-	if contentType != "application/json" {
+	if !slices.Contains(contentTypes, strings.TrimSpace(contentType)) {
 		return nil, ErrUnsupportedContentType
 	}
 	data, err := io.ReadAll(r.Body)
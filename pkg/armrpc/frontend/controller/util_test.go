@@ -65,6 +65,42 @@ func TestReadJSONBody(t *testing.T) {
 	}
 }
 
+func TestReadMergePatchBody(t *testing.T) {
+	content, err := json.Marshal(map[string]any{
+		"properties": map[string]any{"propertyA": nil},
+	})
+	require.NoError(t, err)
+
+	contentTypeTests := []struct {
+		contentType string
+		body        []byte
+		err         error
+	}{
+		{"application/merge-patch+json", content, nil},
+		{"application/merge-patch+json; charset=utf8", content, nil},
+		{"application/json", content, nil},
+		{"application/json-patch+json", content, ErrUnsupportedContentType},
+		{"plain/text", content, ErrUnsupportedContentType},
+	}
+
+	for _, tc := range contentTypeTests {
+		t.Run(tc.contentType, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, "http://github.com", bytes.NewBuffer(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+			// act
+			parsed, err := ReadMergePatchBody(req)
+			// assert
+			if tc.err != nil {
+				require.ErrorIs(t, tc.err, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(tc.body), string(parsed))
+			}
+		})
+	}
+}
+
 var tag string = uuid.New().String()
 
 func TestValidateEtag_IfMatch(t *testing.T) {
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDefaultAsyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc      string
+		patch     any
+		getErr    error
+		state     v1.ProvisioningState
+		saveErr   error
+		skipSave  bool
+		rCode     int
+		rErr      error
+		propertyA string
+		propertyB string
+	}{
+		{
+			desc:      "async-patch-update-property",
+			patch:     map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			rCode:     http.StatusAccepted,
+			propertyA: "newValue",
+			propertyB: "propertyBValue",
+		},
+		{
+			desc:     "async-patch-not-existing-resource",
+			patch:    map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			getErr:   &database.ErrNotFound{},
+			skipSave: true,
+			rCode:    http.StatusNotFound,
+		},
+		{
+			desc:     "async-patch-filter-failure",
+			patch:    map[string]any{"properties": map[string]any{"application": "app1"}},
+			skipSave: true,
+			rCode:    http.StatusBadRequest,
+		},
+		{
+			desc:     "async-patch-in-progress-resource",
+			patch:    map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			state:    v1.ProvisioningStateUpdating,
+			skipSave: true,
+			rCode:    http.StatusConflict,
+		},
+		{
+			desc:    "async-patch-concurrency-error",
+			patch:   map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			saveErr: &database.ErrConcurrency{},
+			rErr:    &database.ErrConcurrency{},
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			_, reqDataModel, _ := loadTestResurce()
+			if tt.state != "" {
+				reqDataModel.InternalMetadata.AsyncProvisioningState = tt.state
+			}
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, tt.patch)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", ctrl.MergePatchContentType)
+
+			ctx := rpctest.NewARMRequestContext(req)
+			sCtx := v1.ARMRequestContextFromContext(ctx)
+
+			var asyncOperationTimeout = 1*time.Second + 1*time.Millisecond
+			var asyncOperationRetryAfter = 2*time.Second + 2*time.Millisecond
+
+			so := &database.Object{
+				Metadata: database.Metadata{ID: sCtx.ResourceID.String(), ETag: "existing-etag"},
+				Data:     reqDataModel,
+			}
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(so, tt.getErr).
+				Times(1)
+
+			var saved *TestResourceDataModel
+			if !tt.skipSave {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						saved = obj.Data.(*TestResourceDataModel)
+						return tt.saveErr
+					}).
+					Times(1)
+
+				if tt.saveErr == nil {
+					expectedOptions := statusmanager.QueueOperationOptions{
+						OperationTimeout: asyncOperationTimeout,
+						RetryAfter:       asyncOperationRetryAfter,
+					}
+
+					msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), expectedOptions).
+						Return(nil).
+						Times(1)
+				}
+			}
+
+			opts := ctrl.Options{
+				DatabaseClient: mds,
+				StatusManager:  msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+				AsyncOperationTimeout:    asyncOperationTimeout,
+				AsyncOperationRetryAfter: asyncOperationRetryAfter,
+			}
+
+			ctl, err := NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.rErr != nil {
+				require.ErrorIs(t, tt.rErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.rCode == http.StatusAccepted {
+				require.NotNil(t, saved)
+				require.Equal(t, tt.propertyA, saved.Properties.PropertyA)
+				require.Equal(t, tt.propertyB, saved.Properties.PropertyB)
+				require.Equal(t, v1.ProvisioningStateAccepted, saved.InternalMetadata.AsyncProvisioningState)
+
+				locationHeader := getAsyncLocationPath(sCtx, reqDataModel.TrackedResource.Location, "operationResults", req)
+				require.Equal(t, locationHeader, w.Header().Get("Location"))
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+// DefaultSyncPatch is the controller implementation to update resource synchronously with a JSON merge patch (RFC 7396).
+type DefaultSyncPatch[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any] struct {
+	ctrl.Operation[P, T]
+}
+
+// NewDefaultSyncPatch creates a new DefaultSyncPatch.
+func NewDefaultSyncPatch[P interface {
+	*T
+	v1.ResourceDataModel
+}, T any](opts ctrl.Options, resourceOpts ctrl.ResourceOptions[T]) (ctrl.Controller, error) {
+	return &DefaultSyncPatch[P, T]{ctrl.NewOperation[P](opts, resourceOpts)}, nil
+}
+
+// Run executes synchronous update operation by merging the patch in the request body into the existing resource,
+// validating the merged resource, running custom update filters, and upserting resource metadata and returns the
+// resource as a response. The merged resource is saved with the ETag of the existing resource, so concurrent updates
+// fail instead of being lost.
+func (e *DefaultSyncPatch[P, T]) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	newResource, err := e.GetResourceFromMergePatch(ctx, req, old)
+	if err != nil {
+		return nil, err
+	}
+
+	if r, err := e.PrepareResource(ctx, req, newResource, old, etag); r != nil || err != nil {
+		return r, err
+	}
+
+	for _, filter := range e.UpdateFilters() {
+		if resp, err := filter(ctx, newResource, old, e.Options()); resp != nil || err != nil {
+			return resp, err
+		}
+	}
+
+	P(newResource).SetProvisioningState(v1.ProvisioningStateSucceeded)
+	newEtag, err := e.SaveResource(ctx, serviceCtx.ResourceID.String(), newResource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.ConstructSyncResponse(ctx, req.Method, newEtag, newResource)
+}
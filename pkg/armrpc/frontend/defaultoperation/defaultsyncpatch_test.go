@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDefaultSyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc     string
+		patch    any
+		ifMatch  string
+		getErr   error
+		saveErr  error
+		rCode    int
+		rErr     error
+		expected *TestResourceDataModelProperties
+	}{
+		{
+			desc:  "patch-update-property",
+			patch: map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			rCode: http.StatusOK,
+			expected: &TestResourceDataModelProperties{
+				Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0",
+				Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/environments/env0",
+				PropertyA:   "newValue",
+				PropertyB:   "propertyBValue",
+			},
+		},
+		{
+			desc:  "patch-remove-property",
+			patch: map[string]any{"properties": map[string]any{"propertyB": nil}},
+			rCode: http.StatusOK,
+			expected: &TestResourceDataModelProperties{
+				Application: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app0",
+				Environment: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/environments/env0",
+				PropertyA:   "propertyAValue",
+			},
+		},
+		{
+			desc:   "patch-not-existing-resource",
+			patch:  map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			getErr: &database.ErrNotFound{},
+			rCode:  http.StatusNotFound,
+		},
+		{
+			desc:  "patch-filter-failure",
+			patch: map[string]any{"properties": map[string]any{"application": "app1"}},
+			rCode: http.StatusBadRequest,
+		},
+		{
+			desc:    "patch-etag-mismatch",
+			patch:   map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			ifMatch: "mismatched-etag",
+			rCode:   http.StatusPreconditionFailed,
+		},
+		{
+			desc:  "patch-invalid-document",
+			patch: []string{"propertyA"},
+			rErr:  v1.NewClientErrInvalidRequest("the request body must be a JSON merge patch object"),
+		},
+		{
+			desc:    "patch-concurrency-error",
+			patch:   map[string]any{"properties": map[string]any{"propertyA": "newValue"}},
+			saveErr: &database.ErrConcurrency{},
+			rErr:    &database.ErrConcurrency{},
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, _ := setupTest(t)
+			defer teardownTest(t)
+
+			_, reqDataModel, _ := loadTestResurce()
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, resourceTestHeaderFile, tt.patch)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", ctrl.MergePatchContentType)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+
+			ctx := rpctest.NewARMRequestContext(req)
+
+			so := &database.Object{
+				Metadata: database.Metadata{ID: reqDataModel.ID, ETag: "existing-etag"},
+				Data:     reqDataModel,
+			}
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(so, tt.getErr).
+				Times(1)
+
+			var saved *TestResourceDataModel
+			if tt.expected != nil || tt.saveErr != nil {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						saved = obj.Data.(*TestResourceDataModel)
+						obj.ETag = "new-etag"
+						return tt.saveErr
+					}).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				DatabaseClient: mds,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultSyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			if tt.rErr != nil {
+				require.Equal(t, tt.rErr, err)
+				return
+			}
+
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.expected != nil {
+				require.NotNil(t, saved)
+				require.Equal(t, tt.expected, saved.Properties)
+				require.Equal(t, v1.ProvisioningStateSucceeded, saved.InternalMetadata.AsyncProvisioningState)
+				require.Equal(t, "new-etag", w.Header().Get("ETag"))
+			}
+		})
+	}
+}
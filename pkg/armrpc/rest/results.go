@@ -621,6 +621,45 @@ func (r *ConflictResponse) Apply(ctx context.Context, w http.ResponseWriter, req
 	return nil
 }
 
+// BadGatewayResponse represents an HTTP 502 with an ARM error payload.
+//
+// This is used when an upstream service, such as the webhook of a custom action, fails or returns an invalid response.
+type BadGatewayResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewBadGatewayResponse creates a BadGatewayResponse with a given error message.
+func NewBadGatewayResponse(message string) Response {
+	return &BadGatewayResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeBadGateway,
+				Message: message,
+			},
+		},
+	}
+}
+
+// Apply renders 502 Bad Gateway HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *BadGatewayResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusBadGateway), logging.LogHTTPStatusCode, http.StatusBadGateway)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadGateway)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 type InternalServerErrorResponse struct {
 	Body v1.ErrorResponse
 }
@@ -107,12 +107,16 @@ type DiagnosticsClient interface {
 	Expose(ctx context.Context, options ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error)
 	Logs(ctx context.Context, options LogsOptions) ([]LogStream, error)
 	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
+	Exec(ctx context.Context, options ExecOptions) error
 }
 
 type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
 	Gateways      []GatewayStatus
+
+	// PolicyViolations lists the violations of audit-mode policies recorded on the application's resources.
+	PolicyViolations []PolicyViolationStatus
 }
 
 type GatewayStatus struct {
@@ -120,6 +124,12 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+type PolicyViolationStatus struct {
+	Resource string
+	Policy   string
+	Message  string
+}
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
@@ -133,11 +143,26 @@ type ExposeOptions struct {
 }
 
 type LogsOptions struct {
+	Application  string
+	ResourceType string
+	Resource     string
+	Follow       bool
+	Container    string
+	Replica      string
+}
+
+type ExecOptions struct {
 	Application string
 	Resource    string
-	Follow      bool
-	Container   string
 	Replica     string
+	Container   string
+	Command     []string
+	Stdin       io.Reader
+	Stdout      io.Writer
+	Stderr      io.Writer
+
+	// TTY allocates a terminal for the command. Stdin and Stdout must be the terminal of the user.
+	TTY bool
 }
 
 type LogStream struct {
@@ -176,6 +201,9 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResource creates or updates a resource using its type name (or id).
 	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error)
 
+	// UpdateResource applies a JSON merge patch to an existing resource using its type name (or id).
+	UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch *generated.GenericResource) (generated.GenericResource, error)
+
 	// DeleteResource deletes a resource by its type and name (or id).
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error)
 
@@ -272,8 +300,9 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResourceType creates or updates a resource type in the configured plane.
 	CreateOrUpdateResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string, resource *ucp_v20231001preview.ResourceTypeResource) (ucp_v20231001preview.ResourceTypeResource, error)
 
-	// DeleteResourceType deletes a resource type in the configured plane.
-	DeleteResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string) (bool, error)
+	// DeleteResourceType deletes a resource type in the configured plane. Deleting a resource type that is still used
+	// by resources fails unless force is true.
+	DeleteResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string, force bool) (bool, error)
 
 	// ListAllResourceTypesNames lists the names of all resource types in the configured plane.
 	ListAllResourceTypesNames(ctx context.Context, planeName string) ([]string, error)
@@ -177,6 +177,37 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.C
 	return response.GenericResource, nil
 }
 
+// UpdateResource applies a JSON merge patch to an existing resource using its type name (or id). Properties set to
+// nil in the patch are removed from the resource.
+func (amc *UCPApplicationsManagementClient) UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch *generated.GenericResource) (generated.GenericResource, error) {
+	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	client, err := amc.getGenericClient(scope, resourceType, apiVersions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	poller, err := client.BeginUpdate(ctx, name, *patch, &generated.GenericResourcesClientBeginUpdateOptions{})
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	response, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return response.GenericResource, nil
+}
+
 // DeleteResource deletes a resource by its type and name (or id).
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
@@ -1135,8 +1166,9 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResourceType(ctx conte
 	return response.ResourceTypeResource, nil
 }
 
-// DeleteResourceType deletes a resource type in the configured plane.
-func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string) (bool, error) {
+// DeleteResourceType deletes a resource type in the configured plane. Deleting a resource type that is still used by
+// resources fails unless force is true.
+func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, force bool) (bool, error) {
 	client, err := amc.createResourceTypeClient()
 	if err != nil {
 		return false, err
@@ -1145,7 +1177,12 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Conte
 	var response *http.Response
 	ctx = amc.captureResponse(ctx, &response)
 
-	poller, err := client.BeginDelete(ctx, planeName, resourceProviderName, resourceTypeName, &ucpv20231001.ResourceTypesClientBeginDeleteOptions{})
+	options := &ucpv20231001.ResourceTypesClientBeginDeleteOptions{}
+	if force {
+		options.Force = &force
+	}
+
+	poller, err := client.BeginDelete(ctx, planeName, resourceProviderName, resourceTypeName, options)
 	if err != nil {
 		return false, err
 	}
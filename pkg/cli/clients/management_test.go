@@ -633,6 +633,43 @@ func Test_Resource(t *testing.T) {
 		require.Equal(t, expectedResource, response)
 	})
 
+	t.Run("UpdateResource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		client := createClient(mock)
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return resourceProviderMock, nil
+		}
+		expectedResourceSummary := ucp.ResourceProviderSummary{
+			Name: new("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResourceSummary}, nil)
+
+		patch := generated.GenericResource{
+			Properties: map[string]any{
+				"message": "hello",
+			},
+		}
+
+		mock.EXPECT().
+			BeginUpdate(gomock.Any(), testResourceName, patch, gomock.Any()).
+			Return(poller(&generated.GenericResourcesClientUpdateResponse{GenericResource: expectedResource}), nil)
+
+		response, err := client.UpdateResource(context.Background(), testResourceType, testResourceID, &patch)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, response)
+	})
+
 	t.Run("DeleteResource", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		mock := NewMockgenericResourceClient(ctrl)
@@ -2130,7 +2167,7 @@ func Test_ResourceType(t *testing.T) {
 				return poller(&ucp.ResourceTypesClientDeleteResponse{}), nil
 			})
 
-		deleted, err := client.DeleteResourceType(context.Background(), "local", testResourceProviderName, testResourceTypeName)
+		deleted, err := client.DeleteResourceType(context.Background(), "local", testResourceProviderName, testResourceTypeName, false)
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
@@ -625,18 +625,18 @@ func (c *MockApplicationsManagementClientDeleteResourceProviderCall) DoAndReturn
 }
 
 // DeleteResourceType mocks base method.
-func (m *MockApplicationsManagementClient) DeleteResourceType(arg0 context.Context, arg1, arg2, arg3 string) (bool, error) {
+func (m *MockApplicationsManagementClient) DeleteResourceType(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteResourceType", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "DeleteResourceType", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteResourceType indicates an expected call of DeleteResourceType.
-func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceType(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceType(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceType), arg0, arg1, arg2, arg3)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceType), arg0, arg1, arg2, arg3, arg4)
 	return &MockApplicationsManagementClientDeleteResourceTypeCall{Call: call}
 }
 
@@ -652,13 +652,13 @@ func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Return(arg0 boo
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Do(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Do(f func(context.Context, string, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationsManagementClientDeleteResourceTypeCall) DoAndReturn(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (c *MockApplicationsManagementClientDeleteResourceTypeCall) DoAndReturn(f func(context.Context, string, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1715,3 +1715,42 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateResource mocks base method.
+func (m *MockApplicationsManagementClient) UpdateResource(arg0 context.Context, arg1, arg2 string, arg3 *generated.GenericResource) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockApplicationsManagementClientMockRecorder) UpdateResource(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientUpdateResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).UpdateResource), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientUpdateResourceCall{Call: call}
+}
+
+// MockApplicationsManagementClientUpdateResourceCall wrap *gomock.Call
+type MockApplicationsManagementClientUpdateResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientUpdateResourceCall) Return(arg0 generated.GenericResource, arg1 error) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientUpdateResourceCall) Do(f func(context.Context, string, string, *generated.GenericResource) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientUpdateResourceCall) DoAndReturn(f func(context.Context, string, string, *generated.GenericResource) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -353,3 +353,78 @@ func (client *GenericResourcesClient) listSecretsHandleResponse(resp *http.Respo
 	}
 	return result, nil
 }
+
+// BeginUpdate - Updates an existing Generic resource with a JSON merge patch
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - resourceName - The name of the generic resource
+//   - genericResourceParameters - generic resource merge patch parameters
+//   - options - GenericResourcesClientBeginUpdateOptions contains the optional parameters for the GenericResourcesClient.BeginUpdate
+//     method.
+func (client *GenericResourcesClient) BeginUpdate(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientBeginUpdateOptions) (*runtime.Poller[GenericResourcesClientUpdateResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.update(ctx, resourceName, genericResourceParameters, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[GenericResourcesClientUpdateResponse]{
+			FinalStateVia: runtime.FinalStateViaAzureAsyncOp,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[GenericResourcesClientUpdateResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// Update - Updates an existing Generic resource with a JSON merge patch
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *GenericResourcesClient) update(ctx context.Context, resourceName string, genericResourceParameters GenericResource, options *GenericResourcesClientBeginUpdateOptions) (*http.Response, error) {
+	var err error
+	const operationName = "GenericResourcesClient.BeginUpdate"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.updateCreateRequest(ctx, resourceName, genericResourceParameters, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// updateCreateRequest creates the Update request.
+func (client *GenericResourcesClient) updateCreateRequest(ctx context.Context, resourceName string, genericResourceParameters GenericResource, _ *GenericResourcesClientBeginUpdateOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, genericResourceParameters); err != nil {
+		return nil, err
+	}
+	req.Raw().Header["Content-Type"] = []string{"application/merge-patch+json"}
+	return req, nil
+}
@@ -32,3 +32,9 @@ type GenericResourcesClientListByRootScopeOptions struct {
 type GenericResourcesClientListSecretsOptions struct {
 	// placeholder for future optional parameters
 }
+
+// GenericResourcesClientBeginUpdateOptions contains the optional parameters for the GenericResourcesClient.BeginUpdate method.
+type GenericResourcesClientBeginUpdateOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
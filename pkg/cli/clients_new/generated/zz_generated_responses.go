@@ -32,3 +32,9 @@ type GenericResourcesClientListSecretsResponse struct {
 	// Response to a list secrets request
 	Value map[string]*string
 }
+
+// GenericResourcesClientUpdateResponse contains the response from method GenericResourcesClient.BeginUpdate.
+type GenericResourcesClientUpdateResponse struct {
+	// Generic resource
+	GenericResource
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	resource_exec "github.com/radius-project/radius/pkg/cli/cmd/resource/exec"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad app exec` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "exec [container] [-- command]",
+		Short: "Run a command in a container of a Radius Application",
+		Long: `Run a command in a running replica of a container of a Radius Application. It is a shorthand for 'rad resource exec Applications.Core/containers'.
+
+An interactive shell is opened if no command is specified. A terminal is allocated when the standard input and output of 'rad' are terminals.`,
+		Example: `
+# open a shell in the 'webapp' container of the current default app
+rad app exec webapp
+
+# print the environment of the 'orders' container of the 'icecream-store' application
+rad app exec orders --application icecream-store -- env`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	resource_exec.AddExecFlags(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app exec` command. It differs from `rad resource exec` only
+// in the way the container is specified.
+type Runner struct {
+	resource_exec.Runner
+}
+
+// NewRunner creates a new instance of the `rad app exec` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Runner: *resource_exec.NewRunner(factory),
+	}
+}
+
+// Validate runs validation for the `rad app exec` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	containerArgs, command := resource_exec.SplitCommand(cmd, args)
+	if len(containerArgs) != 1 {
+		return clierrors.Message("Specify the name of a single container, and use '--' to separate the command to run, e.g. 'rad app exec webapp -- ls'.")
+	}
+
+	r.ResourceType = resource_exec.ContainerType
+	r.ResourceName = strings.TrimSpace(containerArgs[0])
+	r.Command = command
+
+	return r.ValidateCommon(cmd)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+
+	resource_exec "github.com/radius-project/radius/pkg/cli/cmd/resource/exec"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Exec Command with default shell",
+			Input:         []string{"webapp", "-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, resource_exec.ContainerType, r.ResourceType)
+				require.Equal(t, "webapp", r.ResourceName)
+				require.Equal(t, resource_exec.DefaultCommand, r.Command)
+			},
+		},
+		{
+			Name:          "Exec Command with command",
+			Input:         []string{"webapp", "-a", "test-app", "--", "env"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, []string{"env"}, runner.(*Runner).Command)
+			},
+		},
+		{
+			Name:          "Exec Command with command but without separator",
+			Input:         []string{"webapp", "env", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Exec Command without container",
+			Input:         []string{"-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
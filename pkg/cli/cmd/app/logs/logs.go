@@ -0,0 +1,340 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sclient "k8s.io/client-go/kubernetes"
+)
+
+// workloadKinds are the kinds of Kubernetes resources whose pods are included in the logs when they are created by
+// a resource of the application, for example by a recipe.
+var workloadKinds = []string{"Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob", "Pod"}
+
+// NewCommand creates an instance of the command and runner for the `rad app logs` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Read logs of a Radius Application",
+		Long: `Read logs of every container of a Radius Application, including the workloads created by recipes.
+
+'rad app logs' outputs the logs that are currently available and then exits. Specify the '--follow' option to stream additional logs as they are emitted. When following, press CTRL+C to exit the command.
+
+Logs can be limited to some resources with '--resource', to some containers with '--container', to pods matching a label selector with '--selector', and to lines matching regular expressions with '--include' and '--exclude'. Use '--output json' to output each line as a JSON object.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Read logs of the current application
+rad app logs
+
+# Stream logs of the 'frontend' and 'backend' resources of the 'icecream-store' application
+rad app logs icecream-store --resource frontend --resource backend --follow
+
+# Read logs of the last 10 minutes containing 'error', as JSON
+rad app logs --since 10m --include error --output json
+
+# Read logs of the pods labeled 'tier=web', excluding health checks
+rad app logs --selector tier=web --exclude healthz
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringArrayP("resource", "r", []string{}, "Name of a resource of the application to read logs from. Can be repeated")
+	cmd.Flags().String("container", "", "Regular expression matching the names of the containers to read logs from")
+	cmd.Flags().Duration("since", 0, "Only read logs newer than a relative duration like 5s, 2m, or 3h. Defaults to 48h")
+	cmd.Flags().BoolP("follow", "f", false, "Stream logs until the command is canceled")
+	cmd.Flags().StringP("selector", "l", "", "Label selector the pods must match, e.g. 'tier=web'")
+	cmd.Flags().StringArray("include", []string{}, "Regular expression the log lines must match. Can be repeated")
+	cmd.Flags().StringArray("exclude", []string{}, "Regular expression of the log lines to drop. Can be repeated")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad app logs` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Logstream         logstream.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	Resources       []string
+	Container       *regexp.Regexp
+	Since           time.Duration
+	Follow          bool
+	LabelSelector   labels.Selector
+	Include         []*regexp.Regexp
+	Exclude         []*regexp.Regexp
+	Format          string
+
+	kubernetesClient k8sclient.Interface
+}
+
+// NewRunner creates a new instance of the `rad app logs` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Logstream:         factory.GetLogstream(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app logs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Resources, err = cmd.Flags().GetStringArray("resource")
+	if err != nil {
+		return err
+	}
+
+	r.Since, err = cmd.Flags().GetDuration("since")
+	if err != nil {
+		return err
+	}
+	if r.Since < 0 {
+		return clierrors.Message("The value of '--since' must be positive.")
+	}
+
+	r.Follow, err = cmd.Flags().GetBool("follow")
+	if err != nil {
+		return err
+	}
+
+	container, err := cmd.Flags().GetString("container")
+	if err != nil {
+		return err
+	}
+	if container != "" {
+		r.Container, err = regexp.Compile(container)
+		if err != nil {
+			return clierrors.Message("The value of '--container' is not a valid regular expression: %v", err)
+		}
+	}
+
+	selector, err := cmd.Flags().GetString("selector")
+	if err != nil {
+		return err
+	}
+	if selector != "" {
+		r.LabelSelector, err = labels.Parse(selector)
+		if err != nil {
+			return clierrors.Message("The value of '--selector' is not a valid label selector: %v", err)
+		}
+	}
+
+	r.Include, err = requireExpressions(cmd, "include")
+	if err != nil {
+		return err
+	}
+
+	r.Exclude, err = requireExpressions(cmd, "exclude")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad app logs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("Reading logs is only supported for workspaces with a Kubernetes connection.")
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := client.GetApplication(ctx, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	namespace := ""
+	if application.Properties != nil && application.Properties.Status != nil && application.Properties.Status.Compute != nil {
+		kube, ok := application.Properties.Status.Compute.(*v20231001preview.KubernetesCompute)
+		if ok && kube.Namespace != nil {
+			namespace = to.String(kube.Namespace)
+		}
+	}
+
+	if namespace == "" {
+		return clierrors.Message("Only kubernetes runtimes are supported.")
+	}
+
+	graph, err := client.GetApplicationGraph(ctx, r.ApplicationName)
+	if err != nil {
+		return err
+	}
+
+	workloads, err := r.workloads(graph)
+	if err != nil {
+		return err
+	}
+
+	if r.kubernetesClient == nil {
+		r.kubernetesClient, _, err = kubernetes.NewClientset(kubeContext)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = r.Logstream.Stream(ctx, logstream.Options{
+		ApplicationName: r.ApplicationName,
+		Namespace:       namespace,
+		KubeClient:      r.kubernetesClient,
+		Follow:          r.Follow,
+		Since:           r.Since,
+		Resources:       r.Resources,
+		Workloads:       workloads,
+		LabelSelector:   r.LabelSelector,
+		Container:       r.Container,
+		Include:         r.Include,
+		Exclude:         r.Exclude,
+		JSON:            r.Format == output.FormatJson,
+
+		// Right now we don't need an abstraction for this because we don't really
+		// run the streaming logs in unit tests.
+		Out: os.Stdout,
+	})
+
+	// context.Canceled here means the user canceled.
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	return err
+}
+
+// workloads returns the Kubernetes workloads created by the resources of the application, limited to the selected
+// resources. It returns an error if a selected resource is not part of the application.
+func (r *Runner) workloads(graph v20231001preview.ApplicationGraphResponse) ([]logstream.Workload, error) {
+	found := map[string]bool{}
+	workloads := []logstream.Workload{}
+	for _, resource := range graph.Resources {
+		if resource == nil {
+			continue
+		}
+
+		name := to.String(resource.Name)
+		if len(r.Resources) > 0 && !slices.ContainsFunc(r.Resources, func(selected string) bool { return strings.EqualFold(selected, name) }) {
+			continue
+		}
+		found[strings.ToLower(name)] = true
+
+		for _, outputResource := range resource.OutputResources {
+			if outputResource == nil {
+				continue
+			}
+
+			id, err := resources.Parse(to.String(outputResource.ID))
+			if err != nil || id.FindScope(resources_kubernetes.PlaneTypeKubernetes) == "" {
+				continue
+			}
+
+			_, kind, namespace, name := resources_kubernetes.ToParts(id)
+			if namespace == "" || !slices.ContainsFunc(workloadKinds, func(k string) bool { return strings.EqualFold(k, kind) }) {
+				continue
+			}
+
+			workloads = append(workloads, logstream.Workload{Namespace: namespace, Kind: kind, Name: name})
+		}
+	}
+
+	for _, selected := range r.Resources {
+		if !found[strings.ToLower(selected)] {
+			return nil, clierrors.Message("The resource %q was not found in application %q.", selected, r.ApplicationName)
+		}
+	}
+
+	return workloads, nil
+}
+
+func requireExpressions(cmd *cobra.Command, flag string) ([]*regexp.Regexp, error) {
+	values, err := cmd.Flags().GetStringArray(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	expressions := []*regexp.Regexp{}
+	for _, value := range values {
+		expression, err := regexp.Compile(value)
+		if err != nil {
+			return nil, clierrors.Message("The value %q of '--%s' is not a valid regular expression: %v", value, flag, err)
+		}
+
+		expressions = append(expressions, expression)
+	}
+
+	return expressions, nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Logs Command with positional arg",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Logs Command with filters",
+			Input:         []string{"-a", "test-app", "-r", "frontend", "--since", "10m", "-f", "-l", "tier=web", "--include", "error", "--exclude", "healthz", "--container", "^app$", "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, []string{"frontend"}, r.Resources)
+				require.Equal(t, 10*time.Minute, r.Since)
+				require.True(t, r.Follow)
+				require.Equal(t, "tier=web", r.LabelSelector.String())
+				require.Len(t, r.Include, 1)
+				require.Len(t, r.Exclude, 1)
+				require.Equal(t, "^app$", r.Container.String())
+				require.Equal(t, output.FormatJson, r.Format)
+			},
+		},
+		{
+			Name:          "Logs Command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Logs Command with invalid expression",
+			Input:         []string{"test-app", "--include", "("},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Logs Command with invalid selector",
+			Input:         []string{"test-app", "--selector", "tier in"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Logs Command with too many positional args",
+			Input:         []string{"test-app", "other"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	application := v20231001preview.ApplicationResource{
+		Name: new("test-app"),
+		Properties: &v20231001preview.ApplicationProperties{
+			Status: &v20231001preview.ResourceStatus{
+				Compute: &v20231001preview.KubernetesCompute{Namespace: new("default-test-app")},
+			},
+		},
+	}
+	graph := v20231001preview.ApplicationGraphResponse{
+		Resources: []*v20231001preview.ApplicationGraphResource{
+			{
+				Name: new("frontend"),
+				OutputResources: []*v20231001preview.ApplicationGraphOutputResource{
+					{ID: new("/planes/kubernetes/local/namespaces/default-test-app/providers/apps/Deployment/frontend")},
+					{ID: new("/planes/kubernetes/local/namespaces/default-test-app/providers/core/Service/frontend")},
+				},
+			},
+			{
+				Name: new("cache"),
+				OutputResources: []*v20231001preview.ApplicationGraphOutputResource{
+					{ID: new("/planes/kubernetes/local/namespaces/redis/providers/apps/StatefulSet/redis")},
+					{ID: new("/subscriptions/test-sub/resourceGroups/test-rg/providers/Microsoft.Cache/redis/test")},
+				},
+			},
+		},
+	}
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	setup := func(t *testing.T) (*clients.MockApplicationsManagementClient, *logstream.MockInterface) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetApplicationGraph(gomock.Any(), "test-app").
+			Return(graph, nil).
+			Times(1)
+
+		return appManagementClient, logstream.NewMockInterface(ctrl)
+	}
+
+	t.Run("Success: recipe workloads included", func(t *testing.T) {
+		appManagementClient, logstreamClient := setup(t)
+
+		var options logstream.Options
+		logstreamClient.EXPECT().
+			Stream(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o logstream.Options) error {
+				options = o
+				return nil
+			}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Logstream:         logstreamClient,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			Format:            output.FormatJson,
+			kubernetesClient:  fake.NewSimpleClientset(),
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, "test-app", options.ApplicationName)
+		require.Equal(t, "default-test-app", options.Namespace)
+		require.True(t, options.JSON)
+		require.Equal(t, []logstream.Workload{
+			{Namespace: "default-test-app", Kind: "Deployment", Name: "frontend"},
+			{Namespace: "redis", Kind: "StatefulSet", Name: "redis"},
+		}, options.Workloads)
+	})
+
+	t.Run("Success: selected resources", func(t *testing.T) {
+		appManagementClient, logstreamClient := setup(t)
+
+		var options logstream.Options
+		logstreamClient.EXPECT().
+			Stream(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o logstream.Options) error {
+				options = o
+				return nil
+			}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Logstream:         logstreamClient,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			Resources:         []string{"Cache"},
+			kubernetesClient:  fake.NewSimpleClientset(),
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.False(t, options.JSON)
+		require.Equal(t, []string{"Cache"}, options.Resources)
+		require.Equal(t, []logstream.Workload{{Namespace: "redis", Kind: "StatefulSet", Name: "redis"}}, options.Workloads)
+	})
+
+	t.Run("Error: unknown resource", func(t *testing.T) {
+		appManagementClient, logstreamClient := setup(t)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Logstream:         logstreamClient,
+			Output:            &output.MockOutput{},
+			Workspace:         workspace,
+			ApplicationName:   "test-app",
+			Resources:         []string{"backend"},
+			kubernetesClient:  fake.NewSimpleClientset(),
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `The resource "backend" was not found`)
+	})
+}
@@ -50,3 +50,24 @@ func gatewayFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// policyViolationFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of a list of policy violations.
+func policyViolationFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Resource }",
+			},
+			{
+				Heading:  "POLICY",
+				JSONPath: "{ .Policy }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
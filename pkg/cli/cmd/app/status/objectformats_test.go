@@ -52,3 +52,18 @@ func Test_GetApplicationGatewaysTableFormat(t *testing.T) {
 	expected := "GATEWAY   ENDPOINT\ntest      test-endpoint\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetApplicationPolicyViolationsTableFormat(t *testing.T) {
+	obj := clients.PolicyViolationStatus{
+		Resource: "test",
+		Policy:   "test-policy",
+		Message:  "test-message",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, policyViolationFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  POLICY       MESSAGE\ntest      test-policy  test-message\n"
+	require.Equal(t, expected, buffer.String())
+}
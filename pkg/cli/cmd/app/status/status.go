@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -167,6 +168,8 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		applicationStatus.PolicyViolations = append(applicationStatus.PolicyViolations, policyViolations(resource)...)
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
@@ -184,5 +187,47 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if r.Format == output.FormatTable && len(applicationStatus.PolicyViolations) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.PolicyViolations, policyViolationFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// policyViolations returns the violations of audit-mode policies that the dynamic resource provider recorded in the
+// status of a resource.
+func policyViolations(resource generated.GenericResource) []clients.PolicyViolationStatus {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	entries, ok := status["policyViolations"].([]any)
+	if !ok {
+		return nil
+	}
+
+	violations := []clients.PolicyViolationStatus{}
+	for _, entry := range entries {
+		violation, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		policyName, _ := violation["policy"].(string)
+		message, _ := violation["message"].(string)
+		violations = append(violations, clients.PolicyViolationStatus{
+			Resource: *resource.Name,
+			Policy:   policyName,
+			Message:  message,
+		})
+	}
+
+	return violations
+}
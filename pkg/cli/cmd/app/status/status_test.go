@@ -190,6 +190,99 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Policy Violations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: new("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: new("test-db"),
+				ID:   new("/planes/radius/local/resourceGroups/test-group/providers/MyCompany.Resources/databases/test-db"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"policyViolations": []any{
+							map[string]any{"policy": "small-only", "message": "Only small databases are allowed."},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace:       workspace,
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		applicationStatus := clients.ApplicationStatus{
+			Name:          "test-app",
+			ResourceCount: 1,
+			PolicyViolations: []clients.PolicyViolationStatus{
+				{
+					Resource: "test-db",
+					Policy:   "small-only",
+					Message:  "Only small databases are allowed.",
+				},
+			},
+		}
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus,
+				Options: statusFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.PolicyViolations,
+				Options: policyViolationFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Application Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
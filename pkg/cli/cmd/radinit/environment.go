@@ -98,7 +98,7 @@ func (r *Runner) CreateEnvironment(ctx context.Context) error {
 	}
 
 	if r.Options.CloudProviders.Azure != nil {
-		credential, err := r.getAzureCredential()
+		credential, err := getAzureCredential(r.Options.CloudProviders.Azure)
 		if err != nil {
 			return clierrors.MessageWithCause(err, "Failed to configure Azure credentials.")
 		}
@@ -110,7 +110,7 @@ func (r *Runner) CreateEnvironment(ctx context.Context) error {
 	}
 
 	if r.Options.CloudProviders.AWS != nil {
-		credential, err := r.getAWSCredential()
+		credential, err := getAWSCredential(r.Options.CloudProviders.AWS)
 		if err != nil {
 			return clierrors.MessageWithCause(err, "Failed to configure AWS credentials.")
 		}
@@ -33,11 +33,13 @@ import (
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/helm"
 	"github.com/radius-project/radius/pkg/cli/kubernetes"
+	"github.com/radius-project/radius/pkg/cli/manifest"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/setup"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/to"
 	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/spf13/cobra"
@@ -65,6 +67,8 @@ If an environment already exists, 'rad init' will prompt the user to use the exi
 By default, 'rad init' will optimize for a developer-focused environment with an environment named "default" and Recipes that support prototyping, development and testing using lightweight containers. These environments are great for building and testing your application.
 
 Specifying the '--full' flag will cause 'rad init' to prompt the user for all available configuration options such as Kubernetes context, environment name, and cloud providers. This is useful for fully customizing your environment.
+
+Specifying the '--from' flag sets up a platform from a configuration file instead of prompting the user. The file lists the resource type manifests to register, the recipe packs, environments and cloud provider credentials to create, and the workspace that uses them. Values can reference environment variables such as ${AZURE_CLIENT_SECRET}. Running 'rad init --from' again only applies what changed, and '--plan' shows the changes without applying them. Radius must already be installed, for example with 'rad install kubernetes'.
 `,
 		Example: `
 ## Create a new development environment named "default"
@@ -94,6 +98,12 @@ rad init --set 'global.imagePullSecrets[0].name=azure-cred' \
 
 ## Initialize with custom values from a file
 rad init --set-file global.rootCA.cert=/path/to/rootCA.crt
+
+## Set up resource types, recipe packs, environments, credentials and a workspace from a configuration file
+rad init --from platform.yaml
+
+## Show the changes 'rad init --from' would make without applying them
+rad init --from platform.yaml --plan
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -104,6 +114,8 @@ rad init --set-file global.rootCA.cert=/path/to/rootCA.crt
 	cmd.Flags().Bool("full", false, "Prompt user for all available configuration options")
 	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	cmd.Flags().StringArrayVar(&runner.SetFile, "set-file", []string{}, "Set values from files on the command line (can specify multiple or separate files with commas: key1=filename1,key2=filename2)")
+	cmd.Flags().StringVar(&runner.From, "from", "", "Set up resource types, recipe packs, environments, credentials and a workspace from a platform configuration file")
+	cmd.Flags().BoolVar(&runner.Plan, "plan", false, "Show the changes of --from without applying them")
 	return cmd, runner
 }
 
@@ -153,6 +165,26 @@ type Runner struct {
 
 	// Options provides the options to used for Radius initialization. This will be populated by Validate.
 	Options *initOptions
+
+	// From is the path of the platform configuration file. When set, the platform is set up from the file
+	// instead of prompting the user.
+	From string
+
+	// Plan shows the changes of the platform configuration file without applying them.
+	Plan bool
+
+	// UCPClientFactory is the client factory for UCP. If nil, it is created from the workspace.
+	UCPClientFactory *ucp.ClientFactory
+
+	// RadiusCoreClientFactory is the client factory for Radius.Core resources. If nil, it is created from the workspace.
+	RadiusCoreClientFactory *corerpv20250801.ClientFactory
+
+	// platform is the platform configuration read from From. This will be populated by Validate.
+	platform *platformConfig
+
+	// platformResourceProviders are the resource provider manifests of the platform configuration. This will be
+	// populated by Validate.
+	platformResourceProviders []*manifest.ResourceProvider
 }
 
 // NewRunner creates a new instance of the `rad init` runner.
@@ -182,6 +214,7 @@ func NewRunner(factory framework.Factory) *Runner {
 // Validate gathers input from the user, creates a workspace and options, and confirms the options with the user before
 // returning the options and workspace. If the user does not confirm the options, the function will loop and gather input again.
 // If an error occurs, the function will return an error.
+// With --from, the platform configuration file is read instead of gathering input from the user.
 func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	format, err := cli.RequireOutput(cmd)
 	if err != nil {
@@ -194,6 +227,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if r.Plan && r.From == "" {
+		return clierrors.Message("The --plan flag requires --from.")
+	}
+
+	if r.From != "" {
+		return r.validatePlatform()
+	}
+
 	for {
 		options, workspace, err := r.enterInitOptions(cmd.Context())
 		if err != nil {
@@ -225,7 +266,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run creates a progress channel, installs the radius control plane, creates an environment, configures cloud
 // providers, scaffolds an application, and updates the config file, all while displaying progress updates to the UI.
+// With --from, it plans the changes of the platform configuration file and applies them unless --plan is set.
 func (r *Runner) Run(ctx context.Context) error {
+	if r.From != "" {
+		return r.runPlatform(ctx)
+	}
+
 	config := r.ConfigFileInterface.ConfigFromContext(ctx)
 
 	// Use this channel to send progress updates to the UI.
@@ -317,8 +363,8 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
-func (r *Runner) getAzureCredential() (ucp.AzureCredentialResource, error) {
-	switch r.Options.CloudProviders.Azure.CredentialKind {
+func getAzureCredential(provider *azure.Provider) (ucp.AzureCredentialResource, error) {
+	switch provider.CredentialKind {
 	case azure.AzureCredentialKindServicePrincipal:
 		return ucp.AzureCredentialResource{
 			Location: to.Ptr(v1.LocationGlobal),
@@ -327,9 +373,9 @@ func (r *Runner) getAzureCredential() (ucp.AzureCredentialResource, error) {
 				Storage: &ucp.CredentialStorageProperties{
 					Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 				},
-				TenantID:     &r.Options.CloudProviders.Azure.ServicePrincipal.TenantID,
-				ClientID:     &r.Options.CloudProviders.Azure.ServicePrincipal.ClientID,
-				ClientSecret: &r.Options.CloudProviders.Azure.ServicePrincipal.ClientSecret,
+				TenantID:     &provider.ServicePrincipal.TenantID,
+				ClientID:     &provider.ServicePrincipal.ClientID,
+				ClientSecret: &provider.ServicePrincipal.ClientSecret,
 			},
 		}, nil
 	case azure.AzureCredentialKindWorkloadIdentity:
@@ -340,17 +386,17 @@ func (r *Runner) getAzureCredential() (ucp.AzureCredentialResource, error) {
 				Storage: &ucp.CredentialStorageProperties{
 					Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 				},
-				TenantID: &r.Options.CloudProviders.Azure.WorkloadIdentity.TenantID,
-				ClientID: &r.Options.CloudProviders.Azure.WorkloadIdentity.ClientID,
+				TenantID: &provider.WorkloadIdentity.TenantID,
+				ClientID: &provider.WorkloadIdentity.ClientID,
 			},
 		}, nil
 	default:
-		return ucp.AzureCredentialResource{}, fmt.Errorf("unsupported Azure credential kind: %s", r.Options.CloudProviders.Azure.CredentialKind)
+		return ucp.AzureCredentialResource{}, fmt.Errorf("unsupported Azure credential kind: %s", provider.CredentialKind)
 	}
 }
 
-func (r *Runner) getAWSCredential() (ucp.AwsCredentialResource, error) {
-	switch r.Options.CloudProviders.AWS.CredentialKind {
+func getAWSCredential(provider *aws.Provider) (ucp.AwsCredentialResource, error) {
+	switch provider.CredentialKind {
 	case aws.AWSCredentialKindAccessKey:
 		return ucp.AwsCredentialResource{
 			Location: to.Ptr(v1.LocationGlobal),
@@ -359,8 +405,8 @@ func (r *Runner) getAWSCredential() (ucp.AwsCredentialResource, error) {
 				Storage: &ucp.CredentialStorageProperties{
 					Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 				},
-				AccessKeyID:     &r.Options.CloudProviders.AWS.AccessKey.AccessKeyID,
-				SecretAccessKey: &r.Options.CloudProviders.AWS.AccessKey.SecretAccessKey,
+				AccessKeyID:     &provider.AccessKey.AccessKeyID,
+				SecretAccessKey: &provider.AccessKey.SecretAccessKey,
 			},
 		}, nil
 	case aws.AWSCredentialKindIRSA:
@@ -371,10 +417,10 @@ func (r *Runner) getAWSCredential() (ucp.AwsCredentialResource, error) {
 				Storage: &ucp.CredentialStorageProperties{
 					Kind: to.Ptr(ucp.CredentialStorageKindInternal),
 				},
-				RoleARN: &r.Options.CloudProviders.AWS.IRSA.RoleARN,
+				RoleARN: &provider.IRSA.RoleARN,
 			},
 		}, nil
 	default:
-		return ucp.AwsCredentialResource{}, fmt.Errorf("unsupported AWS credential kind: %s", r.Options.CloudProviders.AWS.CredentialKind)
+		return ucp.AwsCredentialResource{}, fmt.Errorf("unsupported AWS credential kind: %s", provider.CredentialKind)
 	}
 }
@@ -0,0 +1,1067 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radinit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	yaml "github.com/goccy/go-yaml"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/aws"
+	"github.com/radius-project/radius/pkg/cli/azure"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	recipe_types "github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"golang.org/x/text/cases"
+)
+
+const (
+	platformActionCreate    = "create"
+	platformActionUpdate    = "update"
+	platformActionUnchanged = "unchanged"
+
+	platformEnvironmentKindRadiusCore       = "Radius.Core"
+	platformEnvironmentKindApplicationsCore = "Applications.Core"
+
+	platformPlaneName = "local"
+)
+
+// platformConfig is the configuration file of `rad init --from`. It describes the platform of a Radius installation:
+// the resource types, recipe packs, environments and credentials to create, and the workspace that uses them.
+type platformConfig struct {
+	// Workspace is the workspace to create or update, and to set as the default workspace.
+	Workspace platformWorkspaceConfig `yaml:"workspace"`
+
+	// ResourceTypes is the list of resource provider manifests to register. Directories register each of their files.
+	// Relative paths are relative to the configuration file.
+	ResourceTypes []string `yaml:"resourceTypes"`
+
+	// RecipePacks is the list of Radius.Core recipe packs to create in the resource group of the workspace.
+	RecipePacks []platformRecipePackConfig `yaml:"recipePacks"`
+
+	// Environments is the list of environments to create in the resource group of the workspace.
+	Environments []platformEnvironmentConfig `yaml:"environments"`
+
+	// Credentials are the cloud provider credentials to register.
+	Credentials platformCredentialsConfig `yaml:"credentials"`
+}
+
+type platformWorkspaceConfig struct {
+	// Name is the name of the workspace. Defaults to "default".
+	Name string `yaml:"name"`
+
+	// Connection is the connection of the workspace. Defaults to the current Kubernetes context.
+	Connection map[string]any `yaml:"connection"`
+
+	// Group is the resource group of the workspace. Defaults to "default".
+	Group string `yaml:"group"`
+
+	// Environment is the name of the default environment of the workspace. Defaults to the first environment.
+	Environment string `yaml:"environment"`
+}
+
+type platformRecipePackConfig struct {
+	// Name is the name of the recipe pack.
+	Name string `yaml:"name"`
+
+	// Recipes is the map of resource type to its recipe.
+	Recipes map[string]platformRecipeConfig `yaml:"recipes"`
+}
+
+type platformRecipeConfig struct {
+	// Kind is the kind of the recipe, either bicep or terraform.
+	Kind string `yaml:"kind"`
+
+	// Location is the location of the recipe template.
+	Location string `yaml:"location"`
+
+	// Parameters are the parameters passed to the recipe.
+	Parameters map[string]any `yaml:"parameters"`
+
+	// PlainHTTP connects to the registry of a bicep recipe over HTTP.
+	PlainHTTP bool `yaml:"plainHttp"`
+}
+
+type platformEnvironmentConfig struct {
+	// Name is the name of the environment.
+	Name string `yaml:"name"`
+
+	// Kind is the resource provider of the environment, either Radius.Core or Applications.Core. Defaults to Radius.Core.
+	Kind string `yaml:"kind"`
+
+	// Namespace is the Kubernetes namespace of the environment. Defaults to "default".
+	Namespace string `yaml:"namespace"`
+
+	// RecipePacks is the list of recipe packs of a Radius.Core environment, either the names of recipe packs of
+	// the configuration or resource IDs.
+	RecipePacks []string `yaml:"recipePacks"`
+
+	// RecipeParameters are the recipe parameters of a Radius.Core environment, keyed by resource type.
+	RecipeParameters map[string]map[string]any `yaml:"recipeParameters"`
+
+	// Recipes are the recipes of an Applications.Core environment, keyed by resource type and recipe name.
+	Recipes map[string]map[string]platformRecipeConfig `yaml:"recipes"`
+
+	// Providers are the cloud providers of the environment.
+	Providers platformProvidersConfig `yaml:"providers"`
+}
+
+type platformProvidersConfig struct {
+	Azure *platformAzureProviderConfig `yaml:"azure"`
+	AWS   *platformAWSProviderConfig   `yaml:"aws"`
+}
+
+type platformAzureProviderConfig struct {
+	SubscriptionID string `yaml:"subscriptionId"`
+	ResourceGroup  string `yaml:"resourceGroup"`
+}
+
+type platformAWSProviderConfig struct {
+	AccountID string `yaml:"accountId"`
+	Region    string `yaml:"region"`
+}
+
+type platformCredentialsConfig struct {
+	Azure *platformAzureCredentialConfig `yaml:"azure"`
+	AWS   *platformAWSCredentialConfig   `yaml:"aws"`
+}
+
+type platformAzureCredentialConfig struct {
+	ServicePrincipal *struct {
+		TenantID     string `yaml:"tenantId"`
+		ClientID     string `yaml:"clientId"`
+		ClientSecret string `yaml:"clientSecret"`
+	} `yaml:"servicePrincipal"`
+	WorkloadIdentity *struct {
+		TenantID string `yaml:"tenantId"`
+		ClientID string `yaml:"clientId"`
+	} `yaml:"workloadIdentity"`
+}
+
+type platformAWSCredentialConfig struct {
+	AccessKey *struct {
+		AccessKeyID     string `yaml:"accessKeyId"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
+	} `yaml:"accessKey"`
+	IRSA *struct {
+		RoleARN string `yaml:"roleArn"`
+	} `yaml:"irsa"`
+}
+
+// platformChange is a change of the plan of `rad init --from`.
+type platformChange struct {
+	// Action is the action applied by the change: create, update or unchanged.
+	Action string `json:"action"`
+
+	// Type is the type of the changed object.
+	Type string `json:"type"`
+
+	// Name is the name of the changed object.
+	Name string `json:"name"`
+
+	apply func(ctx context.Context) error
+}
+
+// platformPlanFormat returns the table format of the plan of `rad init --from`.
+func platformPlanFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "ACTION",
+				JSONPath: "{ .Action }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+		},
+	}
+}
+
+// readPlatformConfig reads the configuration file of `rad init --from`. References to environment variables such as
+// ${AZURE_CLIENT_SECRET} are replaced by their values, so that secrets don't need to be stored in the file. Use $$ for
+// a literal $.
+func readPlatformConfig(filePath string) (*platformConfig, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read the platform configuration %q.", filePath)
+	}
+
+	missing := []string{}
+	expanded := os.Expand(string(data), func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return nil, clierrors.Message("The platform configuration %q references environment variables that are not set: %s.", filePath, strings.Join(missing, ", "))
+	}
+
+	config := &platformConfig{}
+	err = yaml.NewDecoder(bytes.NewReader([]byte(expanded)), yaml.Strict()).Decode(config)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to parse the platform configuration %q.", filePath)
+	}
+
+	err = config.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, path := range config.ResourceTypes {
+		if !filepath.IsAbs(path) {
+			config.ResourceTypes[i] = filepath.Join(filepath.Dir(filePath), path)
+		}
+	}
+
+	return config, nil
+}
+
+// validate applies the defaults of the configuration and validates it.
+func (c *platformConfig) validate() error {
+	if c.Workspace.Name == "" {
+		c.Workspace.Name = "default"
+	}
+	if c.Workspace.Group == "" {
+		c.Workspace.Group = "default"
+	}
+
+	recipePacks := map[string]bool{}
+	for _, recipePack := range c.RecipePacks {
+		if recipePack.Name == "" {
+			return clierrors.Message("Recipe packs of the platform configuration must have a name.")
+		} else if recipePacks[recipePack.Name] {
+			return clierrors.Message("The recipe pack %q is defined more than once.", recipePack.Name)
+		}
+		recipePacks[recipePack.Name] = true
+
+		for resourceType, recipe := range recipePack.Recipes {
+			err := recipe.validate(fmt.Sprintf("recipe pack %q", recipePack.Name), resourceType)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	environments := map[string]bool{}
+	for i := range c.Environments {
+		environment := &c.Environments[i]
+		if environment.Name == "" {
+			return clierrors.Message("Environments of the platform configuration must have a name.")
+		} else if environments[environment.Name] {
+			return clierrors.Message("The environment %q is defined more than once.", environment.Name)
+		}
+		environments[environment.Name] = true
+
+		if environment.Kind == "" {
+			environment.Kind = platformEnvironmentKindRadiusCore
+		}
+		if environment.Namespace == "" {
+			environment.Namespace = defaultEnvironmentNamespace
+		}
+
+		switch environment.Kind {
+		case platformEnvironmentKindRadiusCore:
+			if len(environment.Recipes) > 0 {
+				return clierrors.Message("The environment %q is a %s environment and can't have recipes. Use recipe packs instead.", environment.Name, environment.Kind)
+			}
+
+			for _, recipePack := range environment.RecipePacks {
+				if !strings.Contains(recipePack, "/") && !recipePacks[recipePack] {
+					return clierrors.Message("The environment %q references the recipe pack %q, which is not defined.", environment.Name, recipePack)
+				}
+			}
+		case platformEnvironmentKindApplicationsCore:
+			if len(environment.RecipePacks) > 0 || len(environment.RecipeParameters) > 0 {
+				return clierrors.Message("The environment %q is a %s environment and can't have recipe packs or recipe parameters. Use recipes instead.", environment.Name, environment.Kind)
+			}
+
+			for resourceType, recipes := range environment.Recipes {
+				for name, recipe := range recipes {
+					err := recipe.validate(fmt.Sprintf("environment %q", environment.Name), resourceType+"/"+name)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		default:
+			return clierrors.Message("The environment %q has an unsupported kind %q. Supported kinds are %s and %s.", environment.Name, environment.Kind, platformEnvironmentKindRadiusCore, platformEnvironmentKindApplicationsCore)
+		}
+
+		if azure := environment.Providers.Azure; azure != nil && (azure.SubscriptionID == "" || azure.ResourceGroup == "") {
+			return clierrors.Message("The Azure provider of the environment %q must have a subscriptionId and a resourceGroup.", environment.Name)
+		}
+		if aws := environment.Providers.AWS; aws != nil && (aws.AccountID == "" || aws.Region == "") {
+			return clierrors.Message("The AWS provider of the environment %q must have an accountId and a region.", environment.Name)
+		}
+	}
+
+	if c.Workspace.Environment == "" && len(c.Environments) > 0 {
+		c.Workspace.Environment = c.Environments[0].Name
+	} else if c.Workspace.Environment != "" && !environments[c.Workspace.Environment] {
+		return clierrors.Message("The workspace environment %q is not defined.", c.Workspace.Environment)
+	}
+
+	if azure := c.Credentials.Azure; azure != nil && (azure.ServicePrincipal == nil) == (azure.WorkloadIdentity == nil) {
+		return clierrors.Message("The Azure credential must have exactly one of servicePrincipal or workloadIdentity.")
+	}
+	if aws := c.Credentials.AWS; aws != nil && (aws.AccessKey == nil) == (aws.IRSA == nil) {
+		return clierrors.Message("The AWS credential must have exactly one of accessKey or irsa.")
+	}
+
+	return nil
+}
+
+func (r platformRecipeConfig) validate(owner string, name string) error {
+	if r.Location == "" {
+		return clierrors.Message("The recipe %q of the %s must have a location.", name, owner)
+	}
+
+	if r.Kind != recipe_types.TemplateKindBicep && r.Kind != recipe_types.TemplateKindTerraform {
+		return clierrors.Message("The recipe %q of the %s has an unsupported kind %q. Supported kinds are %s.", name, owner, r.Kind, strings.Join(recipe_types.SupportedTemplateKind, ", "))
+	}
+
+	return nil
+}
+
+// environmentKind returns the kind of the environment with the given name.
+func (c *platformConfig) environmentKind(name string) string {
+	for _, environment := range c.Environments {
+		if environment.Name == name {
+			return environment.Kind
+		}
+	}
+
+	return ""
+}
+
+// validatePlatform reads the configuration of `rad init --from` and the manifests it references, and builds the
+// workspace it describes.
+func (r *Runner) validatePlatform() error {
+	config, err := readPlatformConfig(r.From)
+	if err != nil {
+		return err
+	}
+
+	r.platformResourceProviders = nil
+	for _, path := range config.ResourceTypes {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return err
+		}
+
+		for _, file := range files {
+			resourceProvider, err := manifest.ValidateManifest(context.Background(), file)
+			if err != nil {
+				return clierrors.MessageWithCause(err, "Failed to read the resource type manifest %q.", file)
+			}
+			r.platformResourceProviders = append(r.platformResourceProviders, resourceProvider)
+		}
+	}
+
+	connection := config.Workspace.Connection
+	if len(connection) == 0 {
+		kubeConfig, err := r.KubernetesInterface.GetKubeContext()
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to read Kubernetes config.")
+		}
+
+		connection = map[string]any{
+			"kind":    workspaces.KindKubernetes,
+			"context": kubeConfig.CurrentContext,
+		}
+	}
+
+	scope := "/planes/radius/local/resourceGroups/" + config.Workspace.Group
+	environment := ""
+	if config.Workspace.Environment != "" {
+		environment = fmt.Sprintf("%s/providers/%s/environments/%s", scope, config.environmentKind(config.Workspace.Environment), config.Workspace.Environment)
+	}
+
+	r.platform = config
+	r.Workspace = &workspaces.Workspace{
+		Name:        config.Workspace.Name,
+		Connection:  connection,
+		Scope:       scope,
+		Environment: environment,
+	}
+
+	return nil
+}
+
+// manifestFiles returns the manifest file, or the files of the manifest directory.
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "Failed to read the resource type manifest %q.", path)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+
+	return files, nil
+}
+
+// runPlatform displays the plan of `rad init --from`, and applies it unless --plan is set.
+func (r *Runner) runPlatform(ctx context.Context) error {
+	changes, err := r.planPlatform(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.Output.WriteFormatted(r.Format, changes, platformPlanFormat())
+	if err != nil {
+		return err
+	}
+
+	if r.Plan {
+		return nil
+	}
+
+	applied := 0
+	for _, change := range changes {
+		if change.Action == platformActionUnchanged {
+			continue
+		}
+
+		r.Output.LogInfo("Applying %s of %s %q...", change.Action, change.Type, change.Name)
+		err = change.apply(ctx)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to %s %s %q.", change.Action, change.Type, change.Name)
+		}
+		applied++
+	}
+
+	if applied == 0 {
+		r.Output.LogInfo("The platform is up to date.")
+	} else {
+		r.Output.LogInfo("Applied %d change(s) to the platform.", applied)
+	}
+
+	return nil
+}
+
+// planPlatform compares the configuration with the existing platform and returns the changes to apply, in the order
+// they must be applied. Nothing is changed.
+func (r *Runner) planPlatform(ctx context.Context) ([]platformChange, error) {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.UCPClientFactory == nil {
+		r.UCPClientFactory, err = cmd.InitializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.RadiusCoreClientFactory == nil {
+		r.RadiusCoreClientFactory, err = cmd.InitializeRadiusCoreClientFactory(ctx, r.Workspace, r.Workspace.Scope)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := []platformChange{}
+
+	change, err := r.planResourceGroup(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, change)
+
+	resourceTypeChanges, err := r.planResourceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, resourceTypeChanges...)
+
+	for _, recipePack := range r.platform.RecipePacks {
+		change, err := r.planRecipePack(ctx, recipePack)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	for _, environment := range r.platform.Environments {
+		var change platformChange
+		if environment.Kind == platformEnvironmentKindApplicationsCore {
+			change, err = r.planApplicationsCoreEnvironment(ctx, client, environment)
+		} else {
+			change, err = r.planRadiusCoreEnvironment(ctx, environment)
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	credentialChanges, err := r.planCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, credentialChanges...)
+
+	change, err = r.planWorkspace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, change)
+
+	return changes, nil
+}
+
+func (r *Runner) planResourceGroup(ctx context.Context, client clients.ApplicationsManagementClient) (platformChange, error) {
+	group := r.platform.Workspace.Group
+	change := platformChange{
+		Action: platformActionUnchanged,
+		Type:   "ResourceGroup",
+		Name:   group,
+		apply: func(ctx context.Context) error {
+			return client.CreateOrUpdateResourceGroup(ctx, platformPlaneName, group, &ucp.ResourceGroupResource{
+				Location: to.Ptr(v1.LocationGlobal),
+			})
+		},
+	}
+
+	_, err := client.GetResourceGroup(ctx, platformPlaneName, group)
+	if clients.Is404Error(err) {
+		change.Action = platformActionCreate
+	} else if err != nil {
+		return platformChange{}, err
+	}
+
+	return change, nil
+}
+
+func (r *Runner) planResourceTypes(ctx context.Context) ([]platformChange, error) {
+	changes := []platformChange{}
+	for _, resourceProvider := range r.platformResourceProviders {
+		typeChanges, err := manifest.PlanResourceProvider(ctx, r.UCPClientFactory, platformPlaneName, *resourceProvider)
+		if err != nil {
+			return nil, err
+		}
+
+		typeNames := make([]string, 0, len(typeChanges))
+		for typeName := range typeChanges {
+			typeNames = append(typeNames, typeName)
+		}
+		sort.Strings(typeNames)
+
+		for _, typeName := range typeNames {
+			action := string(typeChanges[typeName])
+			if action != platformActionUnchanged {
+				// Refuse incompatible schema changes when planning, so that nothing is applied.
+				err = manifest.CheckAPIVersionCompatibility(ctx, r.UCPClientFactory, platformPlaneName, resourceProvider.Namespace, typeName, resourceProvider.Types[typeName])
+				if err != nil {
+					return nil, clierrors.MessageWithCause(err, "Failed to plan resource type %q.", resourceProvider.Namespace+"/"+typeName)
+				}
+			}
+
+			changes = append(changes, platformChange{
+				Action: action,
+				Type:   "ResourceType",
+				Name:   resourceProvider.Namespace + "/" + typeName,
+				apply: func(ctx context.Context) error {
+					err := manifest.EnsureResourceProviderExists(ctx, r.UCPClientFactory, platformPlaneName, *resourceProvider, nil)
+					if err != nil {
+						return err
+					}
+
+					return manifest.RegisterResourceType(ctx, r.UCPClientFactory, platformPlaneName, *resourceProvider, typeName, nil)
+				},
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func (r *Runner) planRecipePack(ctx context.Context, config platformRecipePackConfig) (platformChange, error) {
+	client := r.RadiusCoreClientFactory.NewRecipePacksClient()
+
+	desired := corerpv20250801.RecipePackProperties{
+		Recipes: map[string]*corerpv20250801.RecipeDefinition{},
+	}
+	for resourceType, recipe := range config.Recipes {
+		desired.Recipes[resourceType] = &corerpv20250801.RecipeDefinition{
+			RecipeKind:     to.Ptr(corerpv20250801.RecipeKind(recipe.Kind)),
+			RecipeLocation: new(recipe.Location),
+			Parameters:     recipe.Parameters,
+			PlainHTTP:      to.Ptr(recipe.PlainHTTP),
+		}
+	}
+
+	change := platformChange{
+		Type: "RecipePack",
+		Name: config.Name,
+		apply: func(ctx context.Context) error {
+			_, err := client.CreateOrUpdate(ctx, config.Name, corerpv20250801.RecipePackResource{
+				Location:   to.Ptr(v1.LocationGlobal),
+				Properties: &desired,
+			}, nil)
+			return err
+		},
+	}
+
+	existing, err := client.Get(ctx, config.Name, nil)
+	if clients.Is404Error(err) {
+		change.Action = platformActionCreate
+		return change, nil
+	} else if err != nil {
+		return platformChange{}, err
+	}
+
+	current := corerpv20250801.RecipePackProperties{}
+	if existing.Properties != nil {
+		current.Recipes = existing.Properties.Recipes
+	}
+
+	change.Action, err = compareProperties(desired, current)
+	if err != nil {
+		return platformChange{}, err
+	}
+
+	return change, nil
+}
+
+func (r *Runner) planRadiusCoreEnvironment(ctx context.Context, config platformEnvironmentConfig) (platformChange, error) {
+	client := r.RadiusCoreClientFactory.NewEnvironmentsClient()
+
+	recipePacks := []*string{}
+	for _, recipePack := range config.RecipePacks {
+		if !strings.Contains(recipePack, "/") {
+			recipePack = r.Workspace.Scope + "/providers/Radius.Core/recipePacks/" + recipePack
+		}
+		recipePacks = append(recipePacks, new(recipePack))
+	}
+
+	desired := corerpv20250801.EnvironmentProperties{
+		Providers: &corerpv20250801.Providers{
+			Kubernetes: &corerpv20250801.ProvidersKubernetes{
+				Namespace: new(config.Namespace),
+			},
+		},
+		RecipePacks:      recipePacks,
+		RecipeParameters: config.RecipeParameters,
+	}
+	if config.Providers.Azure != nil {
+		desired.Providers.Azure = &corerpv20250801.ProvidersAzure{
+			SubscriptionID:    new(config.Providers.Azure.SubscriptionID),
+			ResourceGroupName: new(config.Providers.Azure.ResourceGroup),
+		}
+	}
+	if config.Providers.AWS != nil {
+		desired.Providers.Aws = &corerpv20250801.ProvidersAws{
+			AccountID: new(config.Providers.AWS.AccountID),
+			Region:    new(config.Providers.AWS.Region),
+		}
+	}
+
+	change := platformChange{
+		Type: "Environment",
+		Name: config.Name,
+		apply: func(ctx context.Context) error {
+			_, err := client.CreateOrUpdate(ctx, config.Name, corerpv20250801.EnvironmentResource{
+				Location:   to.Ptr(v1.LocationGlobal),
+				Properties: &desired,
+			}, nil)
+			return err
+		},
+	}
+
+	existing, err := client.Get(ctx, config.Name, nil)
+	if clients.Is404Error(err) {
+		change.Action = platformActionCreate
+		return change, nil
+	} else if err != nil {
+		return platformChange{}, err
+	}
+
+	current := corerpv20250801.EnvironmentProperties{}
+	if existing.Properties != nil {
+		current.Providers = existing.Properties.Providers
+		current.RecipePacks = existing.Properties.RecipePacks
+		current.RecipeParameters = existing.Properties.RecipeParameters
+	}
+
+	change.Action, err = compareProperties(desired, current)
+	if err != nil {
+		return platformChange{}, err
+	}
+
+	return change, nil
+}
+
+func (r *Runner) planApplicationsCoreEnvironment(ctx context.Context, client clients.ApplicationsManagementClient, config platformEnvironmentConfig) (platformChange, error) {
+	providerList := []any{}
+	if config.Providers.Azure != nil {
+		providerList = append(providerList, &azure.Provider{
+			SubscriptionID: config.Providers.Azure.SubscriptionID,
+			ResourceGroup:  config.Providers.Azure.ResourceGroup,
+		})
+	}
+	if config.Providers.AWS != nil {
+		providerList = append(providerList, &aws.Provider{
+			AccountID: config.Providers.AWS.AccountID,
+			Region:    config.Providers.AWS.Region,
+		})
+	}
+
+	providers, err := cmd.CreateEnvProviders(providerList)
+	if err != nil {
+		return platformChange{}, err
+	}
+
+	recipes := map[string]map[string]corerp.RecipePropertiesClassification{}
+	for resourceType, typeRecipes := range config.Recipes {
+		recipes[resourceType] = map[string]corerp.RecipePropertiesClassification{}
+		for name, recipe := range typeRecipes {
+			if recipe.Kind == recipe_types.TemplateKindTerraform {
+				recipes[resourceType][name] = &corerp.TerraformRecipeProperties{
+					TemplateKind: to.Ptr(recipe_types.TemplateKindTerraform),
+					TemplatePath: new(recipe.Location),
+					Parameters:   recipe.Parameters,
+				}
+			} else {
+				recipes[resourceType][name] = &corerp.BicepRecipeProperties{
+					TemplateKind: to.Ptr(recipe_types.TemplateKindBicep),
+					TemplatePath: new(recipe.Location),
+					Parameters:   recipe.Parameters,
+					PlainHTTP:    to.Ptr(recipe.PlainHTTP),
+				}
+			}
+		}
+	}
+
+	desired := corerp.EnvironmentProperties{
+		Compute: &corerp.KubernetesCompute{
+			Namespace: new(config.Namespace),
+		},
+		Providers: &providers,
+		Recipes:   recipes,
+	}
+
+	id := fmt.Sprintf("%s/providers/%s/environments/%s", r.Workspace.Scope, platformEnvironmentKindApplicationsCore, config.Name)
+	change := platformChange{
+		Type: "Environment",
+		Name: config.Name,
+		apply: func(ctx context.Context) error {
+			return client.CreateOrUpdateEnvironment(ctx, id, &corerp.EnvironmentResource{
+				Location:   to.Ptr(v1.LocationGlobal),
+				Properties: &desired,
+			})
+		},
+	}
+
+	existing, err := client.GetEnvironment(ctx, id)
+	if clients.Is404Error(err) {
+		change.Action = platformActionCreate
+		return change, nil
+	} else if err != nil {
+		return platformChange{}, err
+	}
+
+	current := corerp.EnvironmentProperties{}
+	if existing.Properties != nil {
+		current.Compute = existing.Properties.Compute
+		current.Providers = existing.Properties.Providers
+		current.Recipes = existing.Properties.Recipes
+	}
+
+	change.Action, err = compareProperties(desired, current)
+	if err != nil {
+		return platformChange{}, err
+	}
+
+	return change, nil
+}
+
+// planCredentials returns the changes of the cloud provider credentials. Secrets can't be read back, so a credential
+// is unchanged when its kind and identifiers match the registered credential, even if its secret differs.
+func (r *Runner) planCredentials(ctx context.Context) ([]platformChange, error) {
+	changes := []platformChange{}
+	if r.platform.Credentials.Azure == nil && r.platform.Credentials.AWS == nil {
+		return changes, nil
+	}
+
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if config := r.platform.Credentials.Azure; config != nil {
+		provider := &azure.Provider{}
+		if config.ServicePrincipal != nil {
+			provider.CredentialKind = azure.AzureCredentialKindServicePrincipal
+			provider.ServicePrincipal = &azure.ServicePrincipalCredential{
+				TenantID:     config.ServicePrincipal.TenantID,
+				ClientID:     config.ServicePrincipal.ClientID,
+				ClientSecret: config.ServicePrincipal.ClientSecret,
+			}
+		} else {
+			provider.CredentialKind = azure.AzureCredentialKindWorkloadIdentity
+			provider.WorkloadIdentity = &azure.WorkloadIdentityCredential{
+				TenantID: config.WorkloadIdentity.TenantID,
+				ClientID: config.WorkloadIdentity.ClientID,
+			}
+		}
+
+		credential, err := getAzureCredential(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := client.Get(ctx, cli_credential.AzureCredential)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, platformChange{
+			Action: azureCredentialAction(provider, existing),
+			Type:   "Credential",
+			Name:   "azure",
+			apply: func(ctx context.Context) error {
+				return client.PutAzure(ctx, credential)
+			},
+		})
+	}
+
+	if config := r.platform.Credentials.AWS; config != nil {
+		provider := &aws.Provider{}
+		if config.AccessKey != nil {
+			provider.CredentialKind = aws.AWSCredentialKindAccessKey
+			provider.AccessKey = &aws.AccessKeyCredential{
+				AccessKeyID:     config.AccessKey.AccessKeyID,
+				SecretAccessKey: config.AccessKey.SecretAccessKey,
+			}
+		} else {
+			provider.CredentialKind = aws.AWSCredentialKindIRSA
+			provider.IRSA = &aws.IRSACredential{
+				RoleARN: config.IRSA.RoleARN,
+			}
+		}
+
+		credential, err := getAWSCredential(provider)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := client.Get(ctx, cli_credential.AWSCredential)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, platformChange{
+			Action: awsCredentialAction(provider, existing),
+			Type:   "Credential",
+			Name:   "aws",
+			apply: func(ctx context.Context) error {
+				return client.PutAWS(ctx, credential)
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+// azureCredentialAction compares the kind, tenant and client of the configured Azure credential with the registered
+// credential.
+func azureCredentialAction(provider *azure.Provider, existing cli_credential.ProviderCredentialConfiguration) string {
+	if !existing.Enabled || existing.AzureCredentials == nil {
+		return platformActionCreate
+	}
+
+	current := existing.AzureCredentials
+	switch {
+	case provider.ServicePrincipal != nil && current.ServicePrincipal != nil &&
+		to.String(current.Kind) == string(provider.CredentialKind) &&
+		to.String(current.ServicePrincipal.TenantID) == provider.ServicePrincipal.TenantID &&
+		to.String(current.ServicePrincipal.ClientID) == provider.ServicePrincipal.ClientID:
+		return platformActionUnchanged
+	case provider.WorkloadIdentity != nil && current.WorkloadIdentity != nil &&
+		to.String(current.Kind) == string(provider.CredentialKind) &&
+		to.String(current.WorkloadIdentity.TenantID) == provider.WorkloadIdentity.TenantID &&
+		to.String(current.WorkloadIdentity.ClientID) == provider.WorkloadIdentity.ClientID:
+		return platformActionUnchanged
+	}
+
+	return platformActionUpdate
+}
+
+// awsCredentialAction compares the kind and access key ID or role ARN of the configured AWS credential with the
+// registered credential.
+func awsCredentialAction(provider *aws.Provider, existing cli_credential.ProviderCredentialConfiguration) string {
+	if !existing.Enabled || existing.AWSCredentials == nil {
+		return platformActionCreate
+	}
+
+	current := existing.AWSCredentials
+	switch {
+	case provider.AccessKey != nil && current.AccessKey != nil &&
+		to.String(current.Kind) == string(provider.CredentialKind) &&
+		to.String(current.AccessKey.AccessKeyID) == provider.AccessKey.AccessKeyID:
+		return platformActionUnchanged
+	case provider.IRSA != nil && current.IRSA != nil &&
+		to.String(current.Kind) == string(provider.CredentialKind) &&
+		to.String(current.IRSA.RoleARN) == provider.IRSA.RoleARN:
+		return platformActionUnchanged
+	}
+
+	return platformActionUpdate
+}
+
+// planWorkspace returns the change of the workspace in the config file. The workspace is also set as the default
+// workspace.
+func (r *Runner) planWorkspace(ctx context.Context) (platformChange, error) {
+	config := r.ConfigFileInterface.ConfigFromContext(ctx)
+	change := platformChange{
+		Action: platformActionCreate,
+		Type:   "Workspace",
+		Name:   r.Workspace.Name,
+		apply: func(ctx context.Context) error {
+			return r.ConfigFileInterface.EditWorkspaces(ctx, config, r.Workspace)
+		},
+	}
+
+	section, err := cli.ReadWorkspaceSection(config)
+	if err != nil {
+		return platformChange{}, err
+	}
+
+	existing, ok := section.Items[cases.Fold().String(r.Workspace.Name)]
+	if !ok {
+		return change, nil
+	}
+
+	change.Action = platformActionUpdate
+	if section.Default == r.Workspace.Name && existing.Scope == r.Workspace.Scope && existing.Environment == r.Workspace.Environment && reflect.DeepEqual(existing.Connection, r.Workspace.Connection) {
+		change.Action = platformActionUnchanged
+	}
+
+	return change, nil
+}
+
+// compareProperties returns update if the desired and current properties differ, and unchanged otherwise. Properties
+// are compared by their JSON representation, where empty objects and arrays are equal to missing values.
+func compareProperties(desired any, current any) (string, error) {
+	normalizedDesired, err := normalizeJSON(desired)
+	if err != nil {
+		return "", err
+	}
+
+	normalizedCurrent, err := normalizeJSON(current)
+	if err != nil {
+		return "", err
+	}
+
+	if reflect.DeepEqual(normalizedDesired, normalizedCurrent) {
+		return platformActionUnchanged, nil
+	}
+
+	return platformActionUpdate, nil
+}
+
+func normalizeJSON(value any) (any, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	err = json.Unmarshal(b, &normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return removeEmpty(normalized), nil
+}
+
+// removeEmpty removes the null values, empty objects and empty arrays of a JSON value.
+func removeEmpty(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			item = removeEmpty(item)
+			if item != nil {
+				result[key] = item
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			result = append(result, removeEmpty(item))
+		}
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,574 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package radinit
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/azure"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/test_client_factory"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	corerpfake "github.com/radius-project/radius/pkg/corerp/api/v20250801preview/fake"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const testPlatformManifest = `
+namespace: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema: {}
+`
+
+const testPlatformConfig = `
+workspace:
+  name: platform
+  connection:
+    kind: kubernetes
+    context: kind-kind
+  group: platform-group
+resourceTypes:
+  - types
+recipePacks:
+  - name: default-pack
+    recipes:
+      MyCompany.Resources/testResources:
+        kind: bicep
+        location: ghcr.io/mycompany/recipes/test:latest
+environments:
+  - name: dev
+    namespace: dev
+    recipePacks:
+      - default-pack
+    recipeParameters:
+      MyCompany.Resources/testResources:
+        size: 3
+  - name: legacy
+    kind: Applications.Core
+credentials:
+  aws:
+    irsa:
+      roleArn: ${TEST_PLATFORM_ROLE_ARN}
+`
+
+func writePlatformConfig(t *testing.T, config string) string {
+	directory := t.TempDir()
+	err := os.Mkdir(filepath.Join(directory, "types"), 0755)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(directory, "types", "resources.yaml"), []byte(testPlatformManifest), 0644)
+	require.NoError(t, err)
+
+	path := filepath.Join(directory, "platform.yaml")
+	err = os.WriteFile(path, []byte(config), 0644)
+	require.NoError(t, err)
+	return path
+}
+
+func Test_readPlatformConfig(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		t.Setenv("TEST_PLATFORM_ROLE_ARN", "arn:aws:iam::123456789012:role/radius")
+		path := writePlatformConfig(t, testPlatformConfig)
+
+		config, err := readPlatformConfig(path)
+		require.NoError(t, err)
+
+		require.Equal(t, "platform", config.Workspace.Name)
+		require.Equal(t, "platform-group", config.Workspace.Group)
+		require.Equal(t, "dev", config.Workspace.Environment)
+		require.Equal(t, []string{filepath.Join(filepath.Dir(path), "types")}, config.ResourceTypes)
+		require.Equal(t, platformEnvironmentKindRadiusCore, config.Environments[0].Kind)
+		require.Equal(t, "dev", config.Environments[0].Namespace)
+		require.Equal(t, platformEnvironmentKindApplicationsCore, config.Environments[1].Kind)
+		require.Equal(t, "default", config.Environments[1].Namespace)
+		require.Equal(t, "arn:aws:iam::123456789012:role/radius", config.Credentials.AWS.IRSA.RoleARN)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		config, err := readPlatformConfig(writePlatformConfig(t, "recipePacks:\n  - name: pack\n    recipes:\n      Test.Resources/test:\n        kind: terraform\n        location: https://example.com/$$module\n"))
+		require.NoError(t, err)
+
+		require.Equal(t, "default", config.Workspace.Name)
+		require.Equal(t, "default", config.Workspace.Group)
+		require.Equal(t, "", config.Workspace.Environment)
+		require.Equal(t, "https://example.com/$module", config.RecipePacks[0].Recipes["Test.Resources/test"].Location)
+	})
+
+	tests := []struct {
+		name        string
+		config      string
+		expectedErr string
+	}{
+		{
+			name:        "unset environment variable",
+			config:      testPlatformConfig,
+			expectedErr: "TEST_PLATFORM_ROLE_ARN",
+		},
+		{
+			name:        "unknown field",
+			config:      "workspace:\n  unknown: value\n",
+			expectedErr: "Failed to parse the platform configuration",
+		},
+		{
+			name:        "undefined recipe pack",
+			config:      "environments:\n  - name: dev\n    recipePacks: [missing]\n",
+			expectedErr: `references the recipe pack "missing", which is not defined`,
+		},
+		{
+			name:        "unsupported environment kind",
+			config:      "environments:\n  - name: dev\n    kind: Unknown.Core\n",
+			expectedErr: `unsupported kind "Unknown.Core"`,
+		},
+		{
+			name:        "recipes on a Radius.Core environment",
+			config:      "environments:\n  - name: dev\n    recipes:\n      Test.Resources/test:\n        default:\n          kind: bicep\n          location: ghcr.io/test\n",
+			expectedErr: "can't have recipes",
+		},
+		{
+			name:        "unsupported recipe kind",
+			config:      "recipePacks:\n  - name: pack\n    recipes:\n      Test.Resources/test:\n        kind: helm\n        location: ghcr.io/test\n",
+			expectedErr: `unsupported kind "helm"`,
+		},
+		{
+			name:        "two Azure credential kinds",
+			config:      "credentials:\n  azure:\n    servicePrincipal:\n      clientId: id\n    workloadIdentity:\n      clientId: id\n",
+			expectedErr: "exactly one of servicePrincipal or workloadIdentity",
+		},
+		{
+			name:        "undefined workspace environment",
+			config:      "workspace:\n  environment: prod\nenvironments:\n  - name: dev\n",
+			expectedErr: `The workspace environment "prod" is not defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readPlatformConfig(writePlatformConfig(t, tt.config))
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_Validate_Platform(t *testing.T) {
+	t.Setenv("TEST_PLATFORM_ROLE_ARN", "arn:aws:iam::123456789012:role/radius")
+	config := radcli.LoadConfigWithWorkspace(t)
+	path := writePlatformConfig(t, testPlatformConfig)
+	defaultsPath := writePlatformConfig(t, "environments:\n  - name: dev\n")
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "--plan without --from",
+			Input:         []string{"--plan"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "--from with a missing file",
+			Input:         []string{"--from", filepath.Join(t.TempDir(), "missing.yaml")},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "--from with a connection",
+			Input:         []string{"--from", path, "--plan"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.True(t, r.Plan)
+				require.Equal(t, "platform", r.Workspace.Name)
+				require.Equal(t, map[string]any{"kind": "kubernetes", "context": "kind-kind"}, r.Workspace.Connection)
+				require.Equal(t, "/planes/radius/local/resourceGroups/platform-group", r.Workspace.Scope)
+				require.Equal(t, "/planes/radius/local/resourceGroups/platform-group/providers/Radius.Core/environments/dev", r.Workspace.Environment)
+				require.Len(t, r.platformResourceProviders, 1)
+				require.Equal(t, "MyCompany.Resources", r.platformResourceProviders[0].Namespace)
+			},
+		},
+		{
+			Name:          "--from with the current Kubernetes context",
+			Input:         []string{"--from", defaultsPath},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				initGetKubeContextSuccess(mocks.Kubernetes)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.False(t, r.Plan)
+				require.Equal(t, "default", r.Workspace.Name)
+				require.Equal(t, map[string]any{"kind": "kubernetes", "context": "kind-kind"}, r.Workspace.Connection)
+				require.Equal(t, "/planes/radius/local/resourceGroups/default", r.Workspace.Scope)
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run_Platform(t *testing.T) {
+	t.Setenv("TEST_PLATFORM_ROLE_ARN", "arn:aws:iam::123456789012:role/radius")
+
+	t.Run("plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "platform-group").
+			Return(ucp.ResourceGroupResource{}, radcli.Create404Error()).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "/planes/radius/local/resourceGroups/platform-group/providers/Applications.Core/environments/legacy").
+			Return(corerp.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+
+		credentialManagementClient := cli_credential.NewMockCredentialManagementClient(ctrl)
+		credentialManagementClient.EXPECT().
+			Get(gomock.Any(), cli_credential.AWSCredential).
+			Return(cli_credential.ProviderCredentialConfiguration{}, nil).
+			Times(1)
+
+		recorder := &platformRecorder{}
+		runner, outputSink := newPlatformTestRunner(t, ctrl, appManagementClient, credentialManagementClient, recorder)
+		runner.Plan = true
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, [][3]string{
+			{"create", "ResourceGroup", "platform-group"},
+			{"create", "ResourceType", "MyCompany.Resources/testResources"},
+			{"create", "RecipePack", "default-pack"},
+			{"create", "Environment", "dev"},
+			{"create", "Environment", "legacy"},
+			{"create", "Credential", "aws"},
+			{"create", "Workspace", "platform"},
+		}, platformPlanRows(t, outputSink))
+		require.Empty(t, recorder.recipePacks)
+		require.Empty(t, recorder.environments)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "platform-group").
+			Return(ucp.ResourceGroupResource{}, radcli.Create404Error()).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateResourceGroup(gomock.Any(), "local", "platform-group", &ucp.ResourceGroupResource{Location: to.Ptr(v1.LocationGlobal)}).
+			Return(nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "/planes/radius/local/resourceGroups/platform-group/providers/Applications.Core/environments/legacy").
+			Return(corerp.EnvironmentResource{}, radcli.Create404Error()).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "/planes/radius/local/resourceGroups/platform-group/providers/Applications.Core/environments/legacy", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		credentialManagementClient := cli_credential.NewMockCredentialManagementClient(ctrl)
+		credentialManagementClient.EXPECT().
+			Get(gomock.Any(), cli_credential.AWSCredential).
+			Return(cli_credential.ProviderCredentialConfiguration{}, nil).
+			Times(1)
+		credentialManagementClient.EXPECT().
+			PutAWS(gomock.Any(), ucp.AwsCredentialResource{
+				Location: to.Ptr(v1.LocationGlobal),
+				Type:     to.Ptr(cli_credential.AWSCredential),
+				Properties: &ucp.AwsIRSACredentialProperties{
+					Storage: &ucp.CredentialStorageProperties{
+						Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+					},
+					RoleARN: new("arn:aws:iam::123456789012:role/radius"),
+				},
+			}).
+			Return(nil).
+			Times(1)
+
+		recorder := &platformRecorder{}
+		runner, outputSink := newPlatformTestRunner(t, ctrl, appManagementClient, credentialManagementClient, recorder)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"default-pack"}, recorder.recipePacks)
+		require.Equal(t, []string{"dev"}, recorder.environments)
+		require.Equal(t, []*string{new("/planes/radius/local/resourceGroups/platform-group/providers/Radius.Core/recipePacks/default-pack")}, recorder.environment.Properties.RecipePacks)
+		require.Equal(t, "dev", *recorder.environment.Properties.Providers.Kubernetes.Namespace)
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "Applied %d change(s) to the platform.", Params: []any{7}})
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceGroup(gomock.Any(), "local", "platform-group").
+			Return(ucp.ResourceGroupResource{}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "/planes/radius/local/resourceGroups/platform-group/providers/Applications.Core/environments/legacy").
+			Return(corerp.EnvironmentResource{
+				Properties: &corerp.EnvironmentProperties{
+					Compute: &corerp.KubernetesCompute{
+						Kind:      new("kubernetes"),
+						Namespace: new("default"),
+					},
+					Providers:         &corerp.Providers{},
+					Recipes:           map[string]map[string]corerp.RecipePropertiesClassification{},
+					ProvisioningState: to.Ptr(corerp.ProvisioningStateSucceeded),
+				},
+			}, nil).
+			Times(1)
+
+		// The registered credential has the same role, so it's not updated.
+		credentialManagementClient := cli_credential.NewMockCredentialManagementClient(ctrl)
+		credentialManagementClient.EXPECT().
+			Get(gomock.Any(), cli_credential.AWSCredential).
+			Return(cli_credential.ProviderCredentialConfiguration{
+				CloudProviderStatus: cli_credential.CloudProviderStatus{Name: cli_credential.AWSCredential, Enabled: true},
+				AWSCredentials: &cli_credential.AWSCredentialProperties{
+					Kind: new(string(ucp.AWSCredentialKindIRSA)),
+					IRSA: &cli_credential.AWSIRSACredentialProperties{RoleARN: new("arn:aws:iam::123456789012:role/radius")},
+				},
+			}, nil).
+			Times(1)
+
+		recorder := &platformRecorder{existing: true}
+		runner, outputSink := newPlatformTestRunner(t, ctrl, appManagementClient, credentialManagementClient, recorder)
+		runner.platform.ResourceTypes = nil
+		runner.platformResourceProviders = nil
+		runner.ConfigFileInterface = framework.NewMockConfigFileInterface(ctrl)
+		runner.ConfigFileInterface.(*framework.MockConfigFileInterface).EXPECT().
+			ConfigFromContext(gomock.Any()).
+			Return(radcli.LoadConfig(t, `
+workspaces:
+  default: platform
+  items:
+    platform:
+      connection:
+        context: kind-kind
+        kind: kubernetes
+      environment: /planes/radius/local/resourceGroups/platform-group/providers/Radius.Core/environments/dev
+      scope: /planes/radius/local/resourceGroups/platform-group
+`)).
+			Times(1)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Equal(t, [][3]string{
+			{"unchanged", "ResourceGroup", "platform-group"},
+			{"unchanged", "RecipePack", "default-pack"},
+			{"unchanged", "Environment", "dev"},
+			{"unchanged", "Environment", "legacy"},
+			{"unchanged", "Credential", "aws"},
+			{"unchanged", "Workspace", "platform"},
+		}, platformPlanRows(t, outputSink))
+		require.Empty(t, recorder.recipePacks)
+		require.Empty(t, recorder.environments)
+		require.Contains(t, outputSink.Writes, output.LogOutput{Format: "The platform is up to date."})
+	})
+}
+
+// platformRecorder records the Radius.Core resources created by `rad init --from`. When existing is set, the
+// resources of the test platform configuration already exist.
+type platformRecorder struct {
+	existing     bool
+	recipePacks  []string
+	environments []string
+	environment  corerpv20250801.EnvironmentResource
+}
+
+func newPlatformTestRunner(t *testing.T, ctrl *gomock.Controller, appManagementClient clients.ApplicationsManagementClient, credentialManagementClient cli_credential.CredentialManagementClient, recorder *platformRecorder) (*Runner, *output.MockOutput) {
+	config, err := readPlatformConfig(writePlatformConfig(t, testPlatformConfig))
+	require.NoError(t, err)
+
+	resourceProvider, err := manifest.ReadBytes([]byte(testPlatformManifest))
+	require.NoError(t, err)
+
+	ucpClientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNotFoundError)
+	require.NoError(t, err)
+
+	scope := "/planes/radius/local/resourceGroups/platform-group"
+	radiusCoreClientFactory, err := test_client_factory.NewRadiusCoreTestClientFactory(scope,
+		func() corerpfake.EnvironmentsServer {
+			return corerpfake.EnvironmentsServer{
+				Get: func(ctx context.Context, environmentName string, options *corerpv20250801.EnvironmentsClientGetOptions) (resp azfake.Responder[corerpv20250801.EnvironmentsClientGetResponse], errResp azfake.ErrorResponder) {
+					if !recorder.existing {
+						errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+						return
+					}
+					resp.SetResponse(http.StatusOK, corerpv20250801.EnvironmentsClientGetResponse{
+						EnvironmentResource: corerpv20250801.EnvironmentResource{
+							Name: new(environmentName),
+							Properties: &corerpv20250801.EnvironmentProperties{
+								Providers: &corerpv20250801.Providers{
+									Kubernetes: &corerpv20250801.ProvidersKubernetes{Namespace: new("dev")},
+								},
+								RecipePacks: []*string{new(scope + "/providers/Radius.Core/recipePacks/default-pack")},
+								RecipeParameters: map[string]map[string]any{
+									"MyCompany.Resources/testResources": {"size": float64(3)},
+								},
+								ProvisioningState: to.Ptr(corerpv20250801.ProvisioningStateSucceeded),
+							},
+						},
+					}, nil)
+					return
+				},
+				CreateOrUpdate: func(ctx context.Context, environmentName string, resource corerpv20250801.EnvironmentResource, options *corerpv20250801.EnvironmentsClientCreateOrUpdateOptions) (resp azfake.Responder[corerpv20250801.EnvironmentsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+					recorder.environments = append(recorder.environments, environmentName)
+					recorder.environment = resource
+					resp.SetResponse(http.StatusOK, corerpv20250801.EnvironmentsClientCreateOrUpdateResponse{EnvironmentResource: resource}, nil)
+					return
+				},
+			}
+		},
+		func() corerpfake.RecipePacksServer {
+			return corerpfake.RecipePacksServer{
+				Get: func(ctx context.Context, recipePackName string, options *corerpv20250801.RecipePacksClientGetOptions) (resp azfake.Responder[corerpv20250801.RecipePacksClientGetResponse], errResp azfake.ErrorResponder) {
+					if !recorder.existing {
+						errResp.SetResponseError(http.StatusNotFound, v1.CodeNotFound)
+						return
+					}
+					resp.SetResponse(http.StatusOK, corerpv20250801.RecipePacksClientGetResponse{
+						RecipePackResource: corerpv20250801.RecipePackResource{
+							Name: new(recipePackName),
+							Properties: &corerpv20250801.RecipePackProperties{
+								Recipes: map[string]*corerpv20250801.RecipeDefinition{
+									"MyCompany.Resources/testResources": {
+										RecipeKind:     to.Ptr(corerpv20250801.RecipeKindBicep),
+										RecipeLocation: new("ghcr.io/mycompany/recipes/test:latest"),
+										PlainHTTP:      new(false),
+									},
+								},
+								ProvisioningState: to.Ptr(corerpv20250801.ProvisioningStateSucceeded),
+							},
+						},
+					}, nil)
+					return
+				},
+				CreateOrUpdate: func(ctx context.Context, recipePackName string, resource corerpv20250801.RecipePackResource, options *corerpv20250801.RecipePacksClientCreateOrUpdateOptions) (resp azfake.Responder[corerpv20250801.RecipePacksClientCreateOrUpdateResponse], errResp azfake.ErrorResponder) {
+					recorder.recipePacks = append(recorder.recipePacks, recipePackName)
+					resp.SetResponse(http.StatusOK, corerpv20250801.RecipePacksClientCreateOrUpdateResponse{RecipePackResource: resource}, nil)
+					return
+				},
+			}
+		})
+	require.NoError(t, err)
+
+	configFileInterface := framework.NewMockConfigFileInterface(ctrl)
+	configFileInterface.EXPECT().
+		ConfigFromContext(gomock.Any()).
+		Return(radcli.LoadConfigWithWorkspace(t)).
+		AnyTimes()
+	configFileInterface.EXPECT().
+		EditWorkspaces(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		AnyTimes()
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{
+			ApplicationsManagementClient: appManagementClient,
+			CredentialManagementClient:   credentialManagementClient,
+		},
+		ConfigFileInterface:       configFileInterface,
+		Output:                    outputSink,
+		Format:                    "table",
+		From:                      "platform.yaml",
+		UCPClientFactory:          ucpClientFactory,
+		RadiusCoreClientFactory:   radiusCoreClientFactory,
+		platform:                  config,
+		platformResourceProviders: []*manifest.ResourceProvider{resourceProvider},
+		Workspace: &workspaces.Workspace{
+			Name:        "platform",
+			Connection:  map[string]any{"kind": "kubernetes", "context": "kind-kind"},
+			Scope:       scope,
+			Environment: scope + "/providers/Radius.Core/environments/dev",
+		},
+	}
+
+	return runner, outputSink
+}
+
+// platformPlanRows returns the action, type and name of the changes of the plan written to the output.
+func platformPlanRows(t *testing.T, outputSink *output.MockOutput) [][3]string {
+	for _, write := range outputSink.Writes {
+		formatted, ok := write.(output.FormattedOutput)
+		if !ok {
+			continue
+		}
+
+		changes, ok := formatted.Obj.([]platformChange)
+		require.True(t, ok)
+
+		rows := [][3]string{}
+		for _, change := range changes {
+			rows = append(rows, [3]string{change.Action, change.Type, change.Name})
+		}
+		return rows
+	}
+
+	require.Fail(t, "the plan was not written to the output")
+	return nil
+}
+
+func Test_azureCredentialAction(t *testing.T) {
+	provider := &azure.Provider{
+		CredentialKind: azure.AzureCredentialKindServicePrincipal,
+		ServicePrincipal: &azure.ServicePrincipalCredential{
+			TenantID:     "tenant",
+			ClientID:     "client",
+			ClientSecret: "secret",
+		},
+	}
+	registered := func(tenantID string) cli_credential.ProviderCredentialConfiguration {
+		return cli_credential.ProviderCredentialConfiguration{
+			CloudProviderStatus: cli_credential.CloudProviderStatus{Name: cli_credential.AzureCredential, Enabled: true},
+			AzureCredentials: &cli_credential.AzureCredentialProperties{
+				Kind: new(string(ucp.AzureCredentialKindServicePrincipal)),
+				ServicePrincipal: &cli_credential.AzureServicePrincipalCredentialProperties{
+					TenantID: new(tenantID),
+					ClientID: new("client"),
+				},
+			},
+		}
+	}
+
+	require.Equal(t, platformActionCreate, azureCredentialAction(provider, cli_credential.ProviderCredentialConfiguration{}))
+	require.Equal(t, platformActionUnchanged, azureCredentialAction(provider, registered("tenant")))
+	require.Equal(t, platformActionUpdate, azureCredentialAction(provider, registered("other")))
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// ContainerType is the only resource type supported by exec.
+	ContainerType = "Applications.Core/containers"
+)
+
+// DefaultCommand is the command run when none is specified.
+var DefaultCommand = []string{"/bin/sh"}
+
+// NewCommand creates an instance of the command and runner for the `rad resource exec` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "exec [type] [resource] [-- command]",
+		Short: "Run a command in a container resource",
+		Long: `Run a command in a running replica of a container resource. Currently only supports the resource type 'Applications.Core/containers'.
+
+An interactive shell is opened if no command is specified. A terminal is allocated when the standard input and output of 'rad' are terminals.
+
+'rad resource exec' runs the command in the resource's primary container. In scenarios like Dapr where multiple containers are in use, the '--container <name>' option can specify the desired container.`,
+		Example: `
+# open a shell in the 'webapp' resource of the current default app
+rad resource exec Applications.Core/containers webapp
+
+# list the files of the 'orders' resource of the 'icecream-store' application
+rad resource exec Applications.Core/containers orders --application icecream-store -- ls -la
+
+# open a shell in the 'daprd' sidecar container of a specific replica of the 'orders' resource
+rad resource exec Applications.Core/containers orders --container daprd --replica orders-7d9f8b6c5-x2k4p`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	AddExecFlags(cmd)
+
+	return cmd, runner
+}
+
+// AddExecFlags adds the flags selecting the replica and container to run the command in.
+func AddExecFlags(cmd *cobra.Command) {
+	cmd.Flags().String("container", "", "specify the container in which the command is run")
+	cmd.Flags().String("replica", "", "specify the replica in which the command is run")
+}
+
+// Runner is the runner implementation for the `rad resource exec` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	ResourceType    string
+	ResourceName    string
+	Container       string
+	Replica         string
+	Command         []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	TTY    bool
+}
+
+// NewRunner creates a new instance of the `rad resource exec` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		Stdin:             os.Stdin,
+		Stdout:            os.Stdout,
+		Stderr:            os.Stderr,
+		TTY:               isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stdout.Fd()),
+	}
+}
+
+// Validate runs validation for the `rad resource exec` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	resourceArgs, command := SplitCommand(cmd, args)
+
+	resourceType, resourceName, err := cli.RequireResource(cmd, resourceArgs)
+	if err != nil {
+		return err
+	}
+	if len(resourceArgs) > 2 {
+		return clierrors.Message("Unexpected arguments %q. Use '--' to separate the command to run, e.g. 'rad resource exec %s %s -- ls'.", strings.Join(resourceArgs[2:], " "), resourceType, resourceName)
+	}
+
+	r.ResourceType = resourceType
+	r.ResourceName = resourceName
+	r.Command = command
+
+	return r.ValidateCommon(cmd)
+}
+
+// ValidateCommon validates the workspace, application and flags shared by the exec commands.
+func (r *Runner) ValidateCommon(cmd *cobra.Command) error {
+	if !strings.EqualFold(r.ResourceType, ContainerType) {
+		return clierrors.Message("Only %s is supported.", ContainerType)
+	}
+
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplication(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Container, err = cmd.Flags().GetString("container")
+	if err != nil {
+		return err
+	}
+
+	r.Replica, err = cmd.Flags().GetString("replica")
+	if err != nil {
+		return err
+	}
+
+	if len(r.Command) == 0 {
+		r.Command = DefaultCommand
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource exec` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	err = client.Exec(ctx, clients.ExecOptions{
+		Application: r.ApplicationName,
+		Resource:    r.ResourceName,
+		Replica:     r.Replica,
+		Container:   r.Container,
+		Command:     r.Command,
+		Stdin:       r.Stdin,
+		Stdout:      r.Stdout,
+		Stderr:      r.Stderr,
+		TTY:         r.TTY,
+	})
+	if clients.Is404Error(err) {
+		return clierrors.Message("The resource %q of application %q was not found or has been deleted.", r.ResourceName, r.ApplicationName)
+	}
+
+	return err
+}
+
+// SplitCommand splits the arguments into the ones before '--' and the command after it.
+func SplitCommand(cmd *cobra.Command, args []string) ([]string, []string) {
+	dash := cmd.ArgsLenAtDash()
+	if dash < 0 {
+		return args, nil
+	}
+
+	return args[:dash], args[dash:]
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Exec Command with default shell",
+			Input:         []string{"Applications.Core/containers", "webapp", "-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "webapp", r.ResourceName)
+				require.Equal(t, "test-app", r.ApplicationName)
+				require.Equal(t, DefaultCommand, r.Command)
+			},
+		},
+		{
+			Name:          "Exec Command with command",
+			Input:         []string{"Applications.Core/containers", "webapp", "-a", "test-app", "--container", "daprd", "--replica", "webapp-abc", "--", "ls", "-la"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, []string{"ls", "-la"}, r.Command)
+				require.Equal(t, "daprd", r.Container)
+				require.Equal(t, "webapp-abc", r.Replica)
+			},
+		},
+		{
+			Name:          "Exec Command with unsupported resource type",
+			Input:         []string{"Applications.Core/gateways", "gateway", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Exec Command with command but without separator",
+			Input:         []string{"Applications.Core/containers", "webapp", "ls", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Exec Command without application",
+			Input:         []string{"Applications.Core/containers", "webapp"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Exec Command without resource",
+			Input:         []string{"Applications.Core/containers"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	stdin := strings.NewReader("")
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+	diagnosticsClient.EXPECT().
+		Exec(gomock.Any(), clients.ExecOptions{
+			Application: "test-app",
+			Resource:    "webapp",
+			Container:   "daprd",
+			Command:     []string{"ls"},
+			Stdin:       stdin,
+			Stdout:      stdout,
+			Stderr:      stderr,
+		}).
+		Return(nil).
+		Times(1)
+
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+		Output:            &output.MockOutput{},
+		Workspace:         &workspaces.Workspace{Name: "test", Scope: "/planes/radius/local/resourceGroups/test-group"},
+		ApplicationName:   "test-app",
+		ResourceType:      ContainerType,
+		ResourceName:      "webapp",
+		Container:         "daprd",
+		Command:           []string{"ls"},
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Stderr:            stderr,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+}
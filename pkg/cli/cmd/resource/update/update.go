@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad resource update` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "update [resourceType] [resourceName] --set key=value",
+		Short: "Update properties of a resource",
+		Long: `Update properties of an existing resource
+
+Each --set flag updates a single property of the resource. Nested properties are specified with a dot-separated
+path. Values are parsed as JSON when possible (numbers, booleans, objects and arrays) and as strings otherwise.
+Setting a property to null removes it from the resource.
+
+Properties that are not specified are left unchanged.`,
+		Example: `
+# update a property of a resource
+rad resource update Applications.Core/containers orders --set container.image=ghcr.io/radius-project/orders:v2
+
+# update multiple properties of a resource
+rad resource update Radius.Data/redisCaches cache --set size=L --set replicas=3
+
+# remove a property from a resource
+rad resource update Radius.Data/redisCaches cache --set replicas=null`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set a property of the resource (can specify multiple): key=value or nested.key=value")
+	_ = cmd.MarkFlagRequired("set")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource update` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Set                            []string
+	Patch                          *generated.GenericResource
+}
+
+// NewRunner creates an instance of the runner for the `rad resource update` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource update` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	properties, err := parseSetValues(r.Set)
+	if err != nil {
+		return err
+	}
+	r.Patch = &generated.GenericResource{Properties: properties}
+
+	return nil
+}
+
+// Run runs the `rad resource update` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.UpdateResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, r.Patch)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, response, objectformats.GetGenericResourceTableFormat())
+}
+
+// parseSetValues converts the key=value pairs of the --set flags into the properties of a JSON merge patch.
+func parseSetValues(values []string) (map[string]any, error) {
+	if len(values) == 0 {
+		return nil, clierrors.Message("At least one property must be specified with --set.")
+	}
+
+	properties := map[string]any{}
+	for _, value := range values {
+		key, raw, ok := strings.Cut(value, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, clierrors.Message("Invalid value %q for --set. Values must be specified as key=value.", value)
+		}
+
+		segments := strings.Split(strings.TrimSpace(key), ".")
+		if err := setProperty(properties, segments, parseValue(raw)); err != nil {
+			return nil, clierrors.Message("Invalid value %q for --set: %v.", value, err)
+		}
+	}
+
+	return properties, nil
+}
+
+func setProperty(properties map[string]any, segments []string, value any) error {
+	current := properties
+	for i, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("property path contains an empty segment")
+		}
+
+		if i == len(segments)-1 {
+			if _, ok := current[segment].(map[string]any); ok {
+				return fmt.Errorf("property %q is already set by another value", strings.Join(segments[:i+1], "."))
+			}
+			current[segment] = value
+			return nil
+		}
+
+		next, ok := current[segment]
+		if !ok {
+			child := map[string]any{}
+			current[segment] = child
+			current = child
+			continue
+		}
+
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("property %q is already set by another value", strings.Join(segments[:i+1], "."))
+		}
+		current = child
+	}
+
+	return nil
+}
+
+// parseValue parses the value as JSON so numbers, booleans, null, objects and arrays keep their type. Anything that
+// is not valid JSON is treated as a string.
+func parseValue(raw string) any {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+
+	return value
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Update Command",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "message=hello"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Test/exampleResources", r.FullyQualifiedResourceTypeName)
+				require.Equal(t, "foo", r.ResourceName)
+				require.Equal(t, &generated.GenericResource{Properties: map[string]any{"message": "hello"}}, r.Patch)
+			},
+		},
+		{
+			Name:          "Update Command with fallback workspace",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "message=hello", "-g", "my-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Update Command without --set",
+			Input:         []string{"Applications.Test/exampleResources", "foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command with invalid --set value",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "message"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo", "--set", "message=hello"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Update Command with insufficient args",
+			Input:         []string{"Applications.Test/exampleResources", "--set", "message=hello"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	patch := &generated.GenericResource{
+		Properties: map[string]any{"message": "hello"},
+	}
+	resource := radcli.CreateResource("Applications.Test/exampleResources", "foo")
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		UpdateResource(gomock.Any(), "Applications.Test/exampleResources", "foo", patch).
+		Return(resource, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+
+	runner := &Runner{
+		ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:                         outputSink,
+		Workspace:                      &workspaces.Workspace{},
+		FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+		ResourceName:                   "foo",
+		Patch:                          patch,
+		Format:                         "table",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     resource,
+			Options: objectformats.GetGenericResourceTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
+
+func Test_parseSetValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected map[string]any
+		err      string
+	}{
+		{
+			name:     "string value",
+			values:   []string{"message=hello"},
+			expected: map[string]any{"message": "hello"},
+		},
+		{
+			name:     "typed values",
+			values:   []string{"replicas=3", "enabled=true", "ports=[80,443]"},
+			expected: map[string]any{"replicas": float64(3), "enabled": true, "ports": []any{float64(80), float64(443)}},
+		},
+		{
+			name:     "nested values",
+			values:   []string{"container.image=nginx:latest", "container.env.FOO=bar"},
+			expected: map[string]any{"container": map[string]any{"image": "nginx:latest", "env": map[string]any{"FOO": "bar"}}},
+		},
+		{
+			name:     "null removes value",
+			values:   []string{"replicas=null"},
+			expected: map[string]any{"replicas": nil},
+		},
+		{
+			name:     "value containing equals sign",
+			values:   []string{"connection=a=b"},
+			expected: map[string]any{"connection": "a=b"},
+		},
+		{
+			name:   "missing value",
+			values: []string{"message"},
+			err:    "Invalid value \"message\" for --set. Values must be specified as key=value.",
+		},
+		{
+			name:   "empty path segment",
+			values: []string{"container..image=nginx"},
+			err:    "Invalid value \"container..image=nginx\" for --set: property path contains an empty segment.",
+		},
+		{
+			name:   "conflicting values",
+			values: []string{"container=nginx", "container.image=nginx"},
+			err:    "Invalid value \"container.image=nginx\" for --set: property \"container\" is already set by another value.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties, err := parseSetValues(tt.values)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, properties)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/manifest"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
@@ -76,6 +77,32 @@ func ResourceTypesForProvider(provider *v20231001preview.ResourceProviderSummary
 	return resourceTypes
 }
 
+// ResourceTypesForManifest returns a list of resource types for a resource provider manifest.
+func ResourceTypesForManifest(resourceProvider *manifest.ResourceProvider) []ResourceType {
+	resourceTypes := []ResourceType{}
+	for name, resourceType := range resourceProvider.Types {
+		rt := ResourceType{
+			Name:                      resourceProvider.Namespace + "/" + name,
+			ResourceProviderNamespace: resourceProvider.Namespace,
+		}
+
+		if resourceType.Description != nil {
+			rt.Description = *resourceType.Description
+		}
+
+		rt.APIVersions = make(map[string]*APIVersionProperties)
+		for apiVersion, properties := range resourceType.APIVersions {
+			schema, _ := properties.Schema.(map[string]any)
+			rt.APIVersions[apiVersion] = &APIVersionProperties{
+				Schema: schema,
+			}
+		}
+
+		resourceTypes = append(resourceTypes, rt)
+	}
+	return resourceTypes
+}
+
 // GetResourceTypeTableFormat returns the fields to output from a resource type object.
 func GetResourceTypeTableFormat() output.FormatterOptions {
 	formatterOptions := GetResourceTypeShowTableFormat()
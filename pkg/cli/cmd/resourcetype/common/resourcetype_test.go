@@ -62,3 +62,31 @@ func Test_GetResourceTypeDetails(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func Test_ResourceTypesForManifest(t *testing.T) {
+	resourceProvider := &manifest.ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*manifest.ResourceType{
+			"testResources": {
+				Description: new("A test resource type."),
+				APIVersions: map[string]*manifest.ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{"type": "object"},
+					},
+				},
+			},
+		},
+	}
+
+	resourceTypes := ResourceTypesForManifest(resourceProvider)
+	require.Equal(t, []ResourceType{
+		{
+			Name:                      "MyCompany.Resources/testResources",
+			Description:               "A test resource type.",
+			ResourceProviderNamespace: "MyCompany.Resources",
+			APIVersions: map[string]*APIVersionProperties{
+				"2025-01-01-preview": {Schema: map[string]any{"type": "object"}},
+			},
+		},
+	}, resourceTypes)
+}
@@ -53,6 +53,8 @@ Input can be passed in using a JSON or YAML file using the --from-file option.
 The resource type name argument is optional. If specified, only the specified type is created/updated. If not specified, all resource types in the referenced file are created/updated.
 
 The resource type name argument is the simple name (e.g., 'testResources') not the fully qualified name.
+
+A new API version must be backward-compatible with the API version it replaces, or declare a conversion from it. Removing a property, changing the type of a property, making a property required, or removing an enum value are backward-incompatible changes.
 `,
 		Example: `
 # Create a specific resource type from a YAML file
@@ -176,6 +178,14 @@ func (r *Runner) registerTypes(ctx context.Context, typeNames []string) error {
 		}
 	}
 
+	// Refuse incompatible schema changes before registering any of the types
+	for _, typeName := range typesToRegister {
+		err = manifest.CheckAPIVersionCompatibility(ctx, r.UCPClientFactory, defaultPlaneName, r.ResourceProvider.Namespace, typeName, r.ResourceProvider.Types[typeName])
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to create resource type %q.", typeName)
+		}
+	}
+
 	// Register each type individually using the unified approach
 	for _, typeName := range typesToRegister {
 		err = manifest.RegisterType(ctx, r.UCPClientFactory, defaultPlaneName, r.ResourceProviderManifestFilePath, typeName, r.Logger)
@@ -209,4 +209,33 @@ func Test_Run(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "unexpected status code 500.")
 	})
+
+	t.Run("Incompatible API version without conversion", func(t *testing.T) {
+		resourceProviderData, err := manifest.ReadFile("testdata/incompatible.yaml")
+		require.NoError(t, err)
+
+		clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+		require.NoError(t, err)
+
+		var logBuffer bytes.Buffer
+		logger := func(format string, args ...any) {
+			fmt.Fprintf(&logBuffer, format+"\n", args...)
+		}
+
+		runner := &Runner{
+			UCPClientFactory:                 clientFactory,
+			Output:                           &output.MockOutput{},
+			Workspace:                        &workspaces.Workspace{},
+			ResourceProvider:                 resourceProviderData,
+			Format:                           "table",
+			Logger:                           logger,
+			ResourceProviderManifestFilePath: "testdata/incompatible.yaml",
+			ResourceTypeName:                 "testResources",
+		}
+
+		err = runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "database: property was removed")
+		require.NotContains(t, logBuffer.String(), "Creating resource type")
+	})
 }
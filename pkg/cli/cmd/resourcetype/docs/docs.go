@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/resourcetypedocs"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad resource-type docs` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "docs [resource-type]",
+		Short: "Generate reference documentation for resource types",
+		Long: `Generate reference documentation for resource types.
+
+A reference page is written for each resource type, in Markdown or HTML. The page lists the API versions of the resource type and, for each API version, a table of its properties with their type, required, read-only and sensitive markers, and allowed values, followed by an example Bicep snippet.
+
+Resource types are read from a resource type definition file using the --from-file option, or from the resource types registered with Radius. When read from Radius, the page also lists the recipes for the resource type in recipe packs.
+
+The resource type argument is optional. If specified, only the page for that fully-qualified resource type is generated. If not specified, pages are generated for all resource types.
+`,
+		Example: `
+# Generate Markdown reference pages for all resource types in a resource type definition file
+rad resource-type docs --from-file /path/to/input.yaml --output-dir ./docs
+
+# Generate an HTML reference page for a resource type registered with Radius
+rad resource-type docs MyCompany.Resources/testResources --format html --output-dir ./docs
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
+	cmd.Flags().StringVarP(&runner.OutputDirectory, "output-dir", "d", ".", "The directory to write the reference pages to")
+	cmd.Flags().StringVar(&runner.DocsFormat, "format", resourcetypedocs.FormatMarkdown, "The format of the reference pages (supported formats are "+strings.Join(resourcetypedocs.SupportedFormats(), ", ")+")")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type docs` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ResourceProviderManifestFilePath string
+	ResourceTypeName                 string
+	OutputDirectory                  string
+	DocsFormat                       string
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type docs` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type docs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		resourceProviderNamespace, resourceTypeSuffix, err := cli.RequireFullyQualifiedResourceType(args)
+		if err != nil {
+			return err
+		}
+		r.ResourceTypeName = resourceProviderNamespace + "/" + resourceTypeSuffix
+	}
+
+	if !slices.Contains(resourcetypedocs.SupportedFormats(), r.DocsFormat) {
+		return clierrors.Message("Unsupported format %q, supported formats are: %s", r.DocsFormat, strings.Join(resourcetypedocs.SupportedFormats(), ", "))
+	}
+
+	// Reference pages generated from a resource type definition file don't need a connection to Radius.
+	if r.ResourceProviderManifestFilePath != "" {
+		return nil
+	}
+
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	return nil
+}
+
+// Run runs the `rad resource-type docs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	var pages []resourcetypedocs.Page
+	var err error
+	if r.ResourceProviderManifestFilePath != "" {
+		pages, err = r.pagesFromManifest(ctx)
+	} else {
+		pages, err = r.pagesFromRadius(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if r.ResourceTypeName != "" {
+		pages = slices.DeleteFunc(pages, func(page resourcetypedocs.Page) bool {
+			return !strings.EqualFold(page.Name, r.ResourceTypeName)
+		})
+		if len(pages) == 0 {
+			return clierrors.Message("Resource type %q not found.", r.ResourceTypeName)
+		}
+	}
+
+	slices.SortFunc(pages, func(a resourcetypedocs.Page, b resourcetypedocs.Page) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	err = os.MkdirAll(r.OutputDirectory, 0755)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to create the output directory %q.", r.OutputDirectory)
+	}
+
+	for _, page := range pages {
+		content, err := resourcetypedocs.Render(page, r.DocsFormat)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(r.OutputDirectory, resourcetypedocs.FileName(page, r.DocsFormat))
+		err = os.WriteFile(path, content, 0644)
+		if err != nil {
+			return clierrors.MessageWithCause(err, "Failed to write the reference page %q.", path)
+		}
+
+		r.Output.LogInfo("Generated reference page for %s: %s", page.Name, path)
+	}
+
+	return nil
+}
+
+// pagesFromManifest creates reference pages for the resource types in the resource type definition file.
+func (r *Runner) pagesFromManifest(ctx context.Context) ([]resourcetypedocs.Page, error) {
+	resourceProvider, err := manifest.ValidateManifest(ctx, r.ResourceProviderManifestFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := []resourcetypedocs.Page{}
+	for _, resourceType := range common.ResourceTypesForManifest(resourceProvider) {
+		pages = append(pages, resourcetypedocs.NewPage(resourceType, nil))
+	}
+
+	return pages, nil
+}
+
+// pagesFromRadius creates reference pages for the resource types registered with Radius, linking the recipes for
+// each resource type in recipe packs.
+func (r *Runner) pagesFromRadius(ctx context.Context) ([]resourcetypedocs.Page, error) {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceProviders, err := client.ListResourceProviderSummaries(ctx, "local")
+	if err != nil {
+		return nil, err
+	}
+
+	recipePacks, err := client.ListRecipePacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recipes := resourcetypedocs.RecipesForResourceTypes(recipePacks)
+
+	pages := []resourcetypedocs.Page{}
+	for _, resourceProvider := range resourceProviders {
+		for _, resourceType := range common.ResourceTypesForProvider(&resourceProvider) {
+			pages = append(pages, resourcetypedocs.NewPage(resourceType, recipes))
+		}
+	}
+
+	return pages, nil
+}
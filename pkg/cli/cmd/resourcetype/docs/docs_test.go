@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	config := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid: from Radius",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Valid: from file without workspace",
+			Input:         []string{"--from-file", "testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: radcli.LoadEmptyConfig(t)},
+		},
+		{
+			Name:          "Valid: resource type and html format",
+			Input:         []string{"MyCompany.Resources/testResources", "--from-file", "testdata/valid.yaml", "--format", "html"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: resource type is not fully-qualified",
+			Input:         []string{"testResources", "--from-file", "testdata/valid.yaml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: unsupported format",
+			Input:         []string{"--from-file", "testdata/valid.yaml", "--format", "pdf"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+		{
+			Name:          "Invalid: too many arguments",
+			Input:         []string{"MyCompany.Resources/testResources", "MyCompany.Resources/otherResources"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: from file", func(t *testing.T) {
+		outputDirectory := t.TempDir()
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:                           outputSink,
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+			OutputDirectory:                  outputDirectory,
+			DocsFormat:                       "markdown",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		path := filepath.Join(outputDirectory, "mycompany.resources_testresources.md")
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(content), "| password | string |  |  | ✓ |  | The password of the database. |")
+		require.Contains(t, string(content), "| size | string | ✓ |  |  | S, M, L | The size of the database. |")
+		require.Contains(t, string(content), "    size: 'S'\n")
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Generated reference page for %s: %s",
+				Params: []any{"MyCompany.Resources/testResources", path},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: from Radius with recipes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceProviders := []ucpv20231001preview.ResourceProviderSummary{
+			{
+				Name: new("MyCompany.Resources"),
+				ResourceTypes: map[string]*ucpv20231001preview.ResourceProviderSummaryResourceType{
+					"testResources": {
+						APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2025-01-01-preview": {
+								Schema: map[string]any{
+									"type": "object",
+									"properties": map[string]any{
+										"size": map[string]any{"type": "string"},
+									},
+								},
+							},
+						},
+					},
+					"otherResources": {
+						APIVersions: map[string]*ucpv20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2025-01-01-preview": {},
+						},
+					},
+				},
+			},
+		}
+		recipePacks := []v20250801preview.RecipePackResource{
+			{
+				Name: new("default"),
+				Properties: &v20250801preview.RecipePackProperties{
+					Recipes: map[string]*v20250801preview.RecipeDefinition{
+						"MyCompany.Resources/testResources": {
+							RecipeKind:     new(v20250801preview.RecipeKindBicep),
+							RecipeLocation: new("ghcr.io/recipes/test:latest"),
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceProviderSummaries(gomock.Any(), "local").
+			Return(resourceProviders, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListRecipePacks(gomock.Any()).
+			Return(recipePacks, nil).
+			Times(1)
+
+		outputDirectory := t.TempDir()
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ResourceTypeName:  "MyCompany.Resources/testResources",
+			OutputDirectory:   outputDirectory,
+			DocsFormat:        "html",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		entries, err := os.ReadDir(outputDirectory)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		content, err := os.ReadFile(filepath.Join(outputDirectory, "mycompany.resources_testresources.html"))
+		require.NoError(t, err)
+		require.Contains(t, string(content), "<tr><td><code>size</code></td><td>string</td>")
+		require.Contains(t, string(content), "<tr><td>default</td><td>bicep</td><td>ghcr.io/recipes/test:latest</td></tr>")
+	})
+
+	t.Run("Error: resource type not found", func(t *testing.T) {
+		runner := &Runner{
+			Output:                           &output.MockOutput{},
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+			ResourceTypeName:                 "MyCompany.Resources/otherResources",
+			OutputDirectory:                  t.TempDir(),
+			DocsFormat:                       "markdown",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("Resource type %q not found.", "MyCompany.Resources/otherResources"), err)
+	})
+}
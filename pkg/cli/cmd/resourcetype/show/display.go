@@ -47,6 +47,9 @@ type FieldSchema struct {
 	IsReadOnly bool
 	// Properties contains nested fields if the type is "object".
 	Properties map[string]FieldSchema
+	// Variants contains the oneOf or anyOf variants of the field. The name of each variant is its
+	// discriminator value, or "option N" when the field has no discriminator.
+	Variants []FieldSchema
 }
 
 // PropertyTitleStatus defines the status of properties title in the output display.
@@ -117,6 +120,14 @@ func (r *Runner) display(resourceTypeDetails *common.ResourceType) error {
 					Type:       "object",
 				},
 			})
+			for _, variant := range GetResourceTypeSchemaVariants(apiVersionProperties.Schema) {
+				if len(variant.Properties) > 0 {
+					queue.PushBack(PropertiesOutputFormat{
+						Heading: "(" + variant.Name + ")",
+						Schema:  variant,
+					})
+				}
+			}
 
 			for queue.Len() > 0 {
 				front := queue.Front()
@@ -124,16 +135,24 @@ func (r *Runner) display(resourceTypeDetails *common.ResourceType) error {
 				schema := front.Value.(PropertiesOutputFormat)
 				schemaList := []FieldSchema{}
 				for _, property := range schema.Schema.Properties {
-					if property.Type == "object" {
-						heading := property.Name
-						if propertyTitleStatus != PropertyTitleNone {
-							heading = schema.Heading + "." + property.Name
-						}
+					heading := property.Name
+					if propertyTitleStatus != PropertyTitleNone {
+						heading = schema.Heading + "." + property.Name
+					}
+					if property.Type == "object" || len(property.Properties) > 0 {
 						queue.PushBack(PropertiesOutputFormat{
 							Heading: heading,
 							Schema:  property,
 						})
 					}
+					for _, variant := range property.Variants {
+						if len(variant.Properties) > 0 {
+							queue.PushBack(PropertiesOutputFormat{
+								Heading: heading + " (" + variant.Name + ")",
+								Schema:  variant,
+							})
+						}
+					}
 					schemaList = append(schemaList, property)
 				}
 				sort.Slice(schemaList, func(i, j int) bool {
@@ -189,7 +208,15 @@ func GetResourceTypeSchema(schema map[string]any) map[string]FieldSchema {
 			if !ok {
 				continue
 			}
-			schemaType := prop["type"].(string)
+			schemaType, _ := prop["type"].(string)
+			variants := GetResourceTypeSchemaVariants(prop)
+			if keyword := variantKeyword(prop); keyword != "" {
+				if schemaType == "" {
+					schemaType = keyword
+				} else {
+					schemaType = fmt.Sprintf("%s (%s)", schemaType, keyword)
+				}
+			}
 			description := ""
 			if desc, ok := prop["description"]; ok {
 				description = desc.(string)
@@ -212,9 +239,77 @@ func GetResourceTypeSchema(schema map[string]any) map[string]FieldSchema {
 				IsRequired:  isRequired,
 				IsReadOnly:  isReadOnly,
 				Properties:  GetResourceTypeSchema(prop),
+				Variants:    variants,
 			}
 		}
 	}
 
 	return fieldSchema
 }
+
+// GetResourceTypeSchemaVariants extracts the oneOf or anyOf variants of a schema. Each variant is named by
+// the value it declares for the discriminator property, or "option N" when the schema has no discriminator.
+func GetResourceTypeSchemaVariants(schema map[string]any) []FieldSchema {
+	keyword := variantKeyword(schema)
+	if keyword == "" {
+		return nil
+	}
+
+	propertyName := ""
+	if discriminator, ok := schema["discriminator"].(map[string]any); ok {
+		propertyName, _ = discriminator["propertyName"].(string)
+	}
+
+	variants := []FieldSchema{}
+	for i, item := range schema[keyword].([]any) {
+		variant, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := fmt.Sprintf("option %d", i+1)
+		if value := variantDiscriminatorValue(variant, propertyName); value != "" {
+			name = value
+		}
+
+		schemaType, _ := variant["type"].(string)
+		description, _ := variant["description"].(string)
+		variants = append(variants, FieldSchema{
+			Name:        name,
+			Type:        schemaType,
+			Description: description,
+			Properties:  GetResourceTypeSchema(variant),
+			Variants:    GetResourceTypeSchemaVariants(variant),
+		})
+	}
+
+	return variants
+}
+
+// variantKeyword returns "oneOf" or "anyOf" when the schema declares variants, and "" otherwise.
+func variantKeyword(schema map[string]any) string {
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if variants, ok := schema[keyword].([]any); ok && len(variants) > 0 {
+			return keyword
+		}
+	}
+
+	return ""
+}
+
+// variantDiscriminatorValue returns the single enum value a variant declares for the discriminator property.
+func variantDiscriminatorValue(variant map[string]any, propertyName string) string {
+	if propertyName == "" {
+		return ""
+	}
+
+	properties, _ := variant["properties"].(map[string]any)
+	property, _ := properties[propertyName].(map[string]any)
+	enum, _ := property["enum"].([]any)
+	if len(enum) != 1 {
+		return ""
+	}
+
+	value, _ := enum[0].(string)
+	return value
+}
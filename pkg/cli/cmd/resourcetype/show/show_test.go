@@ -245,3 +245,57 @@ func Test_Run(t *testing.T) {
 		require.Empty(t, outputSink.Writes)
 	})
 }
+
+func Test_GetResourceTypeSchema_Variants(t *testing.T) {
+	schema := map[string]any{
+		"properties": map[string]any{
+			"storage": map[string]any{
+				"type":          "object",
+				"discriminator": map[string]any{"propertyName": "kind"},
+				"oneOf": []any{
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind":   map[string]any{"type": "string", "enum": []any{"azure"}},
+							"region": map[string]any{"type": "string"},
+						},
+						"required": []any{"kind"},
+					},
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind":   map[string]any{"type": "string", "enum": []any{"aws"}},
+							"bucket": map[string]any{"type": "string"},
+						},
+						"required": []any{"kind"},
+					},
+				},
+			},
+			"port": map[string]any{
+				"anyOf": []any{
+					map[string]any{"type": "string"},
+					map[string]any{"type": "integer"},
+				},
+			},
+		},
+	}
+
+	fields := GetResourceTypeSchema(schema)
+
+	storage := fields["storage"]
+	require.Equal(t, "object (oneOf)", storage.Type)
+	require.Len(t, storage.Variants, 2)
+	require.Equal(t, "azure", storage.Variants[0].Name)
+	require.Contains(t, storage.Variants[0].Properties, "region")
+	require.True(t, storage.Variants[0].Properties["kind"].IsRequired)
+	require.Equal(t, "aws", storage.Variants[1].Name)
+	require.Contains(t, storage.Variants[1].Properties, "bucket")
+
+	port := fields["port"]
+	require.Equal(t, "anyOf", port.Type)
+	require.Len(t, port.Variants, 2)
+	require.Equal(t, "option 1", port.Variants[0].Name)
+	require.Equal(t, "string", port.Variants[0].Type)
+	require.Equal(t, "option 2", port.Variants[1].Name)
+	require.Equal(t, "integer", port.Variants[1].Type)
+}
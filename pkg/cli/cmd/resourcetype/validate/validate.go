@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// formatSARIF is the output format for SARIF logs, in addition to the formats supported by all commands.
+	formatSARIF = "sarif"
+
+	msgManifestValid = "The manifest %q is valid."
+)
+
+// NewCommand creates an instance of the `rad resource-type validate` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "validate <manifest-file>",
+		Short: "Validate a resource type definition file",
+		Long: `Validate a resource type definition file without connecting to Radius.
+
+The manifest is checked against the manifest format and the Radius schema rules, such as reserved properties, sensitive annotations, $ref usage and platformOptions. It is also linted for style: resource types and properties should have a description, and property names should be camelCase.
+
+Use the --previous option to check that the manifest is backward-compatible with a previous version of it. Removing a resource type, API version or property, changing the type of a property, making a property required, or removing an enum value are backward-incompatible changes. A new API version may make such changes if it declares a conversion from the API version it replaces.
+
+Problems are reported as a table, as JSON, or as a SARIF log for CI annotations. The command fails if there are errors. Style problems are reported as warnings.
+`,
+		Example: `
+# Validate a resource type definition file
+rad resource-type validate /path/to/input.yaml
+
+# Validate a resource type definition file against the previous version of it
+rad resource-type validate /path/to/input.yaml --previous /path/to/previous.yaml
+
+# Validate a resource type definition file and write a SARIF log for CI annotations
+rad resource-type validate /path/to/input.yaml --output sarif > results.sarif
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	supportedFormats := append(output.SupportedFormats(), formatSARIF)
+	cmd.Flags().StringP("output", "o", output.DefaultFormat, fmt.Sprintf("output format (supported formats are %s)", strings.Join(supportedFormats, ", ")))
+	cmd.Flags().StringVar(&runner.PreviousManifestFilePath, "previous", "", "The previous version of the resource type definition file, used to check backward-compatibility")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource-type validate` command.
+type Runner struct {
+	Output output.Interface
+	Format string
+
+	ManifestFilePath         string
+	PreviousManifestFilePath string
+}
+
+// NewRunner creates an instance of the runner for the `rad resource-type validate` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Output: factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource-type validate` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	r.ManifestFilePath = args[0]
+
+	format, err := cmd.Flags().GetString("output")
+	if err != nil {
+		return err
+	}
+
+	format = output.NormalizeFormat(strings.ToLower(strings.TrimSpace(format)))
+	supportedFormats := append(output.SupportedFormats(), formatSARIF)
+	if !slices.Contains(supportedFormats, format) {
+		return clierrors.Message("unsupported output format %q, supported formats are: %s", format, strings.Join(supportedFormats, ", "))
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad resource-type validate` command.
+func (r *Runner) Run(ctx context.Context) error {
+	diagnostics := manifest.LintFile(ctx, r.ManifestFilePath, r.PreviousManifestFilePath)
+
+	var err error
+	switch {
+	case r.Format == formatSARIF:
+		err = r.Output.WriteFormatted(output.FormatJson, manifest.ToSARIF(diagnostics, r.ManifestFilePath), output.FormatterOptions{})
+	case r.Format == output.FormatJson:
+		err = r.Output.WriteFormatted(output.FormatJson, diagnostics, output.FormatterOptions{})
+	case len(diagnostics) == 0:
+		r.Output.LogInfo(msgManifestValid, r.ManifestFilePath)
+	default:
+		err = r.Output.WriteFormatted(r.Format, diagnostics, lintDiagnosticTableFormat())
+	}
+	if err != nil {
+		return err
+	}
+
+	if manifest.HasLintErrors(diagnostics) {
+		return clierrors.Message("The manifest %q is not valid.", r.ManifestFilePath)
+	}
+
+	return nil
+}
+
+// lintDiagnosticTableFormat returns the fields to output from a lint diagnostic.
+func lintDiagnosticTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "SEVERITY",
+				JSONPath: "{ .Severity }",
+			},
+			{
+				Heading:  "RULE",
+				JSONPath: "{ .RuleID }",
+			},
+			{
+				Heading:  "LOCATION",
+				JSONPath: "{ .Location }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
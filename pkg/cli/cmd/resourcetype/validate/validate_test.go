@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid",
+			Input:         []string{"testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Valid: sarif output",
+			Input:         []string{"testdata/valid.yaml", "--output", "sarif"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Valid: previous manifest",
+			Input:         []string{"testdata/valid.yaml", "--previous", "testdata/valid.yaml"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Invalid: no manifest",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+		{
+			Name:          "Invalid: unsupported output format",
+			Input:         []string{"testdata/valid.yaml", "--output", "xml"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success: valid manifest", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:           outputSink,
+			Format:           "table",
+			ManifestFilePath: "testdata/valid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: msgManifestValid,
+				Params: []any{"testdata/valid.yaml"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: warnings do not fail validation", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:           outputSink,
+			Format:           "table",
+			ManifestFilePath: "testdata/warnings.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted, ok := outputSink.Writes[0].(output.FormattedOutput)
+		require.True(t, ok)
+		require.Equal(t, "table", formatted.Format)
+		require.Len(t, formatted.Obj, 2)
+	})
+
+	t.Run("Error: invalid manifest", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:           outputSink,
+			Format:           "json",
+			ManifestFilePath: "testdata/invalid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The manifest %q is not valid.", "testdata/invalid.yaml"), err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted, ok := outputSink.Writes[0].(output.FormattedOutput)
+		require.True(t, ok)
+		require.Equal(t, "json", formatted.Format)
+
+		diagnostics, ok := formatted.Obj.([]manifest.LintDiagnostic)
+		require.True(t, ok)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, manifest.LintRuleSchema, diagnostics[0].RuleID)
+	})
+
+	t.Run("Error: sarif output", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:           outputSink,
+			Format:           formatSARIF,
+			ManifestFilePath: "testdata/invalid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+
+		require.Len(t, outputSink.Writes, 1)
+		formatted, ok := outputSink.Writes[0].(output.FormattedOutput)
+		require.True(t, ok)
+		require.Equal(t, "json", formatted.Format)
+
+		log, ok := formatted.Obj.(*manifest.SARIFLog)
+		require.True(t, ok)
+		require.Len(t, log.Runs[0].Results, 1)
+		require.Equal(t, "error", log.Runs[0].Results[0].Level)
+	})
+}
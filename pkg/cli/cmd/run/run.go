@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
@@ -30,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/kubernetes/logstream"
 	"github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/to"
 
 	"github.com/fatih/color"
@@ -65,6 +67,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 The run command compiles a Bicep or ARM template and runs it in your default environment (unless otherwise specified). It also automatically port-forwards container ports and streams container logs to a user's terminal.
 		
 The run command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+
+With --watch the run command watches the Bicep file and the modules it references, and redeploys the resources whose compiled definitions changed each time a file is saved. Port-forwards and logs keep streaming across redeploys, and deployment errors are displayed without exiting.
 	`,
 		Example: `
 # Run app.bicep
@@ -78,6 +82,9 @@ rad run app.bicep --parameters version=latest
 
 # Run app.bicep and specify parameters from multiple sources
 rad run app.bicep --parameters @myfile.json --parameters version=latest
+
+# Run app.bicep and redeploy changed resources when the file or its modules change
+rad run app.bicep --watch
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -88,6 +95,7 @@ rad run app.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	cmd.Flags().StringArrayP("parameters", "p", []string{}, "Specify parameters for the deployment")
+	cmd.Flags().Bool("watch", false, "Watch the template and its modules, and redeploy changed resources")
 
 	return cmd, runner
 }
@@ -97,6 +105,8 @@ type Runner struct {
 	deploycmd.Runner
 	Logstream            logstream.Interface
 	Portforward          portforward.Interface
+	Watch                bool
+	watchInterval        time.Duration
 	kubernetesClient     k8sclient.Interface
 	kubernetesRESTConfig *k8srest.Config
 }
@@ -126,6 +136,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return clierrors.Message("No application was specified. Use --application to specify the application name.")
 	}
 
+	r.Watch, err = cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -136,6 +151,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 // returns an error if any of the operations fail.
 func (r *Runner) Run(ctx context.Context) error {
 	// Call into base first to deploy, and then set up port-forwards and logs.
+	deployed := r.Template
 	err := r.Runner.Run(ctx)
 	if err != nil {
 		return err
@@ -143,6 +159,9 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	kubeContext, ok := r.Workspace.KubernetesContext()
 	if !ok {
+		if r.Watch {
+			return ignoreCanceled(r.watch(ctx, deployed))
+		}
 		return nil
 	}
 
@@ -162,6 +181,18 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	namespace := ""
 	appStatus := app.Properties.Status
+	if appStatus != nil {
+		if _, ok := appStatus.Compute.(*v20231001preview.DockerCompute); ok {
+			// Containers running on Docker publish their ports on localhost, so there is nothing to port-forward.
+			r.Output.LogInfo("")
+			r.Output.LogInfo("The application is running on Docker. Run `docker ps --filter label=%s=%s` to see its published ports.", k8slabels.LabelRadiusApplication, k8slabels.NormalizeResourceName(r.ApplicationName))
+			if r.Watch {
+				return ignoreCanceled(r.watch(ctx, deployed))
+			}
+			return nil
+		}
+	}
+
 	if appStatus != nil && appStatus.Compute != nil {
 		kube, ok := appStatus.Compute.(*v20231001preview.KubernetesCompute)
 		if ok && kube.Namespace != nil {
@@ -246,6 +277,7 @@ func (r *Runner) Run(ctx context.Context) error {
 			ApplicationName: r.ApplicationName,
 			Namespace:       namespace,
 			KubeClient:      r.kubernetesClient,
+			Follow:          true,
 
 			// Right now we don't need an abstraction for this because we don't really
 			// run the streaming logs in unit tests.
@@ -253,16 +285,24 @@ func (r *Runner) Run(ctx context.Context) error {
 		})
 	})
 
-	err = group.Wait()
+	// Redeploy on changes. Port-forwards and logs follow the pods of the application, so they pick up the
+	// replicas created by each redeploy.
+	if r.Watch {
+		group.Go(func() error {
+			return r.watch(ctx, deployed)
+		})
+	}
 
-	// context.Canceled here means the user canceled.
+	return ignoreCanceled(group.Wait())
+}
+
+// ignoreCanceled returns nil for context.Canceled, which means the user canceled.
+func ignoreCanceled(err error) error {
 	if errors.Is(err, context.Canceled) {
 		return nil
-	} else if err != nil {
-		return err
 	}
 
-	return nil
+	return err
 }
 
 func (r *Runner) displayPortforwardMessages(status <-chan portforward.StatusMessage) {
@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultWatchInterval is the interval between checks of the watched files for changes.
+	defaultWatchInterval = 500 * time.Millisecond
+
+	// nestedDeploymentType is the resource type of Bicep modules in a compiled template. Unchanged modules are
+	// still deployed so that their outputs can be referenced, but the resources of their nested template are
+	// declared as existing.
+	nestedDeploymentType = "microsoft.resources/deployments"
+)
+
+// bicepReferencePattern matches the local files referenced by a Bicep file through module declarations and
+// compile-time imports.
+var bicepReferencePattern = regexp.MustCompile(`(?m)^\s*(?:module\s+\S+|import\b[^\n']*\bfrom)\s+'([^']+)'`)
+
+// watchState is the state of the watch loop of `rad run --watch` between two deployments.
+type watchState struct {
+	// files maps the watched files to their last modification time.
+	files map[string]time.Time
+
+	// resources maps the symbolic names of the deployed resources to the hash of their compiled definitions.
+	resources map[string]string
+
+	// template is the hash of the compiled template excluding its resources.
+	template string
+}
+
+// watch polls the Bicep file and its module graph for changes, and redeploys the resources whose compiled
+// definitions changed. Errors are reported to the user without stopping the loop, which runs until ctx is
+// canceled.
+func (r *Runner) watch(ctx context.Context, deployed map[string]any) error {
+	interval := r.watchInterval
+	if interval == 0 {
+		interval = defaultWatchInterval
+	}
+
+	state := watchState{files: watchedFiles(r.FilePath)}
+	state.resources, state.template = templateHashes(deployed)
+
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Watching %d file(s) for changes...", len(state.files))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		files := watchedFiles(r.FilePath)
+		if maps.Equal(files, state.files) {
+			continue
+		}
+		state.files = files
+
+		r.redeploy(ctx, &state)
+	}
+}
+
+// redeploy recompiles the template and deploys the resources that changed since the last successful deployment.
+func (r *Runner) redeploy(ctx context.Context, state *watchState) {
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Detected changes to %q, recompiling...", r.FilePath)
+
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		r.Output.LogInfo("Compilation failed: %v", err)
+		r.Output.LogInfo("Waiting for changes...")
+		return
+	}
+
+	resources, templateHash := templateHashes(template)
+	incremental, changed := incrementalTemplate(template, resources, templateHash, state)
+	if len(changed) == 0 {
+		r.Output.LogInfo("No resource changes detected.")
+		return
+	}
+
+	if len(changed) < len(resources) {
+		r.Output.LogInfo("Redeploying changed resources: %s", strings.Join(changed, ", "))
+	}
+
+	r.Template = incremental
+	err = r.Runner.Run(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Keep the previous hashes so that the failed resources are deployed again on the next change.
+		r.Output.LogInfo("Deployment failed: %v", err)
+		r.Output.LogInfo("Waiting for changes...")
+		return
+	}
+
+	state.resources = resources
+	state.template = templateHash
+}
+
+// watchedFiles returns the modification times of the Bicep file and the local files it references, recursively.
+// Files that can't be read are skipped, they are picked up on a later check once they exist again.
+func watchedFiles(path string) map[string]time.Time {
+	files := map[string]time.Time{}
+	pending := []string{filepath.Clean(path)}
+	for len(pending) > 0 {
+		file := pending[0]
+		pending = pending[1:]
+		if _, ok := files[file]; ok {
+			continue
+		}
+
+		info, err := os.Stat(file)
+		if err != nil {
+			continue
+		}
+		files[file] = info.ModTime()
+
+		if !strings.EqualFold(filepath.Ext(file), ".bicep") {
+			continue
+		}
+
+		content, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		for _, reference := range bicepReferences(string(content)) {
+			if !filepath.IsAbs(reference) {
+				reference = filepath.Join(filepath.Dir(file), reference)
+			}
+			pending = append(pending, filepath.Clean(reference))
+		}
+	}
+
+	return files
+}
+
+// bicepReferences returns the local paths referenced by the module declarations and imports of a Bicep file.
+// References to registries and template specs are skipped since they can't change locally.
+func bicepReferences(content string) []string {
+	references := []string{}
+	for _, match := range bicepReferencePattern.FindAllStringSubmatch(content, -1) {
+		reference := match[1]
+		if strings.HasPrefix(reference, "br:") || strings.HasPrefix(reference, "br/") || strings.HasPrefix(reference, "ts:") || strings.HasPrefix(reference, "ts/") {
+			continue
+		}
+
+		references = append(references, reference)
+	}
+
+	return references
+}
+
+// templateHashes returns the hashes of the compiled definitions of the resources of a template keyed by their
+// symbolic names, and the hash of the rest of the template. Templates with a resources array don't have
+// symbolic names, so no resource hashes are returned for them.
+func templateHashes(template map[string]any) (map[string]string, string) {
+	resources := map[string]string{}
+	rest := map[string]any{}
+	for key, value := range template {
+		if key == "resources" {
+			continue
+		}
+		rest[key] = value
+	}
+
+	if values, ok := template["resources"].(map[string]any); ok {
+		for name, value := range values {
+			resources[name] = hashValue(value)
+		}
+	}
+
+	return resources, hashValue(rest)
+}
+
+// incrementalTemplate returns a copy of the template in which the resources that are unchanged since the last
+// deployment are declared as existing, so that they are not deployed again but can still be referenced, and the
+// sorted symbolic names of the resources that changed. The resources of unchanged modules are declared as
+// existing in their nested templates. Every resource is deployed when anything outside of the resources changed,
+// since parameters and variables can affect every resource.
+func incrementalTemplate(template map[string]any, resources map[string]string, templateHash string, state *watchState) (map[string]any, []string) {
+	values, ok := template["resources"].(map[string]any)
+	if !ok || templateHash != state.template {
+		changed := slices.Sorted(maps.Keys(resources))
+		if !ok {
+			// Templates with a resources array are always deployed in full.
+			changed = []string{"*"}
+		}
+		return template, changed
+	}
+
+	incremental := maps.Clone(template)
+	incrementalResources := map[string]any{}
+	changed := []string{}
+	for name, value := range values {
+		resource, ok := value.(map[string]any)
+		if !ok || resources[name] != state.resources[name] {
+			incrementalResources[name] = value
+			if resources[name] != state.resources[name] {
+				changed = append(changed, name)
+			}
+			continue
+		}
+
+		if isNestedDeployment(resource) {
+			incrementalResources[name] = existingDeployment(resource)
+			continue
+		}
+
+		incrementalResources[name] = existingResource(resource)
+	}
+	incremental["resources"] = incrementalResources
+
+	slices.Sort(changed)
+	return incremental, changed
+}
+
+// existingResource returns the definition of a resource that references the deployed resource instead of
+// deploying it. Only the fields that identify the resource are kept.
+func existingResource(resource map[string]any) map[string]any {
+	existing := map[string]any{"existing": true}
+	for _, key := range []string{"import", "type", "apiVersion", "name", "scope", "condition"} {
+		if value, ok := resource[key]; ok {
+			existing[key] = value
+		}
+	}
+
+	// Resources of extensions are identified by the name in their properties.
+	if properties, ok := resource["properties"].(map[string]any); ok && resource["import"] != nil {
+		if name, ok := properties["name"]; ok {
+			existing["properties"] = map[string]any{"name": name}
+		}
+	}
+
+	return existing
+}
+
+// existingDeployment returns the definition of an unchanged module in which the resources of the nested template,
+// and of the modules it declares, are declared as existing. The module itself is deployed again so that its
+// outputs can still be referenced. Modules whose nested template has a resources array are returned unchanged.
+func existingDeployment(resource map[string]any) map[string]any {
+	properties, ok := resource["properties"].(map[string]any)
+	if !ok {
+		return resource
+	}
+
+	template, ok := properties["template"].(map[string]any)
+	if !ok {
+		return resource
+	}
+
+	values, ok := template["resources"].(map[string]any)
+	if !ok {
+		return resource
+	}
+
+	existingResources := map[string]any{}
+	for name, value := range values {
+		nested, ok := value.(map[string]any)
+		switch {
+		case !ok:
+			existingResources[name] = value
+		case isNestedDeployment(nested):
+			existingResources[name] = existingDeployment(nested)
+		default:
+			existingResources[name] = existingResource(nested)
+		}
+	}
+
+	existingTemplate := maps.Clone(template)
+	existingTemplate["resources"] = existingResources
+	existingProperties := maps.Clone(properties)
+	existingProperties["template"] = existingTemplate
+	existing := maps.Clone(resource)
+	existing["properties"] = existingProperties
+
+	return existing
+}
+
+func isNestedDeployment(resource map[string]any) bool {
+	resourceType, _ := resource["type"].(string)
+	return strings.EqualFold(resourceType, nestedDeploymentType)
+}
+
+func hashValue(value any) string {
+	// encoding/json sorts the keys of maps, so the hash is stable for the same definition.
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
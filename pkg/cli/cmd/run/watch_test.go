@@ -0,0 +1,284 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	deploycmd "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/deploy"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func Test_bicepReferences(t *testing.T) {
+	content := `
+extension radius
+
+import { config } from 'shared/config.bicep'
+import * as types from 'types.bicep'
+
+module db 'modules/db.bicep' = {
+  name: 'db'
+}
+
+module registry 'br:myregistry.azurecr.io/modules/redis:1.0' = {
+  name: 'redis'
+}
+
+module alias 'br/public:avm/res/storage:0.1' = {
+  name: 'storage'
+}
+
+// module commented 'commented.bicep'
+resource container 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'module'
+}
+`
+
+	require.Equal(t, []string{"shared/config.bicep", "types.bicep", "modules/db.bicep"}, bicepReferences(content))
+}
+
+func Test_watchedFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	write("app.bicep", "module db 'modules/db.bicep' = {}\nmodule missing 'missing.bicep' = {}\n")
+	write("modules/db.bicep", "module shared '../shared.bicep' = {}\nmodule cycle 'db.bicep' = {}\n")
+	write("shared.bicep", "import { values } from 'values.json'\n")
+	write("values.json", "{}")
+	write("unrelated.bicep", "")
+
+	files := watchedFiles(filepath.Join(dir, "app.bicep"))
+
+	names := []string{}
+	for file := range files {
+		relative, err := filepath.Rel(dir, file)
+		require.NoError(t, err)
+		names = append(names, filepath.ToSlash(relative))
+	}
+	require.ElementsMatch(t, []string{"app.bicep", "modules/db.bicep", "shared.bicep", "values.json"}, names)
+}
+
+func Test_incrementalTemplate(t *testing.T) {
+	container := func(image string) map[string]any {
+		return map[string]any{
+			"import":    "Radius",
+			"type":      "Applications.Core/containers@2023-10-01-preview",
+			"dependsOn": []any{"app"},
+			"properties": map[string]any{
+				"name":        "frontend",
+				"application": "[resourceId('Applications.Core/applications', 'myapp')]",
+				"container":   map[string]any{"image": image},
+			},
+		}
+	}
+	app := map[string]any{
+		"import": "Radius",
+		"type":   "Applications.Core/applications@2023-10-01-preview",
+		"properties": map[string]any{
+			"name":        "myapp",
+			"environment": "[parameters('environment')]",
+		},
+	}
+	module := map[string]any{
+		"type":       "Microsoft.Resources/deployments",
+		"apiVersion": "2022-09-01",
+		"name":       "db",
+		"properties": map[string]any{"mode": "Incremental"},
+	}
+	template := func(image string, variable string) map[string]any {
+		return map[string]any{
+			"languageVersion": "2.0",
+			"variables":       map[string]any{"value": variable},
+			"resources": map[string]any{
+				"app":      app,
+				"frontend": container(image),
+				"db":       module,
+			},
+		}
+	}
+
+	previousResources, previousTemplate := templateHashes(template("nginx:1", "a"))
+	state := &watchState{resources: previousResources, template: previousTemplate}
+
+	t.Run("unchanged", func(t *testing.T) {
+		resources, templateHash := templateHashes(template("nginx:1", "a"))
+		_, changed := incrementalTemplate(template("nginx:1", "a"), resources, templateHash, state)
+		require.Empty(t, changed)
+	})
+
+	t.Run("resource changed", func(t *testing.T) {
+		resources, templateHash := templateHashes(template("nginx:2", "a"))
+		incremental, changed := incrementalTemplate(template("nginx:2", "a"), resources, templateHash, state)
+		require.Equal(t, []string{"frontend"}, changed)
+
+		values := incremental["resources"].(map[string]any)
+		require.Equal(t, container("nginx:2"), values["frontend"])
+		require.Equal(t, module, values["db"])
+		require.Equal(t, map[string]any{
+			"existing":   true,
+			"import":     "Radius",
+			"type":       "Applications.Core/applications@2023-10-01-preview",
+			"properties": map[string]any{"name": "myapp"},
+		}, values["app"])
+	})
+
+	t.Run("template changed", func(t *testing.T) {
+		resources, templateHash := templateHashes(template("nginx:1", "b"))
+		incremental, changed := incrementalTemplate(template("nginx:1", "b"), resources, templateHash, state)
+		require.Equal(t, []string{"app", "db", "frontend"}, changed)
+		require.Equal(t, template("nginx:1", "b"), incremental)
+	})
+
+	t.Run("unchanged module", func(t *testing.T) {
+		nested := map[string]any{
+			"type":       "Microsoft.Resources/deployments",
+			"apiVersion": "2022-09-01",
+			"name":       "cache",
+			"properties": map[string]any{
+				"mode": "Incremental",
+				"template": map[string]any{
+					"languageVersion": "2.0",
+					"resources": map[string]any{
+						"cache": container("redis:7"),
+						"inner": module,
+					},
+					"outputs": map[string]any{"host": map[string]any{"type": "string", "value": "cache"}},
+				},
+			},
+		}
+		withModule := func(image string) map[string]any {
+			withModule := template(image, "a")
+			withModule["resources"].(map[string]any)["cache"] = nested
+			return withModule
+		}
+
+		previousResources, previousTemplate := templateHashes(withModule("nginx:1"))
+		state := &watchState{resources: previousResources, template: previousTemplate}
+
+		resources, templateHash := templateHashes(withModule("nginx:2"))
+		incremental, changed := incrementalTemplate(withModule("nginx:2"), resources, templateHash, state)
+		require.Equal(t, []string{"frontend"}, changed)
+
+		values := incremental["resources"].(map[string]any)
+		properties := values["cache"].(map[string]any)["properties"].(map[string]any)
+		nestedTemplate := properties["template"].(map[string]any)
+		require.Equal(t, nested["properties"].(map[string]any)["template"].(map[string]any)["outputs"], nestedTemplate["outputs"])
+		require.Equal(t, map[string]any{
+			"cache": map[string]any{
+				"existing":   true,
+				"import":     "Radius",
+				"type":       "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{"name": "frontend"},
+			},
+			"inner": module,
+		}, nestedTemplate["resources"])
+
+		// The definition of the module is not modified.
+		require.Contains(t, nested["properties"].(map[string]any)["template"].(map[string]any)["resources"].(map[string]any)["cache"], "dependsOn")
+	})
+
+	t.Run("resources array", func(t *testing.T) {
+		arm := map[string]any{"resources": []any{app}}
+		resources, templateHash := templateHashes(arm)
+		incremental, changed := incrementalTemplate(arm, resources, templateHash, state)
+		require.Equal(t, []string{"*"}, changed)
+		require.Equal(t, arm, incremental)
+	})
+}
+
+func Test_redeploy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	template := func(image string) map[string]any {
+		return map[string]any{
+			"resources": map[string]any{
+				"app": map[string]any{
+					"import":     "Radius",
+					"type":       "Applications.Core/applications@2023-10-01-preview",
+					"properties": map[string]any{"name": "myapp"},
+				},
+				"frontend": map[string]any{
+					"import":     "Radius",
+					"type":       "Applications.Core/containers@2023-10-01-preview",
+					"properties": map[string]any{"name": "frontend", "image": image},
+				},
+			},
+		}
+	}
+
+	bicepMock := bicep.NewMockInterface(ctrl)
+	deployMock := deploy.NewMockInterface(ctrl)
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Runner: deploycmd.Runner{
+			Bicep:      bicepMock,
+			Deploy:     deployMock,
+			Output:     outputSink,
+			FilePath:   "app.bicep",
+			Parameters: map[string]map[string]any{},
+			Workspace:  &workspaces.Workspace{Name: "test-workspace"},
+			Providers:  &clients.Providers{Radius: &clients.RadiusProvider{}},
+		},
+	}
+
+	resources, templateHash := templateHashes(template("nginx:1"))
+	state := &watchState{resources: resources, template: templateHash}
+	ctx := testcontext.New(t)
+
+	// A failed deployment is reported and retried on the next change.
+	bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(template("nginx:2"), nil).Times(2)
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		Return(clients.DeploymentResult{}, errors.New("deployment failed"))
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+			values := o.Template["resources"].(map[string]any)
+			require.Equal(t, true, values["app"].(map[string]any)["existing"])
+			require.Equal(t, template("nginx:2")["resources"].(map[string]any)["frontend"], values["frontend"])
+			return clients.DeploymentResult{}, nil
+		})
+
+	runner.redeploy(ctx, state)
+	require.Contains(t, outputSink.Writes, output.LogOutput{Format: "Deployment failed: %v", Params: []any{errors.New("deployment failed")}})
+	require.Equal(t, resources, state.resources)
+
+	runner.redeploy(ctx, state)
+	updated, _ := templateHashes(template("nginx:2"))
+	require.Equal(t, updated, state.resources)
+
+	// Compilation errors are reported without deploying.
+	bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(nil, errors.New("syntax error"))
+	runner.redeploy(ctx, state)
+	require.Contains(t, outputSink.Writes, output.LogOutput{Format: "Compilation failed: %v", Params: []any{errors.New("syntax error")}})
+}
@@ -19,6 +19,7 @@ package create
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
@@ -47,17 +48,26 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 		Short: "Create a workspace",
 		Long: `Create a workspace.
 		
-Available workspaceTypes: kubernetes
+Available workspaceTypes: kubernetes, http
 
 Workspaces allow you to manage multiple Radius platforms and environments using a local configuration file. 
 
+Kubernetes workspaces connect to Radius through the Kubernetes API server of a kubeconfig context. HTTP workspaces connect
+directly to the endpoint of a remote Radius control plane, and support pinning the CA certificate, bearer token, client
+certificate and OIDC device code authentication, and an HTTP proxy. 'rad resource exec' talks to the Kubernetes
+API directly, and is not yet supported for HTTP workspaces.
+
 You can easily define and switch between workspaces to deploy and manage applications across local, test, and production environments.`,
 		Args: ValidateArgs(),
 		Example: `
 # Create a workspace with name 'myworkspace' and kubernetes context 'aks'
 rad workspace create kubernetes myworkspace --context aks
 # Create a workspace with name of current kubernetes context in current kubernetes context
-rad workspace create kubernetes`,
+rad workspace create kubernetes
+# Create a workspace with name 'remote' that connects to a Radius control plane using a bearer token
+rad workspace create http remote --endpoint https://radius.example.com --ca-cert ./ca.pem --auth bearer --token-file ./token
+# Create a workspace with name 'remote' that signs in through an OIDC identity provider
+rad workspace create http remote --endpoint https://radius.example.com --auth oidc --oidc-issuer https://login.example.com --oidc-client-id rad`,
 		RunE: framework.RunCommand(runner),
 	}
 
@@ -66,6 +76,17 @@ rad workspace create kubernetes`,
 	commonflags.AddEnvironmentNameFlag(cmd)
 	cmd.Flags().BoolP("force", "f", false, "Overwrite existing workspace if present")
 	cmd.Flags().StringP("context", "c", "", "the Kubernetes context to use, will use the default if unset")
+	cmd.Flags().String("endpoint", "", "the endpoint of the Radius control plane, used with the 'http' workspace type")
+	cmd.Flags().String("ca-cert", "", "the path of a PEM file with the CA certificates trusted for the endpoint")
+	cmd.Flags().String("auth", workspaces.AuthKindNone, "the authentication kind: none, bearer, clientCertificate or oidc")
+	cmd.Flags().String("token-file", "", "the path of a file containing the bearer token")
+	cmd.Flags().String("token-env", "", "the name of an environment variable containing the bearer token")
+	cmd.Flags().String("client-cert", "", "the path of the PEM encoded client certificate")
+	cmd.Flags().String("client-key", "", "the path of the PEM encoded client certificate private key")
+	cmd.Flags().String("oidc-issuer", "", "the URL of the OIDC issuer")
+	cmd.Flags().String("oidc-client-id", "", "the client ID registered with the OIDC issuer")
+	cmd.Flags().StringSlice("oidc-scope", nil, "additional scopes requested from the OIDC issuer")
+	cmd.Flags().String("proxy", "", "the URL of the proxy used to reach the endpoint, read from the environment if unset")
 
 	return cmd, runner
 }
@@ -108,27 +129,22 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
-	if err != nil {
-		return clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
-	}
-	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
-	if err != nil {
-		return err
-	}
-
-	_, ok := kubeContextList.Contexts[context]
-	if !ok {
-		return fmt.Errorf("the kubeconfig does not contain a context called %q", context)
-	}
-
-	if workspaceName == "" {
-		workspaceName = context
-	}
+	var connection map[string]any
+	switch args[0] {
+	case workspaces.KindHTTP:
+		if workspaceName == "" {
+			return clierrors.Message("A workspace name is required for workspaces of type %q.", workspaces.KindHTTP)
+		}
 
-	state, err := r.HelmInterface.CheckRadiusInstall(context)
-	if !state.RadiusInstalled || err != nil {
-		return fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+		connection, err = validateHTTPConnection(cmd)
+		if err != nil {
+			return err
+		}
+	default:
+		connection, workspaceName, err = r.validateKubernetesConnection(cmd, workspaceName)
+		if err != nil {
+			return err
+		}
 	}
 
 	workspaceExists, err := cli.HasWorkspace(config, workspaceName)
@@ -155,9 +171,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		r.Workspace = &workspaces.Workspace{}
 		r.Workspace.Name = workspaceName
 	}
-	r.Workspace.Connection = map[string]any{}
-	r.Workspace.Connection["context"] = context
-	r.Workspace.Connection["kind"] = args[0]
+	r.Workspace.Connection = connection
 
 	group, err := cmd.Flags().GetString("group")
 	if err != nil {
@@ -202,6 +216,124 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateKubernetesConnection checks that the Kubernetes context exists and has Radius installed, and returns the
+// connection for the context along with the workspace name, which defaults to the context name.
+func (r *Runner) validateKubernetesConnection(cmd *cobra.Command, workspaceName string) (map[string]any, string, error) {
+	kubeContextList, err := r.KubernetesInterface.GetKubeContext()
+	if err != nil {
+		return nil, "", clierrors.Message("Failed to read Kubernetes configuration. Ensure you have a valid Kubeconfig file and try again.")
+	}
+	context, err := cli.RequireKubeContext(cmd, kubeContextList.CurrentContext)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, ok := kubeContextList.Contexts[context]
+	if !ok {
+		return nil, "", fmt.Errorf("the kubeconfig does not contain a context called %q", context)
+	}
+
+	if workspaceName == "" {
+		workspaceName = context
+	}
+
+	state, err := r.HelmInterface.CheckRadiusInstall(context)
+	if !state.RadiusInstalled || err != nil {
+		return nil, "", fmt.Errorf("unable to create workspace %q. Radius control plane not installed on target platform. Run 'rad install' and try again", workspaceName)
+	}
+
+	return map[string]any{
+		"kind":    workspaces.KindKubernetes,
+		"context": context,
+	}, workspaceName, nil
+}
+
+// validateHTTPConnection builds the connection for an HTTP workspace from the flags, and checks that the referenced
+// certificates can be loaded. Paths to files are made absolute, since the workspace is used from other directories.
+func validateHTTPConnection(cmd *cobra.Command) (map[string]any, error) {
+	flags := map[string]string{}
+	for _, name := range []string{"endpoint", "ca-cert", "auth", "token-file", "token-env", "client-cert", "client-key", "oidc-issuer", "oidc-client-id", "proxy"} {
+		value, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return nil, err
+		}
+		flags[name] = value
+	}
+
+	for _, name := range []string{"ca-cert", "token-file", "client-cert", "client-key"} {
+		if flags[name] == "" {
+			continue
+		}
+
+		path, err := filepath.Abs(flags[name])
+		if err != nil {
+			return nil, clierrors.MessageWithCause(err, "The path %q of the --%s flag is invalid.", flags[name], name)
+		}
+		flags[name] = path
+	}
+
+	scopes, err := cmd.Flags().GetStringSlice("oidc-scope")
+	if err != nil {
+		return nil, err
+	}
+
+	if flags["endpoint"] == "" {
+		return nil, clierrors.Message("The --endpoint flag is required for workspaces of type %q.", workspaces.KindHTTP)
+	}
+
+	connection := map[string]any{
+		"kind":     workspaces.KindHTTP,
+		"endpoint": flags["endpoint"],
+	}
+	if flags["ca-cert"] != "" {
+		connection["tls"] = map[string]any{"caCertificate": flags["ca-cert"]}
+	}
+	if flags["proxy"] != "" {
+		connection["proxy"] = map[string]any{"url": flags["proxy"]}
+	}
+
+	auth := map[string]any{"kind": flags["auth"]}
+	switch flags["auth"] {
+	case workspaces.AuthKindNone:
+	case workspaces.AuthKindBearer:
+		setIfNotEmpty(auth, "tokenFile", flags["token-file"])
+		setIfNotEmpty(auth, "tokenEnvVar", flags["token-env"])
+	case workspaces.AuthKindClientCertificate:
+		setIfNotEmpty(auth, "clientCertificate", flags["client-cert"])
+		setIfNotEmpty(auth, "clientKey", flags["client-key"])
+	case workspaces.AuthKindOIDC:
+		oidc := map[string]any{}
+		setIfNotEmpty(oidc, "issuer", flags["oidc-issuer"])
+		setIfNotEmpty(oidc, "clientId", flags["oidc-client-id"])
+		if len(scopes) > 0 {
+			oidc["scopes"] = scopes
+		}
+		auth["oidc"] = oidc
+	default:
+		return nil, clierrors.Message("The authentication kind %q is not supported. Use one of: none, bearer, clientCertificate, oidc.", flags["auth"])
+	}
+	connection["auth"] = auth
+
+	// Connecting does not send any requests, but validates the configuration and loads the certificates.
+	config, err := workspaces.Workspace{Connection: connection}.ConnectionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = config.Connect()
+	if err != nil {
+		return nil, clierrors.MessageWithCause(err, "The connection settings are invalid: %s.", err.Error())
+	}
+
+	return connection, nil
+}
+
+func setIfNotEmpty(m map[string]any, key string, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
+
 // Run runs the `rad workspace create` command.
 //
 
@@ -19,6 +19,7 @@ package create
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -126,6 +127,76 @@ func Test_Validate(t *testing.T) {
 				mocks.ApplicationManagementClient.EXPECT().GetEnvironment(gomock.Any(), "env1").Return(corerp.EnvironmentResource{}, nil).Times(1)
 			},
 		},
+		{
+			Name:          "valid http create command",
+			Input:         []string{"http", "remote", "--endpoint", "https://radius.example.com", "--auth", "oidc", "--oidc-issuer", "https://login.example.com", "--oidc-client-id", "rad"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				workspace := runner.(*Runner).Workspace
+				require.Equal(t, "remote", workspace.Name)
+				require.Equal(t, map[string]any{
+					"kind":     "http",
+					"endpoint": "https://radius.example.com",
+					"auth": map[string]any{
+						"kind": "oidc",
+						"oidc": map[string]any{
+							"issuer":   "https://login.example.com",
+							"clientId": "rad",
+						},
+					},
+				}, workspace.Connection)
+			},
+		},
+		{
+			Name:          "http create command with relative token file",
+			Input:         []string{"http", "remote", "--endpoint", "https://radius.example.com", "--auth", "bearer", "--token-file", "token.txt"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				tokenFile, err := filepath.Abs("token.txt")
+				require.NoError(t, err)
+
+				workspace := runner.(*Runner).Workspace
+				require.Equal(t, map[string]any{
+					"kind":      "bearer",
+					"tokenFile": tokenFile,
+				}, workspace.Connection["auth"])
+			},
+		},
+		{
+			Name:          "http create command without endpoint",
+			Input:         []string{"http", "remote"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "http create command without name",
+			Input:         []string{"http", "--endpoint", "https://radius.example.com"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "http create command with bearer auth but no token",
+			Input:         []string{"http", "remote", "--endpoint", "https://radius.example.com", "--auth", "bearer"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 	}
 
 	radcli.SharedValidateValidation(t, NewCommand, testcases)
@@ -19,6 +19,7 @@ package create
 import (
 	"fmt"
 
+	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/spf13/cobra"
 )
 
@@ -26,14 +27,14 @@ import (
 //
 
 // ValidateArgs checks if the number of arguments passed to the command is between 1 and 2, and if the first argument is
-// "kubernetes", and returns an error if either of these conditions are not met.
+// "kubernetes" or "http", and returns an error if either of these conditions are not met.
 func ValidateArgs() cobra.PositionalArgs {
 	return func(cmd *cobra.Command, args []string) error {
 		if len(args) < 1 || len(args) > 2 {
 			return fmt.Errorf("usage: rad workspace create [workspaceType] [workspaceName] [flags]")
 		}
-		if args[0] != "kubernetes" {
-			return fmt.Errorf("workspaces currently only support type 'kubernetes'")
+		if args[0] != workspaces.KindKubernetes && args[0] != workspaces.KindHTTP {
+			return fmt.Errorf("workspaces currently only support types 'kubernetes' and 'http'")
 		}
 		return nil
 	}
@@ -92,6 +92,9 @@ func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.
 
 // CreateDiagnosticsClient creates a DiagnosticsClient by connecting to a workspace, testing the connection, and creating
 // clients for applications, containers, environments, and gateways. If an error occurs, it is returned.
+//
+// Workspaces using the http connection kind can only look up public endpoints, because logs, exec and port-forwarding
+// talk to the Kubernetes API directly.
 func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error) {
 	connection, err := workspace.Connect(ctx)
 	if err != nil {
@@ -103,6 +106,34 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 		return nil, err
 	}
 
+	clientOpts := sdk.NewClientOptions(connection)
+	appClient, err := generated.NewGenericResourcesClient("Applications.Core/applications", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	cntrClient, err := generated.NewGenericResourcesClient("Applications.Core/containers", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	envClient, err := generated.NewGenericResourcesClient("Applications.Core/environments", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	gwClient, err := generated.NewGenericResourcesClient("Applications.Core/gateways", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnosticsClient := &deployment.ARMDiagnosticsClient{
+		ApplicationClient: *appClient,
+		ContainerClient:   *cntrClient,
+		EnvironmentClient: *envClient,
+		GatewayClient:     *gwClient,
+	}
+
 	switch c := connectionConfig.(type) {
 	case *workspaces.KubernetesConnectionConfig:
 		k8sClient, config, err := kubernetes.NewClientset(c.Context)
@@ -114,36 +145,14 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 			return nil, err
 		}
 
-		clientOpts := sdk.NewClientOptions(connection)
-		appClient, err := generated.NewGenericResourcesClient("Applications.Core/applications", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		cntrClient, err := generated.NewGenericResourcesClient("Applications.Core/containers", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		envClient, err := generated.NewGenericResourcesClient("Applications.Core/environments", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		gwClient, err := generated.NewGenericResourcesClient("Applications.Core/gateways", workspace.Scope, &aztoken.AnonymousCredential{}, clientOpts)
-		if err != nil {
-			return nil, err
-		}
-
-		return &deployment.ARMDiagnosticsClient{
-			K8sTypedClient:    k8sClient,
-			RestConfig:        config,
-			K8sRuntimeClient:  client,
-			ApplicationClient: *appClient,
-			ContainerClient:   *cntrClient,
-			EnvironmentClient: *envClient,
-			GatewayClient:     *gwClient,
-		}, nil
+		diagnosticsClient.K8sTypedClient = k8sClient
+		diagnosticsClient.RestConfig = config
+		diagnosticsClient.K8sRuntimeClient = client
+		return diagnosticsClient, nil
+	case *workspaces.HTTPConnectionConfig:
+		// Without Kubernetes clients the diagnostics client returns deployment.ErrKubernetesRequired for logs, exec and
+		// port-forwarding. Routing these through the UCP proxy is tracked as a follow-up.
+		return diagnosticsClient, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection type: %+v", connection)
 	}
@@ -18,6 +18,7 @@ package deployment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -30,19 +31,31 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/client-go/transport/spdy"
+	"k8s.io/kubectl/pkg/util/term"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// JobType is the resource type of jobs. Jobs run in pods created by a Kubernetes Job or CronJob, which are labeled
+// with the application and resource names like the pods of containers.
+const JobType = "Radius.Compute/jobs"
+
+// ErrKubernetesRequired is returned for logs, exec and port-forwarding when the diagnostics client has no Kubernetes
+// clients, which is the case for workspaces using the http connection kind.
+var ErrKubernetesRequired = errors.New("logs, exec and port-forwarding are only supported for workspaces using the kubernetes connection kind")
+
 type ARMDiagnosticsClient struct {
-	K8sTypedClient    *k8s.Clientset
+	K8sTypedClient    k8s.Interface
 	RestConfig        *rest.Config
 	K8sRuntimeClient  client.Client
 	ApplicationClient generated.GenericResourcesClient
@@ -81,6 +94,11 @@ func (dc *ARMDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options c
 // Expose function finds a running replica of the container, prints the replica name, sets up a signal notification,
 // creates channels for errors, readiness and stopping, and runs a portforwarding process.
 func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error) {
+	if dc.K8sTypedClient == nil {
+		err = ErrKubernetesRequired
+		return
+	}
+
 	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
 	if err != nil {
 		return
@@ -114,9 +132,23 @@ func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.Expo
 }
 
 // Logs() retrieves the running replicas of the container, and creates log streams for the replicas. If an error occurs,
-// it will close all the created streams before returning the error.
+// it will close all the created streams before returning the error. For jobs, the logs of the pods of completed and
+// failed runs are included as well.
 func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOptions) ([]clients.LogStream, error) {
-	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
+	if dc.K8sTypedClient == nil {
+		return nil, ErrKubernetesRequired
+	}
+
+	isJob := strings.EqualFold(options.ResourceType, JobType)
+
+	var namespace string
+	var err error
+	if isJob {
+		// Jobs are deployed by a recipe into the namespace of their application.
+		namespace, err = dc.findNamespaceOfApplication(ctx, options.Application, options.Resource)
+	} else {
+		namespace, err = dc.findNamespaceOfContainer(ctx, options.Resource)
+	}
 	if err != nil {
 		return nil, nil
 	}
@@ -129,6 +161,11 @@ func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOp
 			return nil, err
 		}
 		replicas = append(replicas, *replica)
+	} else if isJob {
+		replicas, err = getJobReplicas(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		replicas, err = getRunningReplicas(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
 		if err != nil {
@@ -149,6 +186,40 @@ func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOp
 	return streams, err
 }
 
+// Exec finds a running replica of the container and runs the command in it, connecting the command to the
+// streams of the options. If no container is specified the primary container of the replica is used.
+func (dc *ARMDiagnosticsClient) Exec(ctx context.Context, options clients.ExecOptions) error {
+	if dc.K8sTypedClient == nil {
+		return ErrKubernetesRequired
+	}
+
+	namespace, err := dc.findNamespaceOfContainer(ctx, options.Resource)
+	if err != nil {
+		return err
+	}
+
+	var replica *corev1.Pod
+	if options.Replica != "" {
+		replica, err = getSpecificReplica(ctx, dc.K8sTypedClient, namespace, options.Resource, options.Replica)
+	} else {
+		replica, err = getRunningReplica(ctx, dc.K8sTypedClient, namespace, options.Application, options.Resource)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	container := options.Container
+	if container == "" {
+		container = getAppContainerName(replica)
+		if container == "" {
+			return fmt.Errorf("failed to find the default container for resource '%s'. use '--container <name>' to specify the name", options.Resource)
+		}
+	}
+
+	return runExec(ctx, dc.RestConfig, dc.K8sTypedClient, replica, container, options)
+}
+
 func (dc *ARMDiagnosticsClient) findNamespaceOfContainer(ctx context.Context, resourceName string) (string, error) {
 	containerResponse, err := dc.ContainerClient.Get(ctx, resourceName, nil)
 	if err != nil {
@@ -170,12 +241,16 @@ func (dc *ARMDiagnosticsClient) findNamespaceOfContainer(ctx context.Context, re
 		return "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
 	}
 
-	applicationResponse, err := dc.ApplicationClient.Get(ctx, id.Name(), nil)
+	return dc.findNamespaceOfApplication(ctx, id.Name(), resourceName)
+}
+
+func (dc *ARMDiagnosticsClient) findNamespaceOfApplication(ctx context.Context, applicationName string, resourceName string) (string, error) {
+	applicationResponse, err := dc.ApplicationClient.Get(ctx, applicationName, nil)
 	if err != nil {
 		return "", fmt.Errorf("could not namespace for container %q:%w", resourceName, err)
 	}
 
-	obj, ok = applicationResponse.Properties["status"]
+	obj, ok := applicationResponse.Properties["status"]
 	if !ok {
 		return "", fmt.Errorf("could not find namespace for container %q", resourceName)
 	}
@@ -234,7 +309,7 @@ func createLogStreams(ctx context.Context, options clients.LogsOptions, dc *ARMD
 	return streams, nil
 }
 
-func getSpecificReplica(ctx context.Context, client *k8s.Clientset, namespace string, resource string, replica string) (*corev1.Pod, error) {
+func getSpecificReplica(ctx context.Context, client k8s.Interface, namespace string, resource string, replica string) (*corev1.Pod, error) {
 	// Right now this connects to a pod related to a resource. We can find the pods with the labels
 	// and then choose one that's in the running state.
 	pod, err := client.CoreV1().Pods(namespace).Get(ctx, replica, v1.GetOptions{})
@@ -249,7 +324,7 @@ func getSpecificReplica(ctx context.Context, client *k8s.Clientset, namespace st
 	return pod, nil
 }
 
-func getRunningReplica(ctx context.Context, client *k8s.Clientset, namespace string, application string, resource string) (*corev1.Pod, error) {
+func getRunningReplica(ctx context.Context, client k8s.Interface, namespace string, application string, resource string) (*corev1.Pod, error) {
 	// Right now this connects to a pod related to a resource. We can find the pods with the labels
 	// and then choose one that's in the running state.
 	pods, err := client.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{
@@ -268,7 +343,7 @@ func getRunningReplica(ctx context.Context, client *k8s.Clientset, namespace str
 	return nil, fmt.Errorf("failed to find a running replica for resource %v", resource)
 }
 
-func getRunningReplicas(ctx context.Context, client *k8s.Clientset, namespace string, application string, resource string) ([]corev1.Pod, error) {
+func getRunningReplicas(ctx context.Context, client k8s.Interface, namespace string, application string, resource string) ([]corev1.Pod, error) {
 	// Right now this connects to a pod related to a resource. We can find the pods with the labels
 	// and then choose one that's in the running state.
 	pods, err := client.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{
@@ -290,7 +365,35 @@ func getRunningReplicas(ctx context.Context, client *k8s.Clientset, namespace st
 	return running, nil
 }
 
-func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, ready chan struct{}, stop <-chan struct{}, localPort int, remotePort int) error {
+// getJobReplicas returns the pods of a job that have started, including the pods of completed and failed runs,
+// ordered from the oldest to the newest.
+func getJobReplicas(ctx context.Context, client k8s.Interface, namespace string, application string, resource string) ([]corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{
+		LabelSelector: labels.FormatLabels(k8slabels.MakeSelectorLabels(application, resource)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicas for resource %v: %w", resource, err)
+	}
+
+	var started []corev1.Pod
+	for _, p := range pods.Items {
+		switch p.Status.Phase {
+		case corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed:
+			started = append(started, p)
+		}
+	}
+	if len(started) == 0 {
+		return nil, fmt.Errorf("failed to find a started replica for resource %v", resource)
+	}
+
+	slices.SortFunc(started, func(a, b corev1.Pod) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+
+	return started, nil
+}
+
+func runPortforward(restconfig *rest.Config, client k8s.Interface, replica *corev1.Pod, ready chan struct{}, stop <-chan struct{}, localPort int, remotePort int) error {
 	// Build URL so we can open a port-forward via SPDY
 	url := client.CoreV1().RESTClient().Post().
 		Resource("pods").
@@ -321,13 +424,79 @@ func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *cor
 	return fw.ForwardPorts()
 }
 
+func runExec(ctx context.Context, restconfig *rest.Config, client k8s.Interface, replica *corev1.Pod, container string, options clients.ExecOptions) error {
+	// With a terminal, stderr is merged into stdout by the server.
+	stderr := options.Stderr
+	if options.TTY {
+		stderr = nil
+	}
+
+	// Build URL so we can open an exec session via SPDY
+	url := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(replica.Namespace).
+		Name(replica.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   options.Command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    options.Stdout != nil,
+			Stderr:    stderr != nil,
+			TTY:       options.TTY,
+		}, scheme.ParameterCodec).URL()
+
+	executor, err := remotecommand.NewSPDYExecutor(restconfig, "POST", url)
+	if err != nil {
+		return err
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  options.Stdin,
+		Stdout: options.Stdout,
+		Stderr: stderr,
+		Tty:    options.TTY,
+	}
+	if !options.TTY {
+		return executor.StreamWithContext(ctx, streamOptions)
+	}
+
+	// Put the local terminal in raw mode for the duration of the session, and forward its size changes.
+	tty := term.TTY{In: options.Stdin, Out: options.Stdout, Raw: true}
+	streamOptions.TerminalSizeQueue = terminalSizeQueue{queue: tty.MonitorSize(tty.GetSize())}
+	return tty.Safe(func() error {
+		return executor.StreamWithContext(ctx, streamOptions)
+	})
+}
+
+// terminalSizeQueue adapts the terminal size queue of kubectl to the one of client-go.
+type terminalSizeQueue struct {
+	queue term.TerminalSizeQueue
+}
+
+// Next returns the new size of the terminal, or nil once the terminal is closed.
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size := q.queue.Next()
+	if size == nil {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+}
+
 func getAppContainerName(replica *corev1.Pod) string {
 	// The container name will be the resource name
 	resource := replica.Labels[k8slabels.LabelRadiusResource]
+
+	// Jobs name their containers after the containers of the resource, so a single container is the primary one.
+	if len(replica.Spec.Containers) == 1 && replica.Spec.Containers[0].Name != resource {
+		return replica.Spec.Containers[0].Name
+	}
+
 	return resource
 }
 
-func streamLogs(ctx context.Context, client *k8s.Clientset, replica *corev1.Pod, container string, follow bool) (io.ReadCloser, error) {
+func streamLogs(ctx context.Context, client k8s.Interface, replica *corev1.Pod, container string, follow bool) (io.ReadCloser, error) {
 	options := &corev1.PodLogOptions{
 		Container: container,
 		Follow:    follow,
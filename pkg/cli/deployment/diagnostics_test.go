@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func makeJobPod(name string, resource string, phase corev1.PodPhase, created time.Time, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default-app",
+			Labels:            k8slabels.MakeSelectorLabels("app", resource),
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+	for _, c := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: c})
+	}
+	return pod
+}
+
+func Test_GetJobReplicas(t *testing.T) {
+	now := time.Now()
+	client := fake.NewSimpleClientset(
+		makeJobPod("migrate-2", "migrate", corev1.PodRunning, now),
+		makeJobPod("migrate-1", "migrate", corev1.PodSucceeded, now.Add(-time.Hour)),
+		makeJobPod("migrate-0", "migrate", corev1.PodFailed, now.Add(-2*time.Hour)),
+		makeJobPod("migrate-3", "migrate", corev1.PodPending, now.Add(time.Minute)),
+		makeJobPod("report-0", "report", corev1.PodSucceeded, now),
+	)
+
+	t.Run("includes finished runs", func(t *testing.T) {
+		replicas, err := getJobReplicas(context.Background(), client, "default-app", "app", "migrate")
+		require.NoError(t, err)
+
+		names := []string{}
+		for _, replica := range replicas {
+			names = append(names, replica.Name)
+		}
+		require.Equal(t, []string{"migrate-0", "migrate-1", "migrate-2"}, names)
+	})
+
+	t.Run("no started runs", func(t *testing.T) {
+		_, err := getJobReplicas(context.Background(), client, "default-app", "app", "cleanup")
+		require.ErrorContains(t, err, "failed to find a started replica for resource cleanup")
+	})
+}
+
+func Test_GetAppContainerName(t *testing.T) {
+	tests := []struct {
+		name       string
+		containers []string
+		expected   string
+	}{
+		{
+			name:       "container named after the resource",
+			containers: []string{"frontend", "daprd"},
+			expected:   "frontend",
+		},
+		{
+			name:       "single container of a job",
+			containers: []string{"migrate"},
+			expected:   "migrate",
+		},
+		{
+			name:       "multiple containers of a job",
+			containers: []string{"migrate", "seed"},
+			expected:   "frontend",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := makeJobPod("pod", "frontend", corev1.PodRunning, time.Now(), tt.containers...)
+			require.Equal(t, tt.expected, getAppContainerName(pod))
+		})
+	}
+}
+
+func Test_ARMDiagnosticsClient_WithoutKubernetes(t *testing.T) {
+	dc := &ARMDiagnosticsClient{}
+
+	_, err := dc.Logs(context.Background(), clients.LogsOptions{Application: "app", Resource: "frontend"})
+	require.ErrorIs(t, err, ErrKubernetesRequired)
+
+	err = dc.Exec(context.Background(), clients.ExecOptions{Application: "app", Resource: "frontend"})
+	require.ErrorIs(t, err, ErrKubernetesRequired)
+
+	_, _, _, err = dc.Expose(context.Background(), clients.ExposeOptions{Application: "app", Resource: "frontend"})
+	require.ErrorIs(t, err, ErrKubernetesRequired)
+}
@@ -18,8 +18,11 @@ package logstream
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"text/template"
 	"time"
 
@@ -27,6 +30,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/stern/stern/stern"
+	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -38,6 +42,12 @@ import (
 // our log messages. For example, we can't use the Radius container name because stern does not provide that to us.
 const outputFormat = "{{color .PodColor .PodName}} {{color .ContainerColor .ContainerName}} {{.Message}}\n"
 
+// Format used for each log line when JSON output is requested.
+const jsonOutputFormat = "{{json .}}\n"
+
+// defaultSince is how far back logs are read if Options.Since is unset.
+const defaultSince = 48 * time.Hour
+
 // Impl is the implementation of logstream.Interface.
 type Impl struct {
 }
@@ -56,32 +66,96 @@ func (i *Impl) Stream(ctx context.Context, options Options) error {
 	// If we need to customize the behavior more, we could replace this functionality. The main
 	// value of stern is that it's reactive to changes in the cluster. As new pods come online they
 	// automatically added to the log stream.
-	cfg := stern.Config{
-		// Almost ALL of the fields on stern.Config are required. Most of what's here is replicating
-		// the defaults of the stern CLI. The library does not provide access to stern's defaults.
-
-		// Fields used to select/filter pods
-		Namespaces:          []string{options.Namespace},
-		PodQuery:            regexp.MustCompile(`.*`),
-		ContainerQuery:      regexp.MustCompile(`.*`),
-		FieldSelector:       fields.Everything(),
-		ContainerStates:     []stern.ContainerState{stern.RUNNING},
-		InitContainers:      true,
-		EphemeralContainers: true,
-
-		// Fields used to configure the lifetime of the command
-		Since:     48 * time.Hour,
-		TailLines: nil,
-		Follow:    true,
-
-		// Fields used to configure output
-		Timestamps: false,
-		Template:   template.Must(template.New("output").Funcs(functionTable()).Parse(outputFormat)),
-		Out:        options.Out,
-		ErrOut:     options.Out,
-
-		// Limit the number of concurrent log fetch function unlike Kubernetes client rate limitter.
-		MaxLogRequests: 50,
+	configs, err := sternConfigs(options)
+	if err != nil {
+		return err
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for _, cfg := range configs {
+		group.Go(func() error {
+			// This will block until the context is cancelled, or until the current logs are written when not
+			// following.
+			err := stern.Run(ctx, options.KubeClient, cfg)
+			if err != nil {
+				// Not returning the error and just logging is intentional!
+				// We don't want the process to exit if there's an error streaming logs.
+				// We just want to log the error and continue.
+				fmt.Println("Error running stern: ", err)
+			}
+
+			return nil
+		})
+	}
+
+	return group.Wait()
+}
+
+// sternConfigs returns the stern configurations for the options. The first selects the pods labeled as part of the
+// application, and the others select the pods of the unlabeled workloads of each namespace.
+func sternConfigs(options Options) ([]*stern.Config, error) {
+	format := outputFormat
+	if options.JSON {
+		format = jsonOutputFormat
+	}
+
+	tmpl, err := template.New("output").Funcs(functionTable()).Parse(format)
+	if err != nil {
+		return nil, err
+	}
+
+	since := options.Since
+	if since <= 0 {
+		since = defaultSince
+	}
+
+	containerQuery := options.Container
+	if containerQuery == nil {
+		containerQuery = regexp.MustCompile(`.*`)
+	}
+
+	// Logs of terminated containers help troubleshooting crashes, but stern only follows running containers.
+	containerStates := []stern.ContainerState{stern.RUNNING}
+	if !options.Follow {
+		containerStates = []stern.ContainerState{stern.ALL_STATES}
+	}
+
+	var extraRequirements labels.Requirements
+	if options.LabelSelector != nil {
+		extraRequirements, _ = options.LabelSelector.Requirements()
+	}
+
+	newConfig := func(namespace string, podQuery *regexp.Regexp, selector labels.Selector) *stern.Config {
+		return &stern.Config{
+			// Almost ALL of the fields on stern.Config are required. Most of what's here is replicating
+			// the defaults of the stern CLI. The library does not provide access to stern's defaults.
+
+			// Fields used to select/filter pods
+			Namespaces:          []string{namespace},
+			PodQuery:            podQuery,
+			ContainerQuery:      containerQuery,
+			LabelSelector:       selector.Add(extraRequirements...),
+			FieldSelector:       fields.Everything(),
+			ContainerStates:     containerStates,
+			InitContainers:      true,
+			EphemeralContainers: true,
+			Include:             options.Include,
+			Exclude:             options.Exclude,
+
+			// Fields used to configure the lifetime of the command
+			Since:     since,
+			TailLines: nil,
+			Follow:    options.Follow,
+
+			// Fields used to configure output
+			Timestamps: false,
+			Template:   tmpl,
+			Out:        options.Out,
+			ErrOut:     options.Out,
+
+			// Limit the number of concurrent log fetch function unlike Kubernetes client rate limitter.
+			MaxLogRequests: 50,
+		}
 	}
 
 	// This is the only Radius-specific customization we make.
@@ -89,23 +163,67 @@ func (i *Impl) Stream(ctx context.Context, options Options) error {
 	// We use the `radapp.io/application` label to include pods that are part of an application.
 	// This can include the user's Radius containers as well as any Kubernetes resources that are labeled
 	// as part of the application (eg: something created with a recipe).
-	req, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.Equals, []string{options.ApplicationName})
+	applicationRequirement, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.Equals, []string{kubernetes.NormalizeResourceName(options.ApplicationName)})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	selector := labels.NewSelector().Add(*applicationRequirement)
+	if len(options.Resources) > 0 {
+		names := []string{}
+		for _, resource := range options.Resources {
+			names = append(names, kubernetes.NormalizeResourceName(resource))
+		}
+
+		resourceRequirement, err := labels.NewRequirement(kubernetes.LabelRadiusResource, selection.In, names)
+		if err != nil {
+			return nil, err
+		}
+
+		selector = selector.Add(*resourceRequirement)
 	}
 
-	cfg.LabelSelector = labels.NewSelector().Add(*req)
+	configs := []*stern.Config{newConfig(options.Namespace, regexp.MustCompile(`.*`), selector)}
 
-	// This will block until the context is cancelled.
-	err = stern.Run(ctx, options.KubeClient, &cfg)
+	// Workloads that are labeled as part of the application are already selected by the application label, so
+	// they are excluded here to avoid writing their logs twice.
+	unlabeledRequirement, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.DoesNotExist, nil)
 	if err != nil {
-		// Not returning the error and just logging is intentional!
-		// We don't want the process to exit if there's an error streaming logs.
-		// We just want to log the error and continue.
-		fmt.Println("Error running stern: ", err)
+		return nil, err
+	}
+
+	patterns := map[string][]string{}
+	for _, workload := range options.Workloads {
+		patterns[workload.Namespace] = append(patterns[workload.Namespace], workloadPodPattern(workload))
 	}
 
-	return nil
+	namespaces := []string{}
+	for namespace := range patterns {
+		namespaces = append(namespaces, namespace)
+	}
+	slices.Sort(namespaces)
+
+	for _, namespace := range namespaces {
+		podQuery, err := regexp.Compile("^(?:" + strings.Join(patterns[namespace], "|") + ")$")
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, newConfig(namespace, podQuery, labels.NewSelector().Add(*unlabeledRequirement)))
+	}
+
+	return configs, nil
+}
+
+// workloadPodPattern returns the regular expression matching the names of the pods of a workload. The pods of
+// Deployments, StatefulSets and DaemonSets are named after the workload followed by one or two generated segments.
+func workloadPodPattern(workload Workload) string {
+	name := regexp.QuoteMeta(workload.Name)
+	if strings.EqualFold(workload.Kind, "Pod") {
+		return name
+	}
+
+	return name + "-[a-z0-9]+(?:-[a-z0-9]+)?"
 }
 
 // functionTable sets the functions available to the text template.
@@ -115,5 +233,9 @@ func functionTable() map[string]any {
 			// Use the provided color to add ascii escapes.
 			return color.SprintFunc()(text)
 		},
+		"json": func(value any) (string, error) {
+			b, err := json.Marshal(value)
+			return string(b), err
+		},
 	}
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logstream
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stern/stern/stern"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func Test_sternConfigs(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		configs, err := sternConfigs(Options{ApplicationName: "myApp", Namespace: "default-myapp", Follow: true})
+		require.NoError(t, err)
+		require.Len(t, configs, 1)
+
+		require.Equal(t, []string{"default-myapp"}, configs[0].Namespaces)
+		require.Equal(t, "radapp.io/application=myapp", configs[0].LabelSelector.String())
+		require.Equal(t, defaultSince, configs[0].Since)
+		require.True(t, configs[0].Follow)
+		require.Equal(t, []stern.ContainerState{stern.RUNNING}, configs[0].ContainerStates)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		selector, err := labels.Parse("tier=frontend")
+		require.NoError(t, err)
+
+		configs, err := sternConfigs(Options{
+			ApplicationName: "myapp",
+			Namespace:       "default-myapp",
+			Since:           time.Hour,
+			Resources:       []string{"frontend", "backend"},
+			LabelSelector:   selector,
+			Container:       regexp.MustCompile("^app$"),
+			Include:         []*regexp.Regexp{regexp.MustCompile("error")},
+		})
+		require.NoError(t, err)
+		require.Len(t, configs, 1)
+
+		require.Equal(t, "radapp.io/application=myapp,radapp.io/resource in (backend,frontend),tier=frontend", configs[0].LabelSelector.String())
+		require.Equal(t, time.Hour, configs[0].Since)
+		require.False(t, configs[0].Follow)
+		require.Equal(t, []stern.ContainerState{stern.ALL_STATES}, configs[0].ContainerStates)
+		require.Equal(t, "^app$", configs[0].ContainerQuery.String())
+		require.Len(t, configs[0].Include, 1)
+	})
+
+	t.Run("workloads", func(t *testing.T) {
+		configs, err := sternConfigs(Options{
+			ApplicationName: "myapp",
+			Namespace:       "default-myapp",
+			Workloads: []Workload{
+				{Namespace: "redis", Kind: "Deployment", Name: "redis"},
+				{Namespace: "default-myapp", Kind: "Pod", Name: "migrate"},
+				{Namespace: "redis", Kind: "StatefulSet", Name: "redis.replica"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, configs, 3)
+
+		require.Equal(t, []string{"default-myapp"}, configs[1].Namespaces)
+		require.Equal(t, "!radapp.io/application", configs[1].LabelSelector.String())
+		require.True(t, configs[1].PodQuery.MatchString("migrate"))
+		require.False(t, configs[1].PodQuery.MatchString("migrate-abc12"))
+
+		require.Equal(t, []string{"redis"}, configs[2].Namespaces)
+		require.True(t, configs[2].PodQuery.MatchString("redis-5d8f7c9b6-x2k4p"))
+		require.True(t, configs[2].PodQuery.MatchString("redis.replica-0"))
+		require.False(t, configs[2].PodQuery.MatchString("redisxreplica-0"))
+		require.False(t, configs[2].PodQuery.MatchString("other-5d8f7c9b6-x2k4p"))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		configs, err := sternConfigs(Options{ApplicationName: "myapp", Namespace: "default-myapp", JSON: true})
+		require.NoError(t, err)
+
+		buffer := &bytes.Buffer{}
+		require.NoError(t, configs[0].Template.Execute(buffer, stern.Log{Message: "hello", PodName: "frontend-abc", ContainerName: "frontend"}))
+		require.JSONEq(t, `{"message":"hello","nodeName":"","namespace":"","podName":"frontend-abc","containerName":"frontend","labels":null,"annotations":null}`, buffer.String())
+	})
+}
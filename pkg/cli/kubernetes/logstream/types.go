@@ -19,7 +19,10 @@ package logstream
 import (
 	"context"
 	"io"
+	"regexp"
+	"time"
 
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -36,6 +39,49 @@ type Options struct {
 
 	// Out is where output will be written.
 	Out io.Writer
+
+	// Follow streams new logs until the context is cancelled. Otherwise the logs that are currently available are
+	// written and Stream returns.
+	Follow bool
+
+	// Since is how far back logs are read. If unset logs of the last 48 hours are read.
+	Since time.Duration
+
+	// Resources limits the logs to the Radius resources with the given names. If unset the logs of every resource of
+	// the application are included.
+	Resources []string
+
+	// Workloads are Kubernetes workloads that are part of the application without being labeled as such, for
+	// example those created by recipes. The logs of their pods are included.
+	Workloads []Workload
+
+	// LabelSelector limits the logs to the pods matching the selector.
+	LabelSelector labels.Selector
+
+	// Container limits the logs to the containers whose name matches.
+	Container *regexp.Regexp
+
+	// Include limits the logs to the lines matching one of the expressions.
+	Include []*regexp.Regexp
+
+	// Exclude drops the lines matching one of the expressions.
+	Exclude []*regexp.Regexp
+
+	// JSON writes each line as a JSON object instead of text.
+	JSON bool
+}
+
+// Workload is a Kubernetes workload, such as a Deployment, whose pods are included in the logs.
+type Workload struct {
+	// Namespace is the kubernetes namespace of the workload.
+	Namespace string
+
+	// Kind is the kind of the workload, e.g. 'Deployment'. Pods are matched by name when the kind is 'Pod', and by
+	// the name of their workload otherwise.
+	Kind string
+
+	// Name is the name of the workload.
+	Name string
 }
 
 //go:generate mockgen -typed -destination=./mock_logstream.go -package=logstream -self_package github.com/radius-project/radius/pkg/cli/kubernetes/logstream github.com/radius-project/radius/pkg/cli/kubernetes/logstream Interface
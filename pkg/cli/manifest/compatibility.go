@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// CheckAPIVersionCompatibility checks that each new API version of a resource type in a manifest is backward-compatible
+// with the API version it replaces, or declares a conversion from that API version. The API version it replaces is
+// the latest API version that sorts before it, either already registered or in the manifest.
+//
+// API versions that are already registered are not checked, so that they can be re-registered.
+func CheckAPIVersionCompatibility(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProviderNamespace string, typeName string, resourceType *ResourceType) error {
+	registered, err := getRegisteredSchemas(ctx, clientFactory, planeName, resourceProviderNamespace, typeName)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIVersionCompatibility(resourceProviderNamespace, typeName, resourceType, registered)
+}
+
+// checkAPIVersionCompatibility checks the new API versions of a resource type in a manifest against the schemas of the
+// API versions that already exist, keyed by API version.
+func checkAPIVersionCompatibility(resourceProviderNamespace string, typeName string, resourceType *ResourceType, registered map[string]map[string]any) error {
+	schemas := map[string]map[string]any{}
+	for name, apiVersion := range registered {
+		schemas[name] = apiVersion
+	}
+	for name, apiVersion := range resourceType.APIVersions {
+		if _, ok := registered[name]; !ok {
+			schemas[name], _ = apiVersion.Schema.(map[string]any)
+		}
+	}
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for i, name := range names {
+		apiVersion, ok := resourceType.APIVersions[name]
+		if !ok || i == 0 {
+			continue
+		}
+		if _, ok := registered[name]; ok {
+			continue
+		}
+
+		previous := names[i-1]
+		if slices.ContainsFunc(apiVersion.Conversions, func(conversion *ResourceTypeConversion) bool {
+			return conversion.From == previous
+		}) {
+			continue
+		}
+
+		changes := schema.CheckBackwardCompatibility(schemas[previous], schemas[name])
+		if len(changes) > 0 {
+			return fmt.Errorf("API version %q of resource type %s/%s is not backward-compatible with API version %q. Declare a conversion from %q or revert the following changes:\n  %s",
+				name, resourceProviderNamespace, typeName, previous, previous, strings.Join(changes, "\n  "))
+		}
+	}
+
+	return nil
+}
+
+// getRegisteredSchemas returns the schemas of the registered API versions of a resource type, keyed by API version.
+func getRegisteredSchemas(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProviderNamespace string, typeName string) (map[string]map[string]any, error) {
+	response, err := clientFactory.NewResourceProvidersClient().GetProviderSummary(ctx, planeName, resourceProviderNamespace, nil)
+	if clients.Is404Error(err) {
+		return map[string]map[string]any{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	schemas := map[string]map[string]any{}
+	resourceType, ok := response.ResourceTypes[typeName]
+	if !ok || resourceType == nil {
+		return schemas, nil
+	}
+
+	for name, apiVersion := range resourceType.APIVersions {
+		if apiVersion != nil {
+			schemas[name] = apiVersion.Schema
+		}
+	}
+
+	return schemas, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAPIVersionCompatibility(t *testing.T) {
+	// The registered 2023-10-01-preview API version of testResources has the application, environment and database
+	// properties, and requires environment.
+	registeredSchema := func() map[string]any {
+		return map[string]any{
+			"properties": map[string]any{
+				"application": map[string]any{"type": "string"},
+				"environment": map[string]any{"type": "string"},
+				"database":    map[string]any{"type": "string", "readOnly": true},
+			},
+			"required": []any{"environment"},
+		}
+	}
+
+	withoutDatabase := func() map[string]any {
+		schema := registeredSchema()
+		delete(schema["properties"].(map[string]any), "database")
+		return schema
+	}
+
+	tests := []struct {
+		name        string
+		notFound    bool
+		apiVersions map[string]*ResourceTypeAPIVersion
+		expectedErr string
+	}{
+		{
+			name: "compatible new API version",
+			apiVersions: map[string]*ResourceTypeAPIVersion{
+				"2025-01-01-preview": {Schema: func() map[string]any {
+					schema := registeredSchema()
+					schema["properties"].(map[string]any)["size"] = map[string]any{"type": "integer"}
+					return schema
+				}()},
+			},
+		},
+		{
+			name: "incompatible new API version",
+			apiVersions: map[string]*ResourceTypeAPIVersion{
+				"2025-01-01-preview": {Schema: withoutDatabase()},
+			},
+			expectedErr: "API version \"2025-01-01-preview\" of resource type MyCompany.Resources/testResources is not backward-compatible with API version \"2023-10-01-preview\"",
+		},
+		{
+			name: "incompatible new API version with conversion",
+			apiVersions: map[string]*ResourceTypeAPIVersion{
+				"2025-01-01-preview": {
+					Schema: withoutDatabase(),
+					Conversions: []*ResourceTypeConversion{
+						{
+							From: "2023-10-01-preview",
+							Rules: []*ResourceTypeConversionRule{
+								{Kind: "move", From: new("database"), To: new("connection.database")},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "registered API version is not checked",
+			apiVersions: map[string]*ResourceTypeAPIVersion{
+				"2023-10-01-preview": {Schema: withoutDatabase()},
+			},
+		},
+		{
+			name:     "incompatible API versions in the manifest",
+			notFound: true,
+			apiVersions: map[string]*ResourceTypeAPIVersion{
+				"2023-10-01-preview": {Schema: registeredSchema()},
+				"2025-01-01-preview": {Schema: withoutDatabase()},
+			},
+			expectedErr: "database: property was removed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := WithResourceProviderServerNoError
+			if tt.notFound {
+				server = WithResourceProviderServerNotFoundError
+			}
+			clientFactory, err := NewTestClientFactory(server)
+			require.NoError(t, err)
+
+			err = CheckAPIVersionCompatibility(context.Background(), clientFactory, "local", "MyCompany.Resources", "testResources", &ResourceType{APIVersions: tt.apiVersions})
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
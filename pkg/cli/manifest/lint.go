@@ -0,0 +1,232 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// LintSeverity is the severity of a lint diagnostic.
+type LintSeverity string
+
+const (
+	// LintSeverityError indicates a problem that prevents the manifest from being registered or breaks existing clients.
+	LintSeverityError LintSeverity = "error"
+
+	// LintSeverityWarning indicates a problem with the style of the manifest.
+	LintSeverityWarning LintSeverity = "warning"
+)
+
+const (
+	// LintRuleManifest reports manifests that cannot be read or do not match the manifest format.
+	LintRuleManifest = "manifest"
+
+	// LintRuleSchema reports schemas that break the Radius schema rules, such as reserved properties, sensitive
+	// annotations, $ref usage and platformOptions.
+	LintRuleSchema = "schema"
+
+	// LintRuleDescription reports resource types and properties without a description.
+	LintRuleDescription = "description"
+
+	// LintRulePropertyName reports property names that are not camelCase.
+	LintRulePropertyName = "property-name"
+
+	// LintRuleCompatibility reports changes that are not backward-compatible with a previous version of the manifest.
+	LintRuleCompatibility = "compatibility"
+)
+
+var camelCaseRegex = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// LintDiagnostic is a problem found in a resource provider manifest.
+type LintDiagnostic struct {
+	// RuleID is the rule that reported the problem.
+	RuleID string `json:"ruleId"`
+
+	// Severity is the severity of the problem.
+	Severity LintSeverity `json:"severity"`
+
+	// Location is the resource type, API version and property the problem was found in.
+	// For example: "MyCompany.Resources/testResources@2025-01-01-preview.database.host".
+	Location string `json:"location"`
+
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// LintFile validates and lints the resource provider manifest at filePath without contacting Radius. When
+// previousFilePath is set, the manifest is also checked for backward-compatibility with the manifest at that path.
+func LintFile(ctx context.Context, filePath string, previousFilePath string) []LintDiagnostic {
+	resourceProvider, err := ReadFile(filePath)
+	if err != nil {
+		return []LintDiagnostic{newLintError(LintRuleManifest, filePath, fmt.Sprintf("failed to read manifest: %v", err))}
+	}
+
+	var previous *ResourceProvider
+	if previousFilePath != "" {
+		previous, err = ReadFile(previousFilePath)
+		if err != nil {
+			return []LintDiagnostic{newLintError(LintRuleManifest, previousFilePath, fmt.Sprintf("failed to read previous manifest: %v", err))}
+		}
+	}
+
+	return Lint(ctx, resourceProvider, previous)
+}
+
+// Lint validates and lints a resource provider manifest. When previous is set, the manifest is also checked
+// for backward-compatibility with it. Diagnostics are sorted by location.
+func Lint(ctx context.Context, resourceProvider *ResourceProvider, previous *ResourceProvider) []LintDiagnostic {
+	diagnostics := []LintDiagnostic{}
+
+	if err := validateManifestSchemas(ctx, resourceProvider); err != nil {
+		var validationErrors *schema.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			for _, validationError := range validationErrors.Errors {
+				diagnostics = append(diagnostics, newLintError(LintRuleSchema, validationError.Field, validationError.Message))
+			}
+		} else {
+			diagnostics = append(diagnostics, newLintError(LintRuleSchema, resourceProvider.Namespace, err.Error()))
+		}
+	}
+
+	for typeName, resourceType := range resourceProvider.Types {
+		typeLocation := fmt.Sprintf("%s/%s", resourceProvider.Namespace, typeName)
+		if resourceType.Description == nil || *resourceType.Description == "" {
+			diagnostics = append(diagnostics, newLintWarning(LintRuleDescription, typeLocation, "resource type has no description"))
+		}
+
+		for apiVersionName, apiVersion := range resourceType.APIVersions {
+			if schemaMap, ok := apiVersion.Schema.(map[string]any); ok {
+				diagnostics = append(diagnostics, lintSchemaProperties(schemaMap, typeLocation+"@"+apiVersionName)...)
+			}
+		}
+	}
+
+	if previous != nil {
+		diagnostics = append(diagnostics, lintCompatibility(resourceProvider, previous)...)
+	}
+
+	slices.SortStableFunc(diagnostics, func(a LintDiagnostic, b LintDiagnostic) int {
+		return cmp.Or(cmp.Compare(a.Location, b.Location), cmp.Compare(a.RuleID, b.RuleID), cmp.Compare(a.Message, b.Message))
+	})
+
+	return diagnostics
+}
+
+// HasLintErrors returns true if any of the diagnostics is an error.
+func HasLintErrors(diagnostics []LintDiagnostic) bool {
+	return slices.ContainsFunc(diagnostics, func(diagnostic LintDiagnostic) bool {
+		return diagnostic.Severity == LintSeverityError
+	})
+}
+
+// lintSchemaProperties checks that the properties of a schema, and of the schemas nested in it, are described
+// and named in camelCase.
+func lintSchemaProperties(schemaMap map[string]any, location string) []LintDiagnostic {
+	diagnostics := []LintDiagnostic{}
+
+	properties, _ := schemaMap["properties"].(map[string]any)
+	for name, property := range properties {
+		propertyLocation := location + "." + name
+		if !camelCaseRegex.MatchString(name) {
+			diagnostics = append(diagnostics, newLintWarning(LintRulePropertyName, propertyLocation, fmt.Sprintf("property name %q is not camelCase", name)))
+		}
+
+		propertySchema, ok := property.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if description, _ := propertySchema["description"].(string); description == "" {
+			diagnostics = append(diagnostics, newLintWarning(LintRuleDescription, propertyLocation, "property has no description"))
+		}
+
+		diagnostics = append(diagnostics, lintSchemaProperties(propertySchema, propertyLocation)...)
+	}
+
+	if items, ok := schemaMap["items"].(map[string]any); ok {
+		diagnostics = append(diagnostics, lintSchemaProperties(items, location+"[*]")...)
+	}
+
+	if additionalProperties, ok := schemaMap["additionalProperties"].(map[string]any); ok {
+		diagnostics = append(diagnostics, lintSchemaProperties(additionalProperties, location+".*")...)
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		variants, _ := schemaMap[keyword].([]any)
+		for i, variant := range variants {
+			if variantSchema, ok := variant.(map[string]any); ok {
+				diagnostics = append(diagnostics, lintSchemaProperties(variantSchema, fmt.Sprintf("%s(%s[%d])", location, keyword, i))...)
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// lintCompatibility checks that a manifest is backward-compatible with a previous version of it. Resource types and
+// API versions must not be removed, existing API versions must not change incompatibly, and new API versions must
+// be backward-compatible with the API version they replace or declare a conversion from it.
+func lintCompatibility(resourceProvider *ResourceProvider, previous *ResourceProvider) []LintDiagnostic {
+	diagnostics := []LintDiagnostic{}
+
+	for typeName, previousType := range previous.Types {
+		typeLocation := fmt.Sprintf("%s/%s", resourceProvider.Namespace, typeName)
+		resourceType, ok := resourceProvider.Types[typeName]
+		if !ok {
+			diagnostics = append(diagnostics, newLintError(LintRuleCompatibility, typeLocation, "resource type was removed"))
+			continue
+		}
+
+		registered := map[string]map[string]any{}
+		for apiVersionName, previousAPIVersion := range previousType.APIVersions {
+			previousSchema, _ := previousAPIVersion.Schema.(map[string]any)
+			registered[apiVersionName] = previousSchema
+
+			apiVersion, ok := resourceType.APIVersions[apiVersionName]
+			if !ok {
+				diagnostics = append(diagnostics, newLintError(LintRuleCompatibility, typeLocation+"@"+apiVersionName, "API version was removed"))
+				continue
+			}
+
+			newSchema, _ := apiVersion.Schema.(map[string]any)
+			for _, change := range schema.CheckBackwardCompatibility(previousSchema, newSchema) {
+				diagnostics = append(diagnostics, newLintError(LintRuleCompatibility, typeLocation+"@"+apiVersionName, change))
+			}
+		}
+
+		if err := checkAPIVersionCompatibility(resourceProvider.Namespace, typeName, resourceType, registered); err != nil {
+			diagnostics = append(diagnostics, newLintError(LintRuleCompatibility, typeLocation, err.Error()))
+		}
+	}
+
+	return diagnostics
+}
+
+func newLintError(ruleID string, location string, message string) LintDiagnostic {
+	return LintDiagnostic{RuleID: ruleID, Severity: LintSeverityError, Location: location, Message: message}
+}
+
+func newLintWarning(ruleID string, location string, message string) LintDiagnostic {
+	return LintDiagnostic{RuleID: ruleID, Severity: LintSeverityWarning, Location: location, Message: message}
+}
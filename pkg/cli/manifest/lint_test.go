@@ -0,0 +1,192 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		filePath     string
+		previousPath string
+		expected     []LintDiagnostic
+	}{
+		{
+			name:     "valid manifest",
+			filePath: "testdata/valid.yaml",
+			expected: []LintDiagnostic{},
+		},
+		{
+			name:     "unreadable manifest",
+			filePath: "testdata/missing.yaml",
+			expected: []LintDiagnostic{
+				{
+					RuleID:   LintRuleManifest,
+					Severity: LintSeverityError,
+					Location: "testdata/missing.yaml",
+					Message:  "failed to read manifest: open testdata/missing.yaml: no such file or directory",
+				},
+			},
+		},
+		{
+			name:     "style warnings",
+			filePath: "testdata/lint-current.yaml",
+			expected: []LintDiagnostic{
+				{
+					RuleID:   LintRulePropertyName,
+					Severity: LintSeverityWarning,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview.database_name",
+					Message:  `property name "database_name" is not camelCase`,
+				},
+				{
+					RuleID:   LintRuleDescription,
+					Severity: LintSeverityWarning,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview.port",
+					Message:  "property has no description",
+				},
+			},
+		},
+		{
+			name:         "backward-incompatible with previous manifest",
+			filePath:     "testdata/lint-current.yaml",
+			previousPath: "testdata/lint-previous.yaml",
+			expected: []LintDiagnostic{
+				{
+					RuleID:   LintRuleCompatibility,
+					Severity: LintSeverityError,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview",
+					Message:  "host: property was removed",
+				},
+				{
+					RuleID:   LintRuleCompatibility,
+					Severity: LintSeverityError,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview",
+					Message:  `port: type changed from "integer" to "string"`,
+				},
+				{
+					RuleID:   LintRulePropertyName,
+					Severity: LintSeverityWarning,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview.database_name",
+					Message:  `property name "database_name" is not camelCase`,
+				},
+				{
+					RuleID:   LintRuleDescription,
+					Severity: LintSeverityWarning,
+					Location: "MyCompany.Resources/testResources@2025-01-01-preview.port",
+					Message:  "property has no description",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnostics := LintFile(context.Background(), tt.filePath, tt.previousPath)
+			require.Equal(t, tt.expected, diagnostics)
+		})
+	}
+}
+
+func TestLintFile_SchemaErrors(t *testing.T) {
+	diagnostics := LintFile(context.Background(), "testdata/lint-invalid-schema.yaml", "")
+	require.True(t, HasLintErrors(diagnostics))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, LintRuleSchema, diagnostics[0].RuleID)
+	require.Contains(t, diagnostics[0].Location, "MyCompany.Resources/testResources@2025-01-01-preview")
+}
+
+func TestLintCompatibility_RemovedTypesAndAPIVersions(t *testing.T) {
+	previous := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {Schema: map[string]any{}},
+				},
+			},
+			"otherResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {Schema: map[string]any{}},
+				},
+			},
+		},
+	}
+	current := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-06-01-preview": {Schema: map[string]any{}},
+				},
+			},
+		},
+	}
+
+	diagnostics := lintCompatibility(current, previous)
+	require.ElementsMatch(t, []LintDiagnostic{
+		{
+			RuleID:   LintRuleCompatibility,
+			Severity: LintSeverityError,
+			Location: "MyCompany.Resources/otherResources",
+			Message:  "resource type was removed",
+		},
+		{
+			RuleID:   LintRuleCompatibility,
+			Severity: LintSeverityError,
+			Location: "MyCompany.Resources/testResources@2025-01-01-preview",
+			Message:  "API version was removed",
+		},
+	}, diagnostics)
+}
+
+func TestToSARIF(t *testing.T) {
+	diagnostics := []LintDiagnostic{
+		{
+			RuleID:   LintRuleDescription,
+			Severity: LintSeverityWarning,
+			Location: "MyCompany.Resources/testResources@2025-01-01-preview.port",
+			Message:  "property has no description",
+		},
+	}
+
+	log := ToSARIF(diagnostics, "testdata/lint-current.yaml")
+	require.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 5)
+	require.Equal(t, []SARIFResult{
+		{
+			RuleID:  LintRuleDescription,
+			Level:   "warning",
+			Message: SARIFMessage{Text: "property has no description"},
+			Locations: []SARIFLocation{
+				{
+					PhysicalLocation: SARIFPhysicalLocation{
+						ArtifactLocation: SARIFArtifactLocation{URI: "testdata/lint-current.yaml"},
+					},
+					LogicalLocations: []SARIFLogicalLocation{
+						{FullyQualifiedName: "MyCompany.Resources/testResources@2025-01-01-preview.port"},
+					},
+				},
+			},
+		},
+	}, log.Runs[0].Results)
+}
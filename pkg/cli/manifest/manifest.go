@@ -16,6 +16,8 @@ limitations under the License.
 
 package manifest
 
+import "time"
+
 // ResourceProvider represents a resource provider manifest.
 type ResourceProvider struct {
 	// Namespace is the resource provider name. This is also the namespace of the types defined by the resource provider.
@@ -41,6 +43,9 @@ type ResourceType struct {
 
 	// Description of the resource type.
 	Description *string `yaml:"description,omitempty"`
+
+	// Lifecycle is the lifecycle of the resource type. Resource types without a lifecycle are generally available.
+	Lifecycle *ResourceTypeLifecycle `yaml:"lifecycle,omitempty"`
 }
 
 type ResourceTypeAPIVersion struct {
@@ -49,4 +54,83 @@ type ResourceTypeAPIVersion struct {
 	// TODO: this allows anything right now, and will be ignored. We'll improve this in
 	// a future pull-request.
 	Schema any `yaml:"schema" validate:"required"`
+
+	// Actions is a map of custom actions that can be invoked on resources of this type. Actions are
+	// invoked with a POST request to the resource ID followed by the action name.
+	Actions map[string]*ResourceTypeAction `yaml:"actions,omitempty" validate:"dive,keys,actionName,endkeys,required"`
+
+	// Conversions is a list of conversions from older API versions of the resource type to this API version.
+	// A conversion is required when the schema of this API version is not backward-compatible with the
+	// API version it replaces.
+	Conversions []*ResourceTypeConversion `yaml:"conversions,omitempty" validate:"dive,required"`
+
+	// Lifecycle is the lifecycle of the API version. API versions without a lifecycle are generally available.
+	Lifecycle *ResourceTypeLifecycle `yaml:"lifecycle,omitempty"`
+}
+
+// ResourceTypeLifecycle describes the lifecycle state of a resource type or API version.
+type ResourceTypeLifecycle struct {
+	// State is the lifecycle state. Must be one of 'preview', 'ga', 'deprecated', or 'retired'.
+	State string `yaml:"state" validate:"required,oneof=preview ga deprecated retired"`
+
+	// SunsetDate is the date a deprecated resource type or API version will be retired.
+	SunsetDate *time.Time `yaml:"sunsetDate,omitempty"`
+
+	// Message is shown to users of a deprecated resource type or API version, for example to suggest a replacement.
+	Message *string `yaml:"message,omitempty"`
+}
+
+// ResourceTypeAction represents a custom action declared by a resource type.
+type ResourceTypeAction struct {
+	// Description of the action.
+	Description *string `yaml:"description,omitempty"`
+
+	// Input is the schema for the request body of the action.
+	Input any `yaml:"input,omitempty"`
+
+	// Output is the schema for the response body of the action.
+	Output any `yaml:"output,omitempty"`
+
+	// Handler describes how the action is handled.
+	Handler ResourceTypeActionHandler `yaml:"handler" validate:"required"`
+}
+
+// ResourceTypeActionHandler describes how a custom action is handled.
+type ResourceTypeActionHandler struct {
+	// Kind is the kind of handler. Must be one of 'sensitiveFields', 'recipe', or 'webhook'.
+	Kind string `yaml:"kind" validate:"required,oneof=sensitiveFields recipe webhook"`
+
+	// RecipeName is the name of the recipe to execute. Required when kind is 'recipe'.
+	RecipeName *string `yaml:"recipeName,omitempty" validate:"required_if=Kind recipe"`
+
+	// URL is the address of the webhook to call. Required when kind is 'webhook'.
+	URL *string `yaml:"url,omitempty" validate:"required_if=Kind webhook,omitempty,url"`
+}
+
+// ResourceTypeConversion describes how to convert a resource from an older API version of a resource type.
+type ResourceTypeConversion struct {
+	// From is the API version to convert from.
+	From string `yaml:"from" validate:"required,apiVersion"`
+
+	// Rules is the ordered list of rules applied to the resource properties during the conversion.
+	Rules []*ResourceTypeConversionRule `yaml:"rules,omitempty" validate:"dive,required"`
+}
+
+// ResourceTypeConversionRule describes a single change to the resource properties between two API versions.
+// Paths are dot-separated property names relative to the resource properties.
+type ResourceTypeConversionRule struct {
+	// Kind is the kind of rule. Must be one of 'rename', 'move', or 'default'.
+	Kind string `yaml:"kind" validate:"required,oneof=rename move default"`
+
+	// From is the path of the property in the older API version. Required when kind is 'rename' or 'move'.
+	From *string `yaml:"from,omitempty" validate:"required_unless=Kind default"`
+
+	// To is the path of the property in this API version. Required when kind is 'rename' or 'move'.
+	To *string `yaml:"to,omitempty" validate:"required_unless=Kind default"`
+
+	// Path is the path of the property added by this API version. Required when kind is 'default'.
+	Path *string `yaml:"path,omitempty" validate:"required_if=Kind default"`
+
+	// Value is the value of the property added by this API version, used when kind is 'default'.
+	Value any `yaml:"value,omitempty"`
 }
@@ -18,6 +18,7 @@ package manifest
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -93,3 +94,132 @@ func TestReadFile_MissingRequiredFieldJSON(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestReadFile_Actions(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{},
+						Actions: map[string]*ResourceTypeAction{
+							"listSecrets": {
+								Description: new("Returns the sensitive fields of the resource."),
+								Handler: ResourceTypeActionHandler{
+									Kind: "sensitiveFields",
+								},
+							},
+							"rotate": {
+								Input: map[string]any{
+									"type": "object",
+									"properties": map[string]any{
+										"reason": map[string]any{
+											"type": "string",
+										},
+									},
+								},
+								Handler: ResourceTypeActionHandler{
+									Kind:       "recipe",
+									RecipeName: new("rotate"),
+								},
+							},
+							"notify": {
+								Handler: ResourceTypeActionHandler{
+									Kind: "webhook",
+									URL:  new("https://example.com/notify"),
+								},
+							},
+						},
+					},
+				},
+				Capabilities: []string{},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-actions.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadFile_InvalidActionHandler(t *testing.T) {
+	result, err := ReadFile("testdata/invalid-action-handler.yaml")
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestReadFile_Conversions(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{},
+					},
+					"2025-06-01-preview": {
+						Schema: map[string]any{},
+						Conversions: []*ResourceTypeConversion{
+							{
+								From: "2025-01-01-preview",
+								Rules: []*ResourceTypeConversionRule{
+									{Kind: "rename", From: new("size"), To: new("capacity")},
+									{Kind: "move", From: new("config.port"), To: new("port")},
+									{Kind: "default", Path: new("tier"), Value: "standard"},
+								},
+							},
+						},
+					},
+				},
+				Capabilities: []string{},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-conversions.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadFile_InvalidConversionRule(t *testing.T) {
+	result, err := ReadFile("testdata/invalid-conversion-rule.yaml")
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestReadFile_Lifecycle(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				Lifecycle: &ResourceTypeLifecycle{
+					State:      "deprecated",
+					SunsetDate: new(time.Date(2026, time.June, 30, 0, 0, 0, 0, time.UTC)),
+					Message:    new("Use MyCompany.Resources/newResources instead."),
+				},
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema:    map[string]any{},
+						Lifecycle: &ResourceTypeLifecycle{State: "retired"},
+					},
+					"2025-06-01-preview": {
+						Schema:    map[string]any{},
+						Lifecycle: &ResourceTypeLifecycle{State: "ga"},
+					},
+				},
+				Capabilities: []string{},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-lifecycle.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadFile_InvalidLifecycle(t *testing.T) {
+	result, err := ReadFile("testdata/invalid-lifecycle.yaml")
+	require.Error(t, err)
+	require.Nil(t, result)
+}
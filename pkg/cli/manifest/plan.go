@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// ResourceTypeChange is the change that registering a resource type of a manifest makes to the registered resource type.
+type ResourceTypeChange string
+
+const (
+	// ResourceTypeChangeNone means the resource type is registered with the same definition as the manifest.
+	ResourceTypeChangeNone ResourceTypeChange = "unchanged"
+
+	// ResourceTypeChangeCreate means the resource type is not registered.
+	ResourceTypeChangeCreate ResourceTypeChange = "create"
+
+	// ResourceTypeChangeUpdate means the resource type or one of its API versions is registered with a different
+	// definition than the manifest, or one of its API versions is not registered.
+	ResourceTypeChangeUpdate ResourceTypeChange = "update"
+)
+
+// PlanResourceProvider returns the change that registering the resource provider makes to each of its resource types,
+// keyed by type name. Nothing is registered.
+func PlanResourceProvider(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProvider ResourceProvider) (map[string]ResourceTypeChange, error) {
+	changes := map[string]ResourceTypeChange{}
+
+	_, err := clientFactory.NewResourceProvidersClient().Get(ctx, planeName, resourceProvider.Namespace, nil)
+	if clients.Is404Error(err) {
+		for typeName := range resourceProvider.Types {
+			changes[typeName] = ResourceTypeChangeCreate
+		}
+		return changes, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for typeName, resourceType := range resourceProvider.Types {
+		changes[typeName], err = planResourceType(ctx, clientFactory, planeName, resourceProvider.Namespace, typeName, resourceType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+// planResourceType compares a resource type and its API versions with the registered ones.
+func planResourceType(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, namespace string, typeName string, resourceType *ResourceType) (ResourceTypeChange, error) {
+	registeredType, err := clientFactory.NewResourceTypesClient().Get(ctx, planeName, namespace, typeName, nil)
+	if clients.Is404Error(err) {
+		return ResourceTypeChangeCreate, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	registeredProperties := &v20231001preview.ResourceTypeProperties{}
+	if registeredType.Properties != nil {
+		*registeredProperties = *registeredType.Properties
+		registeredProperties.ProvisioningState = nil
+	}
+
+	equal, err := equalJSON(toResourceTypeProperties(resourceType), registeredProperties)
+	if err != nil {
+		return "", err
+	} else if !equal {
+		return ResourceTypeChangeUpdate, nil
+	}
+
+	for apiVersionName, apiVersion := range resourceType.APIVersions {
+		registeredAPIVersion, err := clientFactory.NewAPIVersionsClient().Get(ctx, planeName, namespace, typeName, apiVersionName, nil)
+		if clients.Is404Error(err) {
+			return ResourceTypeChangeUpdate, nil
+		} else if err != nil {
+			return "", err
+		}
+
+		registeredProperties := &v20231001preview.APIVersionProperties{}
+		if registeredAPIVersion.Properties != nil {
+			*registeredProperties = *registeredAPIVersion.Properties
+			registeredProperties.ProvisioningState = nil
+		}
+
+		equal, err := equalJSON(toAPIVersionProperties(apiVersion), registeredProperties)
+		if err != nil {
+			return "", err
+		} else if !equal {
+			return ResourceTypeChangeUpdate, nil
+		}
+	}
+
+	return ResourceTypeChangeNone, nil
+}
+
+// equalJSON returns true if the JSON representations of the values are equal. Empty objects and arrays are equal to
+// missing values, since the API doesn't distinguish between them.
+func equalJSON(a any, b any) (bool, error) {
+	normalizedA, err := normalizeJSON(a)
+	if err != nil {
+		return false, err
+	}
+
+	normalizedB, err := normalizeJSON(b)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB), nil
+}
+
+func normalizeJSON(value any) (any, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized any
+	err = json.Unmarshal(b, &normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return removeEmpty(normalized), nil
+}
+
+// removeEmpty removes the null values, empty objects and empty arrays of a JSON value.
+func removeEmpty(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			item = removeEmpty(item)
+			if item != nil {
+				result[key] = item
+			}
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		result := make([]any, 0, len(v))
+		for _, item := range v {
+			result = append(result, removeEmpty(item))
+		}
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+)
+
+func TestPlanResourceProvider(t *testing.T) {
+	resourceProvider := ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				Description: to.Ptr("Test resources"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{
+							"properties": map[string]any{
+								"size": map[string]any{"type": "integer"},
+							},
+							"required": []any{"size"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// The registered schema is decoded from JSON, so numbers are float64 and arrays are []any.
+	registeredSchema := func() map[string]any {
+		return map[string]any{
+			"properties": map[string]any{
+				"size": map[string]any{"type": "integer"},
+			},
+			"required": []any{"size"},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		providerNotFound   bool
+		typeNotFound       bool
+		apiVersionNotFound bool
+		description        string
+		schema             map[string]any
+		expected           ResourceTypeChange
+	}{
+		{
+			name:             "resource provider not registered",
+			providerNotFound: true,
+			expected:         ResourceTypeChangeCreate,
+		},
+		{
+			name:         "resource type not registered",
+			typeNotFound: true,
+			expected:     ResourceTypeChangeCreate,
+		},
+		{
+			name:               "API version not registered",
+			description:        "Test resources",
+			apiVersionNotFound: true,
+			expected:           ResourceTypeChangeUpdate,
+		},
+		{
+			name:        "description changed",
+			description: "Old description",
+			schema:      registeredSchema(),
+			expected:    ResourceTypeChangeUpdate,
+		},
+		{
+			name:        "schema changed",
+			description: "Test resources",
+			schema: map[string]any{
+				"properties": map[string]any{
+					"size": map[string]any{"type": "string"},
+				},
+			},
+			expected: ResourceTypeChangeUpdate,
+		},
+		{
+			name:        "unchanged",
+			description: "Test resources",
+			schema:      registeredSchema(),
+			expected:    ResourceTypeChangeNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverFactory := ucpfake.ServerFactory{
+				ResourceProvidersServer: ucpfake.ResourceProvidersServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, options *v20231001preview.ResourceProvidersClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceProvidersClientGetResponse], errResp azfake.ErrorResponder) {
+						if tt.providerNotFound {
+							errResp.SetResponseError(http.StatusNotFound, "NotFound")
+							return
+						}
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceProvidersClientGetResponse{}, nil)
+						return
+					},
+				},
+				ResourceTypesServer: ucpfake.ResourceTypesServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+						if tt.typeNotFound {
+							errResp.SetResponseError(http.StatusNotFound, "NotFound")
+							return
+						}
+						resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+							ResourceTypeResource: v20231001preview.ResourceTypeResource{
+								Properties: &v20231001preview.ResourceTypeProperties{
+									Capabilities:      []*string{},
+									Description:       to.Ptr(tt.description),
+									ProvisioningState: to.Ptr(v20231001preview.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+				APIVersionsServer: ucpfake.APIVersionsServer{
+					Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+						if tt.apiVersionNotFound {
+							errResp.SetResponseError(http.StatusNotFound, "NotFound")
+							return
+						}
+						resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+							APIVersionResource: v20231001preview.APIVersionResource{
+								Properties: &v20231001preview.APIVersionProperties{
+									Schema:            tt.schema,
+									ProvisioningState: to.Ptr(v20231001preview.ProvisioningStateSucceeded),
+								},
+							},
+						}, nil)
+						return
+					},
+				},
+			}
+
+			clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+				ClientOptions: policy.ClientOptions{
+					Transport: ucpfake.NewServerFactoryTransport(&serverFactory),
+				},
+			})
+			require.NoError(t, err)
+
+			changes, err := PlanResourceProvider(context.Background(), clientFactory, "local", resourceProvider)
+			require.NoError(t, err)
+			require.Equal(t, map[string]ResourceTypeChange{"testResources": tt.expected}, changes)
+		})
+	}
+}
@@ -75,11 +75,7 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 		logIfEnabled(logger, "Creating resource type %s/%s", resourceProvider.Namespace, resourceTypeName)
 		err = retryOperation(ctx, func() error {
 			resourceTypePoller, err := clientFactory.NewResourceTypesClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, resourceTypeName, v20231001preview.ResourceTypeResource{
-				Properties: &v20231001preview.ResourceTypeProperties{
-					Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
-					DefaultAPIVersion: resourceType.DefaultAPIVersion,
-					Description:       resourceType.Description,
-				},
+				Properties: toResourceTypeProperties(resourceType),
 			}, nil)
 			if err != nil {
 				return err
@@ -100,12 +96,10 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 
 		for apiVersionName := range resourceType.APIVersions {
 			logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Namespace, resourceTypeName, apiVersionName)
-			schema := resourceType.APIVersions[apiVersionName].Schema.(map[string]any)
+			properties := toAPIVersionProperties(resourceType.APIVersions[apiVersionName])
 			err = retryOperation(ctx, func() error {
 				apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, resourceTypeName, apiVersionName, v20231001preview.APIVersionResource{
-					Properties: &v20231001preview.APIVersionProperties{
-						Schema: schema,
-					},
+					Properties: properties,
 				}, nil)
 				if err != nil {
 					return err
@@ -218,11 +212,21 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 		return err
 	}
 
-	locationName, address := extractLocationInfo(*resourceProvider)
+	if _, ok := resourceProvider.Types[typeName]; !ok {
+		return fmt.Errorf("type %s not found in manifest file %s", typeName, filePath)
+	}
+
+	return RegisterResourceType(ctx, clientFactory, planeName, *resourceProvider, typeName, logger)
+}
+
+// RegisterResourceType registers a single type of the resource provider, including its API versions, and adds it to
+// the existing location of the resource provider. The resource provider must already exist.
+func RegisterResourceType(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProvider ResourceProvider, typeName string, logger func(format string, args ...any)) error {
+	locationName, address := extractLocationInfo(resourceProvider)
 
 	resourceType, ok := resourceProvider.Types[typeName]
 	if !ok {
-		return fmt.Errorf("type %s not found in manifest file %s", typeName, filePath)
+		return fmt.Errorf("type %s not found in resource provider %s", typeName, resourceProvider.Namespace)
 	}
 
 	if len(resourceType.Capabilities) == 0 {
@@ -231,13 +235,9 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 		logIfEnabled(logger, "Creating resource type %s/%s with capabilities %s ", resourceProvider.Namespace, typeName, strings.Join(resourceType.Capabilities, ","))
 	}
 
-	err = retryOperation(ctx, func() error {
+	err := retryOperation(ctx, func() error {
 		resourceTypePoller, err := clientFactory.NewResourceTypesClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, typeName, v20231001preview.ResourceTypeResource{
-			Properties: &v20231001preview.ResourceTypeProperties{
-				Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
-				DefaultAPIVersion: resourceType.DefaultAPIVersion,
-				Description:       resourceType.Description,
-			},
+			Properties: toResourceTypeProperties(resourceType),
 		}, nil)
 		if err != nil {
 			return err
@@ -254,12 +254,10 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 	}
 
 	for apiVersionName := range resourceType.APIVersions {
-		schema := resourceType.APIVersions[apiVersionName].Schema.(map[string]any)
+		properties := toAPIVersionProperties(resourceType.APIVersions[apiVersionName])
 		logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Namespace, typeName, apiVersionName)
 		apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, typeName, apiVersionName, v20231001preview.APIVersionResource{
-			Properties: &v20231001preview.APIVersionProperties{
-				Schema: schema,
-			},
+			Properties: properties,
 		}, nil)
 		if err != nil {
 			return err
@@ -282,6 +280,9 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 		locationResource.Properties.Address = new(address)
 	}
 
+	if locationResource.Properties.ResourceTypes == nil {
+		locationResource.Properties.ResourceTypes = map[string]*v20231001preview.LocationResourceType{}
+	}
 	locationResource.Properties.ResourceTypes[typeName] = &v20231001preview.LocationResourceType{
 		APIVersions: map[string]map[string]any{},
 	}
@@ -365,6 +366,76 @@ func ValidateManifest(ctx context.Context, path string) (resourceProvider *Resou
 	return resourceProvider, nil
 }
 
+// toResourceTypeProperties converts a resource type in the manifest to the API model.
+func toResourceTypeProperties(resourceType *ResourceType) *v20231001preview.ResourceTypeProperties {
+	return &v20231001preview.ResourceTypeProperties{
+		Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
+		DefaultAPIVersion: resourceType.DefaultAPIVersion,
+		Description:       resourceType.Description,
+		Lifecycle:         toLifecycle(resourceType.Lifecycle),
+	}
+}
+
+// toLifecycle converts the lifecycle of a resource type or API version in the manifest to the API model.
+func toLifecycle(lifecycle *ResourceTypeLifecycle) *v20231001preview.ResourceTypeLifecycle {
+	if lifecycle == nil {
+		return nil
+	}
+
+	return &v20231001preview.ResourceTypeLifecycle{
+		State:      to.Ptr(lifecycle.State),
+		SunsetDate: lifecycle.SunsetDate,
+		Message:    lifecycle.Message,
+	}
+}
+
+// toAPIVersionProperties converts an API version from the manifest to the properties of an API version resource.
+func toAPIVersionProperties(apiVersion *ResourceTypeAPIVersion) *v20231001preview.APIVersionProperties {
+	properties := &v20231001preview.APIVersionProperties{
+		Schema:    apiVersion.Schema.(map[string]any),
+		Lifecycle: toLifecycle(apiVersion.Lifecycle),
+	}
+
+	for _, conversion := range apiVersion.Conversions {
+		rules := []*v20231001preview.APIVersionConversionRule{}
+		for _, rule := range conversion.Rules {
+			rules = append(rules, &v20231001preview.APIVersionConversionRule{
+				Kind:  to.Ptr(rule.Kind),
+				From:  rule.From,
+				To:    rule.To,
+				Path:  rule.Path,
+				Value: rule.Value,
+			})
+		}
+		properties.Conversions = append(properties.Conversions, &v20231001preview.APIVersionConversion{
+			From:  to.Ptr(conversion.From),
+			Rules: rules,
+		})
+	}
+
+	if len(apiVersion.Actions) == 0 {
+		return properties
+	}
+
+	properties.Actions = map[string]*v20231001preview.APIVersionAction{}
+	for name, action := range apiVersion.Actions {
+		input, _ := action.Input.(map[string]any)
+		output, _ := action.Output.(map[string]any)
+		properties.Actions[name] = &v20231001preview.APIVersionAction{
+			Description: action.Description,
+			Input:       input,
+			Output:      output,
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind:       to.Ptr(action.Handler.Kind),
+				RecipeName: action.Handler.RecipeName,
+				URL:        action.Handler.URL,
+			},
+		}
+	}
+
+	return properties
+}
+
 // extractLocationInfo extracts location name and address from resource provider
 func extractLocationInfo(resourceProvider ResourceProvider) (string, string) {
 	var locationName string
@@ -1440,3 +1440,70 @@ func verifyRetryBehavior(t *testing.T, operation func() error, expectedAttempts
 		require.Equal(t, expectedAttempts-1, len(retryLines), "expected retry log messages don't match attempts")
 	}
 }
+
+func TestToAPIVersionProperties(t *testing.T) {
+	t.Run("schema only", func(t *testing.T) {
+		properties := toAPIVersionProperties(&ResourceTypeAPIVersion{
+			Schema: map[string]any{"type": "object"},
+		})
+		require.Equal(t, &v20231001preview.APIVersionProperties{
+			Schema: map[string]any{"type": "object"},
+		}, properties)
+	})
+
+	t.Run("with actions", func(t *testing.T) {
+		properties := toAPIVersionProperties(&ResourceTypeAPIVersion{
+			Schema: map[string]any{},
+			Actions: map[string]*ResourceTypeAction{
+				"rotate": {
+					Description: new("Rotates credentials."),
+					Input:       map[string]any{"type": "object"},
+					Handler: ResourceTypeActionHandler{
+						Kind:       "recipe",
+						RecipeName: new("rotate"),
+					},
+				},
+			},
+		})
+		require.Equal(t, &v20231001preview.APIVersionProperties{
+			Schema: map[string]any{},
+			Actions: map[string]*v20231001preview.APIVersionAction{
+				"rotate": {
+					Description: new("Rotates credentials."),
+					Input:       map[string]any{"type": "object"},
+					Handler: &v20231001preview.APIVersionActionHandler{
+						Kind:       new("recipe"),
+						RecipeName: new("rotate"),
+					},
+				},
+			},
+		}, properties)
+	})
+
+	t.Run("with conversions", func(t *testing.T) {
+		properties := toAPIVersionProperties(&ResourceTypeAPIVersion{
+			Schema: map[string]any{},
+			Conversions: []*ResourceTypeConversion{
+				{
+					From: "2025-01-01-preview",
+					Rules: []*ResourceTypeConversionRule{
+						{Kind: "rename", From: new("size"), To: new("capacity")},
+						{Kind: "default", Path: new("tier"), Value: "standard"},
+					},
+				},
+			},
+		})
+		require.Equal(t, &v20231001preview.APIVersionProperties{
+			Schema: map[string]any{},
+			Conversions: []*v20231001preview.APIVersionConversion{
+				{
+					From: new("2025-01-01-preview"),
+					Rules: []*v20231001preview.APIVersionConversionRule{
+						{Kind: new("rename"), From: new("size"), To: new("capacity")},
+						{Kind: new("default"), Path: new("tier"), Value: "standard"},
+					},
+				},
+			},
+		}, properties)
+	})
+}
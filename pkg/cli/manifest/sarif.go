@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// lintRuleDescriptions describes each lint rule for SARIF consumers.
+var lintRuleDescriptions = map[string]string{
+	LintRuleManifest:      "The manifest must be readable and match the resource provider manifest format.",
+	LintRuleSchema:        "Resource type schemas must follow the Radius schema rules.",
+	LintRuleDescription:   "Resource types and properties should have a description.",
+	LintRulePropertyName:  "Property names should be camelCase.",
+	LintRuleCompatibility: "Changes to a manifest must be backward-compatible with its previous version.",
+}
+
+// SARIFLog is a Static Analysis Results Interchange Format (SARIF) log, used by CI systems to annotate changes
+// with lint diagnostics.
+type SARIFLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+// SARIFRun is a single run of a tool in a SARIF log.
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+// SARIFTool describes the tool that produced a SARIF run.
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+// SARIFDriver describes the tool component that produced a SARIF run and the rules it checks.
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+// SARIFRule describes a rule checked by a tool.
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+}
+
+// SARIFResult is a single problem reported by a tool.
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+// SARIFMessage is the text of a SARIF message.
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+// SARIFLocation is the location of a problem: the manifest file, and the resource type, API version and property.
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []SARIFLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// SARIFPhysicalLocation is the file a problem was found in.
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+// SARIFArtifactLocation is the URI of a file.
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SARIFLogicalLocation is a named location within a file.
+type SARIFLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// ToSARIF converts lint diagnostics for the manifest file at filePath to a SARIF log.
+func ToSARIF(diagnostics []LintDiagnostic, filePath string) *SARIFLog {
+	rules := []SARIFRule{}
+	for _, ruleID := range []string{LintRuleManifest, LintRuleSchema, LintRuleDescription, LintRulePropertyName, LintRuleCompatibility} {
+		rules = append(rules, SARIFRule{
+			ID:               ruleID,
+			ShortDescription: SARIFMessage{Text: lintRuleDescriptions[ruleID]},
+		})
+	}
+
+	results := []SARIFResult{}
+	for _, diagnostic := range diagnostics {
+		location := SARIFLocation{
+			PhysicalLocation: SARIFPhysicalLocation{
+				ArtifactLocation: SARIFArtifactLocation{URI: filePath},
+			},
+		}
+		if diagnostic.Location != "" && diagnostic.Location != filePath {
+			location.LogicalLocations = []SARIFLogicalLocation{{FullyQualifiedName: diagnostic.Location}}
+		}
+
+		results = append(results, SARIFResult{
+			RuleID:    diagnostic.RuleID,
+			Level:     string(diagnostic.Severity),
+			Message:   SARIFMessage{Text: diagnostic.Message},
+			Locations: []SARIFLocation{location},
+		})
+	}
+
+	return &SARIFLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []SARIFRun{
+			{
+				Tool: SARIFTool{
+					Driver: SARIFDriver{
+						Name:           "rad resource-type validate",
+						InformationURI: "https://docs.radapp.io",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
@@ -30,11 +30,13 @@ var (
 	resourceTypeRegex              = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 	apiVersionRegex                = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
 	capabilityRegex                = regexp.MustCompile(`^[A-Z][A-Za-z0-9]+$`)
+	actionNameRegex                = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 
 	resourceProviderNamespaceMessage = "{0} must be a valid resource provider namespace. A resource provider namespace must contain two PascalCased segments separated by a '.'. Example: MyCompany.Resources"
 	resourceTypeMessage              = "{0} must be a valid resource type. A resource type should be camelCased. Example: myResourceType"
 	apiVersionMessage                = "{0} must be a valid API version. An API version must be a date in YYYY-MM-DD format, and may optionally have the suffix '-preview'. Example: 2025-01-01"
 	capabilityMessage                = "{0} must be a valid capability. A capability should use PascalCase. Example: MyCapability"
+	actionNameMessage                = "{0} must be a valid action name. An action name should be camelCased. Example: listSecrets"
 )
 
 func resourceProviderNamespace(fl validator.FieldLevel) bool {
@@ -57,6 +59,11 @@ func validateCapability(fl validator.FieldLevel) bool {
 	return capabilityRegex.Match([]byte(str))
 }
 
+func validateActionName(fl validator.FieldLevel) bool {
+	str := fl.Field().String()
+	return actionNameRegex.Match([]byte(str))
+}
+
 // validateManifestSchemas validates schemas in a ResourceProvider
 func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) error {
 	if provider == nil {
@@ -90,6 +97,21 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 					}
 				}
 			}
+
+			// Action input and output schemas describe request and response bodies rather than
+			// resources, so they only need to be well-formed.
+			for actionName, action := range versionInfo.Actions {
+				actionPath := fmt.Sprintf("%s/%s@%s.actions.%s", provider.Namespace, resourceTypeName, apiVersion, actionName)
+				for field, actionSchema := range map[string]any{"input": action.Input, "output": action.Output} {
+					if actionSchema == nil {
+						continue
+					}
+
+					if _, err := schema.ConvertToOpenAPISchema(actionSchema); err != nil {
+						errors.Add(schema.NewSchemaError(actionPath+"."+field, fmt.Sprintf("failed to parse schema: %v", err)))
+					}
+				}
+			}
 		}
 	}
 
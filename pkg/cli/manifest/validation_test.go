@@ -282,6 +282,56 @@ func TestCapabilityValidation(t *testing.T) {
 	}
 }
 
+func TestActionNameValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		actionName string
+		valid      bool
+	}{
+		{
+			name:       "valid action name",
+			actionName: "listSecrets",
+			valid:      true,
+		},
+		{
+			name:       "invalid - starts with uppercase",
+			actionName: "ListSecrets",
+			valid:      false,
+		},
+		{
+			name:       "invalid - special characters",
+			actionName: "list-secrets",
+			valid:      false,
+		},
+		{
+			name:       "invalid - empty",
+			actionName: "",
+			valid:      false,
+		},
+	}
+
+	v := validator.New()
+	err := v.RegisterValidation("actionName", validateActionName)
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testStruct := struct {
+				Action string `validate:"actionName"`
+			}{
+				Action: tt.actionName,
+			}
+
+			err := v.Struct(testStruct)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateManifestSchemas(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,226 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcetypedocs generates reference documentation for resource types from their schemas.
+package resourcetypedocs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// Page is the reference documentation for a resource type.
+type Page struct {
+	// Name is the fully-qualified name of the resource type (e.g. "MyCompany.Resources/testResources").
+	Name string
+	// Namespace is the namespace of the resource provider.
+	Namespace string
+	// TypeName is the name of the resource type without the namespace (e.g. "testResources").
+	TypeName string
+	// Description of the resource type.
+	Description string
+	// APIVersions is the list of API versions of the resource type, newest first.
+	APIVersions []APIVersion
+	// Recipes is the list of recipes for the resource type from recipe packs.
+	Recipes []Recipe
+}
+
+// APIVersion is the reference documentation for an API version of a resource type.
+type APIVersion struct {
+	// Name is the API version (e.g. "2025-01-01-preview").
+	Name string
+	// Properties is the list of properties of the API version, including nested properties.
+	Properties []Property
+	// Example is an example Bicep snippet that declares a resource of the API version.
+	Example string
+}
+
+// Property is the reference documentation for a property of a resource type.
+type Property struct {
+	// Name is the path of the property (e.g. "database.host").
+	Name string
+	// Type is the type of the property (e.g. "string", "object").
+	Type string
+	// Description of the property.
+	Description string
+	// IsRequired indicates if the property is required.
+	IsRequired bool
+	// IsReadOnly indicates if the property is read-only.
+	IsReadOnly bool
+	// IsSensitive indicates if the property is sensitive and is redacted when read.
+	IsSensitive bool
+	// Enum is the list of allowed values of the property.
+	Enum []string
+}
+
+// Recipe is a recipe for a resource type from a recipe pack.
+type Recipe struct {
+	// RecipePack is the name of the recipe pack.
+	RecipePack string
+	// Kind is the kind of recipe (e.g. "bicep", "terraform").
+	Kind string
+	// Location is the location of the recipe template.
+	Location string
+}
+
+// NewPage creates the reference documentation for a resource type. Recipes are keyed by the fully-qualified
+// resource type name, as returned by RecipesForResourceTypes.
+func NewPage(resourceType common.ResourceType, recipes map[string][]Recipe) Page {
+	page := Page{
+		Name:        resourceType.Name,
+		Namespace:   resourceType.ResourceProviderNamespace,
+		TypeName:    strings.TrimPrefix(resourceType.Name, resourceType.ResourceProviderNamespace+"/"),
+		Description: resourceType.Description,
+		Recipes:     recipes[strings.ToLower(resourceType.Name)],
+	}
+
+	for name, properties := range resourceType.APIVersions {
+		apiVersion := APIVersion{Name: name}
+		if properties != nil && properties.Schema != nil {
+			sensitivePaths := schema.ExtractSensitiveFieldPaths(properties.Schema, "")
+			apiVersion.Properties = schemaProperties(properties.Schema, "", sensitivePaths)
+			apiVersion.Example = bicepExample(resourceType.Name, name, properties.Schema)
+		}
+		page.APIVersions = append(page.APIVersions, apiVersion)
+	}
+
+	slices.SortFunc(page.APIVersions, func(a APIVersion, b APIVersion) int {
+		return strings.Compare(b.Name, a.Name)
+	})
+
+	return page
+}
+
+// RecipesForResourceTypes returns the recipes in a list of recipe packs, keyed by the lower-case fully-qualified
+// name of the resource type they are for.
+func RecipesForResourceTypes(recipePacks []v20250801preview.RecipePackResource) map[string][]Recipe {
+	recipes := map[string][]Recipe{}
+	for _, recipePack := range recipePacks {
+		if recipePack.Properties == nil {
+			continue
+		}
+
+		recipePackName := ""
+		if recipePack.Name != nil {
+			recipePackName = *recipePack.Name
+		}
+
+		for resourceType, definition := range recipePack.Properties.Recipes {
+			if definition == nil {
+				continue
+			}
+
+			recipe := Recipe{RecipePack: recipePackName}
+			if definition.RecipeKind != nil {
+				recipe.Kind = string(*definition.RecipeKind)
+			}
+			if definition.RecipeLocation != nil {
+				recipe.Location = *definition.RecipeLocation
+			}
+
+			key := strings.ToLower(resourceType)
+			recipes[key] = append(recipes[key], recipe)
+		}
+	}
+
+	for _, list := range recipes {
+		slices.SortFunc(list, func(a Recipe, b Recipe) int {
+			return strings.Compare(a.RecipePack, b.RecipePack)
+		})
+	}
+
+	return recipes
+}
+
+// schemaProperties flattens the properties of a schema, and the properties nested in it, into a list sorted by path.
+func schemaProperties(schemaMap map[string]any, prefix string, sensitivePaths []string) []Property {
+	result := []Property{}
+
+	required := requiredProperties(schemaMap)
+	properties, _ := schemaMap["properties"].(map[string]any)
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		propertySchema, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		property := Property{
+			Name:        path,
+			Type:        propertyType(propertySchema),
+			IsRequired:  slices.Contains(required, name),
+			IsSensitive: slices.Contains(sensitivePaths, path),
+		}
+		property.Description, _ = propertySchema["description"].(string)
+		property.IsReadOnly, _ = propertySchema["readOnly"].(bool)
+		if enum, ok := propertySchema["enum"].([]any); ok {
+			for _, value := range enum {
+				property.Enum = append(property.Enum, fmt.Sprint(value))
+			}
+		}
+		result = append(result, property)
+
+		result = append(result, schemaProperties(propertySchema, path, sensitivePaths)...)
+		if items, ok := propertySchema["items"].(map[string]any); ok {
+			result = append(result, schemaProperties(items, path+"[*]", sensitivePaths)...)
+		}
+		if additionalProperties, ok := propertySchema["additionalProperties"].(map[string]any); ok {
+			result = append(result, schemaProperties(additionalProperties, path+".*", sensitivePaths)...)
+		}
+	}
+
+	return result
+}
+
+// propertyType returns the type of a property as displayed in the documentation.
+func propertyType(propertySchema map[string]any) string {
+	schemaType, _ := propertySchema["type"].(string)
+	if items, ok := propertySchema["items"].(map[string]any); ok && schemaType == "array" {
+		if itemType, _ := items["type"].(string); itemType != "" {
+			return itemType + "[]"
+		}
+	}
+
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		if variants, ok := propertySchema[keyword].([]any); ok && len(variants) > 0 {
+			if schemaType == "" {
+				return keyword
+			}
+			return fmt.Sprintf("%s (%s)", schemaType, keyword)
+		}
+	}
+
+	if schemaType == "" {
+		return "any"
+	}
+
+	return schemaType
+}
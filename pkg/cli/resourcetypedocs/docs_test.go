@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetypedocs
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/stretchr/testify/require"
+)
+
+func testResourceType() common.ResourceType {
+	return common.ResourceType{
+		Name:                      "MyCompany.Resources/testResources",
+		ResourceProviderNamespace: "MyCompany.Resources",
+		Description:               "A test resource type.",
+		APIVersions: map[string]*common.APIVersionProperties{
+			"2025-01-01-preview": {},
+			"2025-06-01-preview": {
+				Schema: map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"environment": map[string]any{
+							"type":        "string",
+							"description": "The resource ID of the environment.",
+						},
+						"size": map[string]any{
+							"type":        "string",
+							"description": "The size of the database.",
+							"enum":        []any{"S", "M", "L"},
+						},
+						"credentials": map[string]any{
+							"type":     "object",
+							"required": []any{"username"},
+							"properties": map[string]any{
+								"username": map[string]any{"type": "string"},
+								"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+							},
+						},
+						"host": map[string]any{
+							"type":     "string",
+							"readOnly": true,
+						},
+						"ports": map[string]any{
+							"type":  "array",
+							"items": map[string]any{"type": "integer"},
+						},
+					},
+					"required": []any{"environment", "size", "credentials"},
+				},
+			},
+		},
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	recipes := map[string][]Recipe{
+		"mycompany.resources/testresources": {{RecipePack: "default", Kind: "bicep", Location: "ghcr.io/recipes/test:latest"}},
+	}
+
+	page := NewPage(testResourceType(), recipes)
+
+	require.Equal(t, "MyCompany.Resources/testResources", page.Name)
+	require.Equal(t, "MyCompany.Resources", page.Namespace)
+	require.Equal(t, "testResources", page.TypeName)
+	require.Equal(t, "A test resource type.", page.Description)
+	require.Equal(t, recipes["mycompany.resources/testresources"], page.Recipes)
+
+	require.Len(t, page.APIVersions, 2)
+	require.Equal(t, "2025-06-01-preview", page.APIVersions[0].Name)
+	require.Equal(t, "2025-01-01-preview", page.APIVersions[1].Name)
+	require.Empty(t, page.APIVersions[1].Properties)
+
+	require.Equal(t, []Property{
+		{Name: "credentials", Type: "object", IsRequired: true},
+		{Name: "credentials.password", Type: "string", IsSensitive: true},
+		{Name: "credentials.username", Type: "string", IsRequired: true},
+		{Name: "environment", Type: "string", Description: "The resource ID of the environment.", IsRequired: true},
+		{Name: "host", Type: "string", IsReadOnly: true},
+		{Name: "ports", Type: "integer[]"},
+		{Name: "size", Type: "string", Description: "The size of the database.", IsRequired: true, Enum: []string{"S", "M", "L"}},
+	}, page.APIVersions[0].Properties)
+
+	expectedExample := `param environment string
+
+resource example 'MyCompany.Resources/testResources@2025-06-01-preview' = {
+  name: 'example'
+  properties: {
+    credentials: {
+      username: '<username>'
+    }
+    environment: environment
+    size: 'S'
+  }
+}
+`
+	require.Equal(t, expectedExample, page.APIVersions[0].Example)
+}
+
+func TestRecipesForResourceTypes(t *testing.T) {
+	recipePacks := []v20250801preview.RecipePackResource{
+		{
+			Name: new("zeta"),
+			Properties: &v20250801preview.RecipePackProperties{
+				Recipes: map[string]*v20250801preview.RecipeDefinition{
+					"MyCompany.Resources/testResources": {
+						RecipeKind:     new(v20250801preview.RecipeKindTerraform),
+						RecipeLocation: new("git::https://github.com/example/recipes//test"),
+					},
+				},
+			},
+		},
+		{
+			Name: new("alpha"),
+			Properties: &v20250801preview.RecipePackProperties{
+				Recipes: map[string]*v20250801preview.RecipeDefinition{
+					"MyCompany.Resources/testResources": {
+						RecipeKind:     new(v20250801preview.RecipeKindBicep),
+						RecipeLocation: new("ghcr.io/recipes/test:latest"),
+					},
+					"MyCompany.Resources/otherResources": nil,
+				},
+			},
+		},
+		{
+			Name: new("empty"),
+		},
+	}
+
+	recipes := RecipesForResourceTypes(recipePacks)
+	require.Equal(t, map[string][]Recipe{
+		"mycompany.resources/testresources": {
+			{RecipePack: "alpha", Kind: "bicep", Location: "ghcr.io/recipes/test:latest"},
+			{RecipePack: "zeta", Kind: "terraform", Location: "git::https://github.com/example/recipes//test"},
+		},
+	}, recipes)
+}
+
+func TestRender(t *testing.T) {
+	resourceType := testResourceType()
+	resourceType.Description = "A <test> resource | type."
+	page := NewPage(resourceType, map[string][]Recipe{
+		"mycompany.resources/testresources": {{RecipePack: "default", Kind: "bicep", Location: "ghcr.io/recipes/test:latest"}},
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		content, err := Render(page, FormatMarkdown)
+		require.NoError(t, err)
+
+		markdown := string(content)
+		require.Contains(t, markdown, "# MyCompany.Resources/testResources\n")
+		require.Contains(t, markdown, "- [2025-06-01-preview](#api-version-2025-06-01-preview)")
+		require.Contains(t, markdown, "| size | string | ✓ |  |  | S, M, L | The size of the database. |")
+		require.Contains(t, markdown, "| credentials.password | string |  |  | ✓ |  |  |")
+		require.Contains(t, markdown, "```bicep\nparam environment string\n")
+		require.Contains(t, markdown, "This API version has no properties.")
+		require.Contains(t, markdown, "| default | bicep | ghcr.io/recipes/test:latest |")
+	})
+
+	t.Run("html", func(t *testing.T) {
+		content, err := Render(page, FormatHTML)
+		require.NoError(t, err)
+
+		html := string(content)
+		require.Contains(t, html, "<h1>MyCompany.Resources/testResources</h1>")
+		require.Contains(t, html, "<p>A &lt;test&gt; resource | type.</p>")
+		require.Contains(t, html, `<h2 id="api-version-2025-06-01-preview">API version 2025-06-01-preview</h2>`)
+		require.Contains(t, html, "<tr><td><code>size</code></td><td>string</td><td>✓</td><td></td><td></td><td>S, M, L</td><td>The size of the database.</td></tr>")
+		require.Contains(t, html, "<tr><td>default</td><td>bicep</td><td>ghcr.io/recipes/test:latest</td></tr>")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := Render(page, "pdf")
+		require.Error(t, err)
+	})
+}
+
+func TestFileName(t *testing.T) {
+	page := Page{Namespace: "MyCompany.Resources", TypeName: "testResources"}
+	require.Equal(t, "mycompany.resources_testresources.md", FileName(page, FormatMarkdown))
+	require.Equal(t, "mycompany.resources_testresources.html", FileName(page, FormatHTML))
+}
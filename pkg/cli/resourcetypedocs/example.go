@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetypedocs
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// bicepParameters are the properties that are declared as parameters of the example rather than given a value.
+var bicepParameters = []string{"environment", "application"}
+
+// bicepExample returns a Bicep snippet that declares a resource of the given type and API version, setting each
+// required property that is not read-only.
+func bicepExample(resourceType string, apiVersion string, schemaMap map[string]any) string {
+	builder := strings.Builder{}
+
+	required := requiredProperties(schemaMap)
+	for _, name := range bicepParameters {
+		if slices.Contains(required, name) {
+			fmt.Fprintf(&builder, "param %s string\n", name)
+		}
+	}
+	if builder.Len() > 0 {
+		builder.WriteString("\n")
+	}
+
+	fmt.Fprintf(&builder, "resource example '%s@%s' = {\n", resourceType, apiVersion)
+	builder.WriteString("  name: 'example'\n")
+	builder.WriteString("  properties: ")
+	writeBicepObject(&builder, schemaMap, "  ", true)
+	builder.WriteString("\n}\n")
+
+	return builder.String()
+}
+
+// writeBicepObject writes an object literal with a value for each required property of the schema that is not
+// read-only.
+func writeBicepObject(builder *strings.Builder, schemaMap map[string]any, indent string, topLevel bool) {
+	properties, _ := schemaMap["properties"].(map[string]any)
+
+	names := []string{}
+	for _, name := range requiredProperties(schemaMap) {
+		propertySchema, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		if readOnly, _ := propertySchema["readOnly"].(bool); readOnly {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if len(names) == 0 {
+		builder.WriteString("{}")
+		return
+	}
+
+	builder.WriteString("{\n")
+	for _, name := range names {
+		fmt.Fprintf(builder, "%s  %s: ", indent, name)
+		if topLevel && slices.Contains(bicepParameters, name) {
+			builder.WriteString(name)
+		} else {
+			writeBicepValue(builder, name, properties[name].(map[string]any), indent+"  ")
+		}
+		builder.WriteString("\n")
+	}
+	builder.WriteString(indent + "}")
+}
+
+// writeBicepValue writes an example value for a property.
+func writeBicepValue(builder *strings.Builder, name string, propertySchema map[string]any, indent string) {
+	if value, ok := propertySchema["default"]; ok {
+		if s, ok := value.(string); ok {
+			fmt.Fprintf(builder, "'%s'", s)
+		} else {
+			fmt.Fprint(builder, value)
+		}
+		return
+	}
+
+	if enum, ok := propertySchema["enum"].([]any); ok && len(enum) > 0 {
+		fmt.Fprintf(builder, "'%v'", enum[0])
+		return
+	}
+
+	schemaType, _ := propertySchema["type"].(string)
+	switch schemaType {
+	case "integer", "number":
+		builder.WriteString("0")
+	case "boolean":
+		builder.WriteString("false")
+	case "array":
+		builder.WriteString("[]")
+	case "object":
+		writeBicepObject(builder, propertySchema, indent, false)
+	default:
+		fmt.Fprintf(builder, "'<%s>'", name)
+	}
+}
+
+// requiredProperties returns the names of the required properties of a schema.
+func requiredProperties(schemaMap map[string]any) []string {
+	result := []string{}
+	list, _ := schemaMap["required"].([]any)
+	for _, name := range list {
+		if s, ok := name.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
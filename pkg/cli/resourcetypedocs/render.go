@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcetypedocs
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const (
+	// FormatMarkdown is the format for Markdown reference pages.
+	FormatMarkdown = "markdown"
+	// FormatHTML is the format for HTML reference pages.
+	FormatHTML = "html"
+)
+
+// SupportedFormats returns the formats reference pages can be rendered in.
+func SupportedFormats() []string {
+	return []string{FormatMarkdown, FormatHTML}
+}
+
+// FileName returns the name of the file for the reference page of a resource type in the given format
+// (e.g. "mycompany.resources_testresources.md").
+func FileName(page Page, format string) string {
+	extension := ".md"
+	if format == FormatHTML {
+		extension = ".html"
+	}
+
+	return strings.ToLower(page.Namespace+"_"+page.TypeName) + extension
+}
+
+// Render renders the reference page of a resource type in the given format.
+func Render(page Page, format string) ([]byte, error) {
+	buffer := bytes.Buffer{}
+
+	var err error
+	switch format {
+	case FormatMarkdown:
+		err = markdownTemplate.Execute(&buffer, page)
+	case FormatHTML:
+		err = htmlTemplate.Execute(&buffer, page)
+	default:
+		return nil, fmt.Errorf("unsupported format %q, supported formats are: %s", format, strings.Join(SupportedFormats(), ", "))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+var templateFuncs = map[string]any{
+	"check": func(value bool) string {
+		if value {
+			return "✓"
+		}
+		return ""
+	},
+	"join": strings.Join,
+	// cell escapes a value for use in a Markdown table cell.
+	"cell": func(value string) string {
+		value = strings.ReplaceAll(value, "|", "\\|")
+		return strings.Join(strings.Fields(value), " ")
+	},
+}
+
+var markdownTemplate = texttemplate.Must(texttemplate.New("markdown").Funcs(templateFuncs).Parse(`# {{ .Name }}
+{{ if .Description }}
+{{ .Description }}
+{{ end }}
+## API versions
+{{ range .APIVersions }}
+- [{{ .Name }}](#api-version-{{ .Name }})
+{{- end }}
+{{ range .APIVersions }}
+## API version {{ .Name }}
+
+### Properties
+{{ if .Properties }}
+| Name | Type | Required | Read-only | Sensitive | Allowed values | Description |
+|------|------|----------|-----------|-----------|----------------|-------------|
+{{- range .Properties }}
+| {{ .Name }} | {{ .Type }} | {{ check .IsRequired }} | {{ check .IsReadOnly }} | {{ check .IsSensitive }} | {{ join .Enum ", " | cell }} | {{ cell .Description }} |
+{{- end }}
+{{ else }}
+This API version has no properties.
+{{ end }}
+### Example
+
+` + "```bicep" + `
+{{ .Example }}` + "```" + `
+{{ end }}
+## Recipes
+{{ if .Recipes }}
+| Recipe pack | Kind | Location |
+|-------------|------|----------|
+{{- range .Recipes }}
+| {{ cell .RecipePack }} | {{ cell .Kind }} | {{ cell .Location }} |
+{{- end }}
+{{ else }}
+No recipe packs contain a recipe for this resource type.
+{{ end -}}
+`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(templateFuncs).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{ .Name }}</title>
+</head>
+<body>
+<h1>{{ .Name }}</h1>
+{{- if .Description }}
+<p>{{ .Description }}</p>
+{{- end }}
+<h2>API versions</h2>
+<ul>
+{{- range .APIVersions }}
+<li><a href="#api-version-{{ .Name }}">{{ .Name }}</a></li>
+{{- end }}
+</ul>
+{{- range .APIVersions }}
+<h2 id="api-version-{{ .Name }}">API version {{ .Name }}</h2>
+<h3>Properties</h3>
+{{- if .Properties }}
+<table>
+<thead>
+<tr><th>Name</th><th>Type</th><th>Required</th><th>Read-only</th><th>Sensitive</th><th>Allowed values</th><th>Description</th></tr>
+</thead>
+<tbody>
+{{- range .Properties }}
+<tr><td><code>{{ .Name }}</code></td><td>{{ .Type }}</td><td>{{ check .IsRequired }}</td><td>{{ check .IsReadOnly }}</td><td>{{ check .IsSensitive }}</td><td>{{ join .Enum ", " }}</td><td>{{ .Description }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- else }}
+<p>This API version has no properties.</p>
+{{- end }}
+<h3>Example</h3>
+<pre><code class="language-bicep">{{ .Example }}</code></pre>
+{{- end }}
+<h2>Recipes</h2>
+{{- if .Recipes }}
+<table>
+<thead>
+<tr><th>Recipe pack</th><th>Kind</th><th>Location</th></tr>
+</thead>
+<tbody>
+{{- range .Recipes }}
+<tr><td>{{ .RecipePack }}</td><td>{{ .Kind }}</td><td>{{ .Location }}</td></tr>
+{{- end }}
+</tbody>
+</table>
+{{- else }}
+<p>No recipe packs contain a recipe for this resource type.</p>
+{{- end }}
+</body>
+</html>
+`))
@@ -75,23 +75,27 @@ func (ws Workspace) ConnectionConfig() (ConnectionConfig, error) {
 		return nil, fmt.Errorf("workspace field '$.connection.kind' must be a string")
 	}
 
+	var config ConnectionConfig
 	switch kind {
 	case KindKubernetes:
-		config := &KubernetesConnectionConfig{}
-		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
-		if err != nil {
-			return nil, err
-		}
-
-		err = decoder.Decode(ws.Connection)
-		if err != nil {
-			return nil, err
-		}
-
-		return config, nil
+		config = &KubernetesConnectionConfig{}
+	case KindHTTP:
+		config = &HTTPConnectionConfig{}
 	default:
 		return nil, fmt.Errorf("unsupported connection kind '%s'", kind)
 	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{ErrorUnused: true, Result: config})
+	if err != nil {
+		return nil, err
+	}
+
+	err = decoder.Decode(ws.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
 }
 
 // Connect attempts to create and test a connection to the workspace using the connection configuration and returns the
@@ -117,8 +121,8 @@ func (ws Workspace) Connect(ctx context.Context) (sdk.Connection, error) {
 	return connectionConfig.Connect()
 }
 
-// ConnectionConfigEquals() checks if the given ConnectionConfig is of type Kubernetes and if the Kubernetes
-// context is the same as the one stored in the Workspace, and returns a boolean value accordingly.
+// ConnectionConfigEquals() checks if the given ConnectionConfig points at the same target as the one stored in the
+// Workspace: the same Kubernetes context for Kubernetes connections, or the same endpoint for HTTP connections.
 func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 	switch other.GetKind() {
 	case KindKubernetes:
@@ -128,6 +132,14 @@ func (ws Workspace) ConnectionConfigEquals(other ConnectionConfig) bool {
 		}
 
 		return ws.Connection["kind"] == KindKubernetes && ws.IsSameKubernetesContext(kc.Context)
+	case KindHTTP:
+		hc, ok := other.(*HTTPConnectionConfig)
+		if !ok {
+			return false
+		}
+
+		endpoint, _ := ws.Connection["endpoint"].(string)
+		return ws.Connection["kind"] == KindHTTP && strings.TrimSuffix(endpoint, "/") == strings.TrimSuffix(hc.Endpoint, "/")
 	default:
 		return false
 	}
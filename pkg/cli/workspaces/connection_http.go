@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/sdk"
+)
+
+const (
+	// KindHTTP is the connection kind for a Radius control plane reached directly through its HTTP(S) endpoint.
+	KindHTTP string = "http"
+
+	// AuthKindNone disables authentication for an HTTP connection.
+	AuthKindNone string = "none"
+
+	// AuthKindBearer authenticates an HTTP connection with a static bearer token.
+	AuthKindBearer string = "bearer"
+
+	// AuthKindClientCertificate authenticates an HTTP connection with a TLS client certificate.
+	AuthKindClientCertificate string = "clientCertificate"
+
+	// AuthKindOIDC authenticates an HTTP connection with a token obtained through the OIDC device code flow.
+	AuthKindOIDC string = "oidc"
+
+	// ucpAPIPath is the path of the UCP API relative to the root of the control plane endpoint.
+	ucpAPIPath = "/apis/api.ucp.dev/v1alpha3"
+)
+
+var _ ConnectionConfig = (*HTTPConnectionConfig)(nil)
+
+type HTTPConnectionConfig struct {
+	// Kind specifies the kind of connection. For HTTPConnectionConfig this is always 'http'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind"`
+
+	// Endpoint is the URL of the Radius control plane, for example 'https://radius.example.com'.
+	Endpoint string `json:"endpoint" mapstructure:"endpoint" yaml:"endpoint"`
+
+	// TLS describes how the server certificate is verified. This field is optional.
+	TLS HTTPConnectionTLS `json:"tls" mapstructure:"tls" yaml:"tls,omitempty"`
+
+	// Auth describes how requests are authenticated. This field is optional.
+	Auth HTTPConnectionAuth `json:"auth" mapstructure:"auth" yaml:"auth,omitempty"`
+
+	// Proxy describes the proxy used to reach the endpoint. This field is optional.
+	Proxy HTTPConnectionProxy `json:"proxy" mapstructure:"proxy" yaml:"proxy,omitempty"`
+}
+
+type HTTPConnectionTLS struct {
+	// CACertificate is the path of a PEM file with the certificate authorities trusted for the endpoint. When
+	// unset the system certificate pool is used.
+	CACertificate string `json:"caCertificate" mapstructure:"caCertificate" yaml:"caCertificate,omitempty"`
+}
+
+type HTTPConnectionAuth struct {
+	// Kind is the kind of authentication: 'none', 'bearer', 'clientCertificate' or 'oidc'. Defaults to 'none'.
+	Kind string `json:"kind" mapstructure:"kind" yaml:"kind,omitempty"`
+
+	// TokenFile is the path of a file containing the bearer token. Used with the 'bearer' kind.
+	TokenFile string `json:"tokenFile" mapstructure:"tokenFile" yaml:"tokenFile,omitempty"`
+
+	// TokenEnvVar is the name of an environment variable containing the bearer token. Used with the 'bearer' kind.
+	TokenEnvVar string `json:"tokenEnvVar" mapstructure:"tokenEnvVar" yaml:"tokenEnvVar,omitempty"`
+
+	// ClientCertificate is the path of a PEM encoded client certificate. Used with the 'clientCertificate' kind.
+	ClientCertificate string `json:"clientCertificate" mapstructure:"clientCertificate" yaml:"clientCertificate,omitempty"`
+
+	// ClientKey is the path of the PEM encoded private key of the client certificate. Used with the
+	// 'clientCertificate' kind.
+	ClientKey string `json:"clientKey" mapstructure:"clientKey" yaml:"clientKey,omitempty"`
+
+	// OIDC describes the identity provider used with the 'oidc' kind.
+	OIDC HTTPConnectionOIDC `json:"oidc" mapstructure:"oidc" yaml:"oidc,omitempty"`
+}
+
+type HTTPConnectionOIDC struct {
+	// Issuer is the URL of the OIDC issuer. The device authorization and token endpoints are discovered from it.
+	Issuer string `json:"issuer" mapstructure:"issuer" yaml:"issuer,omitempty"`
+
+	// ClientID is the client ID registered with the identity provider.
+	ClientID string `json:"clientId" mapstructure:"clientId" yaml:"clientId,omitempty"`
+
+	// Scopes are the scopes requested in addition to 'openid'. This field is optional.
+	Scopes []string `json:"scopes" mapstructure:"scopes" yaml:"scopes,omitempty"`
+}
+
+type HTTPConnectionProxy struct {
+	// URL is the URL of the proxy. When unset the proxy is read from the HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+	// environment variables.
+	URL string `json:"url" mapstructure:"url" yaml:"url,omitempty"`
+}
+
+// String() returns a string that describes the HTTP connection configuration.
+func (c *HTTPConnectionConfig) String() string {
+	return fmt.Sprintf("HTTP (endpoint=%s, auth=%s)", c.Endpoint, c.authKind())
+}
+
+// GetKind() returns the string "KindHTTP" for a HTTPConnectionConfig object.
+func (c *HTTPConnectionConfig) GetKind() string {
+	return KindHTTP
+}
+
+// Connect() builds a connection to the UCP API of the endpoint using the configured TLS, authentication and proxy
+// settings. Credentials are read lazily so that tokens are refreshed as needed. An error is returned if the
+// configuration is invalid or referenced files cannot be read.
+func (c *HTTPConnectionConfig) Connect() (sdk.Connection, error) {
+	endpoint := strings.TrimSuffix(c.Endpoint, "/")
+	if _, err := url.ParseRequestURI(endpoint); err != nil {
+		return nil, fmt.Errorf("workspace field '$.connection.endpoint' must be an absolute URL: %w", err)
+	}
+
+	if !strings.HasSuffix(endpoint, ucpAPIPath) {
+		endpoint = endpoint + ucpAPIPath
+	}
+
+	options := sdk.HTTPConnectionOptions{}
+	if c.TLS.CACertificate != "" {
+		b, err := os.ReadFile(c.TLS.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("the CA certificate %q does not contain any PEM encoded certificates", c.TLS.CACertificate)
+		}
+		options.RootCAs = pool
+	}
+
+	if c.Proxy.URL != "" {
+		proxy, err := url.Parse(c.Proxy.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy URL %q: %w", c.Proxy.URL, err)
+		}
+		options.Proxy = http.ProxyURL(proxy)
+	}
+
+	switch c.authKind() {
+	case AuthKindNone:
+	case AuthKindBearer:
+		token, err := c.bearerToken()
+		if err != nil {
+			return nil, err
+		}
+		options.Token = token
+	case AuthKindClientCertificate:
+		if c.Auth.ClientCertificate == "" || c.Auth.ClientKey == "" {
+			return nil, errors.New("client certificate authentication requires '$.connection.auth.clientCertificate' and '$.connection.auth.clientKey'")
+		}
+
+		certificate, err := tls.LoadX509KeyPair(c.Auth.ClientCertificate, c.Auth.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		options.Certificates = []tls.Certificate{certificate}
+	case AuthKindOIDC:
+		source, err := newOIDCTokenSource(c.Auth.OIDC, options.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		options.Token = source.Token
+	default:
+		return nil, fmt.Errorf("unsupported authentication kind '%s'", c.Auth.Kind)
+	}
+
+	return sdk.NewHTTPConnection(endpoint, options)
+}
+
+func (c *HTTPConnectionConfig) authKind() string {
+	if c.Auth.Kind == "" {
+		return AuthKindNone
+	}
+
+	return c.Auth.Kind
+}
+
+// bearerToken returns a function that reads the bearer token for each request, so that rotated tokens are picked up.
+func (c *HTTPConnectionConfig) bearerToken() (func(ctx context.Context) (string, error), error) {
+	switch {
+	case c.Auth.TokenFile != "" && c.Auth.TokenEnvVar != "":
+		return nil, errors.New("bearer authentication accepts only one of '$.connection.auth.tokenFile' and '$.connection.auth.tokenEnvVar'")
+	case c.Auth.TokenFile != "":
+		return func(ctx context.Context) (string, error) {
+			b, err := os.ReadFile(c.Auth.TokenFile)
+			if err != nil {
+				return "", err
+			}
+
+			token := strings.TrimSpace(string(b))
+			if token == "" {
+				return "", fmt.Errorf("the token file %q is empty", c.Auth.TokenFile)
+			}
+
+			return token, nil
+		}, nil
+	case c.Auth.TokenEnvVar != "":
+		return func(ctx context.Context) (string, error) {
+			token := strings.TrimSpace(os.Getenv(c.Auth.TokenEnvVar))
+			if token == "" {
+				return "", fmt.Errorf("the environment variable %q is not set", c.Auth.TokenEnvVar)
+			}
+
+			return token, nil
+		}, nil
+	default:
+		return nil, errors.New("bearer authentication requires '$.connection.auth.tokenFile' or '$.connection.auth.tokenEnvVar'")
+	}
+}
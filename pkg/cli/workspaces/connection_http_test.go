@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTPConnectionConfig(t *testing.T) {
+	ws := Workspace{
+		Connection: map[string]any{
+			"kind":     KindHTTP,
+			"endpoint": "https://radius.example.com/",
+			"auth": map[string]any{
+				"kind":        AuthKindBearer,
+				"tokenEnvVar": "RADIUS_TEST_TOKEN",
+			},
+			"proxy": map[string]any{
+				"url": "http://proxy.example.com:3128",
+			},
+		},
+	}
+
+	config, err := ws.ConnectionConfig()
+	require.NoError(t, err)
+	require.Equal(t, &HTTPConnectionConfig{
+		Kind:     KindHTTP,
+		Endpoint: "https://radius.example.com/",
+		Auth:     HTTPConnectionAuth{Kind: AuthKindBearer, TokenEnvVar: "RADIUS_TEST_TOKEN"},
+		Proxy:    HTTPConnectionProxy{URL: "http://proxy.example.com:3128"},
+	}, config)
+	require.Equal(t, "HTTP (endpoint=https://radius.example.com/, auth=bearer)", ws.FmtConnection())
+
+	connection, err := config.Connect()
+	require.NoError(t, err)
+	require.Equal(t, "https://radius.example.com/apis/api.ucp.dev/v1alpha3", connection.Endpoint())
+
+	require.True(t, ws.ConnectionConfigEquals(&HTTPConnectionConfig{Endpoint: "https://radius.example.com"}))
+	require.False(t, ws.ConnectionConfigEquals(&HTTPConnectionConfig{Endpoint: "https://other.example.com"}))
+	require.False(t, ws.ConnectionConfigEquals(&KubernetesConnectionConfig{Context: ""}))
+}
+
+func Test_HTTPConnectionConfig_UnknownField(t *testing.T) {
+	ws := Workspace{
+		Connection: map[string]any{
+			"kind":     KindHTTP,
+			"endpoint": "https://radius.example.com",
+			"context":  "kind-kind",
+		},
+	}
+
+	_, err := ws.ConnectionConfig()
+	require.ErrorContains(t, err, "context")
+}
+
+func Test_HTTPConnectionConfig_Connect_Invalid(t *testing.T) {
+	directory := t.TempDir()
+	invalidPEM := filepath.Join(directory, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidPEM, []byte("not a certificate"), 0600))
+
+	tests := []struct {
+		name   string
+		config HTTPConnectionConfig
+		err    string
+	}{
+		{
+			name:   "relative endpoint",
+			config: HTTPConnectionConfig{Endpoint: "radius.example.com"},
+			err:    "must be an absolute URL",
+		},
+		{
+			name: "missing CA certificate",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				TLS:      HTTPConnectionTLS{CACertificate: filepath.Join(directory, "missing.pem")},
+			},
+			err: "failed to read CA certificate",
+		},
+		{
+			name: "invalid CA certificate",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				TLS:      HTTPConnectionTLS{CACertificate: invalidPEM},
+			},
+			err: "does not contain any PEM encoded certificates",
+		},
+		{
+			name: "bearer without token",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: AuthKindBearer},
+			},
+			err: "bearer authentication requires",
+		},
+		{
+			name: "bearer over http",
+			config: HTTPConnectionConfig{
+				Endpoint: "http://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: AuthKindBearer, TokenFile: "token"},
+			},
+			err: "bearer token authentication requires the https scheme",
+		},
+		{
+			name: "client certificate without key",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: AuthKindClientCertificate, ClientCertificate: invalidPEM},
+			},
+			err: "client certificate authentication requires",
+		},
+		{
+			name: "invalid client certificate",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: AuthKindClientCertificate, ClientCertificate: invalidPEM, ClientKey: invalidPEM},
+			},
+			err: "failed to load client certificate",
+		},
+		{
+			name: "oidc without issuer",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: AuthKindOIDC, OIDC: HTTPConnectionOIDC{ClientID: "rad"}},
+			},
+			err: "OIDC authentication requires",
+		},
+		{
+			name: "unsupported auth kind",
+			config: HTTPConnectionConfig{
+				Endpoint: "https://radius.example.com",
+				Auth:     HTTPConnectionAuth{Kind: "kerberos"},
+			},
+			err: "unsupported authentication kind 'kerberos'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.config.Connect()
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func Test_HTTPConnectionConfig_BearerTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	config := HTTPConnectionConfig{
+		Endpoint: "https://radius.example.com",
+		Auth:     HTTPConnectionAuth{Kind: AuthKindBearer, TokenFile: tokenFile},
+	}
+
+	token, err := config.bearerToken()
+	require.NoError(t, err)
+
+	_, err = token(context.Background())
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-token\n"), 0600))
+	value, err := token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "my-token", value)
+
+	// Rotated tokens are picked up without reconnecting.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-new-token"), 0600))
+	value, err = token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "my-new-token", value)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// oidcDiscovery is the subset of the OpenID provider metadata needed for the device code flow.
+type oidcDiscovery struct {
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// oidcTokenSource provides tokens obtained through the OAuth 2.0 device authorization grant. Tokens are cached on
+// disk so that users only sign in again once the refresh token is no longer accepted.
+type oidcTokenSource struct {
+	config    HTTPConnectionOIDC
+	client    *http.Client
+	cacheFile string
+	output    io.Writer
+
+	mutex     sync.Mutex
+	source    oauth2.TokenSource
+	fromCache bool
+	saved     string
+}
+
+func newOIDCTokenSource(config HTTPConnectionOIDC, proxy func(*http.Request) (*url.URL, error)) (*oidcTokenSource, error) {
+	if config.Issuer == "" || config.ClientID == "" {
+		return nil, errors.New("OIDC authentication requires '$.connection.auth.oidc.issuer' and '$.connection.auth.oidc.clientId'")
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxy != nil {
+		transport.Proxy = proxy
+	}
+
+	key := sha256.Sum256([]byte(config.Issuer + "\n" + config.ClientID + "\n" + strings.Join(config.Scopes, " ")))
+	return &oidcTokenSource{
+		config:    config,
+		client:    &http.Client{Transport: transport},
+		cacheFile: filepath.Join(home, ".rad", "cache", "oidc", hex.EncodeToString(key[:])+".json"),
+		output:    os.Stderr,
+	}, nil
+}
+
+// Token returns a valid access token, signing in with the device code flow when no usable token is cached.
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.source == nil {
+		err := s.initialize(ctx, true)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	token, err := s.source.Token()
+	if err != nil && s.fromCache {
+		// The cached refresh token was rejected, most likely because it expired or was revoked. Sign in again.
+		_ = os.Remove(s.cacheFile)
+		err = s.initialize(ctx, false)
+		if err != nil {
+			return "", err
+		}
+
+		token, err = s.source.Token()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get an OIDC token: %w", err)
+	}
+
+	if token.AccessToken != s.saved {
+		err = s.writeCache(token)
+		if err != nil {
+			return "", err
+		}
+		s.saved = token.AccessToken
+	}
+
+	return token.AccessToken, nil
+}
+
+func (s *oidcTokenSource) initialize(ctx context.Context, useCache bool) error {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, s.client)
+	config, err := s.discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	var token *oauth2.Token
+	if useCache {
+		token = s.readCache()
+	}
+
+	s.fromCache = token != nil && (token.Valid() || token.RefreshToken != "")
+	if s.fromCache {
+		s.saved = token.AccessToken
+	} else {
+		response, err := config.DeviceAuth(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to start the OIDC device code flow: %w", err)
+		}
+
+		verificationURI := response.VerificationURI
+		if response.VerificationURIComplete != "" {
+			verificationURI = response.VerificationURIComplete
+		}
+		fmt.Fprintf(s.output, "To sign in to %s, open %s and enter the code %s\n", s.config.Issuer, verificationURI, response.UserCode)
+
+		token, err = config.DeviceAccessToken(ctx, response)
+		if err != nil {
+			return fmt.Errorf("failed to complete the OIDC device code flow: %w", err)
+		}
+	}
+
+	// The token source refreshes tokens outside of any single request, so it must not capture the request context.
+	s.source = config.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, s.client), token)
+	return nil
+}
+
+func (s *oidcTokenSource) discover(ctx context.Context) (*oauth2.Config, error) {
+	discoveryURL := strings.TrimSuffix(s.config.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the OIDC configuration of %q: %w", s.config.Issuer, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to read the OIDC configuration of %q: unexpected status code %d", s.config.Issuer, response.StatusCode)
+	}
+
+	discovery := oidcDiscovery{}
+	err = json.NewDecoder(response.Body).Decode(&discovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the OIDC configuration of %q: %w", s.config.Issuer, err)
+	}
+
+	if discovery.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("the OIDC issuer %q does not support the device code flow", s.config.Issuer)
+	}
+
+	return &oauth2.Config{
+		ClientID: s.config.ClientID,
+		Scopes:   append([]string{"openid"}, s.config.Scopes...),
+		Endpoint: oauth2.Endpoint{
+			TokenURL:      discovery.TokenEndpoint,
+			DeviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+		},
+	}, nil
+}
+
+// readCache returns the cached token, or nil if there is no readable cached token.
+func (s *oidcTokenSource) readCache() *oauth2.Token {
+	b, err := os.ReadFile(s.cacheFile)
+	if err != nil {
+		return nil
+	}
+
+	token := &oauth2.Token{}
+	err = json.Unmarshal(b, token)
+	if err != nil {
+		return nil
+	}
+
+	return token
+}
+
+func (s *oidcTokenSource) writeCache(token *oauth2.Token) error {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.cacheFile), 0700)
+	if err != nil {
+		return fmt.Errorf("failed to cache the OIDC token: %w", err)
+	}
+
+	err = os.WriteFile(s.cacheFile, b, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to cache the OIDC token: %w", err)
+	}
+
+	return nil
+}
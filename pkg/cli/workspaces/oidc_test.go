@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OIDCTokenSource(t *testing.T) {
+	deviceRequests := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"token_endpoint":                server.URL + "/token",
+			"device_authorization_endpoint": server.URL + "/device",
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		deviceRequests++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-code",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": server.URL + "/activate",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-token",
+			"token_type":    "Bearer",
+			"refresh_token": "refresh-token",
+			"expires_in":    3600,
+		})
+	})
+
+	cacheFile := filepath.Join(t.TempDir(), "oidc", "token.json")
+	newSource := func(output *bytes.Buffer) *oidcTokenSource {
+		return &oidcTokenSource{
+			config:    HTTPConnectionOIDC{Issuer: server.URL, ClientID: "rad"},
+			client:    server.Client(),
+			cacheFile: cacheFile,
+			output:    output,
+		}
+	}
+
+	output := &bytes.Buffer{}
+	token, err := newSource(output).Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token", token)
+	require.Equal(t, 1, deviceRequests)
+	require.Contains(t, output.String(), "enter the code ABCD-EFGH")
+	require.FileExists(t, cacheFile)
+
+	// A new source uses the cached token instead of signing in again.
+	output = &bytes.Buffer{}
+	token, err = newSource(output).Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "access-token", token)
+	require.Equal(t, 1, deviceRequests)
+	require.Empty(t, output.String())
+}
+
+func Test_OIDCTokenSource_NoDeviceFlow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"token_endpoint": "https://example.com/token"})
+	}))
+	defer server.Close()
+
+	source := &oidcTokenSource{
+		config:    HTTPConnectionOIDC{Issuer: server.URL, ClientID: "rad"},
+		client:    server.Client(),
+		cacheFile: filepath.Join(t.TempDir(), "token.json"),
+		output:    &bytes.Buffer{},
+	}
+
+	_, err := source.Token(context.Background())
+	require.ErrorContains(t, err, "does not support the device code flow")
+}
@@ -119,7 +119,8 @@ func (p *DatabaseProvider) initialize(ctx context.Context) result {
 			return p.result
 		}
 
-		factory = fn
+		// Only the known providers are traced, a factory overridden for testing returns its client as-is.
+		factory = withTracing(fn)
 	}
 
 	client, err := factory(ctx, p.options)
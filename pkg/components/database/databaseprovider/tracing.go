@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseprovider
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+// QueryResourceTypeAttrKey is the attribute key for the resource type of a database query.
+const QueryResourceTypeAttrKey = attribute.Key("radius.query_resource_type")
+
+var _ database.Client = (*tracingClient)(nil)
+
+// tracingClient is a database client that creates a client span for every database operation.
+type tracingClient struct {
+	inner    database.Client
+	provider DatabaseProviderType
+}
+
+// withTracing wraps the database clients created by the given factory with a tracingClient.
+func withTracing(factory databaseClientFactoryFunc) databaseClientFactoryFunc {
+	return func(ctx context.Context, options Options) (database.Client, error) {
+		client, err := factory(ctx, options)
+		if err != nil || client == nil {
+			return client, err
+		}
+
+		return &tracingClient{inner: client, provider: options.Provider}, nil
+	}
+}
+
+func (c *tracingClient) start(ctx context.Context, operation string, attrs ...attribute.KeyValue) (context.Context, func(err error)) {
+	attrs = append(attrs, semconv.DBSystemKey.String(string(c.provider)), semconv.DBOperation(operation))
+	ctx, span := trace.StartClientSpan(ctx, "database."+operation, trace.DatabaseTracerName, attrs...)
+	return ctx, func(err error) { trace.EndSpan(span, err) }
+}
+
+// Query implements database.Client.
+func (c *tracingClient) Query(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+	ctx, end := c.start(ctx, "query", trace.ResourceIDAttrKey.String(query.RootScope), QueryResourceTypeAttrKey.String(query.ResourceType))
+	result, err := c.inner.Query(ctx, query, options...)
+	end(err)
+	return result, err
+}
+
+// Get implements database.Client.
+func (c *tracingClient) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	ctx, end := c.start(ctx, "get", trace.ResourceIDAttrKey.String(id))
+	obj, err := c.inner.Get(ctx, id, options...)
+	end(err)
+	return obj, err
+}
+
+// Delete implements database.Client.
+func (c *tracingClient) Delete(ctx context.Context, id string, options ...database.DeleteOptions) error {
+	ctx, end := c.start(ctx, "delete", trace.ResourceIDAttrKey.String(id))
+	err := c.inner.Delete(ctx, id, options...)
+	end(err)
+	return err
+}
+
+// Save implements database.Client.
+func (c *tracingClient) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	attrs := []attribute.KeyValue{}
+	if obj != nil {
+		attrs = append(attrs, trace.ResourceIDAttrKey.String(obj.ID))
+	}
+
+	ctx, end := c.start(ctx, "save", attrs...)
+	err := c.inner.Save(ctx, obj, options...)
+	end(err)
+	return err
+}
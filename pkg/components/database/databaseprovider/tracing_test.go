@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func Test_TracingClient(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	provider := FromMemory()
+	client, err := provider.GetClient(context.Background())
+	require.NoError(t, err)
+	require.IsType(t, &tracingClient{}, client)
+
+	id := "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/applications/app"
+
+	t.Run("success", func(t *testing.T) {
+		err := client.Save(context.Background(), &database.Object{Metadata: database.Metadata{ID: id}, Data: map[string]any{}})
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		require.Equal(t, "database.save", span.Name())
+		require.Equal(t, oteltrace.SpanKindClient, span.SpanKind())
+		require.Equal(t, codes.Ok, span.Status().Code)
+		require.Contains(t, span.Attributes(), trace.ResourceIDAttrKey.String(id))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, err := client.Get(context.Background(), id+"-missing")
+		require.True(t, errors.Is(err, &database.ErrNotFound{}))
+
+		spans := recorder.Ended()
+		span := spans[len(spans)-1]
+		require.Equal(t, "database.get", span.Name())
+		require.Equal(t, codes.Error, span.Status().Code)
+		require.Contains(t, span.Attributes(), trace.ResourceIDAttrKey.String(id+"-missing"))
+	})
+}
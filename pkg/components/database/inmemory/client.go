@@ -250,6 +250,8 @@ func (c *Client) Save(ctx context.Context, obj *database.Object, options ...data
 	entry, ok := c.resources[strings.ToLower(converted.String())]
 	if !ok && config.ETag != "" {
 		return &database.ErrConcurrency{}
+	} else if ok && config.IfNotExists {
+		return &database.ErrConcurrency{}
 	} else if ok && config.ETag != "" && config.ETag != entry.obj.ETag {
 		return &database.ErrConcurrency{}
 	} else if !ok {
@@ -0,0 +1,20 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// lease implements per-resource leases on top of the database client. A lease gives a single holder the
+// exclusive right to mutate a resource until the lease expires, which allows several replicas of a worker
+// to process operations without coordinating through a leader. Leases rely only on the optimistic concurrency
+// support of database.Client, so they work with every database backend.
+package lease
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ResourceType is the resource type of the lease objects stored in the database.
+	ResourceType = "System.Resources/leases"
+)
+
+var (
+	// ErrLost is returned when a lease can no longer be renewed because it expired and was acquired by another holder.
+	ErrLost = errors.New("the lease was lost to another holder")
+)
+
+// ErrHeld is returned when a lease is held by another holder.
+type ErrHeld struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string
+
+	// Holder is the current holder of the lease.
+	Holder string
+}
+
+// Error returns the error message for ErrHeld error.
+func (e *ErrHeld) Error() string {
+	return fmt.Sprintf("the lease on resource %s is held by %s", e.ResourceID, e.Holder)
+}
+
+// Is checks if the target error is an instance of ErrHeld.
+func (e *ErrHeld) Is(target error) bool {
+	_, ok := target.(*ErrHeld)
+	return ok
+}
+
+// Lease represents a lease on a resource acquired by a Manager.
+type Lease struct {
+	// ID is the ID of the lease object in the database.
+	ID string
+
+	// ResourceID is the ID of the resource.
+	ResourceID string
+
+	// Holder is the holder of the lease.
+	Holder string
+
+	// ExpiresAt is the time at which the lease expires unless it is renewed.
+	ExpiresAt time.Time
+
+	// token identifies this acquisition so that two acquisitions by the same holder are not confused.
+	token string
+
+	// etag is the ETag of the lease object written by the last acquisition or renewal.
+	etag database.ETag
+}
+
+// leaseData is the payload of the lease object stored in the database.
+type leaseData struct {
+	ResourceID string    `json:"resourceId"`
+	Holder     string    `json:"holder"`
+	Token      string    `json:"token"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// Manager acquires, renews and releases leases on resources.
+type Manager struct {
+	client database.Client
+	holder string
+	now    func() time.Time
+}
+
+// NewManager creates a new Manager. holder identifies the owner of the leases acquired by this Manager, such as the
+// name of the replica.
+func NewManager(client database.Client, holder string) *Manager {
+	return &Manager{
+		client: client,
+		holder: holder,
+		now:    time.Now,
+	}
+}
+
+// Acquire acquires the lease on the resource for the given duration. It returns ErrHeld if the lease is held
+// by another acquisition that has not expired yet, including an acquisition by the same holder.
+func (m *Manager) Acquire(ctx context.Context, resourceID string, duration time.Duration) (*Lease, error) {
+	id, err := leaseID(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := leaseData{
+		ResourceID: resourceID,
+		Holder:     m.holder,
+		Token:      uuid.NewString(),
+		ExpiresAt:  m.now().Add(duration).UTC(),
+	}
+	obj := &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data:     data,
+	}
+
+	existing, err := m.client.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		err = m.client.Save(ctx, obj, database.WithIfNotExists())
+	} else if err != nil {
+		return nil, err
+	} else {
+		current := leaseData{}
+		if err := existing.As(&current); err != nil {
+			return nil, err
+		}
+
+		if m.now().Before(current.ExpiresAt) {
+			return nil, &ErrHeld{ResourceID: resourceID, Holder: current.Holder}
+		}
+
+		// The lease has expired, take it over if nobody else did in the meantime.
+		err = m.client.Save(ctx, obj, database.WithETag(existing.ETag))
+	}
+
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		return nil, &ErrHeld{ResourceID: resourceID}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &Lease{
+		ID:         id,
+		ResourceID: resourceID,
+		Holder:     m.holder,
+		ExpiresAt:  data.ExpiresAt,
+		token:      data.Token,
+		etag:       obj.ETag,
+	}, nil
+}
+
+// Renew extends the lease by the given duration from now. It returns ErrLost if the lease was taken over by
+// another holder after it expired.
+func (m *Manager) Renew(ctx context.Context, lease *Lease, duration time.Duration) error {
+	data := leaseData{
+		ResourceID: lease.ResourceID,
+		Holder:     lease.Holder,
+		Token:      lease.token,
+		ExpiresAt:  m.now().Add(duration).UTC(),
+	}
+	obj := &database.Object{
+		Metadata: database.Metadata{ID: lease.ID},
+		Data:     data,
+	}
+
+	err := m.client.Save(ctx, obj, database.WithETag(lease.etag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		return ErrLost
+	} else if err != nil {
+		return err
+	}
+
+	lease.ExpiresAt = data.ExpiresAt
+	lease.etag = obj.ETag
+	return nil
+}
+
+// Release releases the lease so that it can be acquired immediately. Releasing a lease that was lost is a no-op.
+func (m *Manager) Release(ctx context.Context, lease *Lease) error {
+	err := m.client.Delete(ctx, lease.ID, database.WithETag(lease.etag))
+	if errors.Is(err, &database.ErrConcurrency{}) || errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	}
+
+	return err
+}
+
+// leaseID returns the ID of the lease object for the resource. Lease objects are stored at the plane scope of the
+// resource and named after a hash of the resource ID, because resource IDs are case-insensitive and cannot be
+// used as a name.
+func leaseID(resourceID string) (string, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(id.String())))
+	return id.PlaneScope() + "/providers/" + ResourceType + "/" + hex.EncodeToString(hash[:16]), nil
+}
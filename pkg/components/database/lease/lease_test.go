@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Test/testResources/test"
+	testDuration   = time.Minute
+)
+
+func newTestManager(client *inmemory.Client, holder string, now *time.Time) *Manager {
+	m := NewManager(client, holder)
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func Test_Acquire(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	now := time.Now()
+
+	first := newTestManager(client, "replica-0", &now)
+	second := newTestManager(client, "replica-1", &now)
+
+	lease, err := first.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+	require.Equal(t, testResourceID, lease.ResourceID)
+	require.Equal(t, "replica-0", lease.Holder)
+	require.Equal(t, now.Add(testDuration).UTC(), lease.ExpiresAt)
+
+	obj, err := client.Get(ctx, lease.ID)
+	require.NoError(t, err)
+	require.Equal(t, lease.ID, obj.ID)
+
+	t.Run("held by another holder", func(t *testing.T) {
+		_, err := second.Acquire(ctx, testResourceID, testDuration)
+		require.ErrorIs(t, err, &ErrHeld{})
+		require.Equal(t, "replica-0", err.(*ErrHeld).Holder)
+	})
+
+	t.Run("held by the same holder", func(t *testing.T) {
+		_, err := first.Acquire(ctx, testResourceID, testDuration)
+		require.ErrorIs(t, err, &ErrHeld{})
+	})
+
+	t.Run("resource IDs are case-insensitive", func(t *testing.T) {
+		_, err := second.Acquire(ctx, "/planes/radius/local/resourcegroups/TEST-RG/providers/Applications.Test/testResources/TEST", testDuration)
+		require.ErrorIs(t, err, &ErrHeld{})
+	})
+
+	t.Run("other resources are independent", func(t *testing.T) {
+		_, err := second.Acquire(ctx, testResourceID+"2", testDuration)
+		require.NoError(t, err)
+	})
+}
+
+func Test_Acquire_Expired(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	now := time.Now()
+
+	first := newTestManager(client, "replica-0", &now)
+	second := newTestManager(client, "replica-1", &now)
+
+	lease, err := first.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+
+	now = now.Add(testDuration + time.Second)
+
+	taken, err := second.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+	require.Equal(t, "replica-1", taken.Holder)
+
+	// The first holder can no longer renew the lease, and releasing it must not release the new holder's lease.
+	err = first.Renew(ctx, lease, testDuration)
+	require.ErrorIs(t, err, ErrLost)
+
+	err = first.Release(ctx, lease)
+	require.NoError(t, err)
+
+	_, err = first.Acquire(ctx, testResourceID, testDuration)
+	require.ErrorIs(t, err, &ErrHeld{})
+}
+
+func Test_Renew(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	now := time.Now()
+
+	first := newTestManager(client, "replica-0", &now)
+	second := newTestManager(client, "replica-1", &now)
+
+	lease, err := first.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+
+	// Renew the lease before it expires and move past the original expiry.
+	now = now.Add(testDuration / 2)
+	err = first.Renew(ctx, lease, testDuration)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(testDuration).UTC(), lease.ExpiresAt)
+
+	now = now.Add(testDuration / 2).Add(time.Second)
+	_, err = second.Acquire(ctx, testResourceID, testDuration)
+	require.ErrorIs(t, err, &ErrHeld{})
+
+	// Renewing twice in a row must work as well.
+	err = first.Renew(ctx, lease, testDuration)
+	require.NoError(t, err)
+}
+
+func Test_Release(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+	now := time.Now()
+
+	first := newTestManager(client, "replica-0", &now)
+	second := newTestManager(client, "replica-1", &now)
+
+	lease, err := first.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+
+	err = first.Release(ctx, lease)
+	require.NoError(t, err)
+
+	// Releasing twice is a no-op.
+	err = first.Release(ctx, lease)
+	require.NoError(t, err)
+
+	_, err = second.Acquire(ctx, testResourceID, testDuration)
+	require.NoError(t, err)
+}
+
+func Test_Acquire_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	client := inmemory.NewClient()
+
+	managers := []*Manager{}
+	for _, holder := range []string{"replica-0", "replica-1", "replica-2", "replica-3"} {
+		managers = append(managers, NewManager(client, holder))
+	}
+
+	acquired := make(chan *Lease, len(managers))
+	wg := sync.WaitGroup{}
+	for _, m := range managers {
+		wg.Go(func() {
+			lease, err := m.Acquire(ctx, testResourceID, testDuration)
+			if err == nil {
+				acquired <- lease
+			} else {
+				require.ErrorIs(t, err, &ErrHeld{})
+			}
+		})
+	}
+	wg.Wait()
+	close(acquired)
+
+	require.Len(t, acquired, 1)
+}
+
+func Test_Acquire_InvalidResourceID(t *testing.T) {
+	_, err := NewManager(inmemory.NewClient(), "replica-0").Acquire(context.Background(), "invalid", testDuration)
+	require.Error(t, err)
+}
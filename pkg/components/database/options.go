@@ -72,6 +72,9 @@ type DatabaseOptions struct {
 
 	// ETag represents the entity tag for optimistic consistency control.
 	ETag ETag
+
+	// IfNotExists restricts Save() to creating a new object. Save() fails with ErrConcurrency if the object already exists.
+	IfNotExists bool
 }
 
 // Query Options
@@ -150,6 +153,16 @@ func WithETag(etag ETag) MutatingOptions {
 	}
 }
 
+// WithIfNotExists sets the IfNotExists field in the StoreConfig struct so that Save() only creates new objects.
+func WithIfNotExists() SaveOptions {
+	return &saveOptions{
+		fn: func(cfg DatabaseOptions) DatabaseOptions {
+			cfg.IfNotExists = true
+			return cfg
+		},
+	}
+}
+
 // NewQueryConfig applies a set of QueryOptions to a StoreConfig and returns the modified StoreConfig for Query().
 func NewQueryConfig(opts ...QueryOptions) DatabaseOptions {
 	cfg := DatabaseOptions{}
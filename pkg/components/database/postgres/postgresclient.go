@@ -344,7 +344,7 @@ WITH updated AS (
 	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
 	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	ON CONFLICT (id) 
-	DO UPDATE SET resource_data = $7
+	DO UPDATE SET resource_data = $7, etag = $6
 	RETURNING id
 )
 SELECT
@@ -364,12 +364,26 @@ END AS result;`
 		obj.Data,
 	}
 
-	if config.ETag != "" {
+	if config.IfNotExists {
+		// This query only performs inserts. An existing object is reported as a concurrency conflict.
+		sql = `
+WITH inserted AS (
+	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (id) DO NOTHING
+	RETURNING id
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM inserted) THEN 'Success'
+	ELSE 'ErrConcurrency'
+END AS result;`
+	} else if config.ETag != "" {
 		// This is the simpler query that only performs updates. It requires an etag.
 		// NOTE: we want to report ErrConcurrency for all failure cases here. This is what the tests do.
 		sql = `
 WITH updated AS (
-	UPDATE resources SET resource_data = $2
+	UPDATE resources SET resource_data = $2, etag = $4
 	WHERE id = $1 AND etag = $3
 	RETURNING id
 )
@@ -380,7 +394,7 @@ CASE
 	ELSE 'ErrConcurrency'
 END AS result;`
 
-		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag}
+		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag, obj.ETag}
 	}
 
 	result := ""
@@ -163,6 +163,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 		return queue.ErrUnsupportedContentType
 	}
 
+	cfg := queue.NewEnqueueConfig(options...)
 	now := time.Now()
 	id, err := c.generateID()
 	if err != nil {
@@ -174,7 +175,7 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 			Name:      id,
 			Namespace: c.opts.Namespace,
 			Labels: map[string]string{
-				LabelNextVisibleAt: int64toa(now.UnixNano()),
+				LabelNextVisibleAt: int64toa(now.Add(cfg.VisibleAfter).UnixNano()),
 				LabelQueueName:     c.opts.Name,
 			},
 		},
@@ -55,7 +55,8 @@ func (c *Client) Enqueue(ctx context.Context, msg *queue.Message, options ...que
 	if msg == nil || msg.Data == nil || len(msg.Data) == 0 {
 		return queue.ErrEmptyMessage
 	}
-	c.queue.Enqueue(msg)
+	cfg := queue.NewEnqueueConfig(options...)
+	c.queue.EnqueueAfter(msg, cfg.VisibleAfter)
 	return nil
 }
 
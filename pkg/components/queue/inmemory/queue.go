@@ -66,6 +66,11 @@ func (q *InmemQueue) DeleteAll() {
 }
 
 func (q *InmemQueue) Enqueue(msg *queue.Message) {
+	q.EnqueueAfter(msg, 0)
+}
+
+// EnqueueAfter enqueues the message and makes it visible once the given delay has passed.
+func (q *InmemQueue) EnqueueAfter(msg *queue.Message, delay time.Duration) {
 	q.updateQueue()
 
 	q.vMu.Lock()
@@ -76,6 +81,12 @@ func (q *InmemQueue) Enqueue(msg *queue.Message) {
 	msg.Metadata.EnqueueAt = time.Now().UTC()
 	msg.Metadata.ExpireAt = time.Now().UTC().Add(messageExpireDuration)
 
+	if delay > 0 {
+		msg.Metadata.NextVisibleAt = time.Now().Add(delay)
+		q.v.PushBack(&element{val: msg, visible: false})
+		return
+	}
+
 	q.v.PushBack(&element{val: msg, visible: true})
 }
 
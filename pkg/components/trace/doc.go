@@ -26,6 +26,9 @@ In addition, trace package introduces the below helpers:
 * StartCustomSpan(ctx, spanName, tracerName, attr, spanKind) starts a new span with the given names and attributes.
 * StartProducerSpan(ctx, spanName, tracerName) starts a new Producer span with the given names.
 * StartConsumerSpan(ctx, spanName, tracerName) starts a new Consumer span with the given names.
+* StartInternalSpan(ctx, spanName, tracerName, attrs...) starts a new Internal span for a step of an operation.
+* StartClientSpan(ctx, spanName, tracerName, attrs...) starts a new Client span for a call to a remote service.
+* EndSpan(span, err) sets the span status from the error and ends the span.
 
 
 # Examples
@@ -47,6 +50,14 @@ func functionName(ctx context.Context) {
 	...
 }
 
+Adding new client span for a call that can fail:
+
+func functionName(ctx context.Context) (err error) {
+	ctx, span := StartClientSpan(ctx, spanName, tracerName, ResourceIDAttrKey.String(id))
+	defer func() { EndSpan(span, err) }()
+	...
+}
+
 Adding new producer span:
 
 func functionName(ctx context.Context) {
@@ -32,10 +32,21 @@ const (
 	FrontendTracerName string = "radius-frontend-tracer"
 	// BackendTracerName represents the name of backend tracer name.
 	BackendTracerName string = "radius-backend-tracer"
+	// RecipeTracerName represents the name of the tracer for recipe executions.
+	RecipeTracerName string = "radius-recipe-tracer"
+	// ProxyTracerName represents the name of the tracer for the calls proxied to cloud providers.
+	ProxyTracerName string = "radius-proxy-tracer"
+	// DatabaseTracerName represents the name of the tracer for database operations.
+	DatabaseTracerName string = "radius-database-tracer"
 
 	traceparentHeaderKey string = "traceparent"
 )
 
+const (
+	// ResourceIDAttrKey is the attribute key for the ID of the resource being processed.
+	ResourceIDAttrKey = attribute.Key("radius.resource_id")
+)
+
 // StartProducerSpan creates a new span with SpanKindProducer for enqueuing async operations. It creates the span
 // with the given spanName and tracerName, and adds the given attributes.
 func StartProducerSpan(ctx context.Context, spanName string, tracerName string) (context.Context, trace.Span) {
@@ -66,6 +77,29 @@ func StartCustomSpan(ctx context.Context, spanName string, tracerName string, at
 	return ctx, span
 }
 
+// StartInternalSpan creates a new span with SpanKindInternal for a step of an operation, such as a recipe
+// execution or a Terraform command.
+func StartInternalSpan(ctx context.Context, spanName string, tracerName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return StartCustomSpan(ctx, spanName, tracerName, attrs, trace.WithSpanKind(trace.SpanKindInternal))
+}
+
+// StartClientSpan creates a new span with SpanKindClient for a call to a remote service, such as a cloud provider API
+// or the database.
+func StartClientSpan(ctx context.Context, spanName string, tracerName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return StartCustomSpan(ctx, spanName, tracerName, attrs, trace.WithSpanKind(trace.SpanKindClient))
+}
+
+// EndSpan sets the status of the span based on the error, records the error if it is not nil and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	} else {
+		span.SetStatus(otelcodes.Ok, "")
+	}
+	span.End()
+}
+
 // SetAsyncResultStatus sets the status of the span based on the result and adds an exception event if the result contains
 // an error.
 func SetAsyncResultStatus(result ctrl.Result, span trace.Span) {
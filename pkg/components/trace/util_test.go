@@ -18,11 +18,16 @@ package trace
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestExtractTraceparent(t *testing.T) {
@@ -42,3 +47,36 @@ func TestExtractTraceparent(t *testing.T) {
 		})
 	}
 }
+
+func TestEndSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	t.Run("success", func(t *testing.T) {
+		_, span := StartInternalSpan(context.Background(), "success", RecipeTracerName)
+		EndSpan(span, nil)
+
+		spans := recorder.Ended()
+		ended := spans[len(spans)-1]
+		require.Equal(t, "success", ended.Name())
+		require.Equal(t, trace.SpanKindInternal, ended.SpanKind())
+		require.Equal(t, otelcodes.Ok, ended.Status().Code)
+		require.Empty(t, ended.Events())
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		_, span := StartClientSpan(context.Background(), "failure", DatabaseTracerName)
+		EndSpan(span, errors.New("failed"))
+
+		spans := recorder.Ended()
+		ended := spans[len(spans)-1]
+		require.Equal(t, "failure", ended.Name())
+		require.Equal(t, trace.SpanKindClient, ended.SpanKind())
+		require.Equal(t, otelcodes.Error, ended.Status().Code)
+		require.Equal(t, "failed", ended.Status().Description)
+		require.Len(t, ended.Events(), 1)
+	})
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConfigurationConditionReady indicates that a configuration resource has been synced to Radius.
+const ConfigurationConditionReady = "Ready"
+
+// ConfigurationStatus defines the observed state of a Radius configuration resource (Environment, RecipePack,
+// ResourceType or CredentialBinding) that is synced to Radius.
+type ConfigurationStatus struct {
+	// ObservedGeneration is the most recent generation that was synced to Radius.
+	// +kubebuilder:validation:Format=""
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// Resource is the resource ID of the Radius resource.
+	// +optional
+	Resource string `json:"resource,omitempty"`
+
+	// Conditions describe the current state of the resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
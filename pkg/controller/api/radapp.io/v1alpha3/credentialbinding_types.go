@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CredentialProviderAzure is the Azure cloud provider.
+	CredentialProviderAzure = "azure"
+
+	// CredentialProviderAWS is the AWS cloud provider.
+	CredentialProviderAWS = "aws"
+
+	// CredentialKindServicePrincipal is an Azure service principal credential.
+	CredentialKindServicePrincipal = "ServicePrincipal"
+
+	// CredentialKindWorkloadIdentity is an Azure workload identity credential.
+	CredentialKindWorkloadIdentity = "WorkloadIdentity"
+
+	// CredentialKindAccessKey is an AWS access key credential.
+	CredentialKindAccessKey = "AccessKey"
+
+	// CredentialKindIRSA is an AWS IAM roles for service accounts credential.
+	CredentialKindIRSA = "IRSA"
+)
+
+// CredentialBindingSpec defines the desired state of the credential Radius uses for a cloud provider.
+// +kubebuilder:validation:XValidation:rule="self.provider == 'azure' ? self.kind in ['ServicePrincipal', 'WorkloadIdentity'] : self.kind in ['AccessKey', 'IRSA']",message="kind is not supported for the provider"
+type CredentialBindingSpec struct {
+	// Provider is the cloud provider of the credential. The provider cannot be changed.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=azure;aws
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="provider is immutable"
+	Provider string `json:"provider"`
+
+	// Kind is the kind of credential.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=ServicePrincipal;WorkloadIdentity;AccessKey;IRSA
+	Kind string `json:"kind"`
+
+	// TenantID is the Azure tenant of a ServicePrincipal or WorkloadIdentity credential.
+	// +kubebuilder:validation:Optional
+	TenantID string `json:"tenantId,omitempty"`
+
+	// ClientID is the Azure client of a ServicePrincipal or WorkloadIdentity credential.
+	// +kubebuilder:validation:Optional
+	ClientID string `json:"clientId,omitempty"`
+
+	// RoleARN is the AWS role of an IRSA credential.
+	// +kubebuilder:validation:Optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// SecretRef references the secret that contains the secret values of the credential: 'clientSecret' for a
+	// ServicePrincipal, 'accessKeyId' and 'secretAccessKey' for an AccessKey.
+	// +kubebuilder:validation:Optional
+	SecretRef *CredentialSecretReference `json:"secretRef,omitempty"`
+}
+
+// CredentialSecretReference references the secret of a CredentialBinding. CredentialBindings are cluster-scoped, so
+// the namespace of the secret is required.
+type CredentialSecretReference struct {
+	// Name is the name of the secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.provider",description="Cloud provider of the credential"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the credential is registered with Radius"
+// +kubebuilder:resource:scope=Cluster,categories={"all","radius"}
+
+// CredentialBinding is the Schema for the credentialbindings API. Radius uses a single credential per cloud
+// provider for the whole cluster, so CredentialBindings are cluster-scoped and only the oldest CredentialBinding of a
+// provider is bound. Other CredentialBindings of the provider are not ready until it is deleted. A credential that was
+// not registered by a CredentialBinding, for example with 'rad credential register', is never replaced or deleted.
+type CredentialBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CredentialBindingSpec `json:"spec,omitempty"`
+	Status ConfigurationStatus   `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CredentialBindingList contains a list of CredentialBinding
+type CredentialBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CredentialBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CredentialBinding{}, &CredentialBindingList{})
+}
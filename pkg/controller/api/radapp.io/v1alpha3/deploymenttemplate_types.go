@@ -52,8 +52,67 @@ type DeploymentTemplateStatus struct {
 
 	// Phrase indicates the current status of the Deployment Template.
 	Phrase DeploymentTemplatePhrase `json:"phrase,omitempty"`
+
+	// Conditions describe the current state of the Deployment Template using the standard
+	// Ready, Progressing, Degraded and Stalled condition types.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Resources summarizes the health of each output resource created by the last deployment.
+	// +optional
+	Resources []OutputResourceHealth `json:"resources,omitempty"`
+}
+
+// OutputResourceHealth summarizes the health of a single output resource of a Deployment Template.
+type OutputResourceHealth struct {
+	// Id is the resource id of the Radius resource.
+	Id string `json:"id"`
+
+	// Health is the aggregated health of the resource.
+	Health ResourceHealth `json:"health"`
+
+	// Message is a human readable description of the resource health.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// ResourceHealth is a string representation of the health of an output resource.
+type ResourceHealth string
+
+const (
+	// ResourceHealthHealthy indicates that the resource has been provisioned and is available.
+	ResourceHealthHealthy ResourceHealth = "Healthy"
+
+	// ResourceHealthProgressing indicates that the resource is still being provisioned or rolled out.
+	ResourceHealthProgressing ResourceHealth = "Progressing"
+
+	// ResourceHealthDegraded indicates that the resource failed to provision or roll out.
+	ResourceHealthDegraded ResourceHealth = "Degraded"
+
+	// ResourceHealthUnknown indicates that the health of the resource could not be determined.
+	ResourceHealthUnknown ResourceHealth = "Unknown"
+)
+
+const (
+	// DeploymentTemplateConditionReady indicates that the template has been deployed and all of
+	// its output resources are healthy.
+	DeploymentTemplateConditionReady = "Ready"
+
+	// DeploymentTemplateConditionProgressing indicates that a deployment is in progress or that
+	// output resources are still rolling out.
+	DeploymentTemplateConditionProgressing = "Progressing"
+
+	// DeploymentTemplateConditionDegraded indicates that one or more output resources are unhealthy.
+	DeploymentTemplateConditionDegraded = "Degraded"
+
+	// DeploymentTemplateConditionStalled indicates that the deployment failed and will not make
+	// progress until the Deployment Template is changed, or that output resources did not finish
+	// rolling out within the progress deadline.
+	DeploymentTemplateConditionStalled = "Stalled"
+)
+
 // DeploymentTemplatePhrase is a string representation of the current status of a Deployment Template.
 type DeploymentTemplatePhrase string
 
@@ -77,6 +136,8 @@ const (
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the deployment is ready"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",description="Reason for the ready status",priority=1
 // +kubebuilder:resource:categories={"all","radius"}
 
 // DeploymentTemplate is the Schema for the deploymenttemplates API
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EnvironmentSpec defines the desired state of a Radius environment.
+type EnvironmentSpec struct {
+	// ResourceGroup is the name of the Radius resource group that contains the environment. If unset the
+	// namespace of the Environment will be used as the resource group name.
+	// +kubebuilder:validation:Optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// Providers configures the cloud providers of the environment.
+	// +kubebuilder:validation:Optional
+	Providers *EnvironmentProviders `json:"providers,omitempty"`
+
+	// RecipePacks is a list of the names of RecipePack resources in the same namespace that are linked to
+	// the environment.
+	// +kubebuilder:validation:Optional
+	RecipePacks []string `json:"recipePacks,omitempty"`
+
+	// RecipeParameters specifies recipe parameters that apply to all resources of a given type in the
+	// environment, keyed by resource type.
+	// +kubebuilder:validation:Optional
+	RecipeParameters map[string]apiextensionsv1.JSON `json:"recipeParameters,omitempty"`
+
+	// Simulated configures the environment to skip deployment of resources.
+	// +kubebuilder:validation:Optional
+	Simulated bool `json:"simulated,omitempty"`
+}
+
+// EnvironmentProviders configures the cloud providers of an environment.
+type EnvironmentProviders struct {
+	// Kubernetes configures the Kubernetes provider. If unset the namespace of the Environment will be used.
+	// +kubebuilder:validation:Optional
+	Kubernetes *EnvironmentKubernetesProvider `json:"kubernetes,omitempty"`
+
+	// Azure configures the Azure provider.
+	// +kubebuilder:validation:Optional
+	Azure *EnvironmentAzureProvider `json:"azure,omitempty"`
+
+	// AWS configures the AWS provider.
+	// +kubebuilder:validation:Optional
+	AWS *EnvironmentAWSProvider `json:"aws,omitempty"`
+}
+
+// EnvironmentKubernetesProvider configures the Kubernetes provider of an environment.
+type EnvironmentKubernetesProvider struct {
+	// Namespace is the Kubernetes namespace to deploy workloads into.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+}
+
+// EnvironmentAzureProvider configures the Azure provider of an environment.
+type EnvironmentAzureProvider struct {
+	// SubscriptionID is the Azure subscription that hosts deployed resources.
+	// +kubebuilder:validation:Required
+	SubscriptionID string `json:"subscriptionId"`
+
+	// ResourceGroupName is the Azure resource group that hosts deployed resources.
+	// +kubebuilder:validation:Optional
+	ResourceGroupName string `json:"resourceGroupName,omitempty"`
+}
+
+// EnvironmentAWSProvider configures the AWS provider of an environment.
+type EnvironmentAWSProvider struct {
+	// AccountID is the AWS account that hosts deployed resources.
+	// +kubebuilder:validation:Required
+	AccountID string `json:"accountId"`
+
+	// Region is the AWS region that hosts deployed resources.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the environment is synced to Radius"
+// +kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".status.resource",description="Resource ID of the Radius environment",priority=1
+// +kubebuilder:resource:categories={"all","radius"}
+
+// Environment is the Schema for the environments API
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec     `json:"spec,omitempty"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+}
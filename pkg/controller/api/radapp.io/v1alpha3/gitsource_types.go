@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GitSourceSpec defines the desired state of a GitSource resource.
+type GitSourceSpec struct {
+	// URL is the URL of the Git repository. HTTPS URLs ('https://...') and SSH URLs ('ssh://...' or
+	// 'git@host:path') are supported.
+	// +kubebuilder:validation:Required
+	URL string `json:"url,omitempty"`
+
+	// Branch is the branch to track. If unset the default branch of the repository is used.
+	// +kubebuilder:validation:Optional
+	Branch string `json:"branch,omitempty"`
+
+	// Path is the directory of the repository containing the radius-gitops-config.yaml file. If unset the root
+	// of the repository is used.
+	// +kubebuilder:validation:Optional
+	Path string `json:"path,omitempty"`
+
+	// SecretRef is a reference to a Kubernetes secret in the same namespace with the credentials of the Git
+	// repository. HTTPS repositories use the 'username' and 'password' keys. SSH repositories use the 'identity'
+	// key for the private key and the 'known_hosts' key for the host keys of the server.
+	// +kubebuilder:validation:Optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+
+	// Interval is how often the repository is polled for new commits. If unset the repository is polled every
+	// five minutes.
+	// +kubebuilder:validation:Optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// WebhookSecretRef is a reference to a Kubernetes secret in the same namespace with the 'token' key used to
+	// authenticate push webhooks. Webhooks are disabled if unset.
+	// +kubebuilder:validation:Optional
+	WebhookSecretRef *corev1.LocalObjectReference `json:"webhookSecretRef,omitempty"`
+}
+
+// GitSourcePhrase is a string representation of the current status of a GitSource.
+type GitSourcePhrase string
+
+const (
+	// GitSourcePhraseSyncing indicates that the GitSource is being synchronized with the repository.
+	GitSourcePhraseSyncing GitSourcePhrase = "Syncing"
+
+	// GitSourcePhraseReady indicates that the latest revision of the repository has been applied.
+	GitSourcePhraseReady GitSourcePhrase = "Ready"
+
+	// GitSourcePhraseFailed indicates that the repository could not be fetched or applied.
+	GitSourcePhraseFailed GitSourcePhrase = "Failed"
+)
+
+// GitSourceStatus defines the observed state of a GitSource resource.
+type GitSourceStatus struct {
+	// ObservedGeneration is the most recent generation observed for this GitSource.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=""
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// Revision is the last revision of the repository that was fully applied, e.g. 'main@sha1:<commit>'.
+	// +kubebuilder:validation:Optional
+	Revision string `json:"revision,omitempty"`
+
+	// LastSyncTime is the last time the repository was checked for new commits.
+	// +kubebuilder:validation:Optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Phrase indicates the current status of the GitSource.
+	// +kubebuilder:validation:Optional
+	Phrase GitSourcePhrase `json:"phrase,omitempty"`
+
+	// Message describes the last error, if the GitSource has failed.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.url",description="URL of the Git repository"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.revision",description="Last applied revision"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+// +kubebuilder:resource:categories={"all","radius"}
+
+// GitSource is the Schema for the gitsources API. A GitSource tracks a Git repository without requiring Flux and
+// creates DeploymentTemplates from the Bicep files specified in its radius-gitops-config.yaml file.
+type GitSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitSourceSpec   `json:"spec,omitempty"`
+	Status GitSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitSourceList contains a list of GitSource
+type GitSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GitSource{}, &GitSourceList{})
+}
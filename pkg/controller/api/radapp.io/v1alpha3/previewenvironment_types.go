@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreviewEnvironmentSpec defines the desired state of a PreviewEnvironment resource.
+// +kubebuilder:validation:XValidation:rule="!has(self.pullRequests) || !self.pullRequests || (has(self.pullRequestAuthors) && size(self.pullRequestAuthors) > 0) || (has(self.pullRequestLabel) && size(self.pullRequestLabel) > 0)",message="pullRequests requires pullRequestAuthors or pullRequestLabel"
+type PreviewEnvironmentSpec struct {
+	// SourceRef is a reference to the GitSource in the same namespace whose repository is previewed. The URL, path
+	// and credentials of the GitSource are used. Its branch is ignored.
+	// +kubebuilder:validation:Required
+	SourceRef corev1.LocalObjectReference `json:"sourceRef"`
+
+	// Branches are glob patterns, e.g. 'feature/*', selecting the branches that get a preview environment. Patterns
+	// use the syntax of Go's path.Match.
+	// +kubebuilder:validation:Optional
+	Branches []string `json:"branches,omitempty"`
+
+	// PullRequests creates a preview environment for each open GitHub pull request that passes the gate set by
+	// PullRequestAuthors and PullRequestLabel. Open pull requests are listed with the GitHub API, using the password
+	// of the GitSource as the token, and previewed at their 'refs/pull/<number>/head' reference. Pull requests can
+	// come from forks and are deployed with the credentials of the environment, so a gate is required. Only branches
+	// are previewed by default.
+	// +kubebuilder:validation:Optional
+	PullRequests bool `json:"pullRequests,omitempty"`
+
+	// PullRequestAuthors are the GitHub logins whose pull requests are previewed.
+	// +kubebuilder:validation:Optional
+	PullRequestAuthors []string `json:"pullRequestAuthors,omitempty"`
+
+	// PullRequestLabel is a label that previews a pull request of any author, e.g. a label a maintainer adds after
+	// reviewing the pull request.
+	// +kubebuilder:validation:Optional
+	PullRequestLabel string `json:"pullRequestLabel,omitempty"`
+
+	// EnvironmentTemplate is the name of an Environment in the same namespace that preview environments are cloned
+	// from. Its recipe packs, providers and recipe parameters are copied.
+	// +kubebuilder:validation:Required
+	EnvironmentTemplate string `json:"environmentTemplate"`
+
+	// TTL is how long a preview environment is kept after it is created. Expired preview environments are recreated
+	// when a new commit is pushed. If unset preview environments are kept until their branch is deleted.
+	// +kubebuilder:validation:Optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Interval is how often the repository is polled for branches. If unset the interval of the GitSource is used.
+	// +kubebuilder:validation:Optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// PreviewPhrase is a string representation of the current status of a preview.
+type PreviewPhrase string
+
+const (
+	// PreviewPhraseDeploying indicates that the environment or the application of the preview is being deployed.
+	PreviewPhraseDeploying PreviewPhrase = "Deploying"
+
+	// PreviewPhraseReady indicates that the latest revision of the branch is deployed.
+	PreviewPhraseReady PreviewPhrase = "Ready"
+
+	// PreviewPhraseFailed indicates that the preview could not be deployed.
+	PreviewPhraseFailed PreviewPhrase = "Failed"
+
+	// PreviewPhraseDeleting indicates that the preview is being torn down.
+	PreviewPhraseDeleting PreviewPhrase = "Deleting"
+
+	// PreviewPhraseExpired indicates that the preview was torn down because its TTL expired.
+	PreviewPhraseExpired PreviewPhrase = "Expired"
+)
+
+// PreviewStatus is the observed state of the preview of a single branch or pull request.
+type PreviewStatus struct {
+	// Name is the name of the preview. It is used for the Environment, the resource group and the Kubernetes
+	// namespace of the preview.
+	Name string `json:"name"`
+
+	// Reference is the branch or pull request being previewed, e.g. 'feature/login' or 'pull/12'.
+	// +kubebuilder:validation:Optional
+	Reference string `json:"reference,omitempty"`
+
+	// Revision is the last revision that was applied, e.g. 'feature/login@sha1:<commit>'.
+	// +kubebuilder:validation:Optional
+	Revision string `json:"revision,omitempty"`
+
+	// Environment is the resource ID of the Radius environment of the preview.
+	// +kubebuilder:validation:Optional
+	Environment string `json:"environment,omitempty"`
+
+	// URL is the URL of the gateway of the application, if it has one.
+	// +kubebuilder:validation:Optional
+	URL string `json:"url,omitempty"`
+
+	// Phrase indicates the current status of the preview.
+	// +kubebuilder:validation:Optional
+	Phrase PreviewPhrase `json:"phrase,omitempty"`
+
+	// Message describes why the preview is not ready.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// CreatedAt is the time the preview was created. It is used to compute the expiry of the preview.
+	// +kubebuilder:validation:Optional
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+}
+
+// PreviewEnvironmentStatus defines the observed state of a PreviewEnvironment resource.
+type PreviewEnvironmentStatus struct {
+	// ObservedGeneration is the most recent generation observed for this PreviewEnvironment.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Format=""
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,1,opt,name=observedGeneration"`
+
+	// LastSyncTime is the last time the repository was checked for branches.
+	// +kubebuilder:validation:Optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Previews are the previews of the matching branches and pull requests.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	Previews []PreviewStatus `json:"previews,omitempty"`
+
+	// Message describes the last error affecting all previews, e.g. a missing GitSource.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceRef.name",description="Name of the GitSource"
+// +kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.environmentTemplate",description="Name of the template Environment"
+// +kubebuilder:printcolumn:name="Previews",type="string",JSONPath=".status.previews[*].name",description="Names of the previews"
+// +kubebuilder:resource:categories={"all","radius"}
+
+// PreviewEnvironment is the Schema for the previewenvironments API. A PreviewEnvironment creates an ephemeral Radius
+// environment for each matching branch or pull request of a GitSource and deploys the application into it.
+type PreviewEnvironment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PreviewEnvironmentSpec   `json:"spec,omitempty"`
+	Status PreviewEnvironmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PreviewEnvironmentList contains a list of PreviewEnvironment
+type PreviewEnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PreviewEnvironment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PreviewEnvironment{}, &PreviewEnvironmentList{})
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -29,10 +30,39 @@ type RecipeSpec struct {
 	// +kubebuilder:validation:Required
 	Type string `json:"type,omitempty"`
 
-	// SecretName is the name of a Kubernetes secret to create once the resource is created.
+	// SecretName is the name of a Kubernetes secret to create once the resource is created. When ConfigMapName
+	// is also set, the secret only contains the sensitive values of the resource.
 	// +kubebuilder:validation:Optional
 	SecretName string `json:"secretName,omitempty"`
 
+	// ConfigMapName is the name of a Kubernetes ConfigMap to create with the non-sensitive values of the resource
+	// once it is created.
+	// +kubebuilder:validation:Optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// SecretKeys maps the keys written to the secret to templates rendered from the values of the resource, for
+	// example 'url: redis://{{ .host }}:{{ .port }}'. Templates use Go template syntax and can reference both
+	// sensitive and non-sensitive values. When set, only the mapped keys are written.
+	// +kubebuilder:validation:Optional
+	SecretKeys map[string]string `json:"secretKeys,omitempty"`
+
+	// ConfigMapKeys maps the keys written to the ConfigMap to templates rendered from the non-sensitive values of
+	// the resource. When set, only the mapped keys are written.
+	// +kubebuilder:validation:Optional
+	ConfigMapKeys map[string]string `json:"configMapKeys,omitempty"`
+
+	// Parameters are the parameters passed to the recipe.
+	// +kubebuilder:validation:Optional
+	Parameters map[string]apiextensionsv1.JSON `json:"parameters,omitempty"`
+
+	// UpdatePolicy defines how the resource is updated when the parameters of the recipe change. 'InPlace'
+	// updates the existing resource, 'Recreate' deletes the resource and creates it again. Changing the type,
+	// environment or application always recreates the resource.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=InPlace;Recreate
+	// +kubebuilder:default=InPlace
+	UpdatePolicy RecipeUpdatePolicy `json:"updatePolicy,omitempty"`
+
 	// Environment is the name of the Radius environment to use. If unset the value 'default' will be
 	// used as the environment name.
 	Environment string `json:"environment,omitempty"`
@@ -42,6 +72,17 @@ type RecipeSpec struct {
 	Application string `json:"application,omitempty"`
 }
 
+// RecipeUpdatePolicy defines how the resource of a Recipe is updated.
+type RecipeUpdatePolicy string
+
+const (
+	// RecipeUpdatePolicyInPlace updates the existing resource.
+	RecipeUpdatePolicyInPlace RecipeUpdatePolicy = "InPlace"
+
+	// RecipeUpdatePolicyRecreate deletes the resource and creates it again.
+	RecipeUpdatePolicyRecreate RecipeUpdatePolicy = "Recreate"
+)
+
 // RecipePhrase is a string representation of the current status of a Recipe.
 type RecipePhrase string
 
@@ -97,6 +138,20 @@ type RecipeStatus struct {
 	// Secret specifies a reference to the secret being managed by this Recipe.
 	// +kubebuilder:validation:Optional
 	Secret corev1.ObjectReference `json:"secret,omitempty"`
+
+	// ConfigMap specifies a reference to the ConfigMap being managed by this Recipe.
+	// +kubebuilder:validation:Optional
+	ConfigMap corev1.ObjectReference `json:"configMap,omitempty"`
+
+	// PropertiesHash is a hash of the properties the resource was last created or updated with. It is used to
+	// detect changes to the parameters of the recipe.
+	// +kubebuilder:validation:Optional
+	PropertiesHash string `json:"propertiesHash,omitempty"`
+
+	// OutputsHash is a hash of the values written to the secret and ConfigMap. It is used to roll out the
+	// Deployments that consume them when the values change.
+	// +kubebuilder:validation:Optional
+	OutputsHash string `json:"outputsHash,omitempty"`
 }
 
 // ResourceOperation describes the status of an in-progress provisioning operation.
@@ -123,6 +178,7 @@ const (
 //+kubebuilder:resource:categories={"all","radius"}
 //+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of resource the recipe should create"
 //+kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Name of the secret to create"
+//+kubebuilder:printcolumn:name="ConfigMap",type="string",JSONPath=".spec.configMapName",description="Name of the ConfigMap to create",priority=1
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
 //+kubebuilder:subresource:status
 
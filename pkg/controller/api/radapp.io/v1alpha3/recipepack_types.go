@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecipePackSpec defines the desired state of a Radius recipe pack.
+type RecipePackSpec struct {
+	// ResourceGroup is the name of the Radius resource group that contains the recipe pack. If unset the
+	// namespace of the RecipePack will be used as the resource group name.
+	// +kubebuilder:validation:Optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// Recipes maps resource types to the recipe used to provision them. eg: 'Radius.Data/redisCaches'.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	Recipes map[string]RecipePackRecipe `json:"recipes"`
+}
+
+// RecipePackRecipe defines the recipe used for a resource type.
+type RecipePackRecipe struct {
+	// Kind is the kind of recipe.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=bicep;terraform
+	Kind string `json:"kind"`
+
+	// Location is the location of the recipe, for example an OCI registry path or a Terraform module source.
+	// +kubebuilder:validation:Required
+	Location string `json:"location"`
+
+	// Parameters are passed to the recipe.
+	// +kubebuilder:validation:Optional
+	Parameters *apiextensionsv1.JSON `json:"parameters,omitempty"`
+
+	// PlainHTTP connects to the location using HTTP instead of HTTPS.
+	// +kubebuilder:validation:Optional
+	PlainHTTP bool `json:"plainHttp,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the recipe pack is synced to Radius"
+// +kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".status.resource",description="Resource ID of the Radius recipe pack",priority=1
+// +kubebuilder:resource:categories={"all","radius"}
+
+// RecipePack is the Schema for the recipepacks API
+type RecipePack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RecipePackSpec      `json:"spec,omitempty"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RecipePackList contains a list of RecipePack
+type RecipePackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RecipePack `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RecipePack{}, &RecipePackList{})
+}
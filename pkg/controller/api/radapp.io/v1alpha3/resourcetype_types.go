@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceTypeSpec defines the desired state of a Radius resource type.
+type ResourceTypeSpec struct {
+	// ResourceProvider is the namespace of the resource provider that defines the type. eg: 'Radius.Data'.
+	// The resource provider is created if it does not exist.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[A-Za-z][A-Za-z0-9]*\.[A-Za-z][A-Za-z0-9]*$`
+	ResourceProvider string `json:"resourceProvider"`
+
+	// TypeName is the name of the resource type within the resource provider. eg: 'redisCaches'.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-z][A-Za-z0-9]*$`
+	TypeName string `json:"typeName"`
+
+	// Description of the resource type.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// Capabilities is a list of capabilities of the resource type.
+	// +kubebuilder:validation:Optional
+	Capabilities []string `json:"capabilities,omitempty"`
+
+	// DefaultAPIVersion is the default API version of the resource type.
+	// +kubebuilder:validation:Optional
+	DefaultAPIVersion string `json:"defaultApiVersion,omitempty"`
+
+	// APIVersions maps API version names to their definition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	APIVersions map[string]ResourceTypeAPIVersion `json:"apiVersions"`
+}
+
+// ResourceTypeAPIVersion defines an API version of a resource type.
+type ResourceTypeAPIVersion struct {
+	// Schema is the OpenAPI schema of the resource type properties.
+	// +kubebuilder:validation:Required
+	Schema apiextensionsv1.JSON `json:"schema"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether the resource type is registered with Radius"
+// +kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".status.resource",description="Resource ID of the Radius resource type",priority=1
+// +kubebuilder:resource:scope=Cluster,categories={"all","radius"}
+
+// ResourceType is the Schema for the resourcetypes API
+type ResourceType struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceTypeSpec    `json:"spec,omitempty"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceTypeList contains a list of ResourceType
+type ResourceTypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceType `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceType{}, &ResourceTypeList{})
+}
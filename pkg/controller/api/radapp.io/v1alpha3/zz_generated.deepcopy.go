@@ -21,9 +21,128 @@ limitations under the License.
 package v1alpha3
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationStatus) DeepCopyInto(out *ConfigurationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationStatus.
+func (in *ConfigurationStatus) DeepCopy() *ConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBinding) DeepCopyInto(out *CredentialBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBinding.
+func (in *CredentialBinding) DeepCopy() *CredentialBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CredentialBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBindingList) DeepCopyInto(out *CredentialBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CredentialBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBindingList.
+func (in *CredentialBindingList) DeepCopy() *CredentialBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CredentialBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialBindingSpec) DeepCopyInto(out *CredentialBindingSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CredentialSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialBindingSpec.
+func (in *CredentialBindingSpec) DeepCopy() *CredentialBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialSecretReference) DeepCopyInto(out *CredentialSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialSecretReference.
+func (in *CredentialSecretReference) DeepCopy() *CredentialSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeploymentResource) DeepCopyInto(out *DeploymentResource) {
 	*out = *in
@@ -212,6 +331,18 @@ func (in *DeploymentTemplateStatus) DeepCopyInto(out *DeploymentTemplateStatus)
 		*out = new(ResourceOperation)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]OutputResourceHealth, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentTemplateStatus.
@@ -225,26 +356,26 @@ func (in *DeploymentTemplateStatus) DeepCopy() *DeploymentTemplateStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Recipe) DeepCopyInto(out *Recipe) {
+func (in *Environment) DeepCopyInto(out *Environment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Recipe.
-func (in *Recipe) DeepCopy() *Recipe {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
+func (in *Environment) DeepCopy() *Environment {
 	if in == nil {
 		return nil
 	}
-	out := new(Recipe)
+	out := new(Environment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Recipe) DeepCopyObject() runtime.Object {
+func (in *Environment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -252,31 +383,76 @@ func (in *Recipe) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecipeList) DeepCopyInto(out *RecipeList) {
+func (in *EnvironmentAWSProvider) DeepCopyInto(out *EnvironmentAWSProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAWSProvider.
+func (in *EnvironmentAWSProvider) DeepCopy() *EnvironmentAWSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAWSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentAzureProvider) DeepCopyInto(out *EnvironmentAzureProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentAzureProvider.
+func (in *EnvironmentAzureProvider) DeepCopy() *EnvironmentAzureProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentAzureProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentKubernetesProvider) DeepCopyInto(out *EnvironmentKubernetesProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentKubernetesProvider.
+func (in *EnvironmentKubernetesProvider) DeepCopy() *EnvironmentKubernetesProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentKubernetesProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Recipe, len(*in))
+		*out = make([]Environment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeList.
-func (in *RecipeList) DeepCopy() *RecipeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
+func (in *EnvironmentList) DeepCopy() *EnvironmentList {
 	if in == nil {
 		return nil
 	}
-	out := new(RecipeList)
+	out := new(EnvironmentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RecipeList) DeepCopyObject() runtime.Object {
+func (in *EnvironmentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -284,52 +460,658 @@ func (in *RecipeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecipeSpec) DeepCopyInto(out *RecipeSpec) {
+func (in *EnvironmentProviders) DeepCopyInto(out *EnvironmentProviders) {
 	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(EnvironmentKubernetesProvider)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(EnvironmentAzureProvider)
+		**out = **in
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(EnvironmentAWSProvider)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeSpec.
-func (in *RecipeSpec) DeepCopy() *RecipeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentProviders.
+func (in *EnvironmentProviders) DeepCopy() *EnvironmentProviders {
 	if in == nil {
 		return nil
 	}
-	out := new(RecipeSpec)
+	out := new(EnvironmentProviders)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RecipeStatus) DeepCopyInto(out *RecipeStatus) {
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
 	*out = *in
-	if in.Operation != nil {
-		in, out := &in.Operation, &out.Operation
-		*out = new(ResourceOperation)
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = new(EnvironmentProviders)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RecipePacks != nil {
+		in, out := &in.RecipePacks, &out.RecipePacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RecipeParameters != nil {
+		in, out := &in.RecipeParameters, &out.RecipeParameters
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSource) DeepCopyInto(out *GitSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSource.
+func (in *GitSource) DeepCopy() *GitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSourceList) DeepCopyInto(out *GitSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSourceList.
+func (in *GitSourceList) DeepCopy() *GitSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSourceSpec) DeepCopyInto(out *GitSourceSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WebhookSecretRef != nil {
+		in, out := &in.WebhookSecretRef, &out.WebhookSecretRef
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
-	out.Secret = in.Secret
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeStatus.
-func (in *RecipeStatus) DeepCopy() *RecipeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSourceSpec.
+func (in *GitSourceSpec) DeepCopy() *GitSourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RecipeStatus)
+	out := new(GitSourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceOperation) DeepCopyInto(out *ResourceOperation) {
+func (in *GitSourceStatus) DeepCopyInto(out *GitSourceStatus) {
 	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOperation.
-func (in *ResourceOperation) DeepCopy() *ResourceOperation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSourceStatus.
+func (in *GitSourceStatus) DeepCopy() *GitSourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceOperation)
+	out := new(GitSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputResourceHealth) DeepCopyInto(out *OutputResourceHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputResourceHealth.
+func (in *OutputResourceHealth) DeepCopy() *OutputResourceHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputResourceHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironment) DeepCopyInto(out *PreviewEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironment.
+func (in *PreviewEnvironment) DeepCopy() *PreviewEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreviewEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentList) DeepCopyInto(out *PreviewEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PreviewEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentList.
+func (in *PreviewEnvironmentList) DeepCopy() *PreviewEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PreviewEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentSpec) DeepCopyInto(out *PreviewEnvironmentSpec) {
+	*out = *in
+	out.SourceRef = in.SourceRef
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullRequestAuthors != nil {
+		in, out := &in.PullRequestAuthors, &out.PullRequestAuthors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentSpec.
+func (in *PreviewEnvironmentSpec) DeepCopy() *PreviewEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewEnvironmentStatus) DeepCopyInto(out *PreviewEnvironmentStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Previews != nil {
+		in, out := &in.Previews, &out.Previews
+		*out = make([]PreviewStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewEnvironmentStatus.
+func (in *PreviewEnvironmentStatus) DeepCopy() *PreviewEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreviewStatus) DeepCopyInto(out *PreviewStatus) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreviewStatus.
+func (in *PreviewStatus) DeepCopy() *PreviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PreviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Recipe) DeepCopyInto(out *Recipe) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Recipe.
+func (in *Recipe) DeepCopy() *Recipe {
+	if in == nil {
+		return nil
+	}
+	out := new(Recipe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Recipe) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipeList) DeepCopyInto(out *RecipeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Recipe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeList.
+func (in *RecipeList) DeepCopy() *RecipeList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecipeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipePack) DeepCopyInto(out *RecipePack) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipePack.
+func (in *RecipePack) DeepCopy() *RecipePack {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipePack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecipePack) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipePackList) DeepCopyInto(out *RecipePackList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RecipePack, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipePackList.
+func (in *RecipePackList) DeepCopy() *RecipePackList {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipePackList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RecipePackList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipePackRecipe) DeepCopyInto(out *RecipePackRecipe) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipePackRecipe.
+func (in *RecipePackRecipe) DeepCopy() *RecipePackRecipe {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipePackRecipe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipePackSpec) DeepCopyInto(out *RecipePackSpec) {
+	*out = *in
+	if in.Recipes != nil {
+		in, out := &in.Recipes, &out.Recipes
+		*out = make(map[string]RecipePackRecipe, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipePackSpec.
+func (in *RecipePackSpec) DeepCopy() *RecipePackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipePackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipeSpec) DeepCopyInto(out *RecipeSpec) {
+	*out = *in
+	if in.SecretKeys != nil {
+		in, out := &in.SecretKeys, &out.SecretKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConfigMapKeys != nil {
+		in, out := &in.ConfigMapKeys, &out.ConfigMapKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeSpec.
+func (in *RecipeSpec) DeepCopy() *RecipeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecipeStatus) DeepCopyInto(out *RecipeStatus) {
+	*out = *in
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ResourceOperation)
+		**out = **in
+	}
+	out.Secret = in.Secret
+	out.ConfigMap = in.ConfigMap
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecipeStatus.
+func (in *RecipeStatus) DeepCopy() *RecipeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RecipeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceOperation) DeepCopyInto(out *ResourceOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOperation.
+func (in *ResourceOperation) DeepCopy() *ResourceOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceType) DeepCopyInto(out *ResourceType) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceType.
+func (in *ResourceType) DeepCopy() *ResourceType {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceType) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTypeAPIVersion) DeepCopyInto(out *ResourceTypeAPIVersion) {
+	*out = *in
+	in.Schema.DeepCopyInto(&out.Schema)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeAPIVersion.
+func (in *ResourceTypeAPIVersion) DeepCopy() *ResourceTypeAPIVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTypeAPIVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTypeList) DeepCopyInto(out *ResourceTypeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeList.
+func (in *ResourceTypeList) DeepCopy() *ResourceTypeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTypeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceTypeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTypeSpec) DeepCopyInto(out *ResourceTypeSpec) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make(map[string]ResourceTypeAPIVersion, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeSpec.
+func (in *ResourceTypeSpec) DeepCopy() *ResourceTypeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTypeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	// ReasonSynced is the condition reason used when a configuration resource has been synced to Radius.
+	ReasonSynced = "Synced"
+
+	// ReasonSyncFailed is the condition reason used when a configuration resource could not be synced to Radius.
+	ReasonSyncFailed = "SyncFailed"
+
+	// ReasonWaitingForDependencies is the condition reason used when a configuration resource references
+	// resources that are not ready yet.
+	ReasonWaitingForDependencies = "WaitingForDependencies"
+)
+
+// markConfigurationSynced sets the Ready condition of a configuration resource after it has been synced to Radius.
+// Returns true if the resource was not previously synced at this generation.
+func markConfigurationSynced(status *radappiov1alpha3.ConfigurationStatus, generation int64, resourceID string) bool {
+	previous := meta.FindStatusCondition(status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	changed := previous == nil || previous.Status != metav1.ConditionTrue || status.ObservedGeneration != generation
+
+	status.ObservedGeneration = generation
+	status.Resource = resourceID
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               radappiov1alpha3.ConfigurationConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             ReasonSynced,
+		Message:            fmt.Sprintf("Synced to %s.", resourceID),
+		ObservedGeneration: generation,
+	})
+
+	return changed
+}
+
+// markConfigurationNotReady sets the Ready condition of a configuration resource to false.
+func markConfigurationNotReady(status *radappiov1alpha3.ConfigurationStatus, generation int64, reason string, message string) {
+	status.ObservedGeneration = generation
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               radappiov1alpha3.ConfigurationConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// isConfigurationReady returns true if the configuration resource has been synced to Radius at its current generation.
+func isConfigurationReady(status *radappiov1alpha3.ConfigurationStatus, generation int64) bool {
+	condition := meta.FindStatusCondition(status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	return condition != nil && condition.Status == metav1.ConditionTrue && condition.ObservedGeneration == generation && status.Resource != ""
+}
+
+// configurationResourceGroupID returns the resource ID of the Radius resource group of a namespaced configuration
+// resource. The namespace is used as the resource group name when none is specified.
+func configurationResourceGroupID(resourceGroup string, namespace string) string {
+	if resourceGroup == "" {
+		resourceGroup = namespace
+	}
+
+	return "/planes/radius/local/resourceGroups/" + resourceGroup
+}
+
+// resourceTypeID returns the resource ID of a resource type registered in the Radius plane.
+func resourceTypeID(resourceProviderNamespace string, typeName string) string {
+	return fmt.Sprintf("/planes/radius/%s/providers/System.Resources/resourceProviders/%s/resourceTypes/%s", radiusPlaneName, resourceProviderNamespace, typeName)
+}
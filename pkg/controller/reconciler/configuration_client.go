@@ -0,0 +1,276 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	radiuscorev20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// radiusPlaneName is the name of the Radius plane used for resource types.
+	radiusPlaneName = "local"
+
+	// defaultCredentialName is the name of the credential Radius uses for a cloud provider.
+	defaultCredentialName = "default"
+)
+
+// ConfigurationClient manages Radius configuration resources: environments, recipe packs, resource types and
+// cloud provider credentials. Delete operations succeed if the resource does not exist.
+type ConfigurationClient interface {
+	// CreateOrUpdateEnvironment creates or updates a Radius.Core environment.
+	CreateOrUpdateEnvironment(ctx context.Context, environmentID string, resource radiuscorev20250801preview.EnvironmentResource) error
+
+	// DeleteEnvironment deletes a Radius.Core environment.
+	DeleteEnvironment(ctx context.Context, environmentID string) error
+
+	// CreateOrUpdateRecipePack creates or updates a Radius.Core recipe pack.
+	CreateOrUpdateRecipePack(ctx context.Context, recipePackID string, resource radiuscorev20250801preview.RecipePackResource) error
+
+	// DeleteRecipePack deletes a Radius.Core recipe pack.
+	DeleteRecipePack(ctx context.Context, recipePackID string) error
+
+	// RegisterResourceType creates the resource provider if needed and registers the resource type.
+	RegisterResourceType(ctx context.Context, resourceProvider manifest.ResourceProvider, typeName string) error
+
+	// DeleteResourceType deletes a resource type.
+	DeleteResourceType(ctx context.Context, resourceProviderNamespace string, typeName string) error
+
+	// GetAzureCredential returns the credential used for Azure, or nil if there is none.
+	GetAzureCredential(ctx context.Context) (*ucpv20231001preview.AzureCredentialResource, error)
+
+	// CreateOrUpdateAzureCredential registers the credential used for Azure.
+	CreateOrUpdateAzureCredential(ctx context.Context, resource ucpv20231001preview.AzureCredentialResource) error
+
+	// DeleteAzureCredential deletes the credential used for Azure.
+	DeleteAzureCredential(ctx context.Context) error
+
+	// GetAWSCredential returns the credential used for AWS, or nil if there is none.
+	GetAWSCredential(ctx context.Context) (*ucpv20231001preview.AwsCredentialResource, error)
+
+	// CreateOrUpdateAWSCredential registers the credential used for AWS.
+	CreateOrUpdateAWSCredential(ctx context.Context, resource ucpv20231001preview.AwsCredentialResource) error
+
+	// DeleteAWSCredential deletes the credential used for AWS.
+	DeleteAWSCredential(ctx context.Context) error
+}
+
+// NewConfigurationClient creates a new ConfigurationClient.
+func NewConfigurationClient(connection sdk.Connection) *ConfigurationClientImpl {
+	return &ConfigurationClientImpl{connection: connection}
+}
+
+var _ ConfigurationClient = (*ConfigurationClientImpl)(nil)
+
+// ConfigurationClientImpl is the default implementation of ConfigurationClient.
+type ConfigurationClientImpl struct {
+	connection sdk.Connection
+}
+
+func (c *ConfigurationClientImpl) radiusCore(resourceID string) (*radiuscorev20250801preview.ClientFactory, resources.ID, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, resources.ID{}, err
+	}
+
+	factory, err := radiuscorev20250801preview.NewClientFactory(id.RootScope(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return nil, resources.ID{}, err
+	}
+
+	return factory, id, nil
+}
+
+func (c *ConfigurationClientImpl) ucp() (*ucpv20231001preview.ClientFactory, error) {
+	return ucpv20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+}
+
+// CreateOrUpdateEnvironment creates or updates a Radius.Core environment.
+func (c *ConfigurationClientImpl) CreateOrUpdateEnvironment(ctx context.Context, environmentID string, resource radiuscorev20250801preview.EnvironmentResource) error {
+	factory, id, err := c.radiusCore(environmentID)
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewEnvironmentsClient().CreateOrUpdate(ctx, id.Name(), resource, nil)
+	return err
+}
+
+// DeleteEnvironment deletes a Radius.Core environment.
+func (c *ConfigurationClientImpl) DeleteEnvironment(ctx context.Context, environmentID string) error {
+	factory, id, err := c.radiusCore(environmentID)
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewEnvironmentsClient().Delete(ctx, id.Name(), nil)
+	return ignoreNotFound(err)
+}
+
+// CreateOrUpdateRecipePack creates or updates a Radius.Core recipe pack.
+func (c *ConfigurationClientImpl) CreateOrUpdateRecipePack(ctx context.Context, recipePackID string, resource radiuscorev20250801preview.RecipePackResource) error {
+	factory, id, err := c.radiusCore(recipePackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewRecipePacksClient().CreateOrUpdate(ctx, id.Name(), resource, nil)
+	return err
+}
+
+// DeleteRecipePack deletes a Radius.Core recipe pack.
+func (c *ConfigurationClientImpl) DeleteRecipePack(ctx context.Context, recipePackID string) error {
+	factory, id, err := c.radiusCore(recipePackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewRecipePacksClient().Delete(ctx, id.Name(), nil)
+	return ignoreNotFound(err)
+}
+
+// RegisterResourceType creates the resource provider if needed and registers the resource type.
+func (c *ConfigurationClientImpl) RegisterResourceType(ctx context.Context, resourceProvider manifest.ResourceProvider, typeName string) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	log := func(format string, args ...any) {
+		logger.V(ucplog.LevelDebug).Info(fmt.Sprintf(format, args...))
+	}
+
+	err = manifest.EnsureResourceProviderExists(ctx, factory, radiusPlaneName, resourceProvider, log)
+	if err != nil {
+		return err
+	}
+
+	return manifest.RegisterResourceType(ctx, factory, radiusPlaneName, resourceProvider, typeName, log)
+}
+
+// DeleteResourceType deletes a resource type.
+func (c *ConfigurationClientImpl) DeleteResourceType(ctx context.Context, resourceProviderNamespace string, typeName string) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	poller, err := factory.NewResourceTypesClient().BeginDelete(ctx, radiusPlaneName, resourceProviderNamespace, typeName, nil)
+	if err != nil {
+		return ignoreNotFound(err)
+	}
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	return ignoreNotFound(err)
+}
+
+// GetAzureCredential returns the credential used for Azure, or nil if there is none.
+func (c *ConfigurationClientImpl) GetAzureCredential(ctx context.Context) (*ucpv20231001preview.AzureCredentialResource, error) {
+	factory, err := c.ucp()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory.NewAzureCredentialsClient().Get(ctx, credential.AzurePlaneName, defaultCredentialName, nil)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &response.AzureCredentialResource, nil
+}
+
+// CreateOrUpdateAzureCredential registers the credential used for Azure.
+func (c *ConfigurationClientImpl) CreateOrUpdateAzureCredential(ctx context.Context, resource ucpv20231001preview.AzureCredentialResource) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewAzureCredentialsClient().CreateOrUpdate(ctx, credential.AzurePlaneName, defaultCredentialName, resource, nil)
+	return err
+}
+
+// DeleteAzureCredential deletes the credential used for Azure.
+func (c *ConfigurationClientImpl) DeleteAzureCredential(ctx context.Context) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewAzureCredentialsClient().Delete(ctx, credential.AzurePlaneName, defaultCredentialName, nil)
+	return ignoreNotFound(err)
+}
+
+// GetAWSCredential returns the credential used for AWS, or nil if there is none.
+func (c *ConfigurationClientImpl) GetAWSCredential(ctx context.Context) (*ucpv20231001preview.AwsCredentialResource, error) {
+	factory, err := c.ucp()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory.NewAwsCredentialsClient().Get(ctx, credential.AWSPlaneName, defaultCredentialName, nil)
+	if clients.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &response.AwsCredentialResource, nil
+}
+
+// CreateOrUpdateAWSCredential registers the credential used for AWS.
+func (c *ConfigurationClientImpl) CreateOrUpdateAWSCredential(ctx context.Context, resource ucpv20231001preview.AwsCredentialResource) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewAwsCredentialsClient().CreateOrUpdate(ctx, credential.AWSPlaneName, defaultCredentialName, resource, nil)
+	return err
+}
+
+// DeleteAWSCredential deletes the credential used for AWS.
+func (c *ConfigurationClientImpl) DeleteAWSCredential(ctx context.Context) error {
+	factory, err := c.ucp()
+	if err != nil {
+		return err
+	}
+
+	_, err = factory.NewAwsCredentialsClient().Delete(ctx, credential.AWSPlaneName, defaultCredentialName, nil)
+	return ignoreNotFound(err)
+}
+
+func ignoreNotFound(err error) error {
+	if clients.Is404Error(err) {
+		return nil
+	}
+
+	return err
+}
@@ -22,6 +22,13 @@ const (
 	// PollingDelay is the amount of time to wait between polling for the status of a resource.
 	PollingDelay time.Duration = 5 * time.Second
 
+	// MaxRolloutPollingDelay is the maximum amount of time to wait between checks of the rollout of output resources.
+	MaxRolloutPollingDelay time.Duration = time.Minute
+
+	// RolloutProgressDeadline is the amount of time output resources may take to roll out before a DeploymentTemplate
+	// is marked as stalled.
+	RolloutProgressDeadline time.Duration = 10 * time.Minute
+
 	// AnnotationRadiusEnabled is the name of the annotation that indicates if a Deployment has Radius enabled.
 	AnnotationRadiusEnabled = "radapp.io/enabled"
 
@@ -42,6 +49,14 @@ const (
 	// the namespace of the Deployment will be used as the application name.
 	AnnotationRadiusApplication = "radapp.io/application"
 
+	// AnnotationRadiusRolloutRecipes is the name of the annotation that lists the comma-separated names of the Recipes
+	// whose secret or ConfigMap a Deployment consumes. The Deployment is rolled out when their values change.
+	AnnotationRadiusRolloutRecipes = "radapp.io/rollout-recipes"
+
+	// AnnotationRadiusRecipeOutputsHash is the name of the pod template annotation that holds the hash of the values
+	// of the Recipes consumed by a Deployment. Changing it rolls out the Deployment.
+	AnnotationRadiusRecipeOutputsHash = "radapp.io/recipe-outputs-hash"
+
 	// DeploymentFinalizer is the name of the finalizer added to Deployments.
 	DeploymentFinalizer = "radapp.io/deployment-finalizer"
 
@@ -54,6 +69,40 @@ const (
 	// DeploymentResourceFinalizer is the name of the finalizer added to DeploymentResources.
 	DeploymentResourceFinalizer = "radapp.io/deployment-resource-finalizer"
 
+	// GitSourceFinalizer is the name of the finalizer added to GitSources.
+	GitSourceFinalizer = "radapp.io/git-source-finalizer"
+
+	// EnvironmentFinalizer is the name of the finalizer added to Environments.
+	EnvironmentFinalizer = "radapp.io/environment-finalizer"
+
+	// RecipePackFinalizer is the name of the finalizer added to RecipePacks.
+	RecipePackFinalizer = "radapp.io/recipe-pack-finalizer"
+
+	// ResourceTypeFinalizer is the name of the finalizer added to ResourceTypes.
+	ResourceTypeFinalizer = "radapp.io/resource-type-finalizer"
+
+	// CredentialBindingFinalizer is the name of the finalizer added to CredentialBindings.
+	CredentialBindingFinalizer = "radapp.io/credential-binding-finalizer"
+
+	// PreviewEnvironmentFinalizer is the name of the finalizer added to PreviewEnvironments.
+	PreviewEnvironmentFinalizer = "radapp.io/preview-environment-finalizer"
+
+	// LabelRadiusPreviewEnvironment is the name of the label set on the Environments created for a PreviewEnvironment.
+	// Its value is the name of the PreviewEnvironment.
+	LabelRadiusPreviewEnvironment = "radapp.io/preview-environment"
+
+	// LabelRadiusPreviewOwner is the name of the label set on the namespaces created for the previews of a
+	// PreviewEnvironment. Its value is a hash of the namespace and name of the PreviewEnvironment, since namespaces
+	// are not namespaced themselves. Only namespaces with this label are deployed into and deleted by the previews.
+	LabelRadiusPreviewOwner = "radapp.io/preview-owner"
+
+	// AnnotationGitSourceSyncRequestedAt is the name of the annotation set on a GitSource to request a sync, for
+	// example when a push webhook is received.
+	AnnotationGitSourceSyncRequestedAt = "radapp.io/sync-requested-at"
+
+	// GitSourceDefaultInterval is the default interval between polls of a GitSource repository.
+	GitSourceDefaultInterval = 5 * time.Minute
+
 	// GitRepositoryHttpRetryCount is the number of times to retry GitRepository HTTP requests.
 	GitRepositoryHttpRetryCount = 9
 )
@@ -0,0 +1,502 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/credential"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// credentialBindingSecretField is the name of the index of CredentialBindings by the namespace and name of the
+	// Secret they reference.
+	credentialBindingSecretField = "spec.secretRef"
+
+	// credentialSecretClientSecretKey is the key of the client secret of an Azure service principal.
+	credentialSecretClientSecretKey = "clientSecret"
+
+	// credentialSecretAccessKeyIDKey is the key of the access key ID of an AWS access key.
+	credentialSecretAccessKeyIDKey = "accessKeyId"
+
+	// credentialSecretSecretAccessKeyKey is the key of the secret access key of an AWS access key.
+	credentialSecretSecretAccessKeyKey = "secretAccessKey"
+
+	// ReasonCredentialConflict is the condition reason used when another CredentialBinding already binds the
+	// credential of the provider.
+	ReasonCredentialConflict = "CredentialConflict"
+
+	// credentialBindingTag is the name of the tag set on the credentials registered by a CredentialBinding. Its value
+	// is the name of the CredentialBinding. Credentials without this tag were registered some other way, for example
+	// with 'rad credential register', and are never replaced or deleted by a CredentialBinding.
+	credentialBindingTag = "radapp.io/credential-binding"
+)
+
+// CredentialBindingReconciler reconciles a CredentialBinding object by registering the credential Radius uses for a
+// cloud provider.
+type CredentialBindingReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Configuration is the client for Radius configuration resources.
+	Configuration ConfigurationClient
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=credentialbindings,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=credentialbindings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile is the main reconciliation loop for the CredentialBinding resource.
+func (r *CredentialBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "CredentialBinding", "name", req.Name)
+	ctx = logr.NewContext(ctx, logger)
+
+	binding := radappiov1alpha3.CredentialBinding{}
+	err := r.Client.Get(ctx, req.NamespacedName, &binding)
+	if apierrors.IsNotFound(err) {
+		logger.Info("CredentialBinding is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if binding.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &binding)
+	}
+
+	return r.reconcileUpdate(ctx, &binding)
+}
+
+func (r *CredentialBindingReconciler) reconcileUpdate(ctx context.Context, binding *radappiov1alpha3.CredentialBinding) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if controllerutil.AddFinalizer(binding, CredentialBindingFinalizer) {
+		err := r.Client.Update(ctx, binding)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Radius uses a single credential per provider for the whole cluster, so only the oldest CredentialBinding of a
+	// provider writes it. Other bindings of the provider are rejected until it is deleted.
+	owner, err := r.credentialOwner(ctx, binding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if owner.Name != binding.Name {
+		message := fmt.Sprintf("The %s credential is already bound by CredentialBinding %s. Only one CredentialBinding per provider is supported.", binding.Spec.Provider, owner.Name)
+		logger.Info("Credential is bound by another CredentialBinding.", "owner", owner.Name)
+
+		previous := meta.FindStatusCondition(binding.Status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+		if previous == nil || previous.Reason != ReasonCredentialConflict {
+			r.EventRecorder.Event(binding, corev1.EventTypeWarning, ReasonCredentialConflict, message)
+		}
+
+		binding.Status.Resource = ""
+		markConfigurationNotReady(&binding.Status, binding.Generation, ReasonCredentialConflict, message)
+		return ctrl.Result{}, r.Client.Status().Update(ctx, binding)
+	}
+
+	resourceID, err := r.sync(ctx, binding)
+	if err != nil {
+		logger.Error(err, "Unable to register credential.")
+		r.EventRecorder.Event(binding, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+
+		markConfigurationNotReady(&binding.Status, binding.Generation, ReasonSyncFailed, err.Error())
+		if statusErr := r.Client.Status().Update(ctx, binding); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	changed := markConfigurationSynced(&binding.Status, binding.Generation, resourceID)
+	err = r.Client.Status().Update(ctx, binding)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if changed {
+		r.EventRecorder.Event(binding, corev1.EventTypeNormal, ReasonSynced, fmt.Sprintf("Registered credential %s.", resourceID))
+	}
+
+	logger.Info("Credential is registered.", "resourceId", resourceID)
+	return ctrl.Result{}, nil
+}
+
+// credentialOwner returns the CredentialBinding that writes the credential of the provider of the given binding: the
+// oldest CredentialBinding of the provider in the cluster, including ones that are being deleted.
+func (r *CredentialBindingReconciler) credentialOwner(ctx context.Context, binding *radappiov1alpha3.CredentialBinding) (*radappiov1alpha3.CredentialBinding, error) {
+	bindings := radappiov1alpha3.CredentialBindingList{}
+	err := r.Client.List(ctx, &bindings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CredentialBindings: %w", err)
+	}
+
+	owner := binding
+	for i := range bindings.Items {
+		candidate := &bindings.Items[i]
+		if candidate.Spec.Provider == binding.Spec.Provider && credentialBindingOlder(candidate, owner) {
+			owner = candidate
+		}
+	}
+
+	return owner, nil
+}
+
+// credentialBindingOlder returns true if CredentialBinding a was created before b. Ties are broken by name so that
+// every binding agrees on the owner.
+func credentialBindingOlder(a *radappiov1alpha3.CredentialBinding, b *radappiov1alpha3.CredentialBinding) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+
+	return a.Name < b.Name
+}
+
+// sync registers the credential and returns its resource ID. A credential that was not registered by a
+// CredentialBinding is not replaced.
+func (r *CredentialBindingReconciler) sync(ctx context.Context, binding *radappiov1alpha3.CredentialBinding) (string, error) {
+	tags, exists, err := r.registeredCredentialTags(ctx, binding.Spec.Provider)
+	if err != nil {
+		return "", err
+	}
+
+	if exists && tags[credentialBindingTag] == nil {
+		return "", fmt.Errorf("the %s credential was not registered by a CredentialBinding. Unregister it with 'rad credential unregister %s' to bind it", binding.Spec.Provider, binding.Spec.Provider)
+	}
+
+	secret, err := r.fetchSecret(ctx, binding)
+	if err != nil {
+		return "", err
+	}
+
+	switch binding.Spec.Provider {
+	case radappiov1alpha3.CredentialProviderAzure:
+		resource, err := azureCredentialResource(binding, secret)
+		if err != nil {
+			return "", err
+		}
+
+		return credentialResourceID(binding.Spec.Provider), r.Configuration.CreateOrUpdateAzureCredential(ctx, resource)
+	case radappiov1alpha3.CredentialProviderAWS:
+		resource, err := awsCredentialResource(binding, secret)
+		if err != nil {
+			return "", err
+		}
+
+		return credentialResourceID(binding.Spec.Provider), r.Configuration.CreateOrUpdateAWSCredential(ctx, resource)
+	default:
+		return "", fmt.Errorf("unsupported credential provider: %s", binding.Spec.Provider)
+	}
+}
+
+// registeredCredentialTags returns the tags of the registered credential of the provider, and false if the credential doesn't exist.
+func (r *CredentialBindingReconciler) registeredCredentialTags(ctx context.Context, provider string) (map[string]*string, bool, error) {
+	switch provider {
+	case radappiov1alpha3.CredentialProviderAzure:
+		resource, err := r.Configuration.GetAzureCredential(ctx)
+		if err != nil || resource == nil {
+			return nil, false, err
+		}
+
+		return resource.Tags, true, nil
+	case radappiov1alpha3.CredentialProviderAWS:
+		resource, err := r.Configuration.GetAWSCredential(ctx)
+		if err != nil || resource == nil {
+			return nil, false, err
+		}
+
+		return resource.Tags, true, nil
+	default:
+		return nil, false, fmt.Errorf("unsupported credential provider: %s", provider)
+	}
+}
+
+// fetchSecret returns the data of the secret referenced by the CredentialBinding, or nil if there is none.
+func (r *CredentialBindingReconciler) fetchSecret(ctx context.Context, binding *radappiov1alpha3.CredentialBinding) (map[string][]byte, error) {
+	if binding.Spec.SecretRef == nil || binding.Spec.SecretRef.Name == "" {
+		return nil, nil
+	}
+
+	secret := corev1.Secret{}
+	key := types.NamespacedName{Namespace: binding.Spec.SecretRef.Namespace, Name: binding.Spec.SecretRef.Name}
+	err := r.Client.Get(ctx, key, &secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch secret %q: %w", key.String(), err)
+	}
+
+	return secret.Data, nil
+}
+
+func (r *CredentialBindingReconciler) reconcileDelete(ctx context.Context, binding *radappiov1alpha3.CredentialBinding) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Resource is being deleted.")
+
+	// Only delete the credential if this binding wrote it. Bindings rejected because of a conflict never did, and the
+	// credential may have been registered again some other way since.
+	if binding.Status.Resource != "" && binding.Status.Resource == credentialResourceID(binding.Spec.Provider) {
+		tags, exists, err := r.registeredCredentialTags(ctx, binding.Spec.Provider)
+		if err != nil {
+			logger.Error(err, "Unable to fetch credential.")
+			return ctrl.Result{}, err
+		}
+
+		if !exists || to.String(tags[credentialBindingTag]) != binding.Name {
+			logger.Info("Credential was not registered by the CredentialBinding, skipping its deletion.")
+		} else {
+			switch binding.Spec.Provider {
+			case radappiov1alpha3.CredentialProviderAzure:
+				err = r.Configuration.DeleteAzureCredential(ctx)
+			case radappiov1alpha3.CredentialProviderAWS:
+				err = r.Configuration.DeleteAWSCredential(ctx)
+			}
+			if err != nil {
+				logger.Error(err, "Unable to delete credential.")
+				r.EventRecorder.Event(binding, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(binding, CredentialBindingFinalizer) {
+		err := r.Client.Update(ctx, binding)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("Resource is deleted.")
+	return ctrl.Result{}, nil
+}
+
+// credentialResourceID returns the resource ID of the credential Radius uses for a cloud provider.
+func credentialResourceID(provider string) string {
+	switch provider {
+	case radappiov1alpha3.CredentialProviderAzure:
+		return fmt.Sprintf("/planes/azure/%s/providers/System.Azure/credentials/%s", credential.AzurePlaneName, defaultCredentialName)
+	case radappiov1alpha3.CredentialProviderAWS:
+		return fmt.Sprintf("/planes/aws/%s/providers/System.AWS/credentials/%s", credential.AWSPlaneName, defaultCredentialName)
+	default:
+		return ""
+	}
+}
+
+// azureCredentialResource converts a CredentialBinding to an Azure credential.
+func azureCredentialResource(binding *radappiov1alpha3.CredentialBinding, secret map[string][]byte) (ucpv20231001preview.AzureCredentialResource, error) {
+	storage := &ucpv20231001preview.CredentialStorageProperties{
+		Kind: to.Ptr(ucpv20231001preview.CredentialStorageKindInternal),
+	}
+
+	switch binding.Spec.Kind {
+	case radappiov1alpha3.CredentialKindServicePrincipal:
+		clientSecret, err := secretValue(secret, credentialSecretClientSecretKey)
+		if err != nil {
+			return ucpv20231001preview.AzureCredentialResource{}, err
+		}
+
+		return ucpv20231001preview.AzureCredentialResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Type:     to.Ptr(credential.AzureCredential),
+			Tags:     credentialTags(binding),
+			Properties: &ucpv20231001preview.AzureServicePrincipalProperties{
+				Storage:      storage,
+				TenantID:     to.Ptr(binding.Spec.TenantID),
+				ClientID:     to.Ptr(binding.Spec.ClientID),
+				ClientSecret: to.Ptr(clientSecret),
+			},
+		}, nil
+	case radappiov1alpha3.CredentialKindWorkloadIdentity:
+		return ucpv20231001preview.AzureCredentialResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Type:     to.Ptr(credential.AzureCredential),
+			Tags:     credentialTags(binding),
+			Properties: &ucpv20231001preview.AzureWorkloadIdentityProperties{
+				Storage:  storage,
+				TenantID: to.Ptr(binding.Spec.TenantID),
+				ClientID: to.Ptr(binding.Spec.ClientID),
+			},
+		}, nil
+	default:
+		return ucpv20231001preview.AzureCredentialResource{}, fmt.Errorf("unsupported Azure credential kind: %s", binding.Spec.Kind)
+	}
+}
+
+// awsCredentialResource converts a CredentialBinding to an AWS credential.
+func awsCredentialResource(binding *radappiov1alpha3.CredentialBinding, secret map[string][]byte) (ucpv20231001preview.AwsCredentialResource, error) {
+	storage := &ucpv20231001preview.CredentialStorageProperties{
+		Kind: to.Ptr(ucpv20231001preview.CredentialStorageKindInternal),
+	}
+
+	switch binding.Spec.Kind {
+	case radappiov1alpha3.CredentialKindAccessKey:
+		accessKeyID, err := secretValue(secret, credentialSecretAccessKeyIDKey)
+		if err != nil {
+			return ucpv20231001preview.AwsCredentialResource{}, err
+		}
+
+		secretAccessKey, err := secretValue(secret, credentialSecretSecretAccessKeyKey)
+		if err != nil {
+			return ucpv20231001preview.AwsCredentialResource{}, err
+		}
+
+		return ucpv20231001preview.AwsCredentialResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Type:     to.Ptr(credential.AWSCredential),
+			Tags:     credentialTags(binding),
+			Properties: &ucpv20231001preview.AwsAccessKeyCredentialProperties{
+				Storage:         storage,
+				AccessKeyID:     to.Ptr(accessKeyID),
+				SecretAccessKey: to.Ptr(secretAccessKey),
+			},
+		}, nil
+	case radappiov1alpha3.CredentialKindIRSA:
+		return ucpv20231001preview.AwsCredentialResource{
+			Location: to.Ptr(v1.LocationGlobal),
+			Type:     to.Ptr(credential.AWSCredential),
+			Tags:     credentialTags(binding),
+			Properties: &ucpv20231001preview.AwsIRSACredentialProperties{
+				Storage: storage,
+				RoleARN: to.Ptr(binding.Spec.RoleARN),
+			},
+		}, nil
+	default:
+		return ucpv20231001preview.AwsCredentialResource{}, fmt.Errorf("unsupported AWS credential kind: %s", binding.Spec.Kind)
+	}
+}
+
+// credentialTags returns the tags of the credential registered by a CredentialBinding.
+func credentialTags(binding *radappiov1alpha3.CredentialBinding) map[string]*string {
+	return map[string]*string{credentialBindingTag: to.Ptr(binding.Name)}
+}
+
+// secretValue returns the value of a key of the secret referenced by a CredentialBinding.
+func secretValue(secret map[string][]byte, key string) (string, error) {
+	if secret == nil {
+		return "", fmt.Errorf("a secretRef containing %q is required", key)
+	}
+
+	value, ok := secret[key]
+	if !ok || len(value) == 0 {
+		return "", fmt.Errorf("secret does not contain %q", key)
+	}
+
+	return string(value), nil
+}
+
+// findCredentialBindingsForSecret returns reconcile requests for the CredentialBindings that reference a Secret.
+func (r *CredentialBindingReconciler) findCredentialBindingsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	bindings := &radappiov1alpha3.CredentialBindingList{}
+	options := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(credentialBindingSecretField, obj.GetNamespace()+"/"+obj.GetName()),
+	}
+	err := r.Client.List(ctx, bindings, options)
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, item := range bindings.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: item.GetName()},
+		})
+	}
+	return requests
+}
+
+// findConflictingCredentialBindings returns reconcile requests for the other CredentialBindings of the provider of a
+// deleted CredentialBinding, so that the next one in line takes over the credential.
+func (r *CredentialBindingReconciler) findConflictingCredentialBindings(ctx context.Context, obj client.Object) []reconcile.Request {
+	deleted, ok := obj.(*radappiov1alpha3.CredentialBinding)
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	bindings := &radappiov1alpha3.CredentialBindingList{}
+	err := r.Client.List(ctx, bindings)
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, item := range bindings.Items {
+		if item.Spec.Provider != deleted.Spec.Provider || item.Name == deleted.Name {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: item.GetName()},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CredentialBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &radappiov1alpha3.CredentialBinding{}, credentialBindingSecretField, func(rawObj client.Object) []string {
+		binding := rawObj.(*radappiov1alpha3.CredentialBinding)
+		if binding.Spec.SecretRef == nil || binding.Spec.SecretRef.Name == "" {
+			return nil
+		}
+
+		return []string{binding.Spec.SecretRef.Namespace + "/" + binding.Spec.SecretRef.Name}
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.CredentialBinding{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findCredentialBindingsForSecret), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Watches(&radappiov1alpha3.CredentialBinding{}, handler.EnqueueRequestsFromMapFunc(r.findConflictingCredentialBindings), builder.WithPredicates(predicate.Funcs{
+			CreateFunc:  func(event.CreateEvent) bool { return false },
+			UpdateFunc:  func(event.UpdateEvent) bool { return false },
+			GenericFunc: func(event.GenericEvent) bool { return false },
+		})).
+		Complete(r)
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+func Test_CredentialBindingReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Name: "azure"}
+	binding := &radappiov1alpha3.CredentialBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.CredentialBindingSpec{
+			Provider:  radappiov1alpha3.CredentialProviderAzure,
+			Kind:      radappiov1alpha3.CredentialKindServicePrincipal,
+			TenantID:  "tenant",
+			ClientID:  "client",
+			SecretRef: &radappiov1alpha3.CredentialSecretReference{Namespace: "radius-system", Name: "azure-credentials"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "radius-system", Name: "azure-credentials"},
+		Data:       map[string][]byte{"clientSecret": []byte("secret")},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(binding, secret).
+		WithStatusSubresource(&radappiov1alpha3.CredentialBinding{}).
+		Build()
+
+	configuration := NewMockConfigurationClient(mctrl)
+	reconciler := &CredentialBindingReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Configuration: configuration,
+	}
+
+	var registered *ucpv20231001preview.AzureCredentialResource
+	configuration.EXPECT().GetAzureCredential(gomock.Any()).
+		DoAndReturn(func(context.Context) (*ucpv20231001preview.AzureCredentialResource, error) {
+			return registered, nil
+		}).AnyTimes()
+	configuration.EXPECT().CreateOrUpdateAzureCredential(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, resource ucpv20231001preview.AzureCredentialResource) error {
+			require.Equal(t, "azure", *resource.Tags[credentialBindingTag])
+			registered = &resource
+
+			properties, ok := resource.Properties.(*ucpv20231001preview.AzureServicePrincipalProperties)
+			require.True(t, ok)
+			require.Equal(t, "tenant", *properties.TenantID)
+			require.Equal(t, "client", *properties.ClientID)
+			require.Equal(t, "secret", *properties.ClientSecret)
+			return nil
+		})
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	result := &radappiov1alpha3.CredentialBinding{}
+	require.NoError(t, k8sClient.Get(ctx, name, result))
+	require.Contains(t, result.Finalizers, CredentialBindingFinalizer)
+	require.Equal(t, "/planes/azure/azurecloud/providers/System.Azure/credentials/default", result.Status.Resource)
+	require.True(t, isConfigurationReady(&result.Status, result.Generation))
+
+	// Deleting the CredentialBinding deletes the credential.
+	configuration.EXPECT().DeleteAzureCredential(gomock.Any()).Return(nil)
+	require.NoError(t, k8sClient.Delete(ctx, result))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.CredentialBinding{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_CredentialBindingReconciler_MissingSecretKey(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Name: "aws"}
+	binding := &radappiov1alpha3.CredentialBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.CredentialBindingSpec{
+			Provider:  radappiov1alpha3.CredentialProviderAWS,
+			Kind:      radappiov1alpha3.CredentialKindAccessKey,
+			SecretRef: &radappiov1alpha3.CredentialSecretReference{Namespace: "radius-system", Name: "aws-credentials"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "radius-system", Name: "aws-credentials"},
+		Data:       map[string][]byte{"accessKeyId": []byte("key")},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(binding, secret).
+		WithStatusSubresource(&radappiov1alpha3.CredentialBinding{}).
+		Build()
+
+	configuration := NewMockConfigurationClient(gomock.NewController(t))
+	configuration.EXPECT().GetAWSCredential(gomock.Any()).Return(nil, nil)
+	reconciler := &CredentialBindingReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Configuration: configuration,
+	}
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.ErrorContains(t, err, `secret does not contain "secretAccessKey"`)
+
+	result := &radappiov1alpha3.CredentialBinding{}
+	require.NoError(t, k8sClient.Get(ctx, name, result))
+	condition := meta.FindStatusCondition(result.Status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, ReasonSyncFailed, condition.Reason)
+}
+
+func Test_CredentialBindingReconciler_Conflict(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	makeBinding := func(name string, created time.Time) *radappiov1alpha3.CredentialBinding {
+		return &radappiov1alpha3.CredentialBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1, CreationTimestamp: metav1.NewTime(created)},
+			Spec: radappiov1alpha3.CredentialBindingSpec{
+				Provider: radappiov1alpha3.CredentialProviderAWS,
+				Kind:     radappiov1alpha3.CredentialKindIRSA,
+				RoleARN:  "arn:aws:iam::000:role/" + name,
+			},
+		}
+	}
+
+	now := time.Now().Truncate(time.Second)
+	owner := types.NamespacedName{Name: "team-b"}
+	other := types.NamespacedName{Name: "team-a"}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(makeBinding(owner.Name, now.Add(-time.Hour)), makeBinding(other.Name, now)).
+		WithStatusSubresource(&radappiov1alpha3.CredentialBinding{}).
+		Build()
+
+	configuration := NewMockConfigurationClient(mctrl)
+	reconciler := &CredentialBindingReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Configuration: configuration,
+	}
+
+	// The oldest binding writes the credential.
+	configuration.EXPECT().GetAWSCredential(gomock.Any()).Return(nil, nil)
+	configuration.EXPECT().CreateOrUpdateAWSCredential(gomock.Any(), gomock.Any()).Return(nil)
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: owner})
+	require.NoError(t, err)
+
+	// The other binding is rejected, and doesn't write the credential.
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: other})
+	require.NoError(t, err)
+
+	result := &radappiov1alpha3.CredentialBinding{}
+	require.NoError(t, k8sClient.Get(ctx, other, result))
+	require.Empty(t, result.Status.Resource)
+	condition := meta.FindStatusCondition(result.Status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, metav1.ConditionFalse, condition.Status)
+	require.Equal(t, ReasonCredentialConflict, condition.Reason)
+
+	// Deleting the rejected binding doesn't delete the credential of the owner.
+	require.NoError(t, k8sClient.Delete(ctx, result))
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: other})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, other, &radappiov1alpha3.CredentialBinding{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_CredentialBindingReconciler_UnmanagedCredential(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Name: "aws"}
+	binding := &radappiov1alpha3.CredentialBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.CredentialBindingSpec{
+			Provider: radappiov1alpha3.CredentialProviderAWS,
+			Kind:     radappiov1alpha3.CredentialKindIRSA,
+			RoleARN:  "arn:aws:iam::000:role/radius",
+		},
+		Status: radappiov1alpha3.ConfigurationStatus{
+			Resource: "/planes/aws/aws/providers/System.AWS/credentials/default",
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(binding).
+		WithStatusSubresource(&radappiov1alpha3.CredentialBinding{}).
+		Build()
+
+	// The credential was registered with 'rad credential register', so it has no CredentialBinding tag.
+	configuration := NewMockConfigurationClient(mctrl)
+	configuration.EXPECT().GetAWSCredential(gomock.Any()).Return(&ucpv20231001preview.AwsCredentialResource{}, nil).AnyTimes()
+	reconciler := &CredentialBindingReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Configuration: configuration,
+	}
+
+	// The credential is not replaced.
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.ErrorContains(t, err, "the aws credential was not registered by a CredentialBinding")
+
+	result := &radappiov1alpha3.CredentialBinding{}
+	require.NoError(t, k8sClient.Get(ctx, name, result))
+	condition := meta.FindStatusCondition(result.Status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, ReasonSyncFailed, condition.Reason)
+
+	// The credential is not deleted with the CredentialBinding either.
+	require.NoError(t, k8sClient.Delete(ctx, result))
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.CredentialBinding{})
+	require.True(t, apierrors.IsNotFound(err))
+}
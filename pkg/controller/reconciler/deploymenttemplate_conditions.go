@@ -0,0 +1,292 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azruntime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	// ReasonDeploying is the condition reason used while a deployment is in progress.
+	ReasonDeploying = "Deploying"
+
+	// ReasonDeploymentFailed is the condition reason used when a deployment fails without a more specific error code.
+	ReasonDeploymentFailed = "DeploymentFailed"
+
+	// ReasonInvalidDeploymentTemplate is the condition reason used when the DeploymentTemplate spec cannot be deployed.
+	ReasonInvalidDeploymentTemplate = "InvalidDeploymentTemplate"
+
+	// ReasonResourcesProgressing is the condition reason used while output resources are still rolling out.
+	ReasonResourcesProgressing = "ResourcesProgressing"
+
+	// ReasonResourcesDegraded is the condition reason used when one or more output resources are unhealthy.
+	ReasonResourcesDegraded = "ResourcesDegraded"
+
+	// ReasonResourcesHealthy is the condition reason used when all output resources are healthy.
+	ReasonResourcesHealthy = "ResourcesHealthy"
+
+	// ReasonProgressDeadlineExceeded is the condition reason used when output resources did not finish rolling out
+	// within the progress deadline.
+	ReasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+	// ReasonDeleting is the condition reason used while the DeploymentTemplate is being deleted.
+	ReasonDeleting = "Deleting"
+)
+
+// conditionReasonPattern matches the format required for the reason of a metav1.Condition.
+var conditionReasonPattern = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// invalidDeploymentTemplateError is returned when the DeploymentTemplate spec cannot be deployed. Retrying
+// will not help until the spec is changed.
+type invalidDeploymentTemplateError struct {
+	err error
+}
+
+func (e *invalidDeploymentTemplateError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidDeploymentTemplateError) Unwrap() error {
+	return e.err
+}
+
+// setDeploymentTemplateCondition sets a condition on the DeploymentTemplate and returns true if the status
+// of the condition changed.
+func setDeploymentTemplateCondition(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, conditionType string, status metav1.ConditionStatus, reason string, message string) bool {
+	// The condition is updated in place, so capture the previous status first.
+	var previous *metav1.ConditionStatus
+	if condition := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, conditionType); condition != nil {
+		previous = new(condition.Status)
+	}
+
+	meta.SetStatusCondition(&deploymentTemplate.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: deploymentTemplate.Generation,
+	})
+
+	return previous == nil || *previous != status
+}
+
+// markDeploying updates the conditions of the DeploymentTemplate when a deployment has been started.
+func markDeploying(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) {
+	message := "Deploying template."
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, ReasonDeploying, message)
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionTrue, ReasonDeploying, message)
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionStalled, metav1.ConditionFalse, ReasonDeploying, message)
+}
+
+// markDeploymentFailed updates the conditions of the DeploymentTemplate when a deployment has failed. The
+// deployment will be retried, so the DeploymentTemplate is degraded rather than stalled. Returns true if the
+// status of a condition changed.
+func markDeploymentFailed(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, reason string, message string) bool {
+	changed := setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, reason, message)
+	changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionFalse, reason, message) || changed
+	return setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDegraded, metav1.ConditionTrue, reason, message) || changed
+}
+
+// markStalled updates the conditions of the DeploymentTemplate when it will not make progress until its spec
+// changes. Returns true if the status of a condition changed.
+func markStalled(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, reason string, message string) bool {
+	changed := setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, reason, message)
+	changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionFalse, reason, message) || changed
+	return setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionStalled, metav1.ConditionTrue, reason, message) || changed
+}
+
+// markDeleting updates the conditions of the DeploymentTemplate when it is being deleted.
+func markDeleting(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) {
+	message := "Deleting output resources."
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, ReasonDeleting, message)
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionTrue, ReasonDeleting, message)
+}
+
+// markHealth updates the conditions of the DeploymentTemplate based on the health of its output resources
+// after a successful deployment. Output resources that are still rolling out after the progress deadline, measured
+// from when the DeploymentTemplate started progressing, stall the DeploymentTemplate. Returns true if the status of
+// the Ready, Degraded or Stalled condition changed.
+func markHealth(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, summary []radappiov1alpha3.OutputResourceHealth, now time.Time, progressDeadline time.Duration) bool {
+	deploymentTemplate.Status.Resources = summary
+
+	degraded := []string{}
+	progressing := []string{}
+	for _, resource := range summary {
+		switch resource.Health {
+		case radappiov1alpha3.ResourceHealthDegraded:
+			degraded = append(degraded, healthMessage(resource))
+		case radappiov1alpha3.ResourceHealthProgressing:
+			progressing = append(progressing, healthMessage(resource))
+		}
+	}
+
+	if len(degraded) > 0 {
+		message := strings.Join(degraded, "; ")
+		changed := setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionStalled, metav1.ConditionFalse, ReasonResourcesDegraded, "")
+		changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, ReasonResourcesDegraded, message) || changed
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionFalse, ReasonResourcesDegraded, message)
+		return setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDegraded, metav1.ConditionTrue, ReasonResourcesDegraded, message) || changed
+	}
+
+	changed := setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDegraded, metav1.ConditionFalse, ReasonResourcesHealthy, "")
+
+	if len(progressing) > 0 {
+		message := strings.Join(progressing, "; ")
+		if progressDeadlineExceeded(deploymentTemplate, now, progressDeadline) {
+			message = fmt.Sprintf("Output resources did not finish rolling out within %s: %s", progressDeadline, message)
+			return markStalled(deploymentTemplate, ReasonProgressDeadlineExceeded, message) || changed
+		}
+
+		changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionStalled, metav1.ConditionFalse, ReasonResourcesProgressing, "") || changed
+		changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionFalse, ReasonResourcesProgressing, message) || changed
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionTrue, ReasonResourcesProgressing, message)
+		return changed
+	}
+
+	message := fmt.Sprintf("Deployed %d output resources.", len(summary))
+	changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionStalled, metav1.ConditionFalse, ReasonResourcesHealthy, "") || changed
+	changed = setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionReady, metav1.ConditionTrue, ReasonResourcesHealthy, message) || changed
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionProgressing, metav1.ConditionFalse, ReasonResourcesHealthy, message)
+	return changed
+}
+
+// progressDeadlineExceeded returns true if the DeploymentTemplate has been progressing for longer than the progress
+// deadline, or already stalled because of it.
+func progressDeadlineExceeded(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, now time.Time, progressDeadline time.Duration) bool {
+	stalled := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled)
+	if stalled != nil && stalled.Status == metav1.ConditionTrue && stalled.Reason == ReasonProgressDeadlineExceeded {
+		return true
+	}
+
+	progressing := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing)
+	return progressing != nil && progressing.Status == metav1.ConditionTrue && now.Sub(progressing.LastTransitionTime.Time) > progressDeadline
+}
+
+func healthMessage(resource radappiov1alpha3.OutputResourceHealth) string {
+	if resource.Message == "" {
+		return resource.Id
+	}
+
+	return resource.Id + ": " + resource.Message
+}
+
+// deploymentFailureReason extracts a condition reason and message from the error returned by a failed
+// ARM deployment. The reason is the code of the innermost error detail, which usually identifies the
+// underlying failure (for example a recipe or resource provisioning failure) rather than the generic
+// "DeploymentFailed" wrapper.
+func deploymentFailureReason(err error) (string, string) {
+	details := deploymentErrorDetails(err)
+	if details == nil {
+		return ReasonDeploymentFailed, err.Error()
+	}
+
+	leaves := []*v1.ErrorDetails{}
+	collectErrorLeaves(details, &leaves)
+
+	reason := ReasonDeploymentFailed
+	messages := []string{}
+	for _, leaf := range leaves {
+		if reason == ReasonDeploymentFailed && conditionReasonPattern.MatchString(leaf.Code) {
+			reason = leaf.Code
+		}
+
+		message := leaf.Message
+		if message == "" {
+			message = leaf.Code
+		}
+		if leaf.Target != "" {
+			message = leaf.Target + ": " + message
+		}
+		if message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	if len(messages) == 0 {
+		return reason, err.Error()
+	}
+
+	return reason, strings.Join(messages, "; ")
+}
+
+// deploymentErrorDetails returns the ARM error details for the given error, or nil if the error does not
+// carry any.
+func deploymentErrorDetails(err error) *v1.ErrorDetails {
+	details := &v1.ErrorDetails{}
+	if errors.As(err, &details) {
+		return details
+	}
+
+	responseError := &azcore.ResponseError{}
+	if errors.As(err, &responseError) {
+		if responseError.RawResponse != nil {
+			payload, readErr := azruntime.Payload(responseError.RawResponse)
+			if readErr == nil {
+				if parsed := parseErrorDetails(payload); parsed != nil {
+					return parsed
+				}
+			}
+		}
+
+		if responseError.ErrorCode != "" {
+			return &v1.ErrorDetails{Code: responseError.ErrorCode, Message: err.Error()}
+		}
+
+		return nil
+	}
+
+	return parseErrorDetails([]byte(err.Error()))
+}
+
+// parseErrorDetails parses an ARM error response or operation status payload.
+func parseErrorDetails(payload []byte) *v1.ErrorDetails {
+	body := struct {
+		Error *v1.ErrorDetails `json:"error"`
+	}{}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil
+	}
+
+	return body.Error
+}
+
+func collectErrorLeaves(details *v1.ErrorDetails, leaves *[]*v1.ErrorDetails) {
+	children := 0
+	for _, child := range details.Details {
+		if child != nil {
+			children++
+			collectErrorLeaves(child, leaves)
+		}
+	}
+
+	if children == 0 {
+		*leaves = append(*leaves, details)
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func Test_deploymentFailureReason(t *testing.T) {
+	payload := `{"error":{"code":"DeploymentFailed","message":"At least one resource deployment operation failed.","details":[{"code":"RecipeDeploymentFailed","message":"recipe failed to deploy","target":"/planes/radius/local/resourceGroups/default/providers/Applications.Datastores/redisCaches/cache"}]}}`
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:            "plain error",
+			err:             errors.New("failure"),
+			expectedReason:  ReasonDeploymentFailed,
+			expectedMessage: "failure",
+		},
+		{
+			name: "error details",
+			err: &v1.ErrorDetails{
+				Code:    "DeploymentFailed",
+				Message: "outer",
+				Details: []*v1.ErrorDetails{
+					{Code: "ResourceDeploymentFailure", Message: "first"},
+					{Code: "Conflict", Message: "second", Target: "target"},
+				},
+			},
+			expectedReason:  "ResourceDeploymentFailure",
+			expectedMessage: "first; target: second",
+		},
+		{
+			name:            "wrapped error details",
+			err:             fmt.Errorf("deployment failed: %w", &v1.ErrorDetails{Code: "BadRequest", Message: "invalid"}),
+			expectedReason:  "BadRequest",
+			expectedMessage: "invalid",
+		},
+		{
+			name: "response error",
+			err: &azcore.ResponseError{
+				ErrorCode:  "DeploymentFailed",
+				StatusCode: http.StatusBadRequest,
+				RawResponse: &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(bytes.NewBufferString(payload)),
+				},
+			},
+			expectedReason:  "RecipeDeploymentFailed",
+			expectedMessage: "/planes/radius/local/resourceGroups/default/providers/Applications.Datastores/redisCaches/cache: recipe failed to deploy",
+		},
+		{
+			name:            "json error",
+			err:             errors.New(payload),
+			expectedReason:  "RecipeDeploymentFailed",
+			expectedMessage: "/planes/radius/local/resourceGroups/default/providers/Applications.Datastores/redisCaches/cache: recipe failed to deploy",
+		},
+		{
+			name:            "invalid reason",
+			err:             &v1.ErrorDetails{Code: "not a reason", Message: "invalid"},
+			expectedReason:  ReasonDeploymentFailed,
+			expectedMessage: "invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, message := deploymentFailureReason(tt.err)
+			require.Equal(t, tt.expectedReason, reason)
+			require.Equal(t, tt.expectedMessage, message)
+		})
+	}
+}
+
+func Test_markHealth(t *testing.T) {
+	tests := []struct {
+		name             string
+		summary          []radappiov1alpha3.OutputResourceHealth
+		expectedReady    metav1.ConditionStatus
+		expectedReason   string
+		expectedDegraded bool
+		progressing      bool
+	}{
+		{
+			name:           "no resources",
+			expectedReady:  metav1.ConditionTrue,
+			expectedReason: ReasonResourcesHealthy,
+		},
+		{
+			name: "healthy",
+			summary: []radappiov1alpha3.OutputResourceHealth{
+				{Id: "a", Health: radappiov1alpha3.ResourceHealthHealthy},
+				{Id: "b", Health: radappiov1alpha3.ResourceHealthUnknown},
+			},
+			expectedReady:  metav1.ConditionTrue,
+			expectedReason: ReasonResourcesHealthy,
+		},
+		{
+			name: "progressing",
+			summary: []radappiov1alpha3.OutputResourceHealth{
+				{Id: "a", Health: radappiov1alpha3.ResourceHealthHealthy},
+				{Id: "b", Health: radappiov1alpha3.ResourceHealthProgressing, Message: "rolling out"},
+			},
+			expectedReady:  metav1.ConditionFalse,
+			expectedReason: ReasonResourcesProgressing,
+			progressing:    true,
+		},
+		{
+			name: "degraded",
+			summary: []radappiov1alpha3.OutputResourceHealth{
+				{Id: "a", Health: radappiov1alpha3.ResourceHealthDegraded, Message: "failed"},
+				{Id: "b", Health: radappiov1alpha3.ResourceHealthProgressing},
+			},
+			expectedReady:    metav1.ConditionFalse,
+			expectedReason:   ReasonResourcesDegraded,
+			expectedDegraded: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{ObjectMeta: metav1.ObjectMeta{Generation: 3}}
+			markDeploying(deploymentTemplate)
+
+			changed := markHealth(deploymentTemplate, tt.summary, time.Now(), time.Minute)
+			require.True(t, changed)
+			require.Equal(t, tt.summary, deploymentTemplate.Status.Resources)
+
+			// Marking the same health again doesn't change the conditions.
+			require.False(t, markHealth(deploymentTemplate, tt.summary, time.Now(), time.Minute))
+
+			ready := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionReady)
+			require.NotNil(t, ready)
+			require.Equal(t, tt.expectedReady, ready.Status)
+			require.Equal(t, tt.expectedReason, ready.Reason)
+			require.Equal(t, int64(3), ready.ObservedGeneration)
+
+			require.Equal(t, tt.progressing, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing))
+			require.Equal(t, tt.expectedDegraded, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionDegraded))
+			require.True(t, meta.IsStatusConditionFalse(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled))
+		})
+	}
+}
+
+func Test_markHealth_ProgressDeadline(t *testing.T) {
+	summary := []radappiov1alpha3.OutputResourceHealth{
+		{Id: "a", Health: radappiov1alpha3.ResourceHealthProgressing, Message: "rolling out"},
+	}
+
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{}
+	markDeploying(deploymentTemplate)
+	markHealth(deploymentTemplate, summary, time.Now(), time.Minute)
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing))
+
+	// The deadline is measured from when the DeploymentTemplate started progressing.
+	changed := markHealth(deploymentTemplate, summary, time.Now().Add(2*time.Minute), time.Minute)
+	require.True(t, changed)
+	require.True(t, meta.IsStatusConditionFalse(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing))
+	stalled := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled)
+	require.Equal(t, metav1.ConditionTrue, stalled.Status)
+	require.Equal(t, ReasonProgressDeadlineExceeded, stalled.Reason)
+
+	// Stays stalled while the resources are still rolling out.
+	require.False(t, markHealth(deploymentTemplate, summary, time.Now().Add(3*time.Minute), time.Minute))
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled))
+
+	// Recovers once the resources are healthy.
+	summary[0].Health = radappiov1alpha3.ResourceHealthHealthy
+	require.True(t, markHealth(deploymentTemplate, summary, time.Now().Add(4*time.Minute), time.Minute))
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionReady))
+	require.True(t, meta.IsStatusConditionFalse(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled))
+}
+
+func Test_markDeploymentFailed(t *testing.T) {
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{}
+	markDeploying(deploymentTemplate)
+	markDeploymentFailed(deploymentTemplate, "Conflict", "resource is busy")
+
+	ready := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionReady)
+	require.Equal(t, metav1.ConditionFalse, ready.Status)
+	require.Equal(t, "Conflict", ready.Reason)
+	require.Equal(t, "resource is busy", ready.Message)
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionDegraded))
+	require.True(t, meta.IsStatusConditionFalse(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing))
+
+	markStalled(deploymentTemplate, ReasonInvalidDeploymentTemplate, "invalid template")
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled))
+
+	markDeploying(deploymentTemplate)
+	require.True(t, meta.IsStatusConditionFalse(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled))
+	require.True(t, meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing))
+}
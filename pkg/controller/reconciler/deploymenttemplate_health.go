@@ -0,0 +1,247 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// summarizeHealth computes the health of each output resource of the DeploymentTemplate.
+//
+// The health of a Radius resource is derived from its provisioning state (which also reflects the outcome of
+// recipe execution), and from the rollout status of any Kubernetes Deployments and StatefulSets listed in its output
+// resources. Resources outside of the Radius plane are reported as healthy once the deployment has succeeded.
+//
+// While output resources are rolling out, resources that were healthy on the previous pass are not fetched again.
+func (r *DeploymentTemplateReconciler) summarizeHealth(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) []radappiov1alpha3.OutputResourceHealth {
+	if len(deploymentTemplate.Status.OutputResources) == 0 {
+		return nil
+	}
+
+	healthy := map[string]bool{}
+	progressing := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing)
+	if progressing != nil && progressing.Status == metav1.ConditionTrue && progressing.Reason == ReasonResourcesProgressing {
+		for _, resource := range deploymentTemplate.Status.Resources {
+			if resource.Health == radappiov1alpha3.ResourceHealthHealthy {
+				healthy[resource.Id] = true
+			}
+		}
+	}
+
+	summary := make([]radappiov1alpha3.OutputResourceHealth, 0, len(deploymentTemplate.Status.OutputResources))
+	for _, id := range deploymentTemplate.Status.OutputResources {
+		if healthy[id] {
+			summary = append(summary, radappiov1alpha3.OutputResourceHealth{Id: id, Health: radappiov1alpha3.ResourceHealthHealthy})
+			continue
+		}
+
+		health, message := r.outputResourceHealth(ctx, id)
+		summary = append(summary, radappiov1alpha3.OutputResourceHealth{Id: id, Health: health, Message: message})
+	}
+
+	return summary
+}
+
+// outputResourceHealth computes the health of a single output resource.
+func (r *DeploymentTemplateReconciler) outputResourceHealth(ctx context.Context, resourceID string) (radappiov1alpha3.ResourceHealth, string) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return radappiov1alpha3.ResourceHealthUnknown, fmt.Sprintf("invalid resource id: %s", err.Error())
+	}
+
+	if id.FindScope(resources_radius.PlaneTypeRadius) == "" || len(id.TypeSegments()) != 1 {
+		return radappiov1alpha3.ResourceHealthHealthy, ""
+	}
+
+	if r.Radius == nil {
+		return radappiov1alpha3.ResourceHealthUnknown, "health is not available"
+	}
+
+	response, err := r.Radius.Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
+	if clients.Is404Error(err) {
+		return radappiov1alpha3.ResourceHealthDegraded, "resource was not found"
+	} else if err != nil {
+		return radappiov1alpha3.ResourceHealthUnknown, fmt.Sprintf("failed to fetch resource: %s", err.Error())
+	}
+
+	health, message := provisioningStateHealth(response.Properties)
+	if health != radappiov1alpha3.ResourceHealthHealthy {
+		return health, message
+	}
+
+	for _, workloadID := range kubernetesWorkloadOutputResources(response.Properties) {
+		health, message := r.workloadRolloutHealth(ctx, workloadID)
+		if health != radappiov1alpha3.ResourceHealthHealthy {
+			return health, message
+		}
+	}
+
+	return radappiov1alpha3.ResourceHealthHealthy, ""
+}
+
+// provisioningStateHealth maps the provisioning state of a Radius resource to a health value.
+func provisioningStateHealth(properties map[string]any) (radappiov1alpha3.ResourceHealth, string) {
+	state, _ := properties["provisioningState"].(string)
+
+	_, hasRecipe := properties["recipe"]
+	subject := "resource"
+	if hasRecipe {
+		subject = "recipe"
+	}
+
+	switch {
+	case state == "" || strings.EqualFold(state, "Succeeded"):
+		return radappiov1alpha3.ResourceHealthHealthy, ""
+	case strings.EqualFold(state, "Failed"), strings.EqualFold(state, "Canceled"):
+		return radappiov1alpha3.ResourceHealthDegraded, fmt.Sprintf("%s provisioning state is %s", subject, state)
+	default:
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("%s provisioning state is %s", subject, state)
+	}
+}
+
+// kubernetesWorkloadOutputResources returns the ids of Kubernetes Deployments and StatefulSets listed in the output
+// resources of a Radius resource.
+func kubernetesWorkloadOutputResources(properties map[string]any) []resources.ID {
+	status, _ := properties["status"].(map[string]any)
+	outputResources, _ := status["outputResources"].([]any)
+
+	ids := []resources.ID{}
+	for _, outputResource := range outputResources {
+		entry, _ := outputResource.(map[string]any)
+		value, _ := entry["id"].(string)
+		id, err := resources.ParseResource(value)
+		if err != nil || id.FindScope(resources_kubernetes.PlaneTypeKubernetes) == "" {
+			continue
+		}
+
+		group, kind, _, _ := resources_kubernetes.ToParts(id)
+		if strings.EqualFold(group, appsv1.GroupName) && (strings.EqualFold(kind, resources_kubernetes.KindDeployment) || strings.EqualFold(kind, resources_kubernetes.KindStatefulSet)) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// workloadRolloutHealth computes the rollout health of a Kubernetes Deployment or StatefulSet, following the same
+// rules as `kubectl rollout status`.
+func (r *DeploymentTemplateReconciler) workloadRolloutHealth(ctx context.Context, id resources.ID) (radappiov1alpha3.ResourceHealth, string) {
+	_, kind, namespace, name := resources_kubernetes.ToParts(id)
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+
+	if strings.EqualFold(kind, resources_kubernetes.KindStatefulSet) {
+		statefulSet := appsv1.StatefulSet{}
+		err := r.Client.Get(ctx, key, &statefulSet)
+		if apierrors.IsNotFound(err) {
+			return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("waiting for statefulset %q to be created", name)
+		} else if err != nil {
+			return radappiov1alpha3.ResourceHealthUnknown, fmt.Sprintf("failed to fetch statefulset %q: %s", name, err.Error())
+		}
+
+		return statefulSetRolloutHealth(&statefulSet)
+	}
+
+	deployment := appsv1.Deployment{}
+	err := r.Client.Get(ctx, key, &deployment)
+	if apierrors.IsNotFound(err) {
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("waiting for deployment %q to be created", name)
+	} else if err != nil {
+		return radappiov1alpha3.ResourceHealthUnknown, fmt.Sprintf("failed to fetch deployment %q: %s", name, err.Error())
+	}
+
+	return rolloutHealth(&deployment)
+}
+
+// rolloutHealth computes the rollout health of a Kubernetes Deployment.
+func rolloutHealth(deployment *appsv1.Deployment) (radappiov1alpha3.ResourceHealth, string) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("waiting for deployment %q spec update to be observed", deployment.Name)
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+			return radappiov1alpha3.ResourceHealthDegraded, fmt.Sprintf("deployment %q exceeded its progress deadline", deployment.Name)
+		}
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	switch {
+	case deployment.Status.UpdatedReplicas < replicas:
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("deployment %q rollout: %d out of %d new replicas have been updated", deployment.Name, deployment.Status.UpdatedReplicas, replicas)
+	case deployment.Status.Replicas > deployment.Status.UpdatedReplicas:
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("deployment %q rollout: %d old replicas are pending termination", deployment.Name, deployment.Status.Replicas-deployment.Status.UpdatedReplicas)
+	case deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas:
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("deployment %q rollout: %d of %d updated replicas are available", deployment.Name, deployment.Status.AvailableReplicas, deployment.Status.UpdatedReplicas)
+	}
+
+	return radappiov1alpha3.ResourceHealthHealthy, ""
+}
+
+// statefulSetRolloutHealth computes the rollout health of a Kubernetes StatefulSet. StatefulSets using the OnDelete
+// update strategy are not rolled out by the controller, so they are healthy once their pods are ready.
+func statefulSetRolloutHealth(statefulSet *appsv1.StatefulSet) (radappiov1alpha3.ResourceHealth, string) {
+	if statefulSet.Generation > statefulSet.Status.ObservedGeneration {
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("waiting for statefulset %q spec update to be observed", statefulSet.Name)
+	}
+
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+
+	if statefulSet.Status.ReadyReplicas < replicas {
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("statefulset %q rollout: %d of %d replicas are ready", statefulSet.Name, statefulSet.Status.ReadyReplicas, replicas)
+	}
+
+	if statefulSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return radappiov1alpha3.ResourceHealthHealthy, ""
+	}
+
+	// Only the replicas at or above the partition are updated by a partitioned rolling update.
+	if rollingUpdate := statefulSet.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil && *rollingUpdate.Partition > 0 {
+		expected := replicas - *rollingUpdate.Partition
+		if statefulSet.Status.UpdatedReplicas < expected {
+			return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("statefulset %q rollout: %d of %d new replicas have been updated", statefulSet.Name, statefulSet.Status.UpdatedReplicas, expected)
+		}
+
+		return radappiov1alpha3.ResourceHealthHealthy, ""
+	}
+
+	if statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision {
+		return radappiov1alpha3.ResourceHealthProgressing, fmt.Sprintf("statefulset %q rollout: %d of %d new replicas have been updated", statefulSet.Name, statefulSet.Status.UpdatedReplicas, replicas)
+	}
+
+	return radappiov1alpha3.ResourceHealthHealthy, ""
+}
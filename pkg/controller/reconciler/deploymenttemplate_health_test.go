@@ -0,0 +1,253 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func makeRolloutDeployment(name string, replicas int32, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default-app", Name: name, Generation: 1},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     status,
+	}
+}
+
+func Test_rolloutHealth(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		expected   radappiov1alpha3.ResourceHealth
+	}{
+		{
+			name:       "available",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			expected:   radappiov1alpha3.ResourceHealthHealthy,
+		},
+		{
+			name:       "generation not observed",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{ObservedGeneration: 0, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			expected:   radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:       "updating replicas",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2}),
+			expected:   radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:       "old replicas terminating",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 2, AvailableReplicas: 2}),
+			expected:   radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:       "replicas unavailable",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 1}),
+			expected:   radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: makeRolloutDeployment("app", 2, appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Reason: "ProgressDeadlineExceeded"},
+				},
+			}),
+			expected: radappiov1alpha3.ResourceHealthDegraded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, _ := rolloutHealth(tt.deployment)
+			require.Equal(t, tt.expected, health)
+		})
+	}
+}
+
+func Test_statefulSetRolloutHealth(t *testing.T) {
+	makeStatefulSet := func(replicas int32, strategy appsv1.StatefulSetUpdateStrategy, status appsv1.StatefulSetStatus) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default-app", Name: "db", Generation: 1},
+			Spec:       appsv1.StatefulSetSpec{Replicas: &replicas, UpdateStrategy: strategy},
+			Status:     status,
+		}
+	}
+
+	rollingUpdate := appsv1.StatefulSetUpdateStrategy{Type: appsv1.RollingUpdateStatefulSetStrategyType}
+	partitioned := appsv1.StatefulSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: new(int32(2))},
+	}
+
+	tests := []struct {
+		name        string
+		statefulSet *appsv1.StatefulSet
+		expected    radappiov1alpha3.ResourceHealth
+	}{
+		{
+			name:        "rolled out",
+			statefulSet: makeStatefulSet(3, rollingUpdate, appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 3, CurrentRevision: "v2", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthHealthy,
+		},
+		{
+			name:        "generation not observed",
+			statefulSet: makeStatefulSet(3, rollingUpdate, appsv1.StatefulSetStatus{ObservedGeneration: 0, ReadyReplicas: 3, CurrentRevision: "v2", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:        "replicas not ready",
+			statefulSet: makeStatefulSet(3, rollingUpdate, appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 2, CurrentRevision: "v2", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:        "rolling update in progress",
+			statefulSet: makeStatefulSet(3, rollingUpdate, appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "v1", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthProgressing,
+		},
+		{
+			name:        "partitioned update complete",
+			statefulSet: makeStatefulSet(3, partitioned, appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, UpdatedReplicas: 1, CurrentRevision: "v1", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthHealthy,
+		},
+		{
+			name:        "on delete",
+			statefulSet: makeStatefulSet(3, appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}, appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 3, CurrentRevision: "v1", UpdateRevision: "v2"}),
+			expected:    radappiov1alpha3.ResourceHealthHealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, _ := statefulSetRolloutHealth(tt.statefulSet)
+			require.Equal(t, tt.expected, health)
+		})
+	}
+}
+
+func Test_DeploymentTemplateReconciler_SummarizeHealth(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(
+			makeRolloutDeployment("frontend", 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+			makeRolloutDeployment("backend", 1, appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 0}),
+		).
+		Build()
+
+	scope := "/planes/radius/local/resourceGroups/default"
+	deploymentID := func(name string) map[string]any {
+		return map[string]any{"id": "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/" + name}
+	}
+
+	radius := NewMockRadiusClient()
+	radius.Update(func() {
+		radius.resources[scope+"/providers/Applications.Core/containers/frontend"] = generated.GenericResource{
+			Properties: map[string]any{
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{deploymentID("frontend")}},
+			},
+		}
+		radius.resources[scope+"/providers/Applications.Core/containers/backend"] = generated.GenericResource{
+			Properties: map[string]any{
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"outputResources": []any{deploymentID("backend")}},
+			},
+		}
+		radius.resources[scope+"/providers/Applications.Datastores/redisCaches/cache"] = generated.GenericResource{
+			Properties: map[string]any{
+				"provisioningState": "Failed",
+				"recipe":            map[string]any{"name": "default"},
+			},
+		}
+	})
+
+	reconciler := &DeploymentTemplateReconciler{Client: k8sClient, Radius: radius}
+
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+		Status: radappiov1alpha3.DeploymentTemplateStatus{
+			OutputResources: []string{
+				scope + "/providers/Applications.Core/containers/frontend",
+				scope + "/providers/Applications.Core/containers/backend",
+				scope + "/providers/Applications.Datastores/redisCaches/cache",
+				scope + "/providers/Applications.Core/containers/missing",
+				"/planes/aws/aws/accounts/000/regions/us-west-2/providers/AWS.S3/Bucket/bucket",
+			},
+		},
+	}
+
+	summary := reconciler.summarizeHealth(ctx, deploymentTemplate)
+	require.Len(t, summary, 5)
+
+	expected := []radappiov1alpha3.ResourceHealth{
+		radappiov1alpha3.ResourceHealthHealthy,
+		radappiov1alpha3.ResourceHealthProgressing,
+		radappiov1alpha3.ResourceHealthDegraded,
+		radappiov1alpha3.ResourceHealthDegraded,
+		radappiov1alpha3.ResourceHealthHealthy,
+	}
+	for i, health := range expected {
+		require.Equal(t, deploymentTemplate.Status.OutputResources[i], summary[i].Id)
+		require.Equal(t, health, summary[i].Health, summary[i].Message)
+	}
+
+	require.Equal(t, "recipe provisioning state is Failed", summary[2].Message)
+}
+
+func Test_DeploymentTemplateReconciler_SummarizeHealth_SkipsHealthyWhileProgressing(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	scope := "/planes/radius/local/resourceGroups/default"
+	healthyID := scope + "/providers/Applications.Core/containers/frontend"
+	progressingID := scope + "/providers/Applications.Core/containers/backend"
+
+	// Neither resource exists, so fetching either one would report it as degraded.
+	reconciler := &DeploymentTemplateReconciler{Radius: NewMockRadiusClient()}
+
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+		Status: radappiov1alpha3.DeploymentTemplateStatus{
+			OutputResources: []string{healthyID, progressingID},
+			Resources: []radappiov1alpha3.OutputResourceHealth{
+				{Id: healthyID, Health: radappiov1alpha3.ResourceHealthHealthy},
+				{Id: progressingID, Health: radappiov1alpha3.ResourceHealthProgressing},
+			},
+		},
+	}
+	markHealth(deploymentTemplate, deploymentTemplate.Status.Resources, time.Now(), time.Minute)
+
+	summary := reconciler.summarizeHealth(ctx, deploymentTemplate)
+	require.Equal(t, radappiov1alpha3.ResourceHealthHealthy, summary[0].Health)
+	require.Equal(t, radappiov1alpha3.ResourceHealthDegraded, summary[1].Health)
+}
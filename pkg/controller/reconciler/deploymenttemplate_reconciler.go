@@ -21,10 +21,12 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -61,6 +63,10 @@ type DeploymentTemplateReconciler struct {
 
 	// DelayInterval is the amount of time to wait between operations.
 	DelayInterval time.Duration
+
+	// ProgressDeadline is the amount of time output resources may take to roll out before the DeploymentTemplate
+	// is marked as stalled. Defaults to RolloutProgressDeadline.
+	ProgressDeadline time.Duration
 }
 
 // Reconcile is the main reconciliation loop for the DeploymentTemplate resource.
@@ -95,7 +101,7 @@ func (r *DeploymentTemplateReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// 	2. Once the dependent resources are deleted, remove the `radapp.io/deployment-template-finalizer` finalizer from the `DeploymentTemplate`.
 	// 3. If the `DeploymentTemplate` is not being deleted then process this as a create or update:
 	// 	1. Add the `radapp.io/deployment-template-finalizer` finalizer onto the `DeploymentTemplate` resource.
-	// 	2. Check if the desired state of the `DeploymentTemplate` resource matches the observed state. If it does, then the resource is up-to-date and we can continue processing.
+	// 	2. Check if the desired state of the `DeploymentTemplate` resource matches the observed state. If it does, then summarize the health of the output resources in `status.resources` and `status.conditions`, requeueing while any of them are still progressing.
 	// 	3. Otherwise, queue a PUT operation against the Radius API to deploy the ARM JSON in the `spec.template` field with the parameters in the `spec.parameters` field.
 	// 	4. Set the `status.phrase` for the `DeploymentTemplate` to `Updating` and the `status.operation` to the operation returned by the Radius API.
 	// 	5. Continue processing.
@@ -159,9 +165,11 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 			}
 
 			// Operation failed, reset state and schedule delayed retry.
-			r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
-			logger.Error(err, "Update failed.")
+			reason, message := deploymentFailureReason(err)
+			r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "DeploymentFailed", message)
+			logger.Error(err, "Update failed.", "reason", reason)
 
+			markDeploymentFailed(deploymentTemplate, reason, message)
 			if err := r.updateFailedStatus(ctx, deploymentTemplate); err != nil {
 				return ctrl.Result{}, err
 			}
@@ -280,6 +288,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 			return ctrl.Result{}, err
 		}
 
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "DeploymentSucceeded", fmt.Sprintf("Deployed template with %d output resources.", len(outputResources)))
 		return ctrl.Result{}, nil
 	}
 
@@ -320,7 +329,20 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 	updatePoller, err := r.startPutOperationIfNeeded(ctx, deploymentTemplate)
 	if err != nil {
 		logger.Error(err, "Unable to create or update resource.")
-		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
+
+		// The request is retried with backoff, only emit an event when the conditions change.
+		changed := false
+		invalid := &invalidDeploymentTemplateError{}
+		if errors.As(err, &invalid) {
+			changed = markStalled(deploymentTemplate, ReasonInvalidDeploymentTemplate, err.Error())
+		} else {
+			reason, message := deploymentFailureReason(err)
+			changed = markDeploymentFailed(deploymentTemplate, reason, message)
+		}
+
+		if changed {
+			r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
+		}
 
 		if statusErr := r.updateFailedStatus(ctx, deploymentTemplate); statusErr != nil {
 			return ctrl.Result{}, statusErr
@@ -338,27 +360,55 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 
 		deploymentTemplate.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
 		deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseUpdating
+		markDeploying(deploymentTemplate)
 		err = r.Client.Status().Update(ctx, deploymentTemplate)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "DeploymentStarted", "Started deploying template.")
 		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
 	}
 
 	// If we get here then it means we can process the result of the operation.
 	logger.Info("Resource is in desired state.")
 
+	// The deployment has succeeded, but the output resources may still be rolling out. Summarize their health
+	// and keep polling with backoff until they settle or the progress deadline is exceeded.
+	now := time.Now()
+	summary := r.summarizeHealth(ctx, deploymentTemplate)
+	changed := markHealth(deploymentTemplate, summary, now, r.progressDeadline())
+
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReady
 	err = r.Client.Status().Update(ctx, deploymentTemplate)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	// Only emit events when the conditions change, rather than on every pass.
+	if changed {
+		r.recordHealthEvent(deploymentTemplate)
+	}
+
+	if meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing) {
+		logger.Info("Output resources are still progressing, requeueing.")
+		return ctrl.Result{Requeue: true, RequeueAfter: r.rolloutRequeueDelay(deploymentTemplate, now)}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// recordHealthEvent emits an event for the health of the output resources of the DeploymentTemplate.
+func (r *DeploymentTemplateReconciler) recordHealthEvent(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) {
+	if condition := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionDegraded); condition != nil && condition.Status == metav1.ConditionTrue {
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, ReasonResourcesDegraded, condition.Message)
+	} else if condition := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionStalled); condition != nil && condition.Status == metav1.ConditionTrue {
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, condition.Reason, condition.Message)
+	} else if meta.IsStatusConditionTrue(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionReady) {
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
+	}
+}
+
 func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (ctrl.Result, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -369,12 +419,18 @@ func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, depl
 	// We don't want to do this if we're in the middle of an operation, because we haven't
 	// fully processed any status changes until the async operation completes.
 	deploymentTemplate.Status.ObservedGeneration = deploymentTemplate.Generation
+	deleting := deploymentTemplate.Status.Phrase == radappiov1alpha3.DeploymentTemplatePhraseDeleting
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseDeleting
+	markDeleting(deploymentTemplate)
 	err := r.Client.Status().Update(ctx, deploymentTemplate)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	if !deleting {
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Deleting", "Deleting output resources.")
+	}
+
 	// List all DeploymentResource objects in the same namespace
 	deploymentResourceList := &radappiov1alpha3.DeploymentResourceList{}
 	err = r.Client.List(ctx, deploymentResourceList, client.InNamespace(deploymentTemplate.Namespace))
@@ -446,19 +502,19 @@ func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Con
 	var template any
 	err := json.Unmarshal([]byte(deploymentTemplate.Spec.Template), &template)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal template: %w", err)
+		return nil, &invalidDeploymentTemplateError{err: fmt.Errorf("failed to unmarshal template: %w", err)}
 	}
 
 	providerConfig := sdkclients.ProviderConfig{}
 	err = json.Unmarshal([]byte(deploymentTemplate.Spec.ProviderConfig), &providerConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal providerConfig: %w", err)
+		return nil, &invalidDeploymentTemplateError{err: fmt.Errorf("failed to unmarshal providerConfig: %w", err)}
 	}
 	if providerConfig.Deployments == nil {
-		return nil, fmt.Errorf("providerConfig.Deployments is nil")
+		return nil, &invalidDeploymentTemplateError{err: fmt.Errorf("providerConfig.Deployments is nil")}
 	}
 	if providerConfig.Deployments.Value.Scope == "" {
-		return nil, fmt.Errorf("providerConfig.Deployments.Value.Scope is empty")
+		return nil, &invalidDeploymentTemplateError{err: fmt.Errorf("providerConfig.Deployments.Value.Scope is empty")}
 	}
 
 	// Create the Radius resource group corresponding the providerConfig.Deployments.Value.Scope
@@ -504,6 +560,29 @@ func (r *DeploymentTemplateReconciler) requeueDelay() time.Duration {
 	return delay
 }
 
+func (r *DeploymentTemplateReconciler) progressDeadline() time.Duration {
+	if r.ProgressDeadline == 0 {
+		return RolloutProgressDeadline
+	}
+
+	return r.ProgressDeadline
+}
+
+// rolloutRequeueDelay returns the delay before checking the rollout of output resources again. The delay grows
+// with the time the DeploymentTemplate has been progressing, up to MaxRolloutPollingDelay.
+func (r *DeploymentTemplateReconciler) rolloutRequeueDelay(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, now time.Time) time.Duration {
+	delay := r.requeueDelay()
+
+	progressing := meta.FindStatusCondition(deploymentTemplate.Status.Conditions, radappiov1alpha3.DeploymentTemplateConditionProgressing)
+	if progressing != nil {
+		if backoff := now.Sub(progressing.LastTransitionTime.Time) / 4; backoff > delay {
+			delay = backoff
+		}
+	}
+
+	return min(delay, max(MaxRolloutPollingDelay, r.requeueDelay()))
+}
+
 func ParseDeploymentScopeFromProviderConfig(providerConfig any) (string, error) {
 	var data []byte
 	switch v := providerConfig.(type) {
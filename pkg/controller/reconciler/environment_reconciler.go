@@ -0,0 +1,323 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	radiuscorev20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// environmentRecipePacksField is the name of the index of Environments by the RecipePacks they reference.
+const environmentRecipePacksField = "spec.recipePacks"
+
+// EnvironmentReconciler reconciles an Environment object by syncing it to a Radius.Core environment.
+type EnvironmentReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// Configuration is the client for Radius configuration resources.
+	Configuration ConfigurationClient
+
+	// DelayInterval is the amount of time to wait before checking the recipe packs of the Environment again.
+	DelayInterval time.Duration
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=environments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=environments/status,verbs=get;update;patch
+
+// Reconcile is the main reconciliation loop for the Environment resource.
+func (r *EnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "Environment", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	environment := radappiov1alpha3.Environment{}
+	err := r.Client.Get(ctx, req.NamespacedName, &environment)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Environment is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if environment.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &environment)
+	}
+
+	return r.reconcileUpdate(ctx, &environment)
+}
+
+func (r *EnvironmentReconciler) reconcileUpdate(ctx context.Context, environment *radappiov1alpha3.Environment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if controllerutil.AddFinalizer(environment, EnvironmentFinalizer) {
+		err := r.Client.Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// The recipe packs must be synced before the environment can reference them.
+	recipePacks, waiting, err := r.resolveRecipePacks(ctx, environment)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if waiting != "" {
+		logger.Info("Waiting for recipe pack.", "recipePack", waiting)
+		markConfigurationNotReady(&environment.Status, environment.Generation, ReasonWaitingForDependencies, fmt.Sprintf("Waiting for recipe pack %q to be ready.", waiting))
+		err = r.Client.Status().Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: r.requeueDelay()}, nil
+	}
+
+	resourceGroupID := configurationResourceGroupID(environment.Spec.ResourceGroup, environment.Namespace)
+	resourceID := resourceGroupID + "/providers/Radius.Core/environments/" + environment.Name
+
+	err = r.sync(ctx, environment, recipePacks, resourceGroupID, resourceID)
+	if err != nil {
+		logger.Error(err, "Unable to sync environment.")
+		r.EventRecorder.Event(environment, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+
+		markConfigurationNotReady(&environment.Status, environment.Generation, ReasonSyncFailed, err.Error())
+		if statusErr := r.Client.Status().Update(ctx, environment); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	changed := markConfigurationSynced(&environment.Status, environment.Generation, resourceID)
+	err = r.Client.Status().Update(ctx, environment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if changed {
+		r.EventRecorder.Event(environment, corev1.EventTypeNormal, ReasonSynced, fmt.Sprintf("Synced environment to %s.", resourceID))
+	}
+
+	logger.Info("Environment is synced.", "resourceId", resourceID)
+	return ctrl.Result{}, nil
+}
+
+// resolveRecipePacks returns the resource IDs of the recipe packs referenced by the Environment. If one of them
+// is not ready, its name is returned instead.
+func (r *EnvironmentReconciler) resolveRecipePacks(ctx context.Context, environment *radappiov1alpha3.Environment) ([]string, string, error) {
+	recipePackIDs := []string{}
+	for _, name := range environment.Spec.RecipePacks {
+		recipePack := radappiov1alpha3.RecipePack{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: environment.Namespace, Name: name}, &recipePack)
+		if apierrors.IsNotFound(err) {
+			return nil, name, nil
+		} else if err != nil {
+			return nil, "", err
+		}
+
+		if recipePack.DeletionTimestamp != nil || !isConfigurationReady(&recipePack.Status, recipePack.Generation) {
+			return nil, name, nil
+		}
+
+		recipePackIDs = append(recipePackIDs, recipePack.Status.Resource)
+	}
+
+	return recipePackIDs, "", nil
+}
+
+func (r *EnvironmentReconciler) sync(ctx context.Context, environment *radappiov1alpha3.Environment, recipePacks []string, resourceGroupID string, resourceID string) error {
+	resource, err := environmentResource(environment, recipePacks)
+	if err != nil {
+		return err
+	}
+
+	// The resource group or name of the environment changed, so the previous environment is no longer managed.
+	if environment.Status.Resource != "" && !strings.EqualFold(environment.Status.Resource, resourceID) {
+		err = r.Configuration.DeleteEnvironment(ctx, environment.Status.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to delete previous environment: %w", err)
+		}
+	}
+
+	err = createResourceGroupIfNotExists(ctx, r.Radius, resourceGroupID)
+	if err != nil {
+		return fmt.Errorf("failed to create resource group: %w", err)
+	}
+
+	return r.Configuration.CreateOrUpdateEnvironment(ctx, resourceID, resource)
+}
+
+func (r *EnvironmentReconciler) reconcileDelete(ctx context.Context, environment *radappiov1alpha3.Environment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Resource is being deleted.")
+
+	if environment.Status.Resource != "" {
+		err := r.Configuration.DeleteEnvironment(ctx, environment.Status.Resource)
+		if err != nil {
+			logger.Error(err, "Unable to delete environment.")
+			r.EventRecorder.Event(environment, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(environment, EnvironmentFinalizer) {
+		err := r.Client.Update(ctx, environment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("Resource is deleted.")
+	return ctrl.Result{}, nil
+}
+
+func (r *EnvironmentReconciler) requeueDelay() time.Duration {
+	delay := r.DelayInterval
+	if delay == 0 {
+		delay = PollingDelay
+	}
+
+	return delay
+}
+
+// environmentResource converts an Environment to a Radius.Core environment.
+func environmentResource(environment *radappiov1alpha3.Environment, recipePacks []string) (radiuscorev20250801preview.EnvironmentResource, error) {
+	namespace := environment.Namespace
+	providers := &radiuscorev20250801preview.Providers{}
+	if environment.Spec.Providers != nil {
+		if environment.Spec.Providers.Kubernetes != nil {
+			namespace = environment.Spec.Providers.Kubernetes.Namespace
+		}
+
+		if azure := environment.Spec.Providers.Azure; azure != nil {
+			providers.Azure = &radiuscorev20250801preview.ProvidersAzure{
+				SubscriptionID: to.Ptr(azure.SubscriptionID),
+			}
+			if azure.ResourceGroupName != "" {
+				providers.Azure.ResourceGroupName = to.Ptr(azure.ResourceGroupName)
+			}
+		}
+
+		if aws := environment.Spec.Providers.AWS; aws != nil {
+			providers.Aws = &radiuscorev20250801preview.ProvidersAws{
+				AccountID: to.Ptr(aws.AccountID),
+				Region:    to.Ptr(aws.Region),
+			}
+		}
+	}
+	providers.Kubernetes = &radiuscorev20250801preview.ProvidersKubernetes{Namespace: to.Ptr(namespace)}
+
+	properties := &radiuscorev20250801preview.EnvironmentProperties{
+		Providers:   providers,
+		RecipePacks: to.SliceOfPtrs(recipePacks...),
+	}
+
+	if environment.Spec.Simulated {
+		properties.Simulated = to.Ptr(true)
+	}
+
+	if len(environment.Spec.RecipeParameters) > 0 {
+		properties.RecipeParameters = map[string]map[string]any{}
+		for resourceType, value := range environment.Spec.RecipeParameters {
+			parameters := map[string]any{}
+			err := json.Unmarshal(value.Raw, &parameters)
+			if err != nil {
+				return radiuscorev20250801preview.EnvironmentResource{}, fmt.Errorf("recipe parameters for %q must be an object: %w", resourceType, err)
+			}
+
+			properties.RecipeParameters[resourceType] = parameters
+		}
+	}
+
+	return radiuscorev20250801preview.EnvironmentResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: properties,
+	}, nil
+}
+
+// findEnvironmentsForRecipePack returns reconcile requests for the Environments that reference a RecipePack.
+func (r *EnvironmentReconciler) findEnvironmentsForRecipePack(ctx context.Context, obj client.Object) []reconcile.Request {
+	environments := &radappiov1alpha3.EnvironmentList{}
+	options := &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(environmentRecipePacksField, obj.GetName()),
+		Namespace:     obj.GetNamespace(),
+	}
+	err := r.Client.List(ctx, environments, options)
+	if err != nil {
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, item := range environments.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      item.GetName(),
+				Namespace: item.GetNamespace(),
+			},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *EnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &radappiov1alpha3.Environment{}, environmentRecipePacksField, func(rawObj client.Object) []string {
+		environment := rawObj.(*radappiov1alpha3.Environment)
+		return environment.Spec.RecipePacks
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.Environment{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&radappiov1alpha3.RecipePack{}, handler.EnqueueRequestsFromMapFunc(r.findEnvironmentsForRecipePack), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	radiuscorev20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+const environmentTestControllerDelayInterval = time.Millisecond * 100
+
+func Test_EnvironmentReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "dev"}
+	environment := &radappiov1alpha3.Environment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.EnvironmentSpec{
+			ResourceGroup: "dev",
+			Providers: &radappiov1alpha3.EnvironmentProviders{
+				Azure: &radappiov1alpha3.EnvironmentAzureProvider{SubscriptionID: "sub", ResourceGroupName: "rg"},
+			},
+			RecipePacks: []string{"my-pack"},
+			RecipeParameters: map[string]apiextensionsv1.JSON{
+				"Radius.Data/redisCaches": {Raw: []byte(`{"size":"small"}`)},
+			},
+		},
+	}
+	recipePack := &radappiov1alpha3.RecipePack{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "my-pack", Generation: 1},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(environment, recipePack).
+		WithStatusSubresource(&radappiov1alpha3.Environment{}, &radappiov1alpha3.RecipePack{}).
+		Build()
+
+	configuration := NewMockConfigurationClient(mctrl)
+	reconciler := &EnvironmentReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Radius:        NewMockRadiusClient(),
+		Configuration: configuration,
+		DelayInterval: environmentTestControllerDelayInterval,
+	}
+
+	getEnvironment := func() *radappiov1alpha3.Environment {
+		result := &radappiov1alpha3.Environment{}
+		require.NoError(t, k8sClient.Get(ctx, name, result))
+		return result
+	}
+
+	// The recipe pack is not synced yet, so the environment waits for it.
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, environmentTestControllerDelayInterval, result.RequeueAfter)
+
+	condition := meta.FindStatusCondition(getEnvironment().Status.Conditions, radappiov1alpha3.ConfigurationConditionReady)
+	require.NotNil(t, condition)
+	require.Equal(t, ReasonWaitingForDependencies, condition.Reason)
+
+	// Once the recipe pack is synced, the environment references it.
+	recipePackID := "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/my-pack"
+	markConfigurationSynced(&recipePack.Status, recipePack.Generation, recipePackID)
+	require.NoError(t, k8sClient.Status().Update(ctx, recipePack))
+
+	expectedID := "/planes/radius/local/resourceGroups/dev/providers/Radius.Core/environments/dev"
+	configuration.EXPECT().CreateOrUpdateEnvironment(gomock.Any(), expectedID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, resource radiuscorev20250801preview.EnvironmentResource) error {
+			require.Equal(t, []*string{&recipePackID}, resource.Properties.RecipePacks)
+			require.Equal(t, "default", *resource.Properties.Providers.Kubernetes.Namespace)
+			require.Equal(t, "sub", *resource.Properties.Providers.Azure.SubscriptionID)
+			require.Equal(t, map[string]any{"size": "small"}, resource.Properties.RecipeParameters["Radius.Data/redisCaches"])
+			return nil
+		})
+
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Zero(t, result.RequeueAfter)
+	require.Equal(t, expectedID, getEnvironment().Status.Resource)
+	require.True(t, isConfigurationReady(&getEnvironment().Status, 1))
+
+	// Deleting the Environment deletes the environment.
+	configuration.EXPECT().DeleteEnvironment(gomock.Any(), expectedID).Return(nil)
+	require.NoError(t, k8sClient.Delete(ctx, getEnvironment()))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.Environment{})
+	require.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh"
+)
+
+// GitFetchOptions describes the branch of a Git repository to fetch and how to authenticate.
+type GitFetchOptions struct {
+	// URL is the URL of the repository.
+	URL string
+
+	// Branch is the branch to fetch. The default branch of the repository is fetched if unset.
+	Branch string
+
+	// Reference is the full name of a reference to fetch instead of a branch, e.g. 'refs/pull/12/merge'.
+	Reference string
+
+	// Username and Password are the credentials used for HTTPS repositories.
+	Username string
+	Password string
+
+	// Identity is the PEM encoded private key used for SSH repositories.
+	Identity []byte
+
+	// KnownHosts are the host keys accepted for SSH repositories, in the known_hosts format.
+	KnownHosts []byte
+}
+
+// GitClient fetches Git repositories for the GitSource controller.
+type GitClient interface {
+	// Resolve returns the revision the branch currently points to, without fetching its content. The revision has
+	// the format '<branch>@sha1:<commit>'.
+	Resolve(ctx context.Context, options GitFetchOptions) (string, error)
+
+	// Clone clones the branch into dir and returns the revision that was checked out.
+	Clone(ctx context.Context, options GitFetchOptions, dir string) (string, error)
+
+	// ListReferences returns the commits of the branches and other references of the repository, by full reference
+	// name, e.g. 'refs/heads/main'.
+	ListReferences(ctx context.Context, options GitFetchOptions) (map[string]string, error)
+}
+
+var _ GitClient = (*GitClientImpl)(nil)
+
+// GitClientImpl is the implementation of GitClient using go-git.
+type GitClientImpl struct {
+}
+
+// NewGitClient creates a new GitClient.
+func NewGitClient() GitClient {
+	return &GitClientImpl{}
+}
+
+// Resolve lists the references of the remote repository and returns the revision of the branch.
+func (g *GitClientImpl) Resolve(ctx context.Context, options GitFetchOptions) (string, error) {
+	references, err := listRemoteReferences(ctx, options)
+	if err != nil {
+		return "", err
+	}
+
+	branch := plumbing.NewBranchReferenceName(options.Branch)
+	if options.Reference != "" {
+		branch = plumbing.ReferenceName(options.Reference)
+	} else if options.Branch == "" {
+		head := findReference(references, plumbing.HEAD)
+		if head == nil || head.Type() != plumbing.SymbolicReference {
+			return "", fmt.Errorf("failed to find the default branch of %s", options.URL)
+		}
+		branch = head.Target()
+	}
+
+	reference := findReference(references, branch)
+	if reference == nil {
+		return "", fmt.Errorf("branch %q does not exist in %s", branch.Short(), options.URL)
+	}
+
+	return formatGitRevision(branch, reference.Hash()), nil
+}
+
+// Clone makes a shallow clone of the branch into dir.
+func (g *GitClientImpl) Clone(ctx context.Context, options GitFetchOptions, dir string) (string, error) {
+	auth, err := gitAuthMethod(options)
+	if err != nil {
+		return "", err
+	}
+
+	cloneOptions := &git.CloneOptions{
+		URL:          options.URL,
+		Auth:         auth,
+		SingleBranch: true,
+		Depth:        1,
+		Tags:         git.NoTags,
+	}
+	if options.Reference != "" {
+		cloneOptions.ReferenceName = plumbing.ReferenceName(options.Reference)
+	} else if options.Branch != "" {
+		cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(options.Branch)
+	}
+
+	repository, err := git.PlainCloneContext(ctx, dir, false, cloneOptions)
+	if err != nil {
+		return "", fmt.Errorf("failed to clone %s: %w", options.URL, err)
+	}
+
+	head, err := repository.Head()
+	if err != nil {
+		return "", fmt.Errorf("failed to read the revision of %s: %w", options.URL, err)
+	}
+
+	return formatGitRevision(head.Name(), head.Hash()), nil
+}
+
+// ListReferences lists the references of the remote repository. Symbolic references, like HEAD, are skipped.
+func (g *GitClientImpl) ListReferences(ctx context.Context, options GitFetchOptions) (map[string]string, error) {
+	references, err := listRemoteReferences(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, reference := range references {
+		if reference.Type() != plumbing.HashReference {
+			continue
+		}
+
+		result[reference.Name().String()] = reference.Hash().String()
+	}
+
+	return result, nil
+}
+
+func listRemoteReferences(ctx context.Context, options GitFetchOptions) ([]*plumbing.Reference, error) {
+	auth, err := gitAuthMethod(options)
+	if err != nil {
+		return nil, err
+	}
+
+	remote := git.NewRemote(memory.NewStorage(), &gitconfig.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{options.URL},
+	})
+
+	references, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list references of %s: %w", options.URL, err)
+	}
+
+	return references, nil
+}
+
+// gitAuthMethod returns the go-git authentication method for the repository, or nil if no credentials are needed.
+func gitAuthMethod(options GitFetchOptions) (transport.AuthMethod, error) {
+	endpoint, err := transport.NewEndpoint(options.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the Git repository URL %q: %w", options.URL, err)
+	}
+
+	switch endpoint.Protocol {
+	case "ssh":
+		if len(options.Identity) == 0 {
+			return nil, errors.New("SSH repositories require the 'identity' key in the secret")
+		}
+		if len(options.KnownHosts) == 0 {
+			return nil, errors.New("SSH repositories require the 'known_hosts' key in the secret")
+		}
+
+		user := endpoint.User
+		if user == "" {
+			user = "git"
+		}
+
+		keys, err := gitssh.NewPublicKeys(user, options.Identity, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the SSH identity: %w", err)
+		}
+
+		callback, err := knownHostsCallback(options.KnownHosts)
+		if err != nil {
+			return nil, err
+		}
+		keys.HostKeyCallback = callback
+
+		return keys, nil
+	case "http", "https":
+		if options.Username == "" && options.Password == "" {
+			return nil, nil
+		}
+
+		username := options.Username
+		if username == "" {
+			// Most Git hosts accept any username when the password is a token.
+			username = "git"
+		}
+
+		return &githttp.BasicAuth{Username: username, Password: options.Password}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// knownHostsCallback builds a host key callback from the contents of a known_hosts file.
+func knownHostsCallback(knownHosts []byte) (ssh.HostKeyCallback, error) {
+	// The known_hosts parser only reads files, and reads them when the callback is created.
+	dir, err := os.MkdirTemp("", "known-hosts")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "known_hosts")
+	err = os.WriteFile(file, knownHosts, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	callback, err := gitssh.NewKnownHostsCallback(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse known_hosts: %w", err)
+	}
+
+	return callback, nil
+}
+
+func findReference(references []*plumbing.Reference, name plumbing.ReferenceName) *plumbing.Reference {
+	for _, reference := range references {
+		if reference.Name() == name {
+			return reference
+		}
+	}
+
+	return nil
+}
+
+func formatGitRevision(branch plumbing.ReferenceName, hash plumbing.Hash) string {
+	return fmt.Sprintf("%s@sha1:%s", branch.Short(), hash.String())
+}
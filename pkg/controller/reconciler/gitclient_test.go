@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestGitRepository creates a repository with one commit on the 'main' branch and one more commit on the
+// 'staging' branch, and returns its path and the commit of each branch.
+func createTestGitRepository(t *testing.T) (string, plumbing.Hash, plumbing.Hash) {
+	dir := t.TempDir()
+	repository, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+
+	worktree, err := repository.Worktree()
+	require.NoError(t, err)
+
+	commit := func(file string) plumbing.Hash {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(file), 0644))
+		_, err := worktree.Add(file)
+		require.NoError(t, err)
+
+		hash, err := worktree.Commit("add "+file, &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+		return hash
+	}
+
+	mainCommit := commit(radiusConfigFileName)
+
+	err = worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("staging"), Create: true})
+	require.NoError(t, err)
+	stagingCommit := commit("staging.bicep")
+
+	err = worktree.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName("main")})
+	require.NoError(t, err)
+
+	return dir, mainCommit, stagingCommit
+}
+
+func Test_GitClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	dir, mainCommit, stagingCommit := createTestGitRepository(t)
+	gitClient := NewGitClient()
+
+	t.Run("resolve default branch", func(t *testing.T) {
+		revision, err := gitClient.Resolve(ctx, GitFetchOptions{URL: dir})
+		require.NoError(t, err)
+		require.Equal(t, "main@sha1:"+mainCommit.String(), revision)
+	})
+
+	t.Run("resolve branch", func(t *testing.T) {
+		revision, err := gitClient.Resolve(ctx, GitFetchOptions{URL: dir, Branch: "staging"})
+		require.NoError(t, err)
+		require.Equal(t, "staging@sha1:"+stagingCommit.String(), revision)
+	})
+
+	t.Run("resolve missing branch", func(t *testing.T) {
+		_, err := gitClient.Resolve(ctx, GitFetchOptions{URL: dir, Branch: "missing"})
+		require.ErrorContains(t, err, `branch "missing" does not exist`)
+	})
+
+	t.Run("clone branch", func(t *testing.T) {
+		cloneDir := t.TempDir()
+		revision, err := gitClient.Clone(ctx, GitFetchOptions{URL: dir, Branch: "staging"}, cloneDir)
+		require.NoError(t, err)
+		require.Equal(t, "staging@sha1:"+stagingCommit.String(), revision)
+		require.FileExists(t, filepath.Join(cloneDir, radiusConfigFileName))
+		require.FileExists(t, filepath.Join(cloneDir, "staging.bicep"))
+	})
+}
+
+func Test_gitAuthMethod(t *testing.T) {
+	t.Run("public https", func(t *testing.T) {
+		auth, err := gitAuthMethod(GitFetchOptions{URL: "https://github.com/radius-project/samples.git"})
+		require.NoError(t, err)
+		require.Nil(t, auth)
+	})
+
+	t.Run("https with token", func(t *testing.T) {
+		auth, err := gitAuthMethod(GitFetchOptions{URL: "https://github.com/radius-project/samples.git", Password: "token"})
+		require.NoError(t, err)
+		require.Equal(t, &githttp.BasicAuth{Username: "git", Password: "token"}, auth)
+	})
+
+	t.Run("ssh without identity", func(t *testing.T) {
+		_, err := gitAuthMethod(GitFetchOptions{URL: "git@github.com:radius-project/samples.git"})
+		require.EqualError(t, err, "SSH repositories require the 'identity' key in the secret")
+	})
+
+	t.Run("ssh without known hosts", func(t *testing.T) {
+		_, err := gitAuthMethod(GitFetchOptions{URL: "ssh://git@github.com/radius-project/samples.git", Identity: []byte("key")})
+		require.EqualError(t, err, "SSH repositories require the 'known_hosts' key in the secret")
+	})
+}
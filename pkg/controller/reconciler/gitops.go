@@ -0,0 +1,706 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	deploymentTemplateRepositoryField = "spec.repository"
+	radiusConfigFileName              = "radius-gitops-config.yaml"
+	armJSONParametersKeyName          = "parameters"
+
+	// environmentParameterName is the name of the Bicep parameter that receives the environment selected by an overlay.
+	environmentParameterName = "environment"
+
+	// gitOpsDependencyRequeueInterval is how long to wait before checking again whether the dependencies of a
+	// config entry are ready.
+	gitOpsDependencyRequeueInterval = 10 * time.Second
+)
+
+// RadiusGitOpsConfig is the configuration for Radius in a Git repository.
+type RadiusGitOpsConfig struct {
+	Config []ConfigEntry `yaml:"config"`
+}
+
+// ConfigEntry is the build configuration for a Bicep file in a Git repository.
+type ConfigEntry struct {
+	// Name is the name of the Bicep (.bicep) file.
+	Name string `yaml:"name"`
+	// Params is the name of the Bicep parameters (.bicepparam) file.
+	Params string `yaml:"params,omitempty"`
+	// Namespace is the Kubernetes namespace that the generated DeploymentTemplate should be created in.
+	Namespace string `yaml:"namespace,omitempty"`
+	// ResourceGroup is the Radius resource group that the Bicep file should be deployed to.
+	ResourceGroup string `yaml:"resourceGroup,omitempty"`
+	// DependsOn is the list of names of other entries that must be deployed successfully before this entry.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+	// Overlays customize the entry per environment. The first overlay matching the branch or path of the
+	// Git source is applied on top of the entry.
+	Overlays []ConfigOverlay `yaml:"overlays,omitempty"`
+}
+
+// ConfigOverlay customizes a config entry for the environment selected by a branch or path of the Git source.
+type ConfigOverlay struct {
+	// Branch selects the overlay when the Git source tracks this branch.
+	Branch string `yaml:"branch,omitempty"`
+	// Path selects the overlay when the Git source reads the configuration from this directory of the repository.
+	Path string `yaml:"path,omitempty"`
+	// Environment is the Radius environment to deploy to. It is passed to the Bicep file as the 'environment' parameter.
+	Environment string `yaml:"environment,omitempty"`
+	// Params is the name of the Bicep parameters (.bicepparam) file used instead of the one of the entry.
+	Params string `yaml:"params,omitempty"`
+	// Namespace is the Kubernetes namespace used instead of the one of the entry.
+	Namespace string `yaml:"namespace,omitempty"`
+	// ResourceGroup is the Radius resource group used instead of the one of the entry.
+	ResourceGroup string `yaml:"resourceGroup,omitempty"`
+}
+
+// indexedManagers records the managers that have the DeploymentTemplate repository index. The index is needed by
+// the controller of each kind of Git source, but can only be registered once per manager.
+var indexedManagers sync.Map
+
+// deploymentTemplateRepositoryIndexer indexes DeploymentTemplate objects by their repository field.
+func deploymentTemplateRepositoryIndexer(o client.Object) []string {
+	deploymentTemplate, ok := o.(*radappiov1alpha3.DeploymentTemplate)
+	if !ok {
+		return nil
+	}
+	return []string{deploymentTemplate.Spec.Repository}
+}
+
+// indexDeploymentTemplateRepository registers the DeploymentTemplate repository index with the manager, unless it
+// is already registered.
+func indexDeploymentTemplateRepository(mgr ctrl.Manager) error {
+	if _, loaded := indexedManagers.LoadOrStore(mgr, true); loaded {
+		return nil
+	}
+
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer)
+	if err != nil {
+		indexedManagers.Delete(mgr)
+		return err
+	}
+
+	return nil
+}
+
+// gitOpsSource describes where a checkout of a Git repository came from.
+type gitOpsSource struct {
+	// Repository identifies the source. It is stored in the repository field of the generated DeploymentTemplates.
+	Repository string
+	// Branch is the branch of the checkout, if known. It is used to select overlays.
+	Branch string
+	// Path is the directory of the repository the configuration is read from. It is used to select overlays.
+	Path string
+	// Namespace, ResourceGroup and Environment override the values of every entry when set. They are used to
+	// deploy the configuration into preview environments.
+	Namespace     string
+	ResourceGroup string
+	Environment   string
+	// NamespaceLabels are set on the namespaces created for the entries. When set, an existing namespace is only
+	// deployed into if it has these labels, so that a preview never takes over a namespace it didn't create.
+	NamespaceLabels map[string]string
+}
+
+// resolvedConfigEntry is a config entry with defaults and the matching overlay applied.
+type resolvedConfigEntry struct {
+	Name          string
+	Params        string
+	Namespace     string
+	ResourceGroup string
+	Environment   string
+}
+
+// gitOpsApplier reads the radius-gitops-config.yaml file of a checkout of a Git repository, builds the Bicep
+// files it specifies and creates, updates and prunes the corresponding DeploymentTemplate objects. It is shared
+// by the controllers for each kind of Git source.
+type gitOpsApplier struct {
+	Client     client.Client
+	Bicep      bicep.Interface
+	FileSystem filesystem.FileSystem
+}
+
+// Apply applies the configuration found in dir. It returns true if some entries are waiting for their
+// dependencies, in which case Apply should be called again later.
+func (a *gitOpsApplier) Apply(ctx context.Context, dir string, source gitOpsSource) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Check if the radius-gitops-config.yaml file exists
+	_, err := a.FileSystem.Stat(filepath.Join(dir, radiusConfigFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// No radius-gitops-config.yaml found in the repository, safe to ignore
+			logger.Info(fmt.Sprintf("No radius-gitops-config.yaml found in the repository: %s", source.Repository))
+			return false, nil
+		} else {
+			logger.Error(err, "failed to check if radius-gitops-config.yaml exists")
+			return false, fmt.Errorf("failed to check if radius-gitops-config.yaml exists, error: %w", err)
+		}
+	}
+
+	// Parse the radius-gitops-config.yaml file
+	radiusConfig, err := a.parseAndValidateRadiusGitOpsConfigFromFile(dir, radiusConfigFileName)
+	if err != nil {
+		logger.Error(err, "failed to parse radius-gitops-config.yaml")
+		return false, err
+	}
+
+	entries, err := sortConfigEntries(radiusConfig.Config)
+	if err != nil {
+		logger.Error(err, "failed to order the entries of radius-gitops-config.yaml")
+		return false, err
+	}
+
+	// Run bicep build on all bicep files specified in radius-gitops-config.yaml, dependencies first.
+	waiting := false
+	resolved := map[string]resolvedConfigEntry{}
+	for _, bicepFile := range entries {
+		entry := resolveConfigEntry(bicepFile, source)
+		resolved[entry.Name] = entry
+
+		ready, err := a.dependenciesReady(ctx, bicepFile.DependsOn, resolved)
+		if err != nil {
+			return false, err
+		}
+		if !ready {
+			logger.Info("Waiting for dependencies to be deployed", "name", entry.Name, "dependsOn", bicepFile.DependsOn)
+			waiting = true
+			continue
+		}
+
+		err = a.applyEntry(ctx, dir, entry, source)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = a.prune(ctx, source.Repository, radiusConfig.Config)
+	if err != nil {
+		return false, err
+	}
+
+	return waiting, nil
+}
+
+// applyEntry builds the Bicep file of a single entry and creates or updates its DeploymentTemplate.
+func (a *gitOpsApplier) applyEntry(ctx context.Context, dir string, entry resolvedConfigEntry, source gitOpsSource) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Run bicep build on the bicep file
+	logger.Info("Running bicep build", "name", entry.Name)
+	template, err := a.runBicepBuild(ctx, dir, entry.Name)
+	if err != nil {
+		logger.Error(err, "failed to run bicep build")
+		return err
+	}
+
+	// If the bicepparams file is specified, run bicep build-params on it
+	var armJSONParameters map[string]any
+	if entry.Params != "" {
+		logger.Info("Running bicep build-params", "name", entry.Params)
+		armJSONParameters, err = a.runBicepBuildParams(ctx, dir, entry.Params)
+		if err != nil {
+			logger.Error(err, "failed to run bicep build-params")
+			return err
+		}
+	}
+
+	// Generate the provider config from the radius-gitops-config.yaml file
+	providerConfig := sdkclients.GenerateProviderConfig(entry.ResourceGroup, "", "")
+	marshalledProviderConfig, err := json.MarshalIndent(providerConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Create the namespace if it doesn't exist
+	namespaceObj := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   entry.Namespace,
+			Labels: source.NamespaceLabels,
+		},
+	}
+
+	if err := a.Client.Create(ctx, namespaceObj); err != nil {
+		if !k8serrors.IsAlreadyExists(err) {
+			logger.Error(err, "unable to create namespace")
+			return err
+		}
+
+		err = a.checkNamespaceLabels(ctx, entry.Namespace, source.NamespaceLabels)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Now we should create (or update) the DeploymentTemplate for the bicep file.
+	logger.Info("Creating or updating DeploymentTemplate", "name", entry.Name)
+	parameters := convertFromARMJSONParameters(armJSONParameters)
+	if entry.Environment != "" {
+		parameters[environmentParameterName] = entry.Environment
+	}
+
+	err = a.createOrUpdateDeploymentTemplate(ctx, entry.Name, entry.Namespace, template, string(marshalledProviderConfig), parameters, source.Repository)
+	if err != nil {
+		logger.Error(err, "failed to create or update deployment template")
+		return err
+	}
+
+	logger.Info("Successfully created or updated DeploymentTemplate", "name", entry.Name)
+	return nil
+}
+
+// checkNamespaceLabels returns an error if the existing namespace doesn't have the labels the source sets on the
+// namespaces it creates.
+func (a *gitOpsApplier) checkNamespaceLabels(ctx context.Context, name string, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	namespace := corev1.Namespace{}
+	err := a.Client.Get(ctx, types.NamespacedName{Name: name}, &namespace)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range labels {
+		if namespace.Labels[key] != value {
+			return fmt.Errorf("namespace %q already exists and was not created for this source", name)
+		}
+	}
+
+	return nil
+}
+
+// dependenciesReady returns true if the DeploymentTemplates of all of the given dependencies have been deployed
+// successfully at their latest generation.
+func (a *gitOpsApplier) dependenciesReady(ctx context.Context, dependsOn []string, resolved map[string]resolvedConfigEntry) (bool, error) {
+	for _, dependency := range dependsOn {
+		entry := resolved[dependency]
+
+		deploymentTemplate := radappiov1alpha3.DeploymentTemplate{}
+		err := a.Client.Get(ctx, types.NamespacedName{Namespace: entry.Namespace, Name: entry.Name}, &deploymentTemplate)
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+
+		if deploymentTemplate.Status.ObservedGeneration != deploymentTemplate.Generation ||
+			deploymentTemplate.Status.Phrase != radappiov1alpha3.DeploymentTemplatePhraseReady {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// prune deletes the DeploymentTemplates created from the given repository that are no longer specified in
+// config. Passing an empty config deletes all of them.
+func (a *gitOpsApplier) prune(ctx context.Context, repository string, config []ConfigEntry) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// List all DeploymentTemplates on the cluster that are from the same git repository
+	deploymentTemplates := &radappiov1alpha3.DeploymentTemplateList{}
+	err := a.Client.List(ctx, deploymentTemplates, client.MatchingFields{deploymentTemplateRepositoryField: repository}, client.InNamespace(""))
+	if err != nil {
+		logger.Error(err, "unable to list deployment templates")
+		return err
+	}
+
+	logger.Info("Found DeploymentTemplates", "count", len(deploymentTemplates.Items))
+
+	// For all of the DeploymentTemplates on the cluster, check if the bicep file
+	// that it was created from is still present in config. If not, delete the DeploymentTemplate.
+	for _, deploymentTemplate := range deploymentTemplates.Items {
+		if !isSpecifiedInConfig(deploymentTemplate.Name, config) {
+			// The DeploymentTemplate is not specified in the config, so we should delete it
+			logger.Info("Deleting DeploymentTemplate", "name", deploymentTemplate.Name)
+			if err := a.Client.Delete(ctx, &deploymentTemplate); client.IgnoreNotFound(err) != nil {
+				logger.Error(err, "unable to delete deployment template")
+				return err
+			}
+
+			logger.Info("Deleted DeploymentTemplate", "name", deploymentTemplate.Name)
+		}
+	}
+
+	return nil
+}
+
+func (a *gitOpsApplier) runBicepBuild(ctx context.Context, filepath, filename string) (armJSON string, err error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	bicepFile := path.Join(filepath, filename)
+	outFile := path.Join(filepath, strings.ReplaceAll(filename, ".bicep", ".json"))
+
+	// Run bicep build on the bicep file
+	logger.Info(fmt.Sprintf("Running command: bicep build %s --outfile %s", bicepFile, outFile))
+	_, err = a.Bicep.Call("build", bicepFile, "--outfile", outFile)
+	if err != nil {
+		logger.Error(err, "failed to run bicep build")
+		return "", err
+	}
+
+	// Read the contents of the generated .json file
+	contents, err := a.FileSystem.ReadFile(outFile)
+	if err != nil {
+		logger.Error(err, "failed to read bicep build output")
+		return "", err
+	}
+
+	return string(contents), nil
+}
+
+func (a *gitOpsApplier) runBicepBuildParams(ctx context.Context, filepath, filename string) (map[string]any, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	bicepParamsFile := path.Join(filepath, filename)
+	outfile := path.Join(filepath, strings.ReplaceAll(filename, ".bicepparam", ".parameters.json"))
+
+	// Run bicep build-params on the bicep file
+	logger.Info("Running bicep build-params on " + bicepParamsFile)
+	_, err := a.Bicep.Call("build-params", bicepParamsFile, "--outfile", outfile)
+	if err != nil {
+		logger.Error(err, "failed to run bicep build-params")
+		return map[string]any{}, err
+	}
+
+	// Read the contents of the generated .parameters.json file
+	contents, err := a.FileSystem.ReadFile(outfile)
+	if err != nil {
+		logger.Error(err, "failed to read bicep build-params output")
+		return nil, err
+	}
+
+	params := make(map[string]any)
+	err = json.Unmarshal(contents, &params)
+	if err != nil {
+		logger.Error(err, "failed to unmarshal bicep build-params output")
+		return nil, err
+	}
+
+	if params[armJSONParametersKeyName] == nil {
+		return nil, fmt.Errorf("parameters field not found in bicep build-params output")
+	}
+
+	if _, ok := params[armJSONParametersKeyName].(map[string]any); !ok {
+		typeGot := fmt.Sprintf("%T", params[armJSONParametersKeyName])
+		return nil, fmt.Errorf("unexpected format for parameters field in bicep build-params output, got %s", typeGot)
+	}
+
+	parameters := params[armJSONParametersKeyName].(map[string]any)
+
+	return parameters, nil
+}
+
+// createOrUpdateDeploymentTemplate creates or updates a DeploymentTemplate object in the cluster
+// with the given spec.
+func (a *gitOpsApplier) createOrUpdateDeploymentTemplate(ctx context.Context, fileName, namespace, template, providerConfig string, parameters map[string]string, repository string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Try to get the DeploymentTemplate object from the cluster
+	deploymentTemplate := radappiov1alpha3.DeploymentTemplate{}
+	err := a.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: fileName}, &deploymentTemplate)
+	if err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			// Error getting the DeploymentTemplate object that is not a NotFound error
+			logger.Error(err, "unable to get deployment template")
+			return err
+		}
+
+		// If the DeploymentTemplate doesn't exist, create it
+		deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fileName,
+				Namespace: namespace,
+			},
+			Spec: radappiov1alpha3.DeploymentTemplateSpec{
+				Template:       template,
+				Parameters:     parameters,
+				ProviderConfig: providerConfig,
+				Repository:     repository,
+			},
+		}
+		if err := a.Client.Create(ctx, deploymentTemplate); err != nil {
+			logger.Error(err, "unable to create deployment template")
+			return err
+		}
+
+		logger.Info("Created Deployment Template", "name", deploymentTemplate.Name)
+		return nil
+	}
+
+	// If the DeploymentTemplate already exists, update it
+	deploymentTemplate.Spec = radappiov1alpha3.DeploymentTemplateSpec{
+		Template:       template,
+		Parameters:     parameters,
+		ProviderConfig: providerConfig,
+		Repository:     repository,
+	}
+	if err := a.Client.Update(ctx, &deploymentTemplate); err != nil {
+		logger.Error(err, "unable to update deployment template")
+		return err
+	}
+
+	logger.Info("Updated Deployment Template", "name", deploymentTemplate.Name)
+	return nil
+}
+
+// parseAndValidateRadiusGitOpsConfigFromFile reads the radius-gitops-config.yaml file from the given directory
+// and parses it into a RadiusGitOpsConfig struct. It then validates the Radius configuration in the
+// radius-gitops-config.yaml file.
+func (a *gitOpsApplier) parseAndValidateRadiusGitOpsConfigFromFile(dir, configFileName string) (*RadiusGitOpsConfig, error) {
+	radiusConfig := RadiusGitOpsConfig{}
+
+	// Read the file contents
+	b, err := a.FileSystem.ReadFile(path.Join(dir, configFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read radius-gitops-config.yaml, error: %w", err)
+	}
+
+	// Unmarshal the file contents into the RadiusGitOpsConfig struct
+	err = yaml.Unmarshal(b, &radiusConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse radius-gitops-config.yaml, error: %w", err)
+	}
+
+	names := map[string]bool{}
+	for _, bicepFile := range radiusConfig.Config {
+		names[bicepFile.Name] = true
+	}
+
+	// Validate the Radius configuration in radius-gitops-config.yaml
+	for _, bicepFile := range radiusConfig.Config {
+		// Validate if the Name field is set
+		if bicepFile.Name == "" {
+			return nil, fmt.Errorf("name field is required in bicepBuild")
+		}
+
+		// Validate that the file extension is .bicep
+		if path.Ext(bicepFile.Name) != ".bicep" {
+			return nil, fmt.Errorf("bicep file must have a .bicep extension")
+		}
+
+		// Validate that the file exists
+		_, err := a.FileSystem.Stat(path.Join(dir, bicepFile.Name))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("failed to find bicep file %s, error: %w", bicepFile.Name, err)
+			} else {
+				return nil, fmt.Errorf("failed to check if bicep file exists, error: %w", err)
+			}
+		}
+
+		// If the bicepFile.Params field is set, validate that the file exists
+		err = a.validateParamsFile(dir, bicepFile.Params)
+		if err != nil {
+			return nil, err
+		}
+
+		// Validate that dependencies refer to other entries of the config
+		for _, dependency := range bicepFile.DependsOn {
+			if dependency == bicepFile.Name {
+				return nil, fmt.Errorf("bicep file %s cannot depend on itself", bicepFile.Name)
+			}
+			if !names[dependency] {
+				return nil, fmt.Errorf("bicep file %s depends on %s, which is not specified in the config", bicepFile.Name, dependency)
+			}
+		}
+
+		// Validate that overlays are selected by a branch or a path and refer to existing files
+		for _, overlay := range bicepFile.Overlays {
+			if overlay.Branch == "" && overlay.Path == "" {
+				return nil, fmt.Errorf("overlays of bicep file %s must specify a branch or a path", bicepFile.Name)
+			}
+
+			err = a.validateParamsFile(dir, overlay.Params)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &radiusConfig, nil
+}
+
+// validateParamsFile validates that the given bicepparams file exists, if it is set.
+func (a *gitOpsApplier) validateParamsFile(dir, params string) error {
+	if params == "" {
+		return nil
+	}
+
+	_, err := a.FileSystem.Stat(path.Join(dir, params))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to find bicepparams file %s, error: %w", params, err)
+		} else {
+			return fmt.Errorf("failed to check if bicepparams file exists, error: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// resolveConfigEntry applies the defaults and the overlay matching the source to the given entry.
+func resolveConfigEntry(bicepFile ConfigEntry, source gitOpsSource) resolvedConfigEntry {
+	nameBase := strings.TrimSuffix(bicepFile.Name, path.Ext(bicepFile.Name))
+	entry := resolvedConfigEntry{
+		Name:          bicepFile.Name,
+		Params:        bicepFile.Params,
+		Namespace:     bicepFile.Namespace,
+		ResourceGroup: bicepFile.ResourceGroup,
+	}
+
+	if overlay := selectOverlay(bicepFile.Overlays, source); overlay != nil {
+		entry.Environment = overlay.Environment
+		if overlay.Params != "" {
+			entry.Params = overlay.Params
+		}
+		if overlay.Namespace != "" {
+			entry.Namespace = overlay.Namespace
+		}
+		if overlay.ResourceGroup != "" {
+			entry.ResourceGroup = overlay.ResourceGroup
+		}
+	}
+
+	if source.Namespace != "" {
+		entry.Namespace = source.Namespace
+	}
+	if source.ResourceGroup != "" {
+		entry.ResourceGroup = source.ResourceGroup
+	}
+	if source.Environment != "" {
+		entry.Environment = source.Environment
+	}
+
+	if entry.Namespace == "" {
+		// If the namespace is not set, use the name of the bicep file
+		// (without extension) as the namespace. e.g. "example.bicep" -> "example"
+		entry.Namespace = nameBase
+	}
+	if entry.ResourceGroup == "" {
+		// If the resource group is not set, use the name of the bicep file
+		// (without extension) as the resource group. e.g. "example.bicep" -> "example"
+		entry.ResourceGroup = nameBase
+	}
+
+	return entry
+}
+
+// selectOverlay returns the first overlay matching the branch and path of the source, or nil if none match.
+func selectOverlay(overlays []ConfigOverlay, source gitOpsSource) *ConfigOverlay {
+	for i := range overlays {
+		overlay := &overlays[i]
+		if overlay.Branch != "" && overlay.Branch != source.Branch {
+			continue
+		}
+		if overlay.Path != "" && path.Clean(overlay.Path) != path.Clean(source.Path) {
+			continue
+		}
+
+		return overlay
+	}
+
+	return nil
+}
+
+// sortConfigEntries orders the entries so that each entry comes after its dependencies. Entries without
+// dependencies between them keep the order of the config.
+func sortConfigEntries(entries []ConfigEntry) ([]ConfigEntry, error) {
+	byName := map[string]ConfigEntry{}
+	for _, entry := range entries {
+		byName[entry.Name] = entry
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	sorted := make([]ConfigEntry, 0, len(entries))
+
+	var visit func(name string, chain []string) error
+	visit = func(name string, chain []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("bicep files have a circular dependency: %s", strings.Join(append(chain, name), " -> "))
+		}
+
+		entry, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("bicep file %s is not specified in the config", name)
+		}
+
+		state[name] = visiting
+		for _, dependency := range entry.DependsOn {
+			err := visit(dependency, append(chain, name))
+			if err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+
+		sorted = append(sorted, entry)
+		return nil
+	}
+
+	for _, entry := range entries {
+		err := visit(entry.Name, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sorted, nil
+}
+
+func isSpecifiedInConfig(fileName string, config []ConfigEntry) bool {
+	for _, bicepFile := range config {
+		if bicepFile.Name == fileName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sortConfigEntries(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []ConfigEntry
+		expected []string
+		err      string
+	}{
+		{
+			name:     "no dependencies keeps order",
+			entries:  []ConfigEntry{{Name: "b.bicep"}, {Name: "a.bicep"}},
+			expected: []string{"b.bicep", "a.bicep"},
+		},
+		{
+			name: "dependencies first",
+			entries: []ConfigEntry{
+				{Name: "app.bicep", DependsOn: []string{"database.bicep", "infra.bicep"}},
+				{Name: "database.bicep", DependsOn: []string{"infra.bicep"}},
+				{Name: "infra.bicep"},
+				{Name: "other.bicep"},
+			},
+			expected: []string{"infra.bicep", "database.bicep", "app.bicep", "other.bicep"},
+		},
+		{
+			name: "circular dependency",
+			entries: []ConfigEntry{
+				{Name: "a.bicep", DependsOn: []string{"b.bicep"}},
+				{Name: "b.bicep", DependsOn: []string{"a.bicep"}},
+			},
+			err: "bicep files have a circular dependency: a.bicep -> b.bicep -> a.bicep",
+		},
+		{
+			name:    "unknown dependency",
+			entries: []ConfigEntry{{Name: "a.bicep", DependsOn: []string{"missing.bicep"}}},
+			err:     "bicep file missing.bicep is not specified in the config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted, err := sortConfigEntries(tt.entries)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			names := []string{}
+			for _, entry := range sorted {
+				names = append(names, entry.Name)
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func Test_resolveConfigEntry(t *testing.T) {
+	entry := ConfigEntry{
+		Name:   "app.bicep",
+		Params: "app.bicepparam",
+		Overlays: []ConfigOverlay{
+			{Branch: "main", Environment: "production", Params: "production.bicepparam", ResourceGroup: "prod"},
+			{Path: "environments/staging", Environment: "staging", Namespace: "app-staging"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		source   gitOpsSource
+		expected resolvedConfigEntry
+	}{
+		{
+			name:   "no matching overlay",
+			source: gitOpsSource{Branch: "dev"},
+			expected: resolvedConfigEntry{
+				Name:          "app.bicep",
+				Params:        "app.bicepparam",
+				Namespace:     "app",
+				ResourceGroup: "app",
+			},
+		},
+		{
+			name:   "overlay selected by branch",
+			source: gitOpsSource{Branch: "main"},
+			expected: resolvedConfigEntry{
+				Name:          "app.bicep",
+				Params:        "production.bicepparam",
+				Namespace:     "app",
+				ResourceGroup: "prod",
+				Environment:   "production",
+			},
+		},
+		{
+			name:   "overlay selected by path",
+			source: gitOpsSource{Branch: "dev", Path: "environments/staging/"},
+			expected: resolvedConfigEntry{
+				Name:          "app.bicep",
+				Params:        "app.bicepparam",
+				Namespace:     "app-staging",
+				ResourceGroup: "app",
+				Environment:   "staging",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, resolveConfigEntry(entry, tt.source))
+		})
+	}
+}
+
+func Test_parseAndValidateRadiusGitOpsConfigFromFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name: "valid",
+			config: `config:
+  - name: infra.bicep
+  - name: app.bicep
+    dependsOn: [infra.bicep]
+    overlays:
+      - branch: main
+        environment: production
+        params: app.bicepparam
+`,
+		},
+		{
+			name: "unknown dependency",
+			config: `config:
+  - name: app.bicep
+    dependsOn: [missing.bicep]
+`,
+			err: "bicep file app.bicep depends on missing.bicep, which is not specified in the config",
+		},
+		{
+			name: "self dependency",
+			config: `config:
+  - name: app.bicep
+    dependsOn: [app.bicep]
+`,
+			err: "bicep file app.bicep cannot depend on itself",
+		},
+		{
+			name: "overlay without selector",
+			config: `config:
+  - name: app.bicep
+    overlays:
+      - environment: production
+`,
+			err: "overlays of bicep file app.bicep must specify a branch or a path",
+		},
+		{
+			name: "overlay with missing params file",
+			config: `config:
+  - name: app.bicep
+    overlays:
+      - branch: main
+        params: missing.bicepparam
+`,
+			err: "failed to find bicepparams file missing.bicepparam",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewMemMapFileSystem()
+			require.NoError(t, fs.WriteFile("/repo/infra.bicep", []byte{}, 0644))
+			require.NoError(t, fs.WriteFile("/repo/app.bicep", []byte{}, 0644))
+			require.NoError(t, fs.WriteFile("/repo/app.bicepparam", []byte{}, 0644))
+			require.NoError(t, fs.WriteFile("/repo/"+radiusConfigFileName, []byte(tt.config), 0644))
+
+			applier := &gitOpsApplier{FileSystem: fs}
+			_, err := applier.parseAndValidateRadiusGitOpsConfigFromFile("/repo", radiusConfigFileName)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
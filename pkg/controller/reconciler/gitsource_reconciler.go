@@ -0,0 +1,288 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+// GitSourceReconciler reconciles a GitSource object. It polls the Git repository of the GitSource, and applies
+// the radius-gitops-config.yaml file of each new revision in the same way as the FluxController, without
+// requiring Flux to be installed.
+type GitSourceReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Bicep is used to build the Bicep files of the repository.
+	Bicep bicep.Interface
+
+	// FileSystem is the file system the repository is cloned into.
+	FileSystem filesystem.FileSystem
+
+	// GitClient is used to fetch the repository.
+	GitClient GitClient
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=gitsources,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=gitsources/status,verbs=get;update;patch
+
+// Reconcile is the main reconciliation loop for the GitSource resource.
+func (r *GitSourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "GitSource", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	gitSource := radappiov1alpha3.GitSource{}
+	err := r.Client.Get(ctx, req.NamespacedName, &gitSource)
+	if apierrors.IsNotFound(err) {
+		// The finalizer makes sure DeploymentTemplates are pruned before the GitSource is gone, so there's
+		// nothing to do here.
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if gitSource.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &gitSource)
+	}
+
+	return r.reconcileUpdate(ctx, &gitSource)
+}
+
+func (r *GitSourceReconciler) reconcileUpdate(ctx context.Context, gitSource *radappiov1alpha3.GitSource) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present so that DeploymentTemplates are pruned when the GitSource is deleted.
+	if controllerutil.AddFinalizer(gitSource, GitSourceFinalizer) {
+		err := r.Client.Update(ctx, gitSource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	options, err := gitSourceFetchOptions(ctx, r.Client, gitSource)
+	if err != nil {
+		return r.setFailed(ctx, gitSource, err)
+	}
+
+	revision, err := r.GitClient.Resolve(ctx, options)
+	if err != nil {
+		return r.setFailed(ctx, gitSource, err)
+	}
+
+	now := metav1.Now()
+	gitSource.Status.LastSyncTime = &now
+
+	// Nothing has changed since the last revision was applied, so there's no need to clone the repository.
+	if revision == gitSource.Status.Revision &&
+		gitSource.Status.ObservedGeneration == gitSource.Generation &&
+		gitSource.Status.Phrase == radappiov1alpha3.GitSourcePhraseReady {
+		err = r.Client.Status().Update(ctx, gitSource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: gitSourceInterval(gitSource)}, nil
+	}
+
+	logger.Info("New revision detected", "revision", revision)
+
+	// Create temp dir to store the cloned repository
+	tmpDir, err := r.FileSystem.MkdirTemp("", gitSource.Name)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create temp dir, error: %w", err)
+	}
+
+	defer func(path string) {
+		err := r.FileSystem.RemoveAll(path)
+		if err != nil {
+			logger.Error(err, "unable to remove temp dir")
+		}
+	}(tmpDir)
+
+	revision, err = r.GitClient.Clone(ctx, options, tmpDir)
+	if err != nil {
+		return r.setFailed(ctx, gitSource, err)
+	}
+
+	source := gitOpsSource{
+		Repository: gitSourceRepository(gitSource),
+		Branch:     gitRevisionBranch(revision),
+		Path:       gitSource.Spec.Path,
+	}
+	waiting, err := r.applier().Apply(ctx, filepath.Join(tmpDir, gitSource.Spec.Path), source)
+	if err != nil {
+		return r.setFailed(ctx, gitSource, err)
+	}
+
+	gitSource.Status.ObservedGeneration = gitSource.Generation
+	gitSource.Status.Message = ""
+	if waiting {
+		// Don't record the revision until every entry has been applied, so that the next poll applies it again.
+		gitSource.Status.Phrase = radappiov1alpha3.GitSourcePhraseSyncing
+		err = r.Client.Status().Update(ctx, gitSource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: gitOpsDependencyRequeueInterval}, nil
+	}
+
+	gitSource.Status.Revision = revision
+	gitSource.Status.Phrase = radappiov1alpha3.GitSourcePhraseReady
+	err = r.Client.Status().Update(ctx, gitSource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(gitSource, corev1.EventTypeNormal, "Reconciled", fmt.Sprintf("Applied revision %s.", revision))
+	return ctrl.Result{RequeueAfter: gitSourceInterval(gitSource)}, nil
+}
+
+func (r *GitSourceReconciler) reconcileDelete(ctx context.Context, gitSource *radappiov1alpha3.GitSource) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("GitSource is being deleted, pruning DeploymentTemplates")
+	err := r.applier().prune(ctx, gitSourceRepository(gitSource), nil)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// GitSource.
+	if controllerutil.RemoveFinalizer(gitSource, GitSourceFinalizer) {
+		err := r.Client.Update(ctx, gitSource)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// setFailed records the error in the status of the GitSource and returns it so that the reconciliation is retried.
+func (r *GitSourceReconciler) setFailed(ctx context.Context, gitSource *radappiov1alpha3.GitSource, cause error) (ctrl.Result, error) {
+	gitSource.Status.ObservedGeneration = gitSource.Generation
+	gitSource.Status.Phrase = radappiov1alpha3.GitSourcePhraseFailed
+	gitSource.Status.Message = cause.Error()
+	err := r.Client.Status().Update(ctx, gitSource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(gitSource, corev1.EventTypeWarning, "SyncFailed", cause.Error())
+	return ctrl.Result{}, cause
+}
+
+// gitSourceFetchOptions builds the options used to fetch the repository of the GitSource, reading the credentials
+// from the secret if one is referenced.
+func gitSourceFetchOptions(ctx context.Context, c client.Client, gitSource *radappiov1alpha3.GitSource) (GitFetchOptions, error) {
+	if gitSource.Spec.Path != "" && !filepath.IsLocal(gitSource.Spec.Path) {
+		return GitFetchOptions{}, fmt.Errorf("path %q must be a relative path inside the repository", gitSource.Spec.Path)
+	}
+
+	options := GitFetchOptions{
+		URL:    gitSource.Spec.URL,
+		Branch: gitSource.Spec.Branch,
+	}
+	if gitSource.Spec.SecretRef == nil {
+		return options, nil
+	}
+
+	secret := corev1.Secret{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: gitSource.Namespace, Name: gitSource.Spec.SecretRef.Name}, &secret)
+	if err != nil {
+		return GitFetchOptions{}, fmt.Errorf("failed to read secret %q: %w", gitSource.Spec.SecretRef.Name, err)
+	}
+
+	options.Username = string(secret.Data["username"])
+	options.Password = string(secret.Data["password"])
+	options.Identity = secret.Data["identity"]
+	options.KnownHosts = secret.Data["known_hosts"]
+	return options, nil
+}
+
+// applier returns the gitOpsApplier used to apply the configuration of a GitSource.
+func (r *GitSourceReconciler) applier() *gitOpsApplier {
+	return &gitOpsApplier{
+		Client:     r.Client,
+		Bicep:      r.Bicep,
+		FileSystem: r.FileSystem,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GitSourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := indexDeploymentTemplateRepository(mgr)
+	if err != nil {
+		return err
+	}
+
+	// Status updates don't trigger reconciliation. Spec changes and sync requests from webhooks do, and the
+	// repository is polled by requeueing.
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.GitSource{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Complete(r)
+}
+
+// gitSourceRepository returns the value of the repository field of the DeploymentTemplates created from the
+// GitSource. It is distinct from the names of Flux GitRepositories, which cannot contain a '/'.
+func gitSourceRepository(gitSource *radappiov1alpha3.GitSource) string {
+	return fmt.Sprintf("gitsources/%s/%s", gitSource.Namespace, gitSource.Name)
+}
+
+// gitSourceInterval returns the interval between polls of the repository of the GitSource.
+func gitSourceInterval(gitSource *radappiov1alpha3.GitSource) time.Duration {
+	if gitSource.Spec.Interval == nil || gitSource.Spec.Interval.Duration <= 0 {
+		return GitSourceDefaultInterval
+	}
+
+	return gitSource.Spec.Interval.Duration
+}
+
+// gitRevisionBranch returns the branch of a revision with the format '<branch>@sha1:<commit>'.
+func gitRevisionBranch(revision string) string {
+	branch, _, _ := strings.Cut(revision, "@")
+	return branch
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const testGitSourceConfig = `config:
+  - name: infra.bicep
+  - name: app.bicep
+    dependsOn: [infra.bicep]
+    overlays:
+      - branch: main
+        environment: production
+`
+
+func Test_GitSourceReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "my-repo"}
+	gitSource := &radappiov1alpha3.GitSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Spec: radappiov1alpha3.GitSourceSpec{
+			URL:       "https://github.com/radius-project/samples.git",
+			SecretRef: &corev1.LocalObjectReference{Name: "git-credentials"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "git-credentials"},
+		Data:       map[string][]byte{"password": []byte("token")},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(gitSource, secret).
+		WithStatusSubresource(&radappiov1alpha3.GitSource{}, &radappiov1alpha3.DeploymentTemplate{}).
+		WithIndex(&radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer).
+		Build()
+
+	fs := filesystem.NewMemMapFileSystem()
+	gitClient := NewMockGitClient(mctrl)
+	bicepClient := bicep.NewMockInterface(mctrl)
+
+	revision := "main@sha1:0123456789abcdef0123456789abcdef01234567"
+	expectedOptions := GitFetchOptions{URL: gitSource.Spec.URL, Password: "token"}
+	gitClient.EXPECT().Resolve(gomock.Any(), expectedOptions).Return(revision, nil).AnyTimes()
+	gitClient.EXPECT().Clone(gomock.Any(), expectedOptions, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, options GitFetchOptions, dir string) (string, error) {
+			require.NoError(t, fs.WriteFile(filepath.Join(dir, radiusConfigFileName), []byte(testGitSourceConfig), 0644))
+			require.NoError(t, fs.WriteFile(filepath.Join(dir, "infra.bicep"), []byte{}, 0644))
+			require.NoError(t, fs.WriteFile(filepath.Join(dir, "app.bicep"), []byte{}, 0644))
+			return revision, nil
+		}).Times(2)
+	bicepClient.EXPECT().Call("build", gomock.Any(), "--outfile", gomock.Any()).
+		DoAndReturn(func(args ...string) ([]byte, error) {
+			return nil, fs.WriteFile(args[3], []byte(`{"resources":{}}`), 0644)
+		}).AnyTimes()
+
+	reconciler := &GitSourceReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Bicep:         bicepClient,
+		FileSystem:    fs,
+		GitClient:     gitClient,
+	}
+
+	getGitSource := func() *radappiov1alpha3.GitSource {
+		result := &radappiov1alpha3.GitSource{}
+		require.NoError(t, k8sClient.Get(ctx, name, result))
+		return result
+	}
+
+	// The first sync deploys infra.bicep, and app.bicep waits for it.
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, gitOpsDependencyRequeueInterval, result.RequeueAfter)
+	require.Equal(t, radappiov1alpha3.GitSourcePhraseSyncing, getGitSource().Status.Phrase)
+	require.Contains(t, getGitSource().Finalizers, GitSourceFinalizer)
+
+	infra := &radappiov1alpha3.DeploymentTemplate{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "infra", Name: "infra.bicep"}, infra))
+	require.Equal(t, "gitsources/default/my-repo", infra.Spec.Repository)
+
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: "app", Name: "app.bicep"}, &radappiov1alpha3.DeploymentTemplate{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	// Once infra.bicep is deployed, app.bicep is deployed with the overlay for the main branch.
+	infra.Status.ObservedGeneration = infra.Generation
+	infra.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReady
+	require.NoError(t, k8sClient.Status().Update(ctx, infra))
+
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, GitSourceDefaultInterval, result.RequeueAfter)
+
+	status := getGitSource().Status
+	require.Equal(t, radappiov1alpha3.GitSourcePhraseReady, status.Phrase)
+	require.Equal(t, revision, status.Revision)
+
+	app := &radappiov1alpha3.DeploymentTemplate{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "app", Name: "app.bicep"}, app))
+	require.Equal(t, map[string]string{"environment": "production"}, app.Spec.Parameters)
+
+	// The revision hasn't changed, so the repository is not cloned again.
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, GitSourceDefaultInterval, result.RequeueAfter)
+
+	// Deleting the GitSource prunes its DeploymentTemplates.
+	require.NoError(t, k8sClient.Delete(ctx, getGitSource()))
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	deploymentTemplates := &radappiov1alpha3.DeploymentTemplateList{}
+	require.NoError(t, k8sClient.List(ctx, deploymentTemplates))
+	require.Empty(t, deploymentTemplates.Items)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.GitSource{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_GitSourceReconciler_InvalidPath(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "my-repo"}
+	gitSource := &radappiov1alpha3.GitSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Spec: radappiov1alpha3.GitSourceSpec{
+			URL:  "https://github.com/radius-project/samples.git",
+			Path: "../other",
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(gitSource).
+		WithStatusSubresource(&radappiov1alpha3.GitSource{}).
+		Build()
+
+	reconciler := &GitSourceReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		GitClient:     NewMockGitClient(gomock.NewController(t)),
+	}
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.ErrorContains(t, err, "must be a relative path inside the repository")
+
+	result := &radappiov1alpha3.GitSource{}
+	require.NoError(t, k8sClient.Get(ctx, client.ObjectKeyFromObject(gitSource), result))
+	require.Equal(t, radappiov1alpha3.GitSourcePhraseFailed, result.Status.Phrase)
+	require.True(t, strings.HasPrefix(result.Status.Message, "path \"../other\""))
+}
+
+func Test_gitRevisionBranch(t *testing.T) {
+	require.Equal(t, "main", gitRevisionBranch("main@sha1:0123456789abcdef"))
+	require.Equal(t, "feature/x", gitRevisionBranch("feature/x@sha1:0123456789abcdef"))
+}
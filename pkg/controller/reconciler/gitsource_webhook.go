@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	// GitSourceWebhookPathPrefix is the path prefix of the push webhooks of GitSources. The webhook of a GitSource
+	// is served at '/gitsources/<namespace>/<name>'.
+	GitSourceWebhookPathPrefix = "/gitsources/"
+
+	// gitSourceWebhookMaxBodySize is the maximum size of a webhook payload that is read to verify its signature.
+	gitSourceWebhookMaxBodySize = 10 * 1024 * 1024
+
+	// gitSourceWebhookReadHeaderTimeout is the timeout for reading the headers of a webhook request.
+	gitSourceWebhookReadHeaderTimeout = 10 * time.Second
+)
+
+// GitSourceWebhook receives push webhooks from Git hosts and requests a sync of the corresponding GitSource.
+// Payloads signed with the 'X-Hub-Signature-256' header (GitHub, Gitea) and requests with the 'X-Gitlab-Token'
+// header (GitLab) are accepted.
+type GitSourceWebhook struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+}
+
+// SetupWebhookWithManager adds a server for the webhook listening on the given port to the manager.
+//
+// The webhook is not served by the admission webhook server of the manager, because that server only accepts TLS
+// connections from the Kubernetes API server. The webhook server listens for plain HTTP, and is exposed to Git hosts
+// by the Service and Ingress of the Helm chart, which terminate TLS.
+func (w *GitSourceWebhook) SetupWebhookWithManager(mgr ctrl.Manager, port int) error {
+	w.Client = mgr.GetClient()
+
+	mux := http.NewServeMux()
+	mux.Handle(GitSourceWebhookPathPrefix, w)
+
+	return mgr.Add(&manager.Server{
+		Name: "gitsource-webhook",
+		Server: &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: gitSourceWebhookReadHeaderTimeout,
+		},
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (w *GitSourceWebhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(req.URL.Path, GitSourceWebhookPathPrefix), "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		http.NotFound(rw, req)
+		return
+	}
+	key := client.ObjectKey{Namespace: segments[0], Name: segments[1]}
+
+	gitSource := radappiov1alpha3.GitSource{}
+	err := w.Client.Get(ctx, key, &gitSource)
+	if apierrors.IsNotFound(err) || (err == nil && gitSource.Spec.WebhookSecretRef == nil) {
+		// Webhooks are disabled for GitSources without a webhook secret. Don't reveal whether the GitSource exists.
+		http.NotFound(rw, req)
+		return
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch GitSource.", "name", key.Name, "namespace", key.Namespace)
+		http.Error(rw, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	secret := corev1.Secret{}
+	err = w.Client.Get(ctx, client.ObjectKey{Namespace: gitSource.Namespace, Name: gitSource.Spec.WebhookSecretRef.Name}, &secret)
+	if err != nil {
+		logger.Error(err, "Unable to fetch the webhook secret of the GitSource.", "name", key.Name, "namespace", key.Namespace)
+		http.Error(rw, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, gitSourceWebhookMaxBodySize))
+	if err != nil {
+		http.Error(rw, "failed to read the request body", http.StatusBadRequest)
+		return
+	}
+
+	if !verifyGitSourceWebhook(req.Header, body, secret.Data["token"]) {
+		http.Error(rw, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// Changing an annotation triggers a reconciliation of the GitSource.
+	patch := client.MergeFrom(gitSource.DeepCopy())
+	if gitSource.Annotations == nil {
+		gitSource.Annotations = map[string]string{}
+	}
+	gitSource.Annotations[AnnotationGitSourceSyncRequestedAt] = time.Now().UTC().Format(time.RFC3339Nano)
+	err = w.Client.Patch(ctx, &gitSource, patch)
+	if err != nil {
+		logger.Error(err, "Unable to request a sync of the GitSource.", "name", key.Name, "namespace", key.Namespace)
+		http.Error(rw, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("Sync of GitSource requested by webhook.", "name", key.Name, "namespace", key.Namespace)
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+// verifyGitSourceWebhook returns true if the request is signed with, or carries, the webhook token.
+func verifyGitSourceWebhook(header http.Header, body []byte, token []byte) bool {
+	if len(token) == 0 {
+		return false
+	}
+
+	if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+		expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, token)
+		mac.Write(body)
+		return hmac.Equal(mac.Sum(nil), expected)
+	}
+
+	if gitlabToken := header.Get("X-Gitlab-Token"); gitlabToken != "" {
+		return subtle.ConstantTimeCompare([]byte(gitlabToken), token) == 1
+	}
+
+	return false
+}
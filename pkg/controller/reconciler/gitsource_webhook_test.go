@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func signGitSourceWebhook(body []byte, token string) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func Test_verifyGitSourceWebhook(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+
+	tests := []struct {
+		name     string
+		header   http.Header
+		token    string
+		expected bool
+	}{
+		{
+			name:     "valid signature",
+			header:   http.Header{"X-Hub-Signature-256": []string{signGitSourceWebhook(body, "secret")}},
+			token:    "secret",
+			expected: true,
+		},
+		{
+			name:     "invalid signature",
+			header:   http.Header{"X-Hub-Signature-256": []string{signGitSourceWebhook(body, "other")}},
+			token:    "secret",
+			expected: false,
+		},
+		{
+			name:     "malformed signature",
+			header:   http.Header{"X-Hub-Signature-256": []string{"sha256=not-hex"}},
+			token:    "secret",
+			expected: false,
+		},
+		{
+			name:     "valid gitlab token",
+			header:   http.Header{"X-Gitlab-Token": []string{"secret"}},
+			token:    "secret",
+			expected: true,
+		},
+		{
+			name:     "invalid gitlab token",
+			header:   http.Header{"X-Gitlab-Token": []string{"other"}},
+			token:    "secret",
+			expected: false,
+		},
+		{
+			name:     "no credentials",
+			header:   http.Header{},
+			token:    "secret",
+			expected: false,
+		},
+		{
+			name:     "empty token",
+			header:   http.Header{"X-Gitlab-Token": []string{""}},
+			token:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, verifyGitSourceWebhook(tt.header, body, []byte(tt.token)))
+		})
+	}
+}
+
+func Test_GitSourceWebhook(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(
+			&radappiov1alpha3.GitSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "with-webhook"},
+				Spec:       radappiov1alpha3.GitSourceSpec{WebhookSecretRef: &corev1.LocalObjectReference{Name: "webhook"}},
+			},
+			&radappiov1alpha3.GitSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "without-webhook"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "webhook"},
+				Data:       map[string][]byte{"token": []byte("secret")},
+			},
+		).
+		Build()
+
+	webhook := &GitSourceWebhook{Client: k8sClient}
+	body := []byte(`{"ref":"refs/heads/main"}`)
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		signature string
+		expected  int
+	}{
+		{
+			name:      "sync requested",
+			method:    http.MethodPost,
+			path:      "/gitsources/default/with-webhook",
+			signature: signGitSourceWebhook(body, "secret"),
+			expected:  http.StatusAccepted,
+		},
+		{
+			name:      "invalid signature",
+			method:    http.MethodPost,
+			path:      "/gitsources/default/with-webhook",
+			signature: signGitSourceWebhook(body, "other"),
+			expected:  http.StatusUnauthorized,
+		},
+		{
+			name:      "webhook disabled",
+			method:    http.MethodPost,
+			path:      "/gitsources/default/without-webhook",
+			signature: signGitSourceWebhook(body, "secret"),
+			expected:  http.StatusNotFound,
+		},
+		{
+			name:      "not found",
+			method:    http.MethodPost,
+			path:      "/gitsources/default/missing",
+			signature: signGitSourceWebhook(body, "secret"),
+			expected:  http.StatusNotFound,
+		},
+		{
+			name:     "invalid path",
+			method:   http.MethodPost,
+			path:     "/gitsources/default",
+			expected: http.StatusNotFound,
+		},
+		{
+			name:     "wrong method",
+			method:   http.MethodGet,
+			path:     "/gitsources/default/with-webhook",
+			expected: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(ctx, tt.method, tt.path, bytes.NewReader(body))
+			req.Header.Set("X-Hub-Signature-256", tt.signature)
+			w := httptest.NewRecorder()
+
+			webhook.ServeHTTP(w, req)
+			require.Equal(t, tt.expected, w.Code)
+		})
+	}
+
+	gitSource := &radappiov1alpha3.GitSource{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "with-webhook"}, gitSource))
+	require.Contains(t, gitSource.Annotations, AnnotationGitSourceSyncRequestedAt)
+}
@@ -0,0 +1,741 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	// gatewayResourceType is the resource type whose 'url' property is reported as the URL of a preview.
+	gatewayResourceType = "Applications.Core/gateways"
+
+	// previewNameMaxLength is the maximum length of the name of a preview. The name is used as a Kubernetes
+	// namespace, which must be a valid DNS label.
+	previewNameMaxLength = 63
+)
+
+var (
+	// invalidPreviewNameCharacters matches the characters that are replaced when deriving the name of a preview
+	// from a branch.
+	invalidPreviewNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// PreviewEnvironmentReconciler reconciles a PreviewEnvironment object. It polls the repository of a GitSource for
+// branches and pull requests, and for each of them clones the template Environment into an ephemeral environment
+// and applies the radius-gitops-config.yaml file of the branch into it. Previews are torn down when their branch
+// is deleted, their pull request is closed or their TTL expires.
+type PreviewEnvironmentReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client. It is used to read the URL of gateways.
+	Radius RadiusClient
+
+	// Bicep is used to build the Bicep files of the repository.
+	Bicep bicep.Interface
+
+	// FileSystem is the file system the repository is cloned into.
+	FileSystem filesystem.FileSystem
+
+	// GitClient is used to fetch the repository.
+	GitClient GitClient
+
+	// PullRequestClient is used to list the open pull requests of the repository.
+	PullRequestClient PullRequestClient
+}
+
+// previewReference is a branch or pull request of the repository that matches a PreviewEnvironment.
+type previewReference struct {
+	// Name is the name of the preview.
+	Name string
+	// Reference is the short name of the branch or pull request, e.g. 'feature/login' or 'pull/12'.
+	Reference string
+	// FullName is the full name of the Git reference, e.g. 'refs/heads/feature/login'.
+	FullName string
+	// Revision is the revision the reference points to, with the format '<reference>@sha1:<commit>'.
+	Revision string
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=previewenvironments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=previewenvironments/status,verbs=get;update;patch
+
+// Reconcile is the main reconciliation loop for the PreviewEnvironment resource.
+func (r *PreviewEnvironmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "PreviewEnvironment", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	previewEnvironment := radappiov1alpha3.PreviewEnvironment{}
+	err := r.Client.Get(ctx, req.NamespacedName, &previewEnvironment)
+	if apierrors.IsNotFound(err) {
+		// The finalizer makes sure previews are torn down before the PreviewEnvironment is gone, so there's
+		// nothing to do here.
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if previewEnvironment.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &previewEnvironment)
+	}
+
+	return r.reconcileUpdate(ctx, &previewEnvironment)
+}
+
+func (r *PreviewEnvironmentReconciler) reconcileUpdate(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Ensure that our finalizer is present so that previews are torn down when the PreviewEnvironment is deleted.
+	if controllerutil.AddFinalizer(previewEnvironment, PreviewEnvironmentFinalizer) {
+		err := r.Client.Update(ctx, previewEnvironment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	gitSource := radappiov1alpha3.GitSource{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: previewEnvironment.Namespace, Name: previewEnvironment.Spec.SourceRef.Name}, &gitSource)
+	if err != nil {
+		return r.setFailed(ctx, previewEnvironment, fmt.Errorf("failed to read GitSource %q: %w", previewEnvironment.Spec.SourceRef.Name, err))
+	}
+
+	template := radappiov1alpha3.Environment{}
+	err = r.Client.Get(ctx, client.ObjectKey{Namespace: previewEnvironment.Namespace, Name: previewEnvironment.Spec.EnvironmentTemplate}, &template)
+	if err != nil {
+		return r.setFailed(ctx, previewEnvironment, fmt.Errorf("failed to read Environment %q: %w", previewEnvironment.Spec.EnvironmentTemplate, err))
+	}
+
+	options, err := gitSourceFetchOptions(ctx, r.Client, &gitSource)
+	if err != nil {
+		return r.setFailed(ctx, previewEnvironment, err)
+	}
+
+	references, err := r.listPreviewReferences(ctx, previewEnvironment, options)
+	if err != nil {
+		return r.setFailed(ctx, previewEnvironment, err)
+	}
+
+	now := metav1.Now()
+	requeueAfter := previewEnvironmentInterval(previewEnvironment, &gitSource)
+	previews := []radappiov1alpha3.PreviewStatus{}
+
+	// Tear down the previews of branches and pull requests that are gone.
+	for _, preview := range previewEnvironment.Status.Previews {
+		if slices.ContainsFunc(references, func(reference previewReference) bool { return reference.Name == preview.Name }) {
+			continue
+		}
+
+		done, err := r.teardown(ctx, previewEnvironment, preview.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !done {
+			preview.Phrase = radappiov1alpha3.PreviewPhraseDeleting
+			previews = append(previews, preview)
+			requeueAfter = min(requeueAfter, gitOpsDependencyRequeueInterval)
+			continue
+		}
+
+		logger.Info("Preview deleted", "preview", preview.Name)
+		r.EventRecorder.Event(previewEnvironment, corev1.EventTypeNormal, "PreviewDeleted", fmt.Sprintf("Deleted the preview of %s.", preview.Reference))
+	}
+
+	for _, reference := range references {
+		preview := radappiov1alpha3.PreviewStatus{Name: reference.Name, Reference: reference.Reference, CreatedAt: now}
+		if index := slices.IndexFunc(previewEnvironment.Status.Previews, func(p radappiov1alpha3.PreviewStatus) bool { return p.Name == reference.Name }); index >= 0 {
+			preview = previewEnvironment.Status.Previews[index]
+		}
+
+		if preview.Phrase == radappiov1alpha3.PreviewPhraseExpired {
+			if preview.Revision == reference.Revision {
+				// Expired previews stay down until a new commit is pushed.
+				previews = append(previews, preview)
+				continue
+			}
+
+			preview.CreatedAt = now
+			preview.Message = ""
+		}
+
+		if expiry, ok := previewExpiry(previewEnvironment, &preview); ok && !now.Time.Before(expiry) {
+			done, err := r.teardown(ctx, previewEnvironment, preview.Name)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			if done {
+				logger.Info("Preview expired", "preview", preview.Name)
+				r.EventRecorder.Event(previewEnvironment, corev1.EventTypeNormal, "PreviewExpired", fmt.Sprintf("Deleted the preview of %s because its TTL expired.", preview.Reference))
+				preview.Phrase = radappiov1alpha3.PreviewPhraseExpired
+				preview.Environment = ""
+				preview.URL = ""
+			} else {
+				preview.Phrase = radappiov1alpha3.PreviewPhraseDeleting
+				requeueAfter = min(requeueAfter, gitOpsDependencyRequeueInterval)
+			}
+
+			previews = append(previews, preview)
+			continue
+		}
+
+		err := r.reconcilePreview(ctx, previewEnvironment, &gitSource, &template, options, reference, &preview)
+		if err != nil {
+			logger.Error(err, "Failed to deploy preview", "preview", preview.Name)
+			r.EventRecorder.Event(previewEnvironment, corev1.EventTypeWarning, "PreviewFailed", fmt.Sprintf("Failed to deploy the preview of %s: %s", preview.Reference, err.Error()))
+			preview.Phrase = radappiov1alpha3.PreviewPhraseFailed
+			preview.Message = err.Error()
+		}
+
+		if preview.Phrase != radappiov1alpha3.PreviewPhraseReady {
+			requeueAfter = min(requeueAfter, gitOpsDependencyRequeueInterval)
+		}
+		if expiry, ok := previewExpiry(previewEnvironment, &preview); ok {
+			requeueAfter = min(requeueAfter, max(expiry.Sub(now.Time), time.Second))
+		}
+
+		previews = append(previews, preview)
+	}
+
+	previewEnvironment.Status.ObservedGeneration = previewEnvironment.Generation
+	previewEnvironment.Status.LastSyncTime = &now
+	previewEnvironment.Status.Previews = previews
+	previewEnvironment.Status.Message = ""
+	err = r.Client.Status().Update(ctx, previewEnvironment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// reconcilePreview deploys the latest revision of a branch or pull request into its preview environment, and
+// updates the status of the preview.
+func (r *PreviewEnvironmentReconciler) reconcilePreview(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment, gitSource *radappiov1alpha3.GitSource, template *radappiov1alpha3.Environment, options GitFetchOptions, reference previewReference, preview *radappiov1alpha3.PreviewStatus) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	environment, err := r.createOrUpdateEnvironment(ctx, previewEnvironment, template, preview.Name)
+	if err != nil {
+		return err
+	}
+
+	if !isConfigurationReady(&environment.Status, environment.Generation) {
+		preview.Phrase = radappiov1alpha3.PreviewPhraseDeploying
+		preview.Message = fmt.Sprintf("Waiting for Environment %q to be ready.", environment.Name)
+		return nil
+	}
+
+	preview.Environment = environment.Status.Resource
+
+	source := gitOpsSource{
+		Repository:      previewRepository(previewEnvironment, preview.Name),
+		Branch:          strings.TrimPrefix(reference.FullName, "refs/heads/"),
+		Path:            gitSource.Spec.Path,
+		Namespace:       preview.Name,
+		ResourceGroup:   preview.Name,
+		Environment:     environment.Status.Resource,
+		NamespaceLabels: map[string]string{LabelRadiusPreviewOwner: previewOwner(previewEnvironment)},
+	}
+
+	if preview.Revision != reference.Revision {
+		logger.Info("New revision detected", "preview", preview.Name, "revision", reference.Revision)
+
+		waiting, err := r.apply(ctx, options, reference, source, gitSource.Spec.Path)
+		if err != nil {
+			return err
+		}
+
+		preview.Phrase = radappiov1alpha3.PreviewPhraseDeploying
+		preview.URL = ""
+		if waiting {
+			// Don't record the revision until every entry has been applied, so that the next poll applies it again.
+			preview.Message = "Waiting for dependencies to be deployed."
+			return nil
+		}
+
+		preview.Revision = reference.Revision
+	}
+
+	phrase, url, message, err := r.deploymentStatus(ctx, source.Repository)
+	if err != nil {
+		return err
+	}
+
+	if phrase == radappiov1alpha3.PreviewPhraseReady && preview.Phrase != radappiov1alpha3.PreviewPhraseReady {
+		r.EventRecorder.Event(previewEnvironment, corev1.EventTypeNormal, "PreviewReady", fmt.Sprintf("Deployed %s to the preview of %s.", preview.Revision, preview.Reference))
+	}
+
+	preview.Phrase = phrase
+	preview.URL = url
+	preview.Message = message
+	return nil
+}
+
+// apply clones the reference and applies its radius-gitops-config.yaml file into the preview environment.
+func (r *PreviewEnvironmentReconciler) apply(ctx context.Context, options GitFetchOptions, reference previewReference, source gitOpsSource, sourcePath string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Create temp dir to store the cloned repository
+	tmpDir, err := r.FileSystem.MkdirTemp("", reference.Name)
+	if err != nil {
+		return false, fmt.Errorf("failed to create temp dir, error: %w", err)
+	}
+
+	defer func(path string) {
+		err := r.FileSystem.RemoveAll(path)
+		if err != nil {
+			logger.Error(err, "unable to remove temp dir")
+		}
+	}(tmpDir)
+
+	options.Branch = ""
+	options.Reference = reference.FullName
+	_, err = r.GitClient.Clone(ctx, options, tmpDir)
+	if err != nil {
+		return false, err
+	}
+
+	return r.applier().Apply(ctx, filepath.Join(tmpDir, sourcePath), source)
+}
+
+// deploymentStatus returns the status of the DeploymentTemplates of a preview, and the URL of its gateway if it
+// has one.
+func (r *PreviewEnvironmentReconciler) deploymentStatus(ctx context.Context, repository string) (radappiov1alpha3.PreviewPhrase, string, string, error) {
+	deploymentTemplates := radappiov1alpha3.DeploymentTemplateList{}
+	err := r.Client.List(ctx, &deploymentTemplates, client.MatchingFields{deploymentTemplateRepositoryField: repository}, client.InNamespace(""))
+	if err != nil {
+		return "", "", "", err
+	}
+
+	url := ""
+	for _, deploymentTemplate := range deploymentTemplates.Items {
+		if deploymentTemplate.Status.Phrase == radappiov1alpha3.DeploymentTemplatePhraseFailed {
+			return radappiov1alpha3.PreviewPhraseFailed, "", fmt.Sprintf("DeploymentTemplate %q failed to deploy.", deploymentTemplate.Name), nil
+		}
+
+		if deploymentTemplate.Status.ObservedGeneration != deploymentTemplate.Generation ||
+			deploymentTemplate.Status.Phrase != radappiov1alpha3.DeploymentTemplatePhraseReady {
+			return radappiov1alpha3.PreviewPhraseDeploying, "", fmt.Sprintf("Waiting for DeploymentTemplate %q to be deployed.", deploymentTemplate.Name), nil
+		}
+
+		for _, resourceID := range deploymentTemplate.Status.OutputResources {
+			if url != "" {
+				break
+			}
+
+			url, err = r.gatewayURL(ctx, resourceID)
+			if err != nil {
+				return "", "", "", err
+			}
+		}
+	}
+
+	return radappiov1alpha3.PreviewPhraseReady, url, "", nil
+}
+
+// gatewayURL returns the URL of the resource if it is a gateway, or an empty string otherwise.
+func (r *PreviewEnvironmentReconciler) gatewayURL(ctx context.Context, resourceID string) (string, error) {
+	id, err := resources.Parse(resourceID)
+	if err != nil || !strings.EqualFold(id.Type(), gatewayResourceType) {
+		return "", nil
+	}
+
+	response, err := fetchResource(ctx, r.Radius, resourceID)
+	if clients.Is404Error(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to fetch gateway %q: %w", resourceID, err)
+	}
+
+	url, _ := response.Properties["url"].(string)
+	return url, nil
+}
+
+// createOrUpdateEnvironment clones the template Environment into the Environment of a preview. The preview
+// gets its own resource group and Kubernetes namespace, both named after the preview.
+func (r *PreviewEnvironmentReconciler) createOrUpdateEnvironment(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment, template *radappiov1alpha3.Environment, name string) (*radappiov1alpha3.Environment, error) {
+	spec := template.Spec.DeepCopy()
+	spec.ResourceGroup = name
+	if spec.Providers == nil {
+		spec.Providers = &radappiov1alpha3.EnvironmentProviders{}
+	}
+	spec.Providers.Kubernetes = &radappiov1alpha3.EnvironmentKubernetesProvider{Namespace: name}
+
+	environment := radappiov1alpha3.Environment{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: previewEnvironment.Namespace, Name: name}, &environment)
+	if apierrors.IsNotFound(err) {
+		environment = radappiov1alpha3.Environment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: previewEnvironment.Namespace,
+				Name:      name,
+				Labels:    map[string]string{LabelRadiusPreviewEnvironment: previewEnvironment.Name},
+			},
+			Spec: *spec,
+		}
+
+		err = r.Client.Create(ctx, &environment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Environment %q: %w", name, err)
+		}
+
+		r.EventRecorder.Event(previewEnvironment, corev1.EventTypeNormal, "PreviewCreated", fmt.Sprintf("Created Environment %q.", name))
+		return &environment, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if environment.Labels[LabelRadiusPreviewEnvironment] != previewEnvironment.Name {
+		return nil, fmt.Errorf("Environment %q already exists and is not managed by this PreviewEnvironment", name)
+	}
+
+	if !equality.Semantic.DeepEqual(environment.Spec, *spec) {
+		environment.Spec = *spec
+		err = r.Client.Update(ctx, &environment)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update Environment %q: %w", name, err)
+		}
+	}
+
+	return &environment, nil
+}
+
+// teardown deletes the DeploymentTemplates, the Environment and the namespace of a preview. It returns true once
+// the DeploymentTemplates and the Environment are gone.
+func (r *PreviewEnvironmentReconciler) teardown(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment, name string) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	repository := previewRepository(previewEnvironment, name)
+
+	// The application must be deleted before the environment it is deployed to.
+	err := r.applier().prune(ctx, repository, nil)
+	if err != nil {
+		return false, err
+	}
+
+	deploymentTemplates := radappiov1alpha3.DeploymentTemplateList{}
+	err = r.Client.List(ctx, &deploymentTemplates, client.MatchingFields{deploymentTemplateRepositoryField: repository}, client.InNamespace(""))
+	if err != nil {
+		return false, err
+	}
+	if len(deploymentTemplates.Items) > 0 {
+		logger.Info("Waiting for DeploymentTemplates to be deleted", "preview", name, "count", len(deploymentTemplates.Items))
+		return false, nil
+	}
+
+	environment := radappiov1alpha3.Environment{}
+	key := client.ObjectKey{Namespace: previewEnvironment.Namespace, Name: name}
+	err = r.Client.Get(ctx, key, &environment)
+	if err == nil && environment.Labels[LabelRadiusPreviewEnvironment] == previewEnvironment.Name {
+		if environment.DeletionTimestamp == nil {
+			err = r.Client.Delete(ctx, &environment)
+			if client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+		}
+
+		// The finalizer of the Environment keeps it around until the Radius environment is deleted.
+		err = r.Client.Get(ctx, key, &environment)
+		if err == nil {
+			logger.Info("Waiting for Environment to be deleted", "preview", name)
+			return false, nil
+		} else if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+	} else if client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+
+	namespace := corev1.Namespace{}
+	err = r.Client.Get(ctx, client.ObjectKey{Name: name}, &namespace)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	// Namespaces that were not created for the preview are left alone.
+	if namespace.Labels[LabelRadiusPreviewOwner] != previewOwner(previewEnvironment) {
+		logger.Info("Namespace was not created for the preview, skipping its deletion", "preview", name)
+		return true, nil
+	}
+
+	err = r.Client.Delete(ctx, &namespace)
+	if client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *PreviewEnvironmentReconciler) reconcileDelete(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("PreviewEnvironment is being deleted, tearing down previews")
+	remaining := []radappiov1alpha3.PreviewStatus{}
+	for _, preview := range previewEnvironment.Status.Previews {
+		done, err := r.teardown(ctx, previewEnvironment, preview.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !done {
+			preview.Phrase = radappiov1alpha3.PreviewPhraseDeleting
+			remaining = append(remaining, preview)
+		}
+	}
+
+	if len(remaining) > 0 {
+		previewEnvironment.Status.Previews = remaining
+		err := r.Client.Status().Update(ctx, previewEnvironment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: gitOpsDependencyRequeueInterval}, nil
+	}
+
+	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
+	// PreviewEnvironment.
+	if controllerutil.RemoveFinalizer(previewEnvironment, PreviewEnvironmentFinalizer) {
+		err := r.Client.Update(ctx, previewEnvironment)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("Finished deleting PreviewEnvironment.")
+	return ctrl.Result{}, nil
+}
+
+// setFailed records the error in the status of the PreviewEnvironment and returns it so that the reconciliation
+// is retried. Existing previews are left untouched.
+func (r *PreviewEnvironmentReconciler) setFailed(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment, cause error) (ctrl.Result, error) {
+	previewEnvironment.Status.ObservedGeneration = previewEnvironment.Generation
+	previewEnvironment.Status.Message = cause.Error()
+	err := r.Client.Status().Update(ctx, previewEnvironment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.EventRecorder.Event(previewEnvironment, corev1.EventTypeWarning, "SyncFailed", cause.Error())
+	return ctrl.Result{}, cause
+}
+
+// listPreviewReferences returns the branches and pull requests of the repository that match the
+// PreviewEnvironment, sorted by name.
+func (r *PreviewEnvironmentReconciler) listPreviewReferences(ctx context.Context, previewEnvironment *radappiov1alpha3.PreviewEnvironment, options GitFetchOptions) ([]previewReference, error) {
+	references, err := r.GitClient.ListReferences(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []previewReference{}
+	for fullName, commit := range references {
+		reference, suffix := "", ""
+		if branch, ok := strings.CutPrefix(fullName, "refs/heads/"); ok {
+			matched, err := matchesAnyBranch(previewEnvironment.Spec.Branches, branch)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				reference, suffix = branch, branch
+			}
+		}
+
+		if reference == "" {
+			continue
+		}
+
+		result = append(result, previewReference{
+			Name:      previewName(previewEnvironment, suffix),
+			Reference: reference,
+			FullName:  fullName,
+			Revision:  fmt.Sprintf("%s@sha1:%s", reference, commit),
+		})
+	}
+
+	if previewEnvironment.Spec.PullRequests {
+		// The merge references of GitHub are not updated while a pull request has conflicts, so the head of the pull
+		// request is previewed. Whether the pull request is open comes from the API rather than the references.
+		if r.PullRequestClient == nil {
+			return nil, errors.New("pull requests are not supported, the pull request client is not configured")
+		}
+
+		pullRequests, err := r.PullRequestClient.ListOpenPullRequests(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pullRequest := range pullRequests {
+			if !pullRequestAllowed(&previewEnvironment.Spec, pullRequest) {
+				continue
+			}
+
+			reference := fmt.Sprintf("pull/%d", pullRequest.Number)
+			result = append(result, previewReference{
+				Name:      previewName(previewEnvironment, fmt.Sprintf("pr-%d", pullRequest.Number)),
+				Reference: reference,
+				FullName:  fmt.Sprintf("refs/pull/%d/head", pullRequest.Number),
+				Revision:  fmt.Sprintf("%s@sha1:%s", reference, pullRequest.HeadCommit),
+			})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b previewReference) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+// pullRequestAllowed returns true if the author of the pull request is allowed, or the pull request has the label
+// of the PreviewEnvironment. Pull requests are never allowed without one of them, because pull requests from forks
+// would be deployed with the credentials of the environment.
+func pullRequestAllowed(spec *radappiov1alpha3.PreviewEnvironmentSpec, pullRequest PullRequest) bool {
+	for _, author := range spec.PullRequestAuthors {
+		if strings.EqualFold(author, pullRequest.Author) {
+			return true
+		}
+	}
+
+	if spec.PullRequestLabel == "" {
+		return false
+	}
+
+	for _, label := range pullRequest.Labels {
+		if strings.EqualFold(label, spec.PullRequestLabel) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applier returns the gitOpsApplier used to apply the configuration of the previews.
+func (r *PreviewEnvironmentReconciler) applier() *gitOpsApplier {
+	return &gitOpsApplier{
+		Client:     r.Client,
+		Bicep:      r.Bicep,
+		FileSystem: r.FileSystem,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PreviewEnvironmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := indexDeploymentTemplateRepository(mgr)
+	if err != nil {
+		return err
+	}
+
+	// Status updates don't trigger reconciliation. The repository is polled by requeueing.
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.PreviewEnvironment{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Complete(r)
+}
+
+// matchesAnyBranch returns true if the branch matches one of the patterns.
+func matchesAnyBranch(patterns []string, branch string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, branch)
+		if err != nil {
+			return false, fmt.Errorf("invalid branch pattern %q: %w", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// previewName returns the name of the preview of a branch or pull request. The name is a valid DNS label, so that
+// it can be used for the Kubernetes namespace of the preview. Namespaces and resource groups are shared by the
+// cluster, so the name is suffixed with a hash of the namespace and name of the PreviewEnvironment and of the
+// reference, which keeps the names of PreviewEnvironments of different namespaces apart. Long names are truncated.
+func previewName(previewEnvironment *radappiov1alpha3.PreviewEnvironment, reference string) string {
+	hash := sha1.Sum([]byte(previewEnvironment.Namespace + "/" + previewEnvironment.Name + "/" + reference))
+	suffix := hex.EncodeToString(hash[:])[:8]
+
+	name := strings.ToLower(previewEnvironment.Name + "-" + reference)
+	name = strings.Trim(invalidPreviewNameCharacters.ReplaceAllString(name, "-"), "-")
+	if len(name) > previewNameMaxLength-len(suffix)-1 {
+		name = strings.TrimRight(name[:previewNameMaxLength-len(suffix)-1], "-")
+	}
+
+	return name + "-" + suffix
+}
+
+// previewOwner returns the value of the LabelRadiusPreviewOwner label of the namespaces of the previews of a
+// PreviewEnvironment.
+func previewOwner(previewEnvironment *radappiov1alpha3.PreviewEnvironment) string {
+	hash := sha1.Sum([]byte(previewEnvironment.Namespace + "/" + previewEnvironment.Name))
+	return hex.EncodeToString(hash[:])
+}
+
+// previewRepository returns the value of the repository field of the DeploymentTemplates of a preview.
+func previewRepository(previewEnvironment *radappiov1alpha3.PreviewEnvironment, name string) string {
+	return fmt.Sprintf("previewenvironments/%s/%s/%s", previewEnvironment.Namespace, previewEnvironment.Name, name)
+}
+
+// previewEnvironmentInterval returns the interval between polls of the repository.
+func previewEnvironmentInterval(previewEnvironment *radappiov1alpha3.PreviewEnvironment, gitSource *radappiov1alpha3.GitSource) time.Duration {
+	if previewEnvironment.Spec.Interval == nil || previewEnvironment.Spec.Interval.Duration <= 0 {
+		return gitSourceInterval(gitSource)
+	}
+
+	return previewEnvironment.Spec.Interval.Duration
+}
+
+// previewExpiry returns the time a preview expires, and false if the PreviewEnvironment has no TTL.
+func previewExpiry(previewEnvironment *radappiov1alpha3.PreviewEnvironment, preview *radappiov1alpha3.PreviewStatus) (time.Time, bool) {
+	if previewEnvironment.Spec.TTL == nil || previewEnvironment.Spec.TTL.Duration <= 0 {
+		return time.Time{}, false
+	}
+
+	return preview.CreatedAt.Add(previewEnvironment.Spec.TTL.Duration), true
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const testPreviewEnvironmentConfig = `config:
+  - name: app.bicep
+`
+
+func Test_PreviewEnvironmentReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "previews"}
+	previewEnvironment := &radappiov1alpha3.PreviewEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name},
+		Spec: radappiov1alpha3.PreviewEnvironmentSpec{
+			SourceRef:           corev1.LocalObjectReference{Name: "my-repo"},
+			Branches:            []string{"feature/*"},
+			PullRequests:        true,
+			PullRequestLabel:    "preview",
+			EnvironmentTemplate: "template",
+		},
+	}
+	gitSource := &radappiov1alpha3.GitSource{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "my-repo"},
+		Spec: radappiov1alpha3.GitSourceSpec{
+			URL:    "https://github.com/radius-project/samples.git",
+			Branch: "main",
+		},
+	}
+	template := &radappiov1alpha3.Environment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "template"},
+		Spec: radappiov1alpha3.EnvironmentSpec{
+			ResourceGroup: "staging",
+			RecipePacks:   []string{"default"},
+			Providers: &radappiov1alpha3.EnvironmentProviders{
+				Kubernetes: &radappiov1alpha3.EnvironmentKubernetesProvider{Namespace: "staging"},
+				AWS:        &radappiov1alpha3.EnvironmentAWSProvider{AccountID: "0123456789", Region: "us-west-2"},
+			},
+		},
+	}
+
+	preview := previewName(previewEnvironment, "feature/login")
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(previewEnvironment, gitSource, template).
+		WithStatusSubresource(&radappiov1alpha3.PreviewEnvironment{}, &radappiov1alpha3.Environment{}, &radappiov1alpha3.DeploymentTemplate{}).
+		WithIndex(&radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer).
+		Build()
+
+	fs := filesystem.NewMemMapFileSystem()
+	gitClient := NewMockGitClient(mctrl)
+	bicepClient := bicep.NewMockInterface(mctrl)
+	radius := NewMockRadiusClient()
+
+	references := map[string]string{
+		"refs/heads/main":          "1111111111111111111111111111111111111111",
+		"refs/heads/feature/login": "2222222222222222222222222222222222222222",
+	}
+	gitClient.EXPECT().ListReferences(gomock.Any(), GitFetchOptions{URL: gitSource.Spec.URL, Branch: "main"}).
+		DoAndReturn(func(ctx context.Context, options GitFetchOptions) (map[string]string, error) {
+			return references, nil
+		}).AnyTimes()
+	gitClient.EXPECT().Clone(gomock.Any(), GitFetchOptions{URL: gitSource.Spec.URL, Reference: "refs/heads/feature/login"}, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, options GitFetchOptions, dir string) (string, error) {
+			require.NoError(t, fs.WriteFile(filepath.Join(dir, radiusConfigFileName), []byte(testPreviewEnvironmentConfig), 0644))
+			require.NoError(t, fs.WriteFile(filepath.Join(dir, "app.bicep"), []byte{}, 0644))
+			return "feature/login@sha1:2222222222222222222222222222222222222222", nil
+		}).Times(1)
+	bicepClient.EXPECT().Call("build", gomock.Any(), "--outfile", gomock.Any()).
+		DoAndReturn(func(args ...string) ([]byte, error) {
+			return nil, fs.WriteFile(args[3], []byte(`{"resources":{}}`), 0644)
+		}).AnyTimes()
+
+	// Pull requests without the label are not previewed.
+	pullRequestClient := NewMockPullRequestClient(mctrl)
+	pullRequestClient.EXPECT().ListOpenPullRequests(gomock.Any(), GitFetchOptions{URL: gitSource.Spec.URL, Branch: "main"}).
+		Return([]PullRequest{{Number: 7, HeadCommit: "4444444444444444444444444444444444444444", Author: "someone"}}, nil).AnyTimes()
+
+	reconciler := &PreviewEnvironmentReconciler{
+		Client:            k8sClient,
+		Scheme:            testScheme,
+		EventRecorder:     record.NewFakeRecorder(20),
+		Radius:            radius,
+		Bicep:             bicepClient,
+		FileSystem:        fs,
+		GitClient:         gitClient,
+		PullRequestClient: pullRequestClient,
+	}
+
+	getPreviews := func() []radappiov1alpha3.PreviewStatus {
+		result := &radappiov1alpha3.PreviewEnvironment{}
+		require.NoError(t, k8sClient.Get(ctx, name, result))
+		return result.Status.Previews
+	}
+
+	// The first sync clones the template environment for the matching branch.
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, gitOpsDependencyRequeueInterval, result.RequeueAfter)
+
+	previews := getPreviews()
+	require.Len(t, previews, 1)
+	require.Equal(t, preview, previews[0].Name)
+	require.Equal(t, "feature/login", previews[0].Reference)
+	require.Equal(t, radappiov1alpha3.PreviewPhraseDeploying, previews[0].Phrase)
+
+	environment := &radappiov1alpha3.Environment{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: preview}, environment))
+	require.Equal(t, "previews", environment.Labels[LabelRadiusPreviewEnvironment])
+	require.Equal(t, preview, environment.Spec.ResourceGroup)
+	require.Equal(t, []string{"default"}, environment.Spec.RecipePacks)
+	require.Equal(t, preview, environment.Spec.Providers.Kubernetes.Namespace)
+	require.Equal(t, template.Spec.Providers.AWS, environment.Spec.Providers.AWS)
+
+	// Once the environment is ready, the branch is deployed into it.
+	environmentID := "/planes/radius/local/resourceGroups/" + preview + "/providers/Radius.Core/environments/" + preview
+	markConfigurationSynced(&environment.Status, environment.Generation, environmentID)
+	require.NoError(t, k8sClient.Status().Update(ctx, environment))
+
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, gitOpsDependencyRequeueInterval, result.RequeueAfter)
+
+	previews = getPreviews()
+	require.Equal(t, radappiov1alpha3.PreviewPhraseDeploying, previews[0].Phrase)
+	require.Equal(t, "feature/login@sha1:2222222222222222222222222222222222222222", previews[0].Revision)
+	require.Equal(t, environmentID, previews[0].Environment)
+
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: preview, Name: "app.bicep"}, deploymentTemplate))
+	require.Equal(t, "previewenvironments/default/previews/"+preview, deploymentTemplate.Spec.Repository)
+	require.Equal(t, environmentID, deploymentTemplate.Spec.Parameters[environmentParameterName])
+	require.Contains(t, deploymentTemplate.Spec.ProviderConfig, preview)
+
+	namespace := &corev1.Namespace{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: preview}, namespace))
+	require.Equal(t, previewOwner(previewEnvironment), namespace.Labels[LabelRadiusPreviewOwner])
+
+	// Once the application is deployed, the URL of its gateway is reported.
+	gatewayID := "/planes/radius/local/resourceGroups/" + preview + "/providers/Applications.Core/gateways/gateway"
+	radius.resources[gatewayID] = generated.GenericResource{
+		ID:         to.Ptr(gatewayID),
+		Properties: map[string]any{"url": "http://" + preview + ".example.com"},
+	}
+	deploymentTemplate.Status.ObservedGeneration = deploymentTemplate.Generation
+	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReady
+	deploymentTemplate.Status.OutputResources = []string{
+		"/planes/radius/local/resourceGroups/" + preview + "/providers/Applications.Core/applications/app",
+		gatewayID,
+	}
+	require.NoError(t, k8sClient.Status().Update(ctx, deploymentTemplate))
+
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, GitSourceDefaultInterval, result.RequeueAfter)
+
+	previews = getPreviews()
+	require.Equal(t, radappiov1alpha3.PreviewPhraseReady, previews[0].Phrase)
+	require.Equal(t, "http://"+preview+".example.com", previews[0].URL)
+
+	// Deleting the branch tears down the preview.
+	delete(references, "refs/heads/feature/login")
+
+	result, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, GitSourceDefaultInterval, result.RequeueAfter)
+	require.Empty(t, getPreviews())
+
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: preview, Name: "app.bicep"}, &radappiov1alpha3.DeploymentTemplate{})
+	require.True(t, apierrors.IsNotFound(err))
+	err = k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: preview}, &radappiov1alpha3.Environment{})
+	require.True(t, apierrors.IsNotFound(err))
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: preview}, &corev1.Namespace{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_PreviewEnvironmentReconciler_TTL(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "previews"}
+	revision := "pull/12@sha1:3333333333333333333333333333333333333333"
+	preview := previewName(&radappiov1alpha3.PreviewEnvironment{ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name}}, "pr-12")
+	previewEnvironment := &radappiov1alpha3.PreviewEnvironment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name, Finalizers: []string{PreviewEnvironmentFinalizer}},
+		Spec: radappiov1alpha3.PreviewEnvironmentSpec{
+			SourceRef:           corev1.LocalObjectReference{Name: "my-repo"},
+			PullRequests:        true,
+			PullRequestAuthors:  []string{"octocat"},
+			EnvironmentTemplate: "template",
+			TTL:                 &metav1.Duration{Duration: time.Hour},
+		},
+		Status: radappiov1alpha3.PreviewEnvironmentStatus{
+			Previews: []radappiov1alpha3.PreviewStatus{
+				{
+					Name:      preview,
+					Reference: "pull/12",
+					Revision:  revision,
+					Phrase:    radappiov1alpha3.PreviewPhraseReady,
+					URL:       "http://" + preview + ".example.com",
+					CreatedAt: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+				},
+			},
+		},
+	}
+	environment := &radappiov1alpha3.Environment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name.Namespace,
+			Name:      preview,
+			Labels:    map[string]string{LabelRadiusPreviewEnvironment: name.Name},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(previewEnvironment, environment,
+			&radappiov1alpha3.GitSource{ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "my-repo"}, Spec: radappiov1alpha3.GitSourceSpec{URL: "https://github.com/radius-project/samples.git"}},
+			&radappiov1alpha3.Environment{ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "template"}}).
+		WithStatusSubresource(&radappiov1alpha3.PreviewEnvironment{}, &radappiov1alpha3.Environment{}, &radappiov1alpha3.DeploymentTemplate{}).
+		WithIndex(&radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer).
+		Build()
+
+	gitClient := NewMockGitClient(mctrl)
+	gitClient.EXPECT().ListReferences(gomock.Any(), gomock.Any()).
+		Return(map[string]string{"refs/pull/12/head": "3333333333333333333333333333333333333333"}, nil).AnyTimes()
+
+	pullRequestClient := NewMockPullRequestClient(mctrl)
+	pullRequestClient.EXPECT().ListOpenPullRequests(gomock.Any(), gomock.Any()).
+		Return([]PullRequest{{Number: 12, HeadCommit: "3333333333333333333333333333333333333333", Author: "OctoCat"}}, nil).AnyTimes()
+
+	reconciler := &PreviewEnvironmentReconciler{
+		Client:            k8sClient,
+		Scheme:            testScheme,
+		EventRecorder:     record.NewFakeRecorder(20),
+		Radius:            NewMockRadiusClient(),
+		Bicep:             bicep.NewMockInterface(mctrl),
+		FileSystem:        filesystem.NewMemMapFileSystem(),
+		GitClient:         gitClient,
+		PullRequestClient: pullRequestClient,
+	}
+
+	// The expired preview is torn down, and stays down while the pull request has no new commits.
+	for range 2 {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+		require.NoError(t, err)
+
+		result := &radappiov1alpha3.PreviewEnvironment{}
+		require.NoError(t, k8sClient.Get(ctx, name, result))
+		require.Len(t, result.Status.Previews, 1)
+		require.Equal(t, radappiov1alpha3.PreviewPhraseExpired, result.Status.Previews[0].Phrase)
+		require.Equal(t, revision, result.Status.Previews[0].Revision)
+		require.Empty(t, result.Status.Previews[0].URL)
+
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: preview}, &radappiov1alpha3.Environment{})
+		require.True(t, apierrors.IsNotFound(err))
+	}
+}
+
+func Test_pullRequestAllowed(t *testing.T) {
+	spec := &radappiov1alpha3.PreviewEnvironmentSpec{PullRequests: true, PullRequestAuthors: []string{"octocat"}, PullRequestLabel: "preview"}
+
+	require.True(t, pullRequestAllowed(spec, PullRequest{Author: "OctoCat"}))
+	require.True(t, pullRequestAllowed(spec, PullRequest{Author: "fork-user", Labels: []string{"bug", "Preview"}}))
+	require.False(t, pullRequestAllowed(spec, PullRequest{Author: "fork-user", Labels: []string{"bug"}}))
+
+	// Without a gate no pull request is allowed.
+	require.False(t, pullRequestAllowed(&radappiov1alpha3.PreviewEnvironmentSpec{PullRequests: true}, PullRequest{Author: "octocat"}))
+}
+
+func Test_PreviewEnvironmentReconciler_NamespaceOwnership(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	previewEnvironment := &radappiov1alpha3.PreviewEnvironment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "previews"}}
+	owned := previewName(previewEnvironment, "pr-1")
+	unowned := previewName(previewEnvironment, "pr-2")
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: owned, Labels: map[string]string{LabelRadiusPreviewOwner: previewOwner(previewEnvironment)}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: unowned}}).
+		WithIndex(&radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer).
+		Build()
+
+	reconciler := &PreviewEnvironmentReconciler{Client: k8sClient, Scheme: testScheme}
+
+	// Previews are not deployed into namespaces they didn't create.
+	labels := map[string]string{LabelRadiusPreviewOwner: previewOwner(previewEnvironment)}
+	require.NoError(t, reconciler.applier().checkNamespaceLabels(ctx, owned, labels))
+	err := reconciler.applier().checkNamespaceLabels(ctx, unowned, labels)
+	require.ErrorContains(t, err, "already exists and was not created for this source")
+
+	// Tearing down a preview only deletes the namespace it created.
+	done, err := reconciler.teardown(ctx, previewEnvironment, owned)
+	require.NoError(t, err)
+	require.True(t, done)
+	err = k8sClient.Get(ctx, types.NamespacedName{Name: owned}, &corev1.Namespace{})
+	require.True(t, apierrors.IsNotFound(err))
+
+	done, err = reconciler.teardown(ctx, previewEnvironment, unowned)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Name: unowned}, &corev1.Namespace{}))
+}
+
+func Test_previewName(t *testing.T) {
+	previewEnvironment := &radappiov1alpha3.PreviewEnvironment{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "Previews"}}
+
+	tests := []struct {
+		reference string
+		prefix    string
+	}{
+		{reference: "feature/login", prefix: "previews-feature-login-"},
+		{reference: "pr-12", prefix: "previews-pr-12-"},
+		{reference: "Fix_Bug..2", prefix: "previews-fix-bug-2-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			name := previewName(previewEnvironment, tt.reference)
+			require.True(t, strings.HasPrefix(name, tt.prefix), name)
+			require.Len(t, name, len(tt.prefix)+8)
+			require.Equal(t, name, previewName(previewEnvironment, tt.reference))
+		})
+	}
+
+	t.Run("namespaced", func(t *testing.T) {
+		// PreviewEnvironments with the same name in different namespaces get different previews.
+		other := &radappiov1alpha3.PreviewEnvironment{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "Previews"}}
+		require.NotEqual(t, previewName(previewEnvironment, "pr-12"), previewName(other, "pr-12"))
+		require.NotEqual(t, previewOwner(previewEnvironment), previewOwner(other))
+	})
+
+	t.Run("long", func(t *testing.T) {
+		name := previewName(previewEnvironment, "feature/"+strings.Repeat("a", 80))
+		require.Len(t, name, previewNameMaxLength)
+		require.NotEqual(t, name, previewName(previewEnvironment, "feature/"+strings.Repeat("a", 81)))
+	})
+}
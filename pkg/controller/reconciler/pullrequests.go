@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// gitHubAPIURL is the URL of the API of github.com. GitHub Enterprise Server serves its API at '/api/v3' of
+	// the host of the repository.
+	gitHubAPIURL = "https://api.github.com"
+
+	// gitHubPullRequestsPageSize is the number of pull requests requested per page.
+	gitHubPullRequestsPageSize = 100
+)
+
+// PullRequest is an open pull request of a repository.
+type PullRequest struct {
+	// Number is the number of the pull request.
+	Number int
+
+	// HeadCommit is the commit the head of the pull request points to.
+	HeadCommit string
+
+	// Author is the login of the author of the pull request.
+	Author string
+
+	// Labels are the names of the labels of the pull request.
+	Labels []string
+}
+
+// PullRequestClient lists the open pull requests of a repository.
+type PullRequestClient interface {
+	// ListOpenPullRequests returns the open pull requests of the repository. The password of the options is used as
+	// the API token if set.
+	ListOpenPullRequests(ctx context.Context, options GitFetchOptions) ([]PullRequest, error)
+}
+
+var _ PullRequestClient = (*GitHubClientImpl)(nil)
+
+// GitHubClientImpl is the implementation of PullRequestClient using the GitHub REST API.
+type GitHubClientImpl struct {
+	// HTTPClient is the client used to call the API. http.DefaultClient is used if unset.
+	HTTPClient *http.Client
+
+	// BaseURL overrides the URL of the API.
+	BaseURL string
+}
+
+// NewGitHubClient creates a new PullRequestClient for GitHub repositories.
+func NewGitHubClient() PullRequestClient {
+	return &GitHubClientImpl{}
+}
+
+// ListOpenPullRequests lists the open pull requests of a GitHub repository, following pagination.
+func (g *GitHubClientImpl) ListOpenPullRequests(ctx context.Context, options GitFetchOptions) ([]PullRequest, error) {
+	baseURL, repository, err := g.repositoryAPI(options.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := g.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	result := []PullRequest{}
+	for page := 1; ; page++ {
+		endpoint := fmt.Sprintf("%s/repos/%s/pulls?state=open&per_page=%d&page=%d", baseURL, repository, gitHubPullRequestsPageSize, page)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Accept", "application/vnd.github+json")
+		if options.Password != "" {
+			req.Header.Set("Authorization", "Bearer "+options.Password)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests of %s: %w", repository, err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests of %s: %w", repository, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to list pull requests of %s: unexpected status code %d", repository, resp.StatusCode)
+		}
+
+		pulls := []struct {
+			Number int `json:"number"`
+			User   struct {
+				Login string `json:"login"`
+			} `json:"user"`
+			Head struct {
+				SHA string `json:"sha"`
+			} `json:"head"`
+			Labels []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+		}{}
+		err = json.Unmarshal(body, &pulls)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse pull requests of %s: %w", repository, err)
+		}
+
+		for _, pull := range pulls {
+			pullRequest := PullRequest{Number: pull.Number, HeadCommit: pull.Head.SHA, Author: pull.User.Login}
+			for _, label := range pull.Labels {
+				pullRequest.Labels = append(pullRequest.Labels, label.Name)
+			}
+			result = append(result, pullRequest)
+		}
+
+		if len(pulls) < gitHubPullRequestsPageSize {
+			return result, nil
+		}
+	}
+}
+
+// repositoryAPI returns the URL of the API and the '<owner>/<repository>' name of a GitHub repository URL.
+func (g *GitHubClientImpl) repositoryAPI(repositoryURL string) (string, string, error) {
+	u, err := url.Parse(repositoryURL)
+	if err != nil || u.Host == "" {
+		return "", "", fmt.Errorf("pull requests are only supported for HTTPS GitHub repository URLs, got %q", repositoryURL)
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimSuffix(u.Path, ".git"), "/"), "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("failed to find the owner and name of the repository in %q", repositoryURL)
+	}
+
+	baseURL := g.BaseURL
+	if baseURL == "" {
+		baseURL = gitHubAPIURL
+		if !strings.EqualFold(u.Hostname(), "github.com") {
+			baseURL = fmt.Sprintf("%s://%s/api/v3", u.Scheme, u.Host)
+		}
+	}
+
+	return strings.TrimSuffix(baseURL, "/"), segments[0] + "/" + segments[1], nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GitHubClient_ListOpenPullRequests(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repos/radius-project/samples/pulls", r.URL.Path)
+		require.Equal(t, "open", r.URL.Query().Get("state"))
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		// The first page is full, the second page ends the listing.
+		pulls := []map[string]any{}
+		count := gitHubPullRequestsPageSize
+		if r.URL.Query().Get("page") == "2" {
+			count = 1
+		}
+		for i := range count {
+			pulls = append(pulls, map[string]any{
+				"number": i + 1,
+				"user":   map[string]any{"login": "octocat"},
+				"head":   map[string]any{"sha": fmt.Sprintf("sha-%d", i+1)},
+				"labels": []map[string]any{{"name": "preview"}},
+			})
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(pulls))
+	}))
+	defer server.Close()
+
+	client := &GitHubClientImpl{HTTPClient: server.Client(), BaseURL: server.URL}
+	pullRequests, err := client.ListOpenPullRequests(ctx, GitFetchOptions{URL: "https://github.com/radius-project/samples.git", Password: "token"})
+	require.NoError(t, err)
+	require.Len(t, pullRequests, gitHubPullRequestsPageSize+1)
+	require.Equal(t, PullRequest{Number: 1, HeadCommit: "sha-1", Author: "octocat", Labels: []string{"preview"}}, pullRequests[0])
+}
+
+func Test_GitHubClient_ListOpenPullRequests_Error(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &GitHubClientImpl{HTTPClient: server.Client(), BaseURL: server.URL}
+	_, err := client.ListOpenPullRequests(ctx, GitFetchOptions{URL: "https://github.com/radius-project/samples"})
+	require.ErrorContains(t, err, "unexpected status code 404")
+}
+
+func Test_GitHubClient_repositoryAPI(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		baseURL    string
+		repository string
+		err        string
+	}{
+		{
+			name:       "github.com",
+			url:        "https://github.com/radius-project/samples.git",
+			baseURL:    "https://api.github.com",
+			repository: "radius-project/samples",
+		},
+		{
+			name:       "github enterprise server",
+			url:        "https://git.contoso.com/radius-project/samples",
+			baseURL:    "https://git.contoso.com/api/v3",
+			repository: "radius-project/samples",
+		},
+		{
+			name: "ssh url",
+			url:  "git@github.com:radius-project/samples.git",
+			err:  "only supported for HTTPS GitHub repository URLs",
+		},
+		{
+			name: "missing repository",
+			url:  "https://github.com/radius-project",
+			err:  "failed to find the owner and name of the repository",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, repository, err := (&GitHubClientImpl{}).repositoryAPI(tt.url)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.baseURL, baseURL)
+			require.Equal(t, tt.repository, repository)
+		})
+	}
+}
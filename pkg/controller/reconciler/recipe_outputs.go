@@ -0,0 +1,424 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// recipeProperties returns the properties used to create or update the resource of a Recipe.
+func recipeProperties(recipe *radappiov1alpha3.Recipe) (map[string]any, error) {
+	properties := map[string]any{
+		"application":          recipe.Status.Application,
+		"environment":          recipe.Status.Environment,
+		"resourceProvisioning": "recipe",
+	}
+
+	if len(recipe.Spec.Parameters) > 0 {
+		parameters := map[string]any{}
+		for k, v := range recipe.Spec.Parameters {
+			var value any
+			err := json.Unmarshal(v.Raw, &value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read parameter %s: %w", k, err)
+			}
+
+			parameters[k] = value
+		}
+
+		properties["recipe"] = map[string]any{"parameters": parameters}
+	}
+
+	return properties, nil
+}
+
+// computeRecipePropertiesHash returns a hash of the properties of the resource of a Recipe.
+func computeRecipePropertiesHash(properties map[string]any) (string, error) {
+	b, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// updateOutputs writes the values of the resource to the secret and ConfigMap of the Recipe. When the values
+// change, the Deployments that consume them are rolled out.
+func (r *RecipeReconciler) updateOutputs(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	secretData, configMapData, err := r.fetchOutputs(ctx, recipe)
+	if err != nil {
+		return err
+	}
+
+	err = r.updateSecret(ctx, recipe, secretData)
+	if err != nil {
+		return fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
+	}
+
+	err = r.updateConfigMap(ctx, recipe, configMapData)
+	if err != nil {
+		return fmt.Errorf("failed to process config map %s: %w", recipe.Spec.ConfigMapName, err)
+	}
+
+	hash, err := computeRecipeOutputsHash(secretData, configMapData)
+	if err != nil {
+		return err
+	}
+
+	if hash == recipe.Status.OutputsHash {
+		return nil
+	}
+
+	recipe.Status.OutputsHash = hash
+	return r.rolloutDeployments(ctx, recipe)
+}
+
+// fetchOutputs reads the values of the resource and returns the data to write to the secret and ConfigMap.
+func (r *RecipeReconciler) fetchOutputs(ctx context.Context, recipe *radappiov1alpha3.Recipe) (map[string]string, map[string]string, error) {
+	if recipe.Spec.SecretName == "" && recipe.Spec.ConfigMapName == "" {
+		return nil, nil, nil
+	}
+
+	result, err := fetchResource(ctx, r.Radius, recipe.Status.Resource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read resource: %w", err)
+	}
+
+	values, err := resourceToConnectionValues(result.GenericResource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read connection values: %w", err)
+	}
+
+	secrets := map[string]string{}
+	if recipe.Spec.SecretName != "" {
+		response, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ListSecrets(ctx, recipe.Name)
+		if clients.Is404Error(err) {
+			// Safe to ignore. Not everything implements this.
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to list secrets: %w", err)
+		} else {
+			for k, v := range response.Value {
+				secrets[k] = *v
+			}
+		}
+	}
+
+	return recipeOutputData(recipe, values, secrets)
+}
+
+// recipeOutputData returns the data to write to the secret and ConfigMap of a Recipe, given the non-sensitive
+// values and the secrets of its resource.
+//
+// Without a ConfigMap, the secret contains every value for compatibility. Otherwise the non-sensitive values
+// are written to the ConfigMap and the secret only contains the secrets. Key mappings replace these defaults.
+func recipeOutputData(recipe *radappiov1alpha3.Recipe, values map[string]string, secrets map[string]string) (map[string]string, map[string]string, error) {
+	var secretData, configMapData map[string]string
+	var err error
+
+	if recipe.Spec.SecretName != "" {
+		all := maps.Clone(values)
+		maps.Copy(all, secrets)
+
+		switch {
+		case len(recipe.Spec.SecretKeys) > 0:
+			secretData, err = renderRecipeKeys(recipe.Spec.SecretKeys, all)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to render secret keys: %w", err)
+			}
+		case recipe.Spec.ConfigMapName != "":
+			secretData = secrets
+		default:
+			secretData = all
+		}
+	}
+
+	if recipe.Spec.ConfigMapName != "" {
+		if len(recipe.Spec.ConfigMapKeys) > 0 {
+			configMapData, err = renderRecipeKeys(recipe.Spec.ConfigMapKeys, values)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to render config map keys: %w", err)
+			}
+		} else {
+			configMapData = values
+		}
+	}
+
+	return secretData, configMapData, nil
+}
+
+// parseRecipeKeyTemplate parses the template of a key mapping.
+func parseRecipeKeyTemplate(key string, text string) (*template.Template, error) {
+	return template.New(key).Option("missingkey=error").Parse(text)
+}
+
+// renderRecipeKeys renders each template of a key mapping with the given values.
+func renderRecipeKeys(templates map[string]string, values map[string]string) (map[string]string, error) {
+	result := map[string]string{}
+	for key, text := range templates {
+		tmpl, err := parseRecipeKeyTemplate(key, text)
+		if err != nil {
+			return nil, err
+		}
+
+		buf := bytes.Buffer{}
+		err = tmpl.Execute(&buf, values)
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = buf.String()
+	}
+
+	return result, nil
+}
+
+// computeRecipeOutputsHash returns a hash of the data written to the secret and ConfigMap of a Recipe, or an empty
+// string if nothing is written.
+func computeRecipeOutputsHash(secretData map[string]string, configMapData map[string]string) (string, error) {
+	if len(secretData) == 0 && len(configMapData) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal([]map[string]string{secretData, configMapData})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (r *RecipeReconciler) updateConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe, data map[string]string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// If the config map name changed, delete the old config map.
+	if recipe.Spec.ConfigMapName != recipe.Status.ConfigMap.Name && recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting stale config map", "configMap", recipe.Status.ConfigMap.Name)
+		err := r.deleteOwnedObject(ctx, recipe, &corev1.ConfigMap{}, recipe.Status.ConfigMap.Name)
+		if err != nil {
+			return fmt.Errorf("failed to delete stale config map %s: %w", recipe.Status.ConfigMap.Name, err)
+		}
+	}
+
+	if recipe.Spec.ConfigMapName == "" {
+		logger.Info("No config map name specified, skipping config map creation")
+		recipe.Status.ConfigMap = corev1.ObjectReference{}
+		return nil
+	}
+
+	logger.Info("Creating or updating config map.", "configMap", recipe.Spec.ConfigMapName)
+	configMap := &corev1.ConfigMap{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: recipe.Spec.ConfigMapName}, configMap)
+	if apierrors.IsNotFound(err) {
+		// This is OK, we'll create it next.
+		configMap = nil
+	} else if err != nil {
+		return fmt.Errorf("failed to fetch config map %s: %w", recipe.Spec.ConfigMapName, err)
+	} else if !metav1.IsControlledBy(configMap, recipe) {
+		return fmt.Errorf("config map %s already exists and is not owned by the recipe", recipe.Spec.ConfigMapName)
+	}
+
+	// Initialize the config map if it doesn't exist.
+	if configMap == nil {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Spec.ConfigMapName,
+				Namespace: recipe.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(recipe, radappiov1alpha3.GroupVersion.WithKind("Recipe")),
+				},
+			},
+		}
+
+		err = r.Client.Create(ctx, configMap)
+		if err != nil {
+			return fmt.Errorf("failed to create config map %s: %w", configMap.Name, err)
+		}
+	}
+
+	// The data is replaced so that values which are no longer rendered are removed.
+	configMap.Data = maps.Clone(data)
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+
+	err = r.Client.Update(ctx, configMap)
+	if err != nil {
+		return fmt.Errorf("failed to update config map %s: %w", configMap.Name, err)
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  configMap.Namespace,
+		Name:       configMap.Name,
+		UID:        configMap.UID,
+	}
+
+	return nil
+}
+
+// deleteOwnedObject deletes the object with the given name in the namespace of the Recipe if the Recipe controls it.
+// Objects that don't exist or that belong to someone else are left alone.
+func (r *RecipeReconciler) deleteOwnedObject(ctx context.Context, recipe *radappiov1alpha3.Recipe, obj client.Object, name string) error {
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: name}, obj)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(obj, recipe) {
+		ucplog.FromContextOrDiscard(ctx).Info("Skipping deletion of object not owned by the recipe.", "name", name)
+		return nil
+	}
+
+	err = r.Client.Delete(ctx, obj)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RecipeReconciler) deleteConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting config map.", "configMap", recipe.Status.ConfigMap.Name)
+		err := r.Client.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Status.ConfigMap.Name,
+				Namespace: recipe.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete config map %s: %w", recipe.Status.ConfigMap.Name, err)
+		}
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{}
+	return nil
+}
+
+// rolloutDeployments rolls out the Deployments in the namespace of the Recipe that list it in their
+// 'radapp.io/rollout-recipes' annotation, by updating a hash of the outputs of their Recipes in the pod template.
+func (r *RecipeReconciler) rolloutDeployments(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	deployments := appsv1.DeploymentList{}
+	err := r.Client.List(ctx, &deployments, client.InNamespace(recipe.Namespace))
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		names := rolloutRecipeNames(deployment)
+		if !slices.Contains(names, recipe.Name) {
+			continue
+		}
+
+		hash, err := r.rolloutHash(ctx, recipe, names)
+		if err != nil {
+			return err
+		}
+
+		if deployment.Spec.Template.Annotations[AnnotationRadiusRecipeOutputsHash] == hash {
+			continue
+		}
+
+		logger.Info("Rolling out deployment.", "deployment", deployment.Name)
+		if deployment.Spec.Template.Annotations == nil {
+			deployment.Spec.Template.Annotations = map[string]string{}
+		}
+
+		deployment.Spec.Template.Annotations[AnnotationRadiusRecipeOutputsHash] = hash
+		err = r.Client.Update(ctx, deployment)
+		if err != nil {
+			return fmt.Errorf("failed to roll out deployment %s: %w", deployment.Name, err)
+		}
+
+		r.EventRecorder.Event(recipe, corev1.EventTypeNormal, "RolloutTriggered", fmt.Sprintf("Rolling out Deployment %s.", deployment.Name))
+	}
+
+	return nil
+}
+
+// rolloutHash returns the combined hash of the outputs of the Recipes consumed by a Deployment.
+func (r *RecipeReconciler) rolloutHash(ctx context.Context, recipe *radappiov1alpha3.Recipe, names []string) (string, error) {
+	hashes := map[string]string{}
+	for _, name := range names {
+		if name == recipe.Name {
+			hashes[name] = recipe.Status.OutputsHash
+			continue
+		}
+
+		other := radappiov1alpha3.Recipe{}
+		err := r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: name}, &other)
+		if apierrors.IsNotFound(err) {
+			hashes[name] = ""
+			continue
+		} else if err != nil {
+			return "", fmt.Errorf("failed to fetch recipe %s: %w", name, err)
+		}
+
+		hashes[name] = other.Status.OutputsHash
+	}
+
+	b, err := json.Marshal(hashes)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha1.Sum(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// rolloutRecipeNames returns the names of the Recipes listed in the 'radapp.io/rollout-recipes' annotation of a
+// Deployment.
+func rolloutRecipeNames(deployment *appsv1.Deployment) []string {
+	names := []string{}
+	for _, name := range strings.Split(deployment.Annotations[AnnotationRadiusRolloutRecipes], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func Test_recipeOutputData(t *testing.T) {
+	values := map[string]string{"host": "localhost", "port": "6379"}
+	secrets := map[string]string{"password": "p@ss"}
+
+	tests := []struct {
+		name              string
+		spec              radappiov1alpha3.RecipeSpec
+		expectedSecret    map[string]string
+		expectedConfigMap map[string]string
+		expectedErr       string
+	}{
+		{
+			name:           "secret only",
+			spec:           radappiov1alpha3.RecipeSpec{SecretName: "s"},
+			expectedSecret: map[string]string{"host": "localhost", "port": "6379", "password": "p@ss"},
+		},
+		{
+			name:              "secret and config map",
+			spec:              radappiov1alpha3.RecipeSpec{SecretName: "s", ConfigMapName: "c"},
+			expectedSecret:    map[string]string{"password": "p@ss"},
+			expectedConfigMap: map[string]string{"host": "localhost", "port": "6379"},
+		},
+		{
+			name: "key mappings",
+			spec: radappiov1alpha3.RecipeSpec{
+				SecretName:    "s",
+				SecretKeys:    map[string]string{"url": "redis://:{{ .password }}@{{ .host }}:{{ .port }}"},
+				ConfigMapName: "c",
+				ConfigMapKeys: map[string]string{"REDIS_HOST": "{{ .host }}"},
+			},
+			expectedSecret:    map[string]string{"url": "redis://:p@ss@localhost:6379"},
+			expectedConfigMap: map[string]string{"REDIS_HOST": "localhost"},
+		},
+		{
+			name: "config map cannot reference secrets",
+			spec: radappiov1alpha3.RecipeSpec{
+				ConfigMapName: "c",
+				ConfigMapKeys: map[string]string{"PASSWORD": "{{ .password }}"},
+			},
+			expectedErr: "failed to render config map keys",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := &radappiov1alpha3.Recipe{Spec: tt.spec}
+			secretData, configMapData, err := recipeOutputData(recipe, values, secrets)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSecret, secretData)
+			require.Equal(t, tt.expectedConfigMap, configMapData)
+		})
+	}
+}
+
+func Test_recipeProperties(t *testing.T) {
+	recipe := &radappiov1alpha3.Recipe{
+		Spec: radappiov1alpha3.RecipeSpec{
+			Parameters: map[string]apiextensionsv1.JSON{
+				"size":     {Raw: []byte(`"large"`)},
+				"replicas": {Raw: []byte(`3`)},
+			},
+		},
+		Status: radappiov1alpha3.RecipeStatus{
+			Application: "app",
+			Environment: "env",
+		},
+	}
+
+	properties, err := recipeProperties(recipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"application":          "app",
+		"environment":          "env",
+		"resourceProvisioning": "recipe",
+		"recipe": map[string]any{
+			"parameters": map[string]any{"size": "large", "replicas": float64(3)},
+		},
+	}, properties)
+
+	hash, err := computeRecipePropertiesHash(properties)
+	require.NoError(t, err)
+
+	recipe.Spec.Parameters["size"] = apiextensionsv1.JSON{Raw: []byte(`"small"`)}
+	properties, err = recipeProperties(recipe)
+	require.NoError(t, err)
+
+	updated, err := computeRecipePropertiesHash(properties)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, updated)
+}
+
+func Test_RecipeReconciler_OutputsAndUpdatePolicy(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "recipe-outputs", Name: "cache"}
+	recipe := makeRecipe(name, "Applications.Core/extenders")
+	recipe.Spec.SecretName = "cache-secret"
+	recipe.Spec.ConfigMapName = "cache-config"
+	recipe.Spec.UpdatePolicy = radappiov1alpha3.RecipeUpdatePolicyRecreate
+	recipe.Spec.Parameters = map[string]apiextensionsv1.JSON{"size": {Raw: []byte(`"small"`)}}
+
+	deployment := makeDeployment(types.NamespacedName{Namespace: name.Namespace, Name: "app"})
+	deployment.Annotations = map[string]string{AnnotationRadiusRolloutRecipes: "cache, other"}
+	unrelated := makeDeployment(types.NamespacedName{Namespace: name.Namespace, Name: "unrelated"})
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(recipe, deployment, unrelated).
+		WithStatusSubresource(&radappiov1alpha3.Recipe{}).
+		Build()
+
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default", "default")
+
+	reconciler := &RecipeReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Radius:        radius,
+		DelayInterval: recipeTestControllerDelayInterval,
+	}
+
+	getRecipe := func() *radappiov1alpha3.Recipe {
+		result := &radappiov1alpha3.Recipe{}
+		require.NoError(t, k8sClient.Get(ctx, name, result))
+		return result
+	}
+
+	getRolloutHash := func(name string) string {
+		result := &appsv1.Deployment{}
+		require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: recipe.Namespace, Name: name}, result))
+		return result.Spec.Template.Annotations[AnnotationRadiusRecipeOutputsHash]
+	}
+
+	completeOperation := func(password string) {
+		status := getRecipe().Status
+		require.NotNil(t, status.Operation)
+		radius.CompleteOperation(status.Operation.ResumeToken, func(state *sdkclients.OperationState) {
+			resource, ok := radius.resources[state.ResourceID]
+			require.True(t, ok, "failed to find resource")
+
+			resource.Properties["host"] = "localhost"
+			resource.Properties["secrets"] = map[string]string{"password": password}
+			state.Value = generated.GenericResourcesClientCreateOrUpdateResponse{GenericResource: resource}
+		})
+	}
+
+	// The first reconcile starts creating the resource with the parameters of the recipe.
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, radappiov1alpha3.PhraseUpdating, getRecipe().Status.Phrase)
+
+	completeOperation("first")
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	status := getRecipe().Status
+	require.Equal(t, radappiov1alpha3.PhraseReady, status.Phrase)
+	require.NotEmpty(t, status.PropertiesHash)
+	require.NotEmpty(t, status.OutputsHash)
+
+	resource, err := radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{"size": "small"}}, resource.Properties["recipe"])
+
+	// Non-sensitive values are written to the ConfigMap, secrets to the Secret.
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "cache-config"}, configMap))
+	require.Equal(t, map[string]string{"host": "localhost"}, configMap.Data)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "cache-secret"}, secret))
+	require.Equal(t, map[string][]byte{"password": []byte("first")}, secret.Data)
+
+	// Only the annotated Deployment is rolled out.
+	firstHash := getRolloutHash("app")
+	require.NotEmpty(t, firstHash)
+	require.Empty(t, getRolloutHash("unrelated"))
+
+	// Changing the parameters recreates the resource with the Recreate policy.
+	updated := getRecipe()
+	updated.Spec.Parameters = map[string]apiextensionsv1.JSON{"size": {Raw: []byte(`"large"`)}}
+	updated.Generation++
+	require.NoError(t, k8sClient.Update(ctx, updated))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, radappiov1alpha3.PhraseDeleting, getRecipe().Status.Phrase)
+
+	radius.CompleteOperation(getRecipe().Status.Operation.ResumeToken, nil)
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, radappiov1alpha3.PhraseUpdating, getRecipe().Status.Phrase)
+
+	// The recreated resource has a new password, so the Deployment is rolled out again.
+	completeOperation("second")
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+	require.Equal(t, radappiov1alpha3.PhraseReady, getRecipe().Status.Phrase)
+
+	resource, err = radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"parameters": map[string]any{"size": "large"}}, resource.Properties["recipe"])
+
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "cache-secret"}, secret))
+	require.Equal(t, map[string][]byte{"password": []byte("second")}, secret.Data)
+	require.NotEqual(t, firstHash, getRolloutHash("app"))
+}
+
+func Test_RecipeReconciler_UpdateOutputObjects(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "recipe-outputs", Name: "cache"}
+	recipe := makeRecipe(name, "Applications.Core/extenders")
+	recipe.UID = "recipe-uid"
+	recipe.Spec.SecretName = "cache-secret"
+	recipe.Spec.ConfigMapName = "cache-config"
+
+	unowned := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: "user-config"},
+		Data:       map[string]string{"key": "value"},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(recipe, unowned).
+		Build()
+
+	reconciler := &RecipeReconciler{Client: k8sClient, Scheme: testScheme}
+
+	// The rendered data replaces the data of the objects, so keys that are no longer rendered are removed.
+	require.NoError(t, reconciler.updateConfigMap(ctx, recipe, map[string]string{"host": "localhost", "port": "6379"}))
+	require.NoError(t, reconciler.updateSecret(ctx, recipe, map[string]string{"password": "first", "token": "abc"}))
+
+	require.NoError(t, reconciler.updateConfigMap(ctx, recipe, map[string]string{"host": "localhost"}))
+	require.NoError(t, reconciler.updateSecret(ctx, recipe, map[string]string{"password": "second"}))
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "cache-config"}, configMap))
+	require.Equal(t, map[string]string{"host": "localhost"}, configMap.Data)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "cache-secret"}, secret))
+	require.Equal(t, map[string][]byte{"password": []byte("second")}, secret.Data)
+
+	// An existing object that the recipe doesn't own is not taken over, or deleted when the recipe moves away from it.
+	recipe.Spec.ConfigMapName = "user-config"
+	err := reconciler.updateConfigMap(ctx, recipe, map[string]string{"host": "localhost"})
+	require.ErrorContains(t, err, "config map user-config already exists and is not owned by the recipe")
+
+	recipe.Status.ConfigMap.Name = "user-config"
+	recipe.Spec.ConfigMapName = ""
+	require.NoError(t, reconciler.updateConfigMap(ctx, recipe, map[string]string{"host": "localhost"}))
+
+	require.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: name.Namespace, Name: "user-config"}, configMap))
+	require.Equal(t, map[string]string{"key": "value"}, configMap.Data)
+}
+
+func Test_rolloutRecipeNames(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{AnnotationRadiusRolloutRecipes: " cache,,db "},
+		},
+	}
+
+	require.Equal(t, []string{"cache", "db"}, rolloutRecipeNames(deployment))
+	require.Empty(t, rolloutRecipeNames(&appsv1.Deployment{}))
+}
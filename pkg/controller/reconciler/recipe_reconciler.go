@@ -30,7 +30,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/go-logr/logr"
-	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
@@ -142,7 +141,9 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 			r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Update failed.")
 
+			// Clear the hash of the properties so the update is retried.
 			recipe.Status.Operation = nil
+			recipe.Status.PropertiesHash = ""
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
 
 			err = r.Client.Status().Update(ctx, recipe)
@@ -294,9 +295,9 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	// If we get here then it means we can process the result of the operation.
 	logger.Info("Resource is in desired state.", "resourceId", recipe.Status.Resource)
 
-	err = r.updateSecret(ctx, recipe)
+	err = r.updateOutputs(ctx, recipe)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
+		return ctrl.Result{}, err
 	}
 
 	recipe.Status.Phrase = radappiov1alpha3.PhraseReady
@@ -347,6 +348,11 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
 	}
 
+	err = r.deleteConfigMap(ctx, recipe)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to process config map %s: %w", recipe.Spec.ConfigMapName, err)
+	}
+
 	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
 	// recipe.
 	if controllerutil.RemoveFinalizer(recipe, RecipeFinalizer) {
@@ -371,6 +377,16 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context, recipe *radappiov1alpha3.Recipe) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
+	properties, err := recipeProperties(recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	propertiesHash, err := computeRecipePropertiesHash(properties)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	resourceID := recipe.Status.Scope + "/providers/" + recipe.Spec.Type + "/" + recipe.Name
 	if recipe.Status.Resource != "" && !strings.EqualFold(recipe.Status.Resource, resourceID) {
 		// If we get here it means that the environment or application changed, so we should delete
@@ -390,22 +406,36 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 	}
 
 	// Note: we separate this check from the previous block, because it could complete synchronously.
-	if recipe.Status.Resource != "" {
+	if recipe.Status.Resource != "" && recipe.Status.PropertiesHash == propertiesHash {
 		logger.Info("Resource is already created and is up-to-date.")
 		return nil, nil, nil
 	}
 
-	logger.Info("Starting PUT operation.")
-	properties := map[string]any{
-		"application":          recipe.Status.Application,
-		"environment":          recipe.Status.Environment,
-		"resourceProvisioning": "recipe",
+	// If we get here and the resource exists, the parameters of the recipe changed. Resources created before
+	// the hash was recorded, or whose last update failed, are always updated in-place.
+	if recipe.Status.Resource != "" && recipe.Status.PropertiesHash != "" && recipe.Spec.UpdatePolicy == radappiov1alpha3.RecipeUpdatePolicyRecreate {
+		logger.Info("Resource is out-of-date and will be recreated.")
+
+		logger.Info("Starting DELETE operation.")
+		poller, err := deleteResource(ctx, r.Radius, recipe.Status.Resource)
+		if err != nil {
+			return nil, nil, err
+		} else if poller != nil {
+			return nil, poller, nil
+		}
+
+		// Deletion was synchronous
+		recipe.Status.Resource = ""
 	}
 
+	logger.Info("Starting PUT operation.")
 	poller, err := createOrUpdateResource(ctx, r.Radius, resourceID, properties)
 	if err != nil {
 		return nil, nil, err
-	} else if poller != nil {
+	}
+
+	recipe.Status.PropertiesHash = propertiesHash
+	if poller != nil {
 		return poller, nil, nil
 	}
 
@@ -435,19 +465,14 @@ func (r *RecipeReconciler) startDeleteOperationIfNeeded(ctx context.Context, rec
 	return nil, nil
 }
 
-func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1alpha3.Recipe, data map[string]string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// If the secret name changed, delete the old secret.
 	if recipe.Spec.SecretName != recipe.Status.Secret.Name && recipe.Status.Secret.Name != "" {
 		logger.Info("Deleting stale secret", "secret", recipe.Status.Secret.Name)
-		err := r.Client.Delete(ctx, &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      recipe.Status.Secret.Name,
-				Namespace: recipe.Namespace,
-			},
-		})
-		if err != nil && !apierrors.IsNotFound(err) {
+		err := r.deleteOwnedObject(ctx, recipe, &corev1.Secret{}, recipe.Status.Secret.Name)
+		if err != nil {
 			return fmt.Errorf("failed to delete stale secret %s: %w", recipe.Status.Secret.Name, err)
 		}
 	}
@@ -459,18 +484,15 @@ func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1a
 	}
 
 	logger.Info("Creating or updating secret.", "secret", recipe.Spec.SecretName)
-	result, err := fetchResource(ctx, r.Radius, recipe.Status.Resource)
-	if err != nil {
-		return fmt.Errorf("failed to read resource: %w", err)
-	}
-
 	secret := &corev1.Secret{}
-	err = r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: recipe.Spec.SecretName}, secret)
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: recipe.Spec.SecretName}, secret)
 	if apierrors.IsNotFound(err) {
 		// This is OK, we'll create it next.
 		secret = nil
 	} else if err != nil {
 		return fmt.Errorf("failed to fetch secret %s: %w", recipe.Spec.SecretName, err)
+	} else if !metav1.IsControlledBy(secret, recipe) {
+		return fmt.Errorf("secret %s already exists and is not owned by the recipe", recipe.Spec.SecretName)
 	}
 
 	// Initialize the secret if it doesn't exist.
@@ -493,30 +515,13 @@ func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1a
 
 	// envtest has some quirky behavior around StringData which makes it hard to test. So we're
 	// using Data directly.
-	if secret.Data == nil {
-		secret.Data = map[string][]byte{}
-	}
-
-	values, err := resourceToConnectionValues(result.GenericResource)
-	if err != nil {
-		return fmt.Errorf("failed to read connection values: %w", err)
-	}
-
-	for k, v := range values {
+	//
+	// The data is replaced so that values which are no longer rendered are removed.
+	secret.Data = map[string][]byte{}
+	for k, v := range data {
 		secret.Data[k] = []byte(v)
 	}
 
-	secrets, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ListSecrets(ctx, recipe.Name)
-	if clients.Is404Error(err) {
-		// Safe to ignore. Not everything implements this.
-	} else if err != nil {
-		return fmt.Errorf("failed to list secrets: %w", err)
-	} else {
-		for k, v := range secrets.Value {
-			secret.Data[k] = []byte(*v)
-		}
-	}
-
 	err = r.Client.Update(ctx, secret)
 	if err != nil {
 		return fmt.Errorf("failed to update secret %s: %w", secret.Name, err)
@@ -567,5 +572,6 @@ func (r *RecipeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&radappiov1alpha3.Recipe{}).
 		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		Complete(r)
 }
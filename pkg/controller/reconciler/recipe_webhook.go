@@ -18,6 +18,7 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
@@ -46,7 +47,7 @@ func (r *RecipeWebhook) ValidateCreate(ctx context.Context, recipe *radappiov1al
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	logger.Info("Validating Create Recipe %s", recipe.Name)
-	return r.validateRecipeType(ctx, recipe)
+	return r.validateRecipe(ctx, recipe)
 }
 
 // ValidateUpdate validates the update of a Recipe object.
@@ -54,7 +55,7 @@ func (r *RecipeWebhook) ValidateUpdate(ctx context.Context, oldRecipe, newRecipe
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	logger.Info("Validating Update Recipe %s", newRecipe.Name)
-	return r.validateRecipeType(ctx, newRecipe)
+	return r.validateRecipe(ctx, newRecipe)
 }
 
 // ValidateDelete validates the deletion of a Recipe object.
@@ -66,6 +67,16 @@ func (r *RecipeWebhook) ValidateDelete(ctx context.Context, recipe *radappiov1al
 	return nil, nil
 }
 
+// validateRecipe validates the type and key mappings of a Recipe object.
+func (r *RecipeWebhook) validateRecipe(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	warnings, err := r.validateRecipeType(ctx, recipe)
+	if err != nil {
+		return warnings, err
+	}
+
+	return r.validateRecipeKeys(ctx, recipe)
+}
+
 // validateRecipeType validates Recipe object.
 func (r *RecipeWebhook) validateRecipeType(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -84,3 +95,33 @@ func (r *RecipeWebhook) validateRecipeType(ctx context.Context, recipe *radappio
 
 	return nil, nil
 }
+
+// validateRecipeKeys validates the key mappings of a Recipe object.
+func (r *RecipeWebhook) validateRecipeKeys(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	var errList field.ErrorList
+
+	validate := func(flPath *field.Path, keys map[string]string, name string, nameField string) {
+		if len(keys) > 0 && name == "" {
+			errList = append(errList, field.Required(field.NewPath("spec").Child(nameField), fmt.Sprintf("must be set when %s is set", flPath.String())))
+		}
+
+		for key, text := range keys {
+			_, err := parseRecipeKeyTemplate(key, text)
+			if err != nil {
+				errList = append(errList, field.Invalid(flPath.Key(key), text, err.Error()))
+			}
+		}
+	}
+
+	validate(field.NewPath("spec").Child("secretKeys"), recipe.Spec.SecretKeys, recipe.Spec.SecretName, "secretName")
+	validate(field.NewPath("spec").Child("configMapKeys"), recipe.Spec.ConfigMapKeys, recipe.Spec.ConfigMapName, "configMapName")
+
+	if len(errList) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "radapp.io", Kind: "Recipe"},
+			recipe.Name,
+			errList)
+	}
+
+	return nil, nil
+}
@@ -354,3 +354,55 @@ func updateWebhookFailurePolicy(t *testing.T, webhookConfigName string, webhookf
 	err = k8sClient.Update(ctx, webhook)
 	require.NoError(t, err)
 }
+
+// Test_Webhook_ValidateRecipeKeys tests the validation of the key mappings of a recipe.
+func Test_Webhook_ValidateRecipeKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    radappiov1alpha3.RecipeSpec
+		wantErr string
+	}{
+		{
+			name: "valid key mappings",
+			spec: radappiov1alpha3.RecipeSpec{
+				SecretName:    "secret",
+				SecretKeys:    map[string]string{"url": "redis://{{ .host }}:{{ .port }}"},
+				ConfigMapName: "config",
+				ConfigMapKeys: map[string]string{"HOST": "{{ .host }}"},
+			},
+		},
+		{
+			name: "invalid template",
+			spec: radappiov1alpha3.RecipeSpec{
+				SecretName: "secret",
+				SecretKeys: map[string]string{"url": "{{ .host"},
+			},
+			wantErr: "spec.secretKeys[url]",
+		},
+		{
+			name: "config map keys without config map",
+			spec: radappiov1alpha3.RecipeSpec{
+				ConfigMapKeys: map[string]string{"HOST": "{{ .host }}"},
+			},
+			wantErr: "spec.configMapName: Required value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			recipe := makeRecipe(types.NamespacedName{Namespace: defaultNamespace, Name: "recipe"}, validResourceType)
+			tt.spec.Type = validResourceType
+			recipe.Spec = tt.spec
+
+			_, err := (&RecipeWebhook{}).ValidateCreate(ctx, recipe)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, apierrors.IsInvalid(err))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	radiuscorev20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// RecipePackReconciler reconciles a RecipePack object by syncing it to a Radius.Core recipe pack.
+type RecipePackReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Radius is the Radius client.
+	Radius RadiusClient
+
+	// Configuration is the client for Radius configuration resources.
+	Configuration ConfigurationClient
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=recipepacks,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=recipepacks/status,verbs=get;update;patch
+
+// Reconcile is the main reconciliation loop for the RecipePack resource.
+func (r *RecipePackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "RecipePack", "name", req.Name, "namespace", req.Namespace)
+	ctx = logr.NewContext(ctx, logger)
+
+	recipePack := radappiov1alpha3.RecipePack{}
+	err := r.Client.Get(ctx, req.NamespacedName, &recipePack)
+	if apierrors.IsNotFound(err) {
+		logger.Info("RecipePack is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if recipePack.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &recipePack)
+	}
+
+	return r.reconcileUpdate(ctx, &recipePack)
+}
+
+func (r *RecipePackReconciler) reconcileUpdate(ctx context.Context, recipePack *radappiov1alpha3.RecipePack) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if controllerutil.AddFinalizer(recipePack, RecipePackFinalizer) {
+		err := r.Client.Update(ctx, recipePack)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	resourceGroupID := configurationResourceGroupID(recipePack.Spec.ResourceGroup, recipePack.Namespace)
+	resourceID := resourceGroupID + "/providers/Radius.Core/recipePacks/" + recipePack.Name
+
+	err := r.sync(ctx, recipePack, resourceGroupID, resourceID)
+	if err != nil {
+		logger.Error(err, "Unable to sync recipe pack.")
+		r.EventRecorder.Event(recipePack, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+
+		markConfigurationNotReady(&recipePack.Status, recipePack.Generation, ReasonSyncFailed, err.Error())
+		if statusErr := r.Client.Status().Update(ctx, recipePack); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	changed := markConfigurationSynced(&recipePack.Status, recipePack.Generation, resourceID)
+	err = r.Client.Status().Update(ctx, recipePack)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if changed {
+		r.EventRecorder.Event(recipePack, corev1.EventTypeNormal, ReasonSynced, fmt.Sprintf("Synced recipe pack to %s.", resourceID))
+	}
+
+	logger.Info("Recipe pack is synced.", "resourceId", resourceID)
+	return ctrl.Result{}, nil
+}
+
+func (r *RecipePackReconciler) sync(ctx context.Context, recipePack *radappiov1alpha3.RecipePack, resourceGroupID string, resourceID string) error {
+	resource, err := recipePackResource(recipePack)
+	if err != nil {
+		return err
+	}
+
+	// The resource group or name of the recipe pack changed, so the previous recipe pack is no longer managed.
+	if recipePack.Status.Resource != "" && !strings.EqualFold(recipePack.Status.Resource, resourceID) {
+		err = r.Configuration.DeleteRecipePack(ctx, recipePack.Status.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to delete previous recipe pack: %w", err)
+		}
+	}
+
+	err = createResourceGroupIfNotExists(ctx, r.Radius, resourceGroupID)
+	if err != nil {
+		return fmt.Errorf("failed to create resource group: %w", err)
+	}
+
+	return r.Configuration.CreateOrUpdateRecipePack(ctx, resourceID, resource)
+}
+
+func (r *RecipePackReconciler) reconcileDelete(ctx context.Context, recipePack *radappiov1alpha3.RecipePack) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Resource is being deleted.")
+
+	if recipePack.Status.Resource != "" {
+		err := r.Configuration.DeleteRecipePack(ctx, recipePack.Status.Resource)
+		if err != nil {
+			logger.Error(err, "Unable to delete recipe pack.")
+			r.EventRecorder.Event(recipePack, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(recipePack, RecipePackFinalizer) {
+		err := r.Client.Update(ctx, recipePack)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("Resource is deleted.")
+	return ctrl.Result{}, nil
+}
+
+// recipePackResource converts a RecipePack to a Radius.Core recipe pack.
+func recipePackResource(recipePack *radappiov1alpha3.RecipePack) (radiuscorev20250801preview.RecipePackResource, error) {
+	recipes := map[string]*radiuscorev20250801preview.RecipeDefinition{}
+	for resourceType, recipe := range recipePack.Spec.Recipes {
+		definition := &radiuscorev20250801preview.RecipeDefinition{
+			RecipeKind:     to.Ptr(radiuscorev20250801preview.RecipeKind(recipe.Kind)),
+			RecipeLocation: to.Ptr(recipe.Location),
+		}
+
+		if recipe.PlainHTTP {
+			definition.PlainHTTP = to.Ptr(true)
+		}
+
+		if recipe.Parameters != nil {
+			err := json.Unmarshal(recipe.Parameters.Raw, &definition.Parameters)
+			if err != nil {
+				return radiuscorev20250801preview.RecipePackResource{}, fmt.Errorf("parameters of the recipe for %q must be an object: %w", resourceType, err)
+			}
+		}
+
+		recipes[resourceType] = definition
+	}
+
+	return radiuscorev20250801preview.RecipePackResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &radiuscorev20250801preview.RecipePackProperties{
+			Recipes: recipes,
+		},
+	}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RecipePackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.RecipePack{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	radiuscorev20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+)
+
+func Test_RecipePackReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Namespace: "default", Name: "my-pack"}
+	recipePack := &radappiov1alpha3.RecipePack{
+		ObjectMeta: metav1.ObjectMeta{Namespace: name.Namespace, Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.RecipePackSpec{
+			Recipes: map[string]radappiov1alpha3.RecipePackRecipe{
+				"Radius.Data/redisCaches": {
+					Kind:       "bicep",
+					Location:   "ghcr.io/radius-project/recipes/redis:latest",
+					Parameters: &apiextensionsv1.JSON{Raw: []byte(`{"size":"small"}`)},
+				},
+			},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(recipePack).
+		WithStatusSubresource(&radappiov1alpha3.RecipePack{}).
+		Build()
+
+	radius := NewMockRadiusClient()
+	configuration := NewMockConfigurationClient(mctrl)
+	reconciler := &RecipePackReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Radius:        radius,
+		Configuration: configuration,
+	}
+
+	expectedID := "/planes/radius/local/resourceGroups/default/providers/Radius.Core/recipePacks/my-pack"
+	configuration.EXPECT().CreateOrUpdateRecipePack(gomock.Any(), expectedID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, resource radiuscorev20250801preview.RecipePackResource) error {
+			recipe := resource.Properties.Recipes["Radius.Data/redisCaches"]
+			require.Equal(t, radiuscorev20250801preview.RecipeKindBicep, *recipe.RecipeKind)
+			require.Equal(t, "ghcr.io/radius-project/recipes/redis:latest", *recipe.RecipeLocation)
+			require.Equal(t, map[string]any{"size": "small"}, recipe.Parameters)
+			return nil
+		})
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	result := &radappiov1alpha3.RecipePack{}
+	require.NoError(t, k8sClient.Get(ctx, name, result))
+	require.Contains(t, result.Finalizers, RecipePackFinalizer)
+	require.Equal(t, expectedID, result.Status.Resource)
+	require.True(t, isConfigurationReady(&result.Status, result.Generation))
+
+	// The resource group is named after the namespace.
+	_, err = radius.Groups("/planes/radius/local/resourceGroups/default").Get(ctx, "default", nil)
+	require.NoError(t, err)
+
+	// Deleting the RecipePack deletes the recipe pack and removes the finalizer.
+	configuration.EXPECT().DeleteRecipePack(gomock.Any(), expectedID).Return(nil)
+	require.NoError(t, k8sClient.Delete(ctx, result))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.RecipePack{})
+	require.True(t, apierrors.IsNotFound(err))
+}
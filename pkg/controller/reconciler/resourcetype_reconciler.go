@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// ResourceTypeReconciler reconciles a ResourceType object by registering it with Radius.
+type ResourceTypeReconciler struct {
+	// Client is the Kubernetes client.
+	Client client.Client
+
+	// Scheme is the Kubernetes scheme.
+	Scheme *runtime.Scheme
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Configuration is the client for Radius configuration resources.
+	Configuration ConfigurationClient
+}
+
+// +kubebuilder:rbac:groups=radapp.io,resources=resourcetypes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=radapp.io,resources=resourcetypes/status,verbs=get;update;patch
+
+// Reconcile is the main reconciliation loop for the ResourceType resource.
+func (r *ResourceTypeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "ResourceType", "name", req.Name)
+	ctx = logr.NewContext(ctx, logger)
+
+	resourceType := radappiov1alpha3.ResourceType{}
+	err := r.Client.Get(ctx, req.NamespacedName, &resourceType)
+	if apierrors.IsNotFound(err) {
+		logger.Info("ResourceType is being deleted.")
+		return ctrl.Result{}, nil
+	} else if err != nil {
+		logger.Error(err, "Unable to fetch resource.")
+		return ctrl.Result{}, err
+	}
+
+	if resourceType.DeletionTimestamp != nil {
+		return r.reconcileDelete(ctx, &resourceType)
+	}
+
+	return r.reconcileUpdate(ctx, &resourceType)
+}
+
+func (r *ResourceTypeReconciler) reconcileUpdate(ctx context.Context, resourceType *radappiov1alpha3.ResourceType) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if controllerutil.AddFinalizer(resourceType, ResourceTypeFinalizer) {
+		err := r.Client.Update(ctx, resourceType)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	resourceID := resourceTypeID(resourceType.Spec.ResourceProvider, resourceType.Spec.TypeName)
+
+	err := r.sync(ctx, resourceType, resourceID)
+	if err != nil {
+		logger.Error(err, "Unable to register resource type.")
+		r.EventRecorder.Event(resourceType, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+
+		markConfigurationNotReady(&resourceType.Status, resourceType.Generation, ReasonSyncFailed, err.Error())
+		if statusErr := r.Client.Status().Update(ctx, resourceType); statusErr != nil {
+			return ctrl.Result{}, statusErr
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	changed := markConfigurationSynced(&resourceType.Status, resourceType.Generation, resourceID)
+	err = r.Client.Status().Update(ctx, resourceType)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if changed {
+		r.EventRecorder.Event(resourceType, corev1.EventTypeNormal, ReasonSynced, fmt.Sprintf("Registered resource type %s.", resourceID))
+	}
+
+	logger.Info("Resource type is registered.", "resourceId", resourceID)
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceTypeReconciler) sync(ctx context.Context, resourceType *radappiov1alpha3.ResourceType, resourceID string) error {
+	resourceProvider, err := resourceProviderManifest(resourceType)
+	if err != nil {
+		return err
+	}
+
+	// The resource provider or type name changed, so the previous resource type is no longer managed.
+	if resourceType.Status.Resource != "" && !strings.EqualFold(resourceType.Status.Resource, resourceID) {
+		err = r.deleteResourceType(ctx, resourceType.Status.Resource)
+		if err != nil {
+			return fmt.Errorf("failed to delete previous resource type: %w", err)
+		}
+	}
+
+	return r.Configuration.RegisterResourceType(ctx, resourceProvider, resourceType.Spec.TypeName)
+}
+
+func (r *ResourceTypeReconciler) reconcileDelete(ctx context.Context, resourceType *radappiov1alpha3.ResourceType) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Resource is being deleted.")
+
+	if resourceType.Status.Resource != "" {
+		err := r.deleteResourceType(ctx, resourceType.Status.Resource)
+		if err != nil {
+			logger.Error(err, "Unable to delete resource type.")
+			r.EventRecorder.Event(resourceType, corev1.EventTypeWarning, ReasonSyncFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	if controllerutil.RemoveFinalizer(resourceType, ResourceTypeFinalizer) {
+		err := r.Client.Update(ctx, resourceType)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	logger.Info("Resource is deleted.")
+	return ctrl.Result{}, nil
+}
+
+// deleteResourceType deletes the resource type with the given resource ID.
+func (r *ResourceTypeReconciler) deleteResourceType(ctx context.Context, resourceID string) error {
+	id, err := resources.Parse(resourceID)
+	if err != nil {
+		return err
+	}
+
+	segments := id.TypeSegments()
+	if len(segments) != 2 {
+		return fmt.Errorf("%q is not a valid resource type ID", resourceID)
+	}
+
+	return r.Configuration.DeleteResourceType(ctx, segments[0].Name, segments[1].Name)
+}
+
+// resourceProviderManifest converts a ResourceType to a resource provider manifest containing only that type.
+func resourceProviderManifest(resourceType *radappiov1alpha3.ResourceType) (manifest.ResourceProvider, error) {
+	definition := &manifest.ResourceType{
+		Capabilities: resourceType.Spec.Capabilities,
+		APIVersions:  map[string]*manifest.ResourceTypeAPIVersion{},
+	}
+
+	if resourceType.Spec.Description != "" {
+		definition.Description = to.Ptr(resourceType.Spec.Description)
+	}
+
+	if resourceType.Spec.DefaultAPIVersion != "" {
+		definition.DefaultAPIVersion = to.Ptr(resourceType.Spec.DefaultAPIVersion)
+	}
+
+	for name, apiVersion := range resourceType.Spec.APIVersions {
+		schema := map[string]any{}
+		err := json.Unmarshal(apiVersion.Schema.Raw, &schema)
+		if err != nil {
+			return manifest.ResourceProvider{}, fmt.Errorf("schema of API version %q must be an object: %w", name, err)
+		}
+
+		definition.APIVersions[name] = &manifest.ResourceTypeAPIVersion{Schema: schema}
+	}
+
+	return manifest.ResourceProvider{
+		Namespace: resourceType.Spec.ResourceProvider,
+		Types: map[string]*manifest.ResourceType{
+			resourceType.Spec.TypeName: definition,
+		},
+	}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourceTypeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&radappiov1alpha3.ResourceType{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
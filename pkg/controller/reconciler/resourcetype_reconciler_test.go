@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func Test_ResourceTypeReconciler(t *testing.T) {
+	ctx := testcontext.New(t)
+	mctrl := gomock.NewController(t)
+
+	testScheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(testScheme))
+	require.NoError(t, radappiov1alpha3.AddToScheme(testScheme))
+
+	name := types.NamespacedName{Name: "redis-caches"}
+	resourceType := &radappiov1alpha3.ResourceType{
+		ObjectMeta: metav1.ObjectMeta{Name: name.Name, Generation: 1},
+		Spec: radappiov1alpha3.ResourceTypeSpec{
+			ResourceProvider:  "Radius.Data",
+			TypeName:          "redisCaches",
+			Description:       "Redis cache",
+			DefaultAPIVersion: "2025-01-01-preview",
+			APIVersions: map[string]radappiov1alpha3.ResourceTypeAPIVersion{
+				"2025-01-01-preview": {Schema: apiextensionsv1.JSON{Raw: []byte(`{"type":"object"}`)}},
+			},
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(resourceType).
+		WithStatusSubresource(&radappiov1alpha3.ResourceType{}).
+		Build()
+
+	configuration := NewMockConfigurationClient(mctrl)
+	reconciler := &ResourceTypeReconciler{
+		Client:        k8sClient,
+		Scheme:        testScheme,
+		EventRecorder: record.NewFakeRecorder(10),
+		Configuration: configuration,
+	}
+
+	expectedProvider := manifest.ResourceProvider{
+		Namespace: "Radius.Data",
+		Types: map[string]*manifest.ResourceType{
+			"redisCaches": {
+				Description:       to.Ptr("Redis cache"),
+				DefaultAPIVersion: to.Ptr("2025-01-01-preview"),
+				APIVersions: map[string]*manifest.ResourceTypeAPIVersion{
+					"2025-01-01-preview": {Schema: map[string]any{"type": "object"}},
+				},
+			},
+		},
+	}
+	configuration.EXPECT().RegisterResourceType(gomock.Any(), expectedProvider, "redisCaches").Return(nil)
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	result := &radappiov1alpha3.ResourceType{}
+	require.NoError(t, k8sClient.Get(ctx, name, result))
+	require.Contains(t, result.Finalizers, ResourceTypeFinalizer)
+	require.Equal(t, "/planes/radius/local/providers/System.Resources/resourceProviders/Radius.Data/resourceTypes/redisCaches", result.Status.Resource)
+	require.True(t, isConfigurationReady(&result.Status, result.Generation))
+
+	// Deleting the ResourceType deletes the resource type.
+	configuration.EXPECT().DeleteResourceType(gomock.Any(), "Radius.Data", "redisCaches").Return(nil)
+	require.NoError(t, k8sClient.Delete(ctx, result))
+
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: name})
+	require.NoError(t, err)
+
+	err = k8sClient.Get(ctx, name, &radappiov1alpha3.ResourceType{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func Test_resourceProviderManifest_InvalidSchema(t *testing.T) {
+	resourceType := &radappiov1alpha3.ResourceType{
+		Spec: radappiov1alpha3.ResourceTypeSpec{
+			ResourceProvider: "Radius.Data",
+			TypeName:         "redisCaches",
+			APIVersions: map[string]radappiov1alpha3.ResourceTypeAPIVersion{
+				"2025-01-01-preview": {Schema: apiextensionsv1.JSON{Raw: []byte(`"string"`)}},
+			},
+		},
+	}
+
+	_, err := resourceProviderManifest(resourceType)
+	require.ErrorContains(t, err, `schema of API version "2025-01-01-preview" must be an object`)
+}
@@ -61,6 +61,10 @@ type Service struct {
 
 	// TLSConfigDir is the directory containing the TLS configuration.
 	TLSCertDir string
+
+	// GitSourceWebhookPort is the port of the server receiving push webhooks for GitSources. The server is disabled
+	// when the port is 0, and GitSources are only synced by polling.
+	GitSourceWebhookPort int
 }
 
 // Name returns the name of the service.
@@ -160,6 +164,78 @@ func (s *Service) Run(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "FluxController", err)
 	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.GitSourceReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("gitsource-controller"),
+		GitClient:     reconciler.NewGitClient(),
+		FileSystem:    filesystem.NewOSFS(),
+		Bicep: &bicep.Impl{
+			FileSystem: filesystem.NewOSFS(),
+		},
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "GitSource", err)
+	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.PreviewEnvironmentReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		EventRecorder:     mgr.GetEventRecorderFor("previewenvironment-controller"),
+		Radius:            reconciler.NewRadiusClient(s.Options.UCPConnection),
+		GitClient:         reconciler.NewGitClient(),
+		PullRequestClient: reconciler.NewGitHubClient(),
+		FileSystem:        filesystem.NewOSFS(),
+		Bicep: &bicep.Impl{
+			FileSystem: filesystem.NewOSFS(),
+		},
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "PreviewEnvironment", err)
+	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.RecipePackReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("recipepack-controller"),
+		Radius:        reconciler.NewRadiusClient(s.Options.UCPConnection),
+		Configuration: reconciler.NewConfigurationClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "RecipePack", err)
+	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.EnvironmentReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("environment-controller"),
+		Radius:        reconciler.NewRadiusClient(s.Options.UCPConnection),
+		Configuration: reconciler.NewConfigurationClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "Environment", err)
+	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.ResourceTypeReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("resourcetype-controller"),
+		Configuration: reconciler.NewConfigurationClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "ResourceType", err)
+	}
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
+	err = (&reconciler.CredentialBindingReconciler{
+		Client:        mgr.GetClient(),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("credentialbinding-controller"),
+		Configuration: reconciler.NewConfigurationClient(s.Options.UCPConnection),
+	}).SetupWithManager(mgr)
+	if err != nil {
+		return fmt.Errorf("failed to setup %s controller: %w", "CredentialBinding", err)
+	}
 
 	if s.TLSCertDir == "" {
 		logger.Info("Webhooks will be skipped. TLS certificates not present.")
@@ -170,6 +246,15 @@ func (s *Service) Run(ctx context.Context) error {
 		}
 	}
 
+	if s.GitSourceWebhookPort == 0 {
+		logger.Info("GitSource push webhook will be skipped. GitSources are synced by polling only.")
+	} else {
+		logger.Info("Registering GitSource push webhook.", "port", s.GitSourceWebhookPort)
+		if err = (&reconciler.GitSourceWebhook{}).SetupWebhookWithManager(mgr, s.GitSourceWebhookPort); err != nil {
+			return fmt.Errorf("failed to create gitsource-webhook: %w", err)
+		}
+	}
+
 	logger.Info("Registering health checks.")
 	err = mgr.AddHealthzCheck("healthz", healthz.Ping)
 	if err != nil {
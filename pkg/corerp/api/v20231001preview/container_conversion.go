@@ -761,6 +761,41 @@ func toExtensionDataModel(e ExtensionClassification) datamodel.Extension {
 				Labels:      to.StringMap(c.Labels),
 			},
 		}
+	case *AutoscalingExtension:
+		return datamodel.Extension{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MinReplicas: c.MinReplicas,
+				MaxReplicas: to.Int32(c.MaxReplicas),
+				Metrics:     toAutoscalingMetricsDataModel(c.Metrics),
+			},
+		}
+	case *DisruptionBudgetExtension:
+		return datamodel.Extension{
+			Kind: datamodel.DisruptionBudget,
+			DisruptionBudget: &datamodel.DisruptionBudgetExtension{
+				MinAvailable:   to.String(c.MinAvailable),
+				MaxUnavailable: to.String(c.MaxUnavailable),
+			},
+		}
+	case *NetworkPolicyExtension:
+		return datamodel.Extension{
+			Kind: datamodel.NetworkPolicy,
+			NetworkPolicy: &datamodel.NetworkPolicyExtension{
+				IngressNamespaces: to.StringArray(c.IngressNamespaces),
+			},
+		}
+	case *StatefulExtension:
+		stateful := &datamodel.StatefulExtension{
+			VolumeClaims: toStatefulVolumeClaimsDataModel(c.VolumeClaims),
+		}
+		if c.PodManagementPolicy != nil {
+			stateful.PodManagementPolicy = datamodel.StatefulPodManagementPolicy(*c.PodManagementPolicy)
+		}
+		return datamodel.Extension{
+			Kind:     datamodel.Stateful,
+			Stateful: stateful,
+		}
 	}
 
 	return datamodel.Extension{}
@@ -789,11 +824,125 @@ func fromExtensionClassificationDataModel(e datamodel.Extension) ExtensionClassi
 			Annotations: *to.StringMapPtr(ann),
 			Labels:      *to.StringMapPtr(lbl),
 		}
+	case datamodel.Autoscaling:
+		return &AutoscalingExtension{
+			Kind:        new(string(e.Kind)),
+			MinReplicas: e.Autoscaling.MinReplicas,
+			MaxReplicas: new(e.Autoscaling.MaxReplicas),
+			Metrics:     fromAutoscalingMetricsDataModel(e.Autoscaling.Metrics),
+		}
+	case datamodel.DisruptionBudget:
+		return &DisruptionBudgetExtension{
+			Kind:           new(string(e.Kind)),
+			MinAvailable:   toStringPtr(e.DisruptionBudget.MinAvailable),
+			MaxUnavailable: toStringPtr(e.DisruptionBudget.MaxUnavailable),
+		}
+	case datamodel.NetworkPolicy:
+		return &NetworkPolicyExtension{
+			Kind:              new(string(e.Kind)),
+			IngressNamespaces: to.ArrayofStringPtrs(e.NetworkPolicy.IngressNamespaces),
+		}
+	case datamodel.Stateful:
+		stateful := &StatefulExtension{
+			Kind: new(string(e.Kind)),
+		}
+		if e.Stateful != nil {
+			if e.Stateful.PodManagementPolicy != "" {
+				stateful.PodManagementPolicy = new(StatefulPodManagementPolicy(e.Stateful.PodManagementPolicy))
+			}
+			stateful.VolumeClaims = fromStatefulVolumeClaimsDataModel(e.Stateful.VolumeClaims)
+		}
+		return stateful
 	}
 
 	return nil
 }
 
+func toAutoscalingMetricsDataModel(metrics []*AutoscalingMetric) []datamodel.AutoscalingMetric {
+	if metrics == nil {
+		return nil
+	}
+
+	converted := []datamodel.AutoscalingMetric{}
+	for _, m := range metrics {
+		if m == nil {
+			continue
+		}
+
+		var kind datamodel.AutoscalingMetricKind
+		if m.Kind != nil {
+			kind = datamodel.AutoscalingMetricKind(*m.Kind)
+		}
+
+		converted = append(converted, datamodel.AutoscalingMetric{
+			Kind:                     kind,
+			Name:                     to.String(m.Name),
+			TargetAverageUtilization: m.TargetAverageUtilization,
+			TargetAverageValue:       to.String(m.TargetAverageValue),
+		})
+	}
+
+	return converted
+}
+
+func fromAutoscalingMetricsDataModel(metrics []datamodel.AutoscalingMetric) []*AutoscalingMetric {
+	if metrics == nil {
+		return nil
+	}
+
+	converted := []*AutoscalingMetric{}
+	for _, m := range metrics {
+		converted = append(converted, &AutoscalingMetric{
+			Kind:                     new(AutoscalingMetricKind(m.Kind)),
+			Name:                     toStringPtr(m.Name),
+			TargetAverageUtilization: m.TargetAverageUtilization,
+			TargetAverageValue:       toStringPtr(m.TargetAverageValue),
+		})
+	}
+
+	return converted
+}
+
+func toStatefulVolumeClaimsDataModel(claims map[string]*StatefulVolumeClaim) map[string]datamodel.StatefulVolumeClaim {
+	if claims == nil {
+		return nil
+	}
+
+	converted := map[string]datamodel.StatefulVolumeClaim{}
+	for name, c := range claims {
+		if c == nil {
+			continue
+		}
+
+		converted[name] = datamodel.StatefulVolumeClaim{
+			MountPath:    to.String(c.MountPath),
+			Size:         to.String(c.Size),
+			StorageClass: to.String(c.StorageClass),
+			AccessModes:  to.StringArray(c.AccessModes),
+		}
+	}
+
+	return converted
+}
+
+func fromStatefulVolumeClaimsDataModel(claims map[string]datamodel.StatefulVolumeClaim) map[string]*StatefulVolumeClaim {
+	if claims == nil {
+		return nil
+	}
+
+	converted := map[string]*StatefulVolumeClaim{}
+	for name, c := range claims {
+		converted[name] = &StatefulVolumeClaim{
+			MountPath:    toStringPtr(c.MountPath),
+			Size:         toStringPtr(c.Size),
+			StorageClass: toStringPtr(c.StorageClass),
+			AccessModes:  to.ArrayofStringPtrs(c.AccessModes),
+		}
+	}
+
+	return converted
+}
+
 func toHealthProbeBase(h HealthProbeProperties) datamodel.HealthProbeBase {
 	return datamodel.HealthProbeBase{
 		FailureThreshold:    h.FailureThreshold,
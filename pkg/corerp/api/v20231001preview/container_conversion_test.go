@@ -404,3 +404,95 @@ func getTestContainerEmptyKubernetesMetadataExt() []datamodel.Extension {
 
 	return extensions
 }
+
+func TestContainerConvertScalingExtensions(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-scaling-extensions.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MinReplicas: new(int32(2)),
+				MaxReplicas: 10,
+				Metrics: []datamodel.AutoscalingMetric{
+					{Kind: datamodel.AutoscalingMetricCPU, TargetAverageUtilization: new(int32(70))},
+					{Kind: datamodel.AutoscalingMetricCustom, Name: "requests_per_second", TargetAverageValue: "100"},
+				},
+			},
+		},
+		{
+			Kind:             datamodel.DisruptionBudget,
+			DisruptionBudget: &datamodel.DisruptionBudgetExtension{MinAvailable: "50%"},
+		},
+		{
+			Kind:          datamodel.NetworkPolicy,
+			NetworkPolicy: &datamodel.NetworkPolicyExtension{IngressNamespaces: []string{"radius-system"}},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+
+	autoscaling := versioned.Properties.Extensions[0].(*AutoscalingExtension)
+	require.Equal(t, int32(10), *autoscaling.MaxReplicas)
+	require.Equal(t, int32(2), *autoscaling.MinReplicas)
+	require.Len(t, autoscaling.Metrics, 2)
+	require.Equal(t, AutoscalingMetricKindCustom, *autoscaling.Metrics[1].Kind)
+	require.Nil(t, autoscaling.Metrics[0].Name)
+
+	budget := versioned.Properties.Extensions[1].(*DisruptionBudgetExtension)
+	require.Equal(t, "50%", *budget.MinAvailable)
+	require.Nil(t, budget.MaxUnavailable)
+
+	networkPolicy := versioned.Properties.Extensions[2].(*NetworkPolicyExtension)
+	require.Equal(t, []*string{new("radius-system")}, networkPolicy.IngressNamespaces)
+}
+
+func TestContainerConvertStatefulExtension(t *testing.T) {
+	rawPayload := testutil.ReadFixture("containerresource-stateful-extension.json")
+	r := &ContainerResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	dm, err := r.ConvertTo()
+	require.NoError(t, err)
+
+	ct := dm.(*datamodel.ContainerResource)
+	expected := []datamodel.Extension{
+		{
+			Kind: datamodel.Stateful,
+			Stateful: &datamodel.StatefulExtension{
+				PodManagementPolicy: datamodel.StatefulPodManagementParallel,
+				VolumeClaims: map[string]datamodel.StatefulVolumeClaim{
+					"data": {
+						MountPath:    "/var/lib/postgresql/data",
+						Size:         "10Gi",
+						StorageClass: "managed-csi",
+						AccessModes:  []string{"ReadWriteOnce"},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, expected, ct.Properties.Extensions)
+
+	versioned := &ContainerResource{}
+	err = versioned.ConvertFrom(ct)
+	require.NoError(t, err)
+
+	stateful := versioned.Properties.Extensions[0].(*StatefulExtension)
+	require.Equal(t, "stateful", *stateful.Kind)
+	require.Equal(t, StatefulPodManagementPolicyParallel, *stateful.PodManagementPolicy)
+	require.Equal(t, "10Gi", *stateful.VolumeClaims["data"].Size)
+	require.Equal(t, "managed-csi", *stateful.VolumeClaims["data"].StorageClass)
+	require.Equal(t, []*string{new("ReadWriteOnce")}, stateful.VolumeClaims["data"].AccessModes)
+}
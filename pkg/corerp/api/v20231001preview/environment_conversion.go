@@ -23,6 +23,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/docker"
 	"github.com/radius-project/radius/pkg/kubernetes"
 	types "github.com/radius-project/radius/pkg/recipes"
 
@@ -33,6 +34,7 @@ import (
 const (
 	EnvironmentComputeKindKubernetes = "kubernetes"
 	EnvironmentComputeKindACI        = "aci"
+	EnvironmentComputeKindDocker     = "docker"
 	invalidLocalModulePathFmt        = "local module paths are not supported with Terraform Recipes. The 'templatePath' '%s' was detected as a local module path because it begins with '/' or './' or '../'."
 )
 
@@ -325,6 +327,25 @@ func toEnvironmentComputeDataModel(h EnvironmentComputeClassification) (*rpv1.En
 			Identity: identity,
 		}, nil
 
+	case *DockerCompute:
+		k, err := toEnvironmentComputeKindDataModel(*v.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.Network != nil {
+			if !docker.IsValidObjectName(*v.Network) {
+				return nil, &v1.ErrModelConversion{PropertyName: "$.properties.compute.network", ValidValue: "a name starting with a letter or digit followed by letters, digits, '_', '.' or '-'"}
+			}
+		}
+
+		return &rpv1.EnvironmentCompute{
+			Kind: k,
+			DockerCompute: rpv1.DockerComputeProperties{
+				Network: to.String(v.Network),
+			},
+		}, nil
+
 	default:
 		return nil, v1.ErrInvalidModelConversion
 	}
@@ -370,6 +391,15 @@ func fromEnvironmentComputeDataModel(envCompute *rpv1.EnvironmentCompute) Enviro
 		}
 		return compute
 
+	case rpv1.DockerComputeKind:
+		compute := &DockerCompute{
+			Kind: fromEnvironmentComputeKind(envCompute.Kind),
+		}
+		if envCompute.DockerCompute.Network != "" {
+			compute.Network = new(envCompute.DockerCompute.Network)
+		}
+		return compute
+
 	default:
 		return nil
 	}
@@ -381,6 +411,8 @@ func toEnvironmentComputeKindDataModel(kind string) (rpv1.EnvironmentComputeKind
 		return rpv1.KubernetesComputeKind, nil
 	case EnvironmentComputeKindACI:
 		return rpv1.ACIComputeKind, nil
+	case EnvironmentComputeKindDocker:
+		return rpv1.DockerComputeKind, nil
 	default:
 		return rpv1.UnknownComputeKind, &v1.ErrModelConversion{PropertyName: "$.properties.compute.kind", ValidValue: "[kubernetes]"}
 	}
@@ -393,6 +425,8 @@ func fromEnvironmentComputeKind(kind rpv1.EnvironmentComputeKind) *string {
 		k = EnvironmentComputeKindKubernetes
 	case rpv1.ACIComputeKind:
 		k = EnvironmentComputeKindACI
+	case rpv1.DockerComputeKind:
+		k = EnvironmentComputeKindDocker
 	default:
 		k = EnvironmentComputeKindKubernetes // 2023-10-01-preview supports only kubernetes.
 	}
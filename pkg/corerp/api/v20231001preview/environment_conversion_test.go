@@ -365,6 +365,33 @@ func TestConvertVersionedToDataModel(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			filename: "environmentresource-with-dockercompute.json",
+			expected: &datamodel.Environment{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Core/environments/env0",
+						Name: "env0",
+						Type: "Applications.Core/environments",
+						Tags: map[string]string{},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						CreatedAPIVersion:      "2023-10-01-preview",
+						UpdatedAPIVersion:      "2023-10-01-preview",
+						AsyncProvisioningState: v1.ProvisioningStateAccepted,
+					},
+				},
+				Properties: datamodel.EnvironmentProperties{
+					Compute: rpv1.EnvironmentCompute{
+						Kind: rpv1.DockerComputeKind,
+						DockerCompute: rpv1.DockerComputeProperties{
+							Network: "radius-dev",
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			filename: "environmentresource-invalid-missing-namespace.json",
 			err:      &v1.ErrModelConversion{PropertyName: "$.properties.compute.namespace", ValidValue: "63 characters or less"},
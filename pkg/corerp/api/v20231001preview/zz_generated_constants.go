@@ -18,6 +18,27 @@ func PossibleActionTypeValues() []ActionType {
 	}
 }
 
+// AutoscalingMetricKind - The kind of metric used to scale a container
+type AutoscalingMetricKind string
+
+const (
+	// AutoscalingMetricKindCPU - Average CPU utilization or usage of the container
+	AutoscalingMetricKindCPU AutoscalingMetricKind = "cpu"
+	// AutoscalingMetricKindCustom - A custom metric exposed through the Kubernetes custom metrics API
+	AutoscalingMetricKindCustom AutoscalingMetricKind = "custom"
+	// AutoscalingMetricKindMemory - Average memory utilization or usage of the container
+	AutoscalingMetricKindMemory AutoscalingMetricKind = "memory"
+)
+
+// PossibleAutoscalingMetricKindValues returns the possible values for the AutoscalingMetricKind const type.
+func PossibleAutoscalingMetricKindValues() []AutoscalingMetricKind {
+	return []AutoscalingMetricKind{
+		AutoscalingMetricKindCPU,
+		AutoscalingMetricKindCustom,
+		AutoscalingMetricKindMemory,
+	}
+}
+
 // CertificateFormats - Represents certificate formats
 type CertificateFormats string
 
@@ -379,6 +400,24 @@ func PossibleSecretValueEncodingValues() []SecretValueEncoding {
 	}
 }
 
+// StatefulPodManagementPolicy - The policy used to create and delete the replicas of a stateful container
+type StatefulPodManagementPolicy string
+
+const (
+	// StatefulPodManagementPolicyOrderedReady - Create replicas one at a time in order and delete them in reverse order
+	StatefulPodManagementPolicyOrderedReady StatefulPodManagementPolicy = "OrderedReady"
+	// StatefulPodManagementPolicyParallel - Create and delete all replicas at the same time
+	StatefulPodManagementPolicyParallel StatefulPodManagementPolicy = "Parallel"
+)
+
+// PossibleStatefulPodManagementPolicyValues returns the possible values for the StatefulPodManagementPolicy const type.
+func PossibleStatefulPodManagementPolicyValues() []StatefulPodManagementPolicy {
+	return []StatefulPodManagementPolicy{
+		StatefulPodManagementPolicyOrderedReady,
+		StatefulPodManagementPolicyParallel,
+	}
+}
+
 // TLSMinVersion - TLS minimum protocol version (defaults to 1.2).
 type TLSMinVersion string
 
@@ -133,6 +133,43 @@ type AuthConfig struct {
 	Git *GitAuthConfig
 }
 
+// AutoscalingExtension - Specifies the container should be scaled horizontally based on metrics
+type AutoscalingExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// REQUIRED; The maximum number of replicas.
+	MaxReplicas *int32
+
+	// The metrics used to scale the container. Defaults to 80% average CPU utilization.
+	Metrics []*AutoscalingMetric
+
+	// The minimum number of replicas. Defaults to 1.
+	MinReplicas *int32
+}
+
+// GetExtension implements the ExtensionClassification interface for type AutoscalingExtension.
+func (a *AutoscalingExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: a.Kind,
+	}
+}
+
+// AutoscalingMetric - A metric used to scale a container
+type AutoscalingMetric struct {
+	// REQUIRED; The kind of the metric.
+	Kind *AutoscalingMetricKind
+
+	// The name of the metric. Only used when kind is 'custom'.
+	Name *string
+
+	// The target average utilization in percent of the requested resource. Only used for 'cpu' and 'memory' metrics.
+	TargetAverageUtilization *int32
+
+	// The target average value of the metric across all replicas, as a Kubernetes quantity.
+	TargetAverageValue *string
+}
+
 // AzureContainerInstanceCompute - The Azure container instance compute configuration
 type AzureContainerInstanceCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -464,6 +501,49 @@ func (d *DaprSidecarExtension) GetExtension() *Extension {
 	}
 }
 
+// DisruptionBudgetExtension - Specifies the availability budget of the container during voluntary disruptions
+type DisruptionBudgetExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// The number or percentage of replicas that can be unavailable during a voluntary disruption.
+	MaxUnavailable *string
+
+	// The number or percentage of replicas that must remain available during a voluntary disruption.
+	MinAvailable *string
+}
+
+// GetExtension implements the ExtensionClassification interface for type DisruptionBudgetExtension.
+func (d *DisruptionBudgetExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: d.Kind,
+	}
+}
+
+// DockerCompute - The Docker compute configuration
+type DockerCompute struct {
+	// REQUIRED; Discriminator property for EnvironmentCompute.
+	Kind *string
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+	// The Docker network the containers of the environment are attached to. Defaults to the name of the environment.
+	Network *string
+
+	// The resource id of the compute resource for application environment.
+	ResourceID *string
+}
+
+// GetEnvironmentCompute implements the EnvironmentComputeClassification interface for type DockerCompute.
+func (d *DockerCompute) GetEnvironmentCompute() *EnvironmentCompute {
+	return &EnvironmentCompute{
+		Identity:   d.Identity,
+		Kind:       d.Kind,
+		ResourceID: d.ResourceID,
+	}
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
@@ -1061,6 +1141,22 @@ func (m *ManualScalingExtension) GetExtension() *Extension {
 	}
 }
 
+// NetworkPolicyExtension - Specifies the container traffic should be restricted to its declared connections and ports. Connections to other containers are restricted to their pods and URL connections to their IP address and port. Connections to other Radius resources are restricted to their port and to their host, either its IP address or the namespace of a Kubernetes service host. Connections whose destination can't be restricted, such as URL connections with a host name, are rejected.
+type NetworkPolicyExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// The namespaces allowed to send traffic to the container ports in addition to the application, such as the gateway namespace.
+	IngressNamespaces []*string
+}
+
+// GetExtension implements the ExtensionClassification interface for type NetworkPolicyExtension.
+func (n *NetworkPolicyExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: n.Kind,
+	}
+}
+
 // Operation - Details of a REST API operation, returned from the Resource Provider Operations API
 type Operation struct {
 	// Localized display information for this particular operation.
@@ -1440,6 +1536,40 @@ type SecretValueProperties struct {
 	ValueFrom *ValueFromProperties
 }
 
+// StatefulExtension - Specifies the container should run as a stateful workload with stable network identities and per-replica persistent storage
+type StatefulExtension struct {
+	// REQUIRED; Discriminator property for Extension.
+	Kind *string
+
+	// The policy used to create and delete the replicas. Defaults to OrderedReady.
+	PodManagementPolicy *StatefulPodManagementPolicy
+
+	// The per-replica persistent volume claims, keyed by volume name.
+	VolumeClaims map[string]*StatefulVolumeClaim
+}
+
+// GetExtension implements the ExtensionClassification interface for type StatefulExtension.
+func (s *StatefulExtension) GetExtension() *Extension {
+	return &Extension{
+		Kind: s.Kind,
+	}
+}
+
+// StatefulVolumeClaim - A persistent volume claim created for each replica of a stateful container
+type StatefulVolumeClaim struct {
+	// REQUIRED; The path where the volume is mounted in the container.
+	MountPath *string
+
+	// REQUIRED; The requested storage size, as a Kubernetes quantity such as 10Gi.
+	Size *string
+
+	// The access modes of the volume. Defaults to ReadWriteOnce.
+	AccessModes []*string
+
+	// The name of the storage class. The cluster default storage class is used when not specified.
+	StorageClass *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
@@ -18,6 +18,8 @@ func unmarshalEnvironmentComputeClassification(rawMsg json.RawMessage) (Environm
 	switch m["kind"] {
 	case "aci":
 		b = &AzureContainerInstanceCompute{}
+	case "docker":
+		b = &DockerCompute{}
 	case "kubernetes":
 		b = &KubernetesCompute{}
 	default:
@@ -41,14 +43,22 @@ func unmarshalExtensionClassification(rawMsg json.RawMessage) (ExtensionClassifi
 	switch m["kind"] {
 	case "aci":
 		b = &AzureContainerInstanceExtension{}
+	case "autoscaling":
+		b = &AutoscalingExtension{}
 	case "daprSidecar":
 		b = &DaprSidecarExtension{}
+	case "disruptionBudget":
+		b = &DisruptionBudgetExtension{}
 	case "kubernetesMetadata":
 		b = &KubernetesMetadataExtension{}
 	case "kubernetesNamespace":
 		b = &KubernetesNamespaceExtension{}
 	case "manualScaling":
 		b = &ManualScalingExtension{}
+	case "networkPolicy":
+		b = &NetworkPolicyExtension{}
+	case "stateful":
+		b = &StatefulExtension{}
 	default:
 		b = &Extension{}
 	}
@@ -18,6 +18,8 @@ func unmarshalEnvironmentComputeClassification(rawMsg json.RawMessage) (Environm
 	switch m["kind"] {
 	case "aci":
 		b = &AzureContainerInstanceCompute{}
+	case "docker":
+		b = &DockerCompute{}
 	case "kubernetes":
 		b = &KubernetesCompute{}
 	default:
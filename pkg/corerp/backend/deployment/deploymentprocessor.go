@@ -23,6 +23,7 @@ import (
 	"maps"
 	"net"
 	"os"
+	"slices"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -411,6 +412,9 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		}
 		envOpts.Compute = &env.Properties.Compute
 
+	case rpv1.DockerComputeKind:
+		envOpts.Compute = &env.Properties.Compute
+
 	default:
 		return renderers.EnvironmentOptions{}, fmt.Errorf("%s is unsupported", env.Properties.Compute.Kind)
 	}
@@ -645,6 +649,7 @@ func (dp *deploymentProcessor) getRendererDependency(ctx context.Context, depend
 		ResourceID:      dependency.ID,
 		Resource:        dependency.Resource,
 		ComputedValues:  computedValues,
+		SecretKeys:      slices.Sorted(maps.Keys(secretValues)),
 		OutputResources: outputResourceIDs,
 	}
 
@@ -283,6 +283,78 @@ type ManualScalingExtension struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 }
 
+// AutoscalingMetricKind - The kind of metric used to scale a container.
+type AutoscalingMetricKind string
+
+const (
+	AutoscalingMetricCPU    AutoscalingMetricKind = "cpu"
+	AutoscalingMetricMemory AutoscalingMetricKind = "memory"
+	AutoscalingMetricCustom AutoscalingMetricKind = "custom"
+)
+
+// AutoscalingExtension - Specifies the container should be scaled horizontally based on metrics.
+type AutoscalingExtension struct {
+	MinReplicas *int32              `json:"minReplicas,omitempty"`
+	MaxReplicas int32               `json:"maxReplicas,omitempty"`
+	Metrics     []AutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoscalingMetric - A metric used to scale a container.
+type AutoscalingMetric struct {
+	Kind AutoscalingMetricKind `json:"kind,omitempty"`
+	// Name is the name of the metric. Only used when Kind is custom.
+	Name string `json:"name,omitempty"`
+	// TargetAverageUtilization is the target average utilization in percent of the requested resource. Only used for cpu and memory metrics.
+	TargetAverageUtilization *int32 `json:"targetAverageUtilization,omitempty"`
+	// TargetAverageValue is the target average value of the metric across all replicas, as a Kubernetes quantity.
+	TargetAverageValue string `json:"targetAverageValue,omitempty"`
+}
+
+// DisruptionBudgetExtension - Specifies the availability budget of the container during voluntary disruptions.
+type DisruptionBudgetExtension struct {
+	// MinAvailable is the number or percentage of replicas that must remain available.
+	MinAvailable string `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of replicas that can be unavailable.
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+}
+
+// NetworkPolicyExtension - Specifies the container traffic should be restricted to its declared connections.
+type NetworkPolicyExtension struct {
+	// IngressNamespaces is the list of additional namespaces allowed to send traffic to the container ports, such as the gateway namespace.
+	IngressNamespaces []string `json:"ingressNamespaces,omitempty"`
+}
+
+// StatefulPodManagementPolicy - The policy used to create and delete the replicas of a stateful container.
+type StatefulPodManagementPolicy string
+
+const (
+	// StatefulPodManagementOrderedReady creates replicas one at a time in order and deletes them in reverse order.
+	StatefulPodManagementOrderedReady StatefulPodManagementPolicy = "OrderedReady"
+	// StatefulPodManagementParallel creates and deletes all replicas at the same time.
+	StatefulPodManagementParallel StatefulPodManagementPolicy = "Parallel"
+)
+
+// StatefulExtension - Specifies the container should run as a stateful workload with stable network identities
+// and per-replica persistent storage.
+type StatefulExtension struct {
+	// PodManagementPolicy is the policy used to create and delete replicas. Defaults to OrderedReady.
+	PodManagementPolicy StatefulPodManagementPolicy `json:"podManagementPolicy,omitempty"`
+	// VolumeClaims is the map of per-replica persistent volume claims, keyed by volume name.
+	VolumeClaims map[string]StatefulVolumeClaim `json:"volumeClaims,omitempty"`
+}
+
+// StatefulVolumeClaim - A persistent volume claim created for each replica of a stateful container.
+type StatefulVolumeClaim struct {
+	// MountPath is the path where the volume is mounted in the container.
+	MountPath string `json:"mountPath,omitempty"`
+	// Size is the requested storage size, as a Kubernetes quantity.
+	Size string `json:"size,omitempty"`
+	// StorageClass is the name of the storage class. The cluster default is used when empty.
+	StorageClass string `json:"storageClass,omitempty"`
+	// AccessModes is the list of access modes of the volume. Defaults to ReadWriteOnce.
+	AccessModes []string `json:"accessModes,omitempty"`
+}
+
 // DaprSidecarExtension - Specifies the resource should have a Dapr sidecar injected
 type DaprSidecarExtension struct {
 	AppID    string   `json:"appId,omitempty"`
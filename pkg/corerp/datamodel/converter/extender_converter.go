@@ -42,6 +42,21 @@ func ExtenderDataModelToVersioned(model *datamodel.Extender, version string) (v1
 	}
 }
 
+// ExtenderDataModelToVersionedWithSecrets converts a datamodel.Extender to a versioned model like
+// ExtenderDataModelToVersioned, but keeps the secrets so that the result can be used as the base of a PATCH request.
+func ExtenderDataModelToVersionedWithSecrets(model *datamodel.Extender, version string) (v1.VersionedModelInterface, error) {
+	versioned, err := ExtenderDataModelToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if extender, ok := versioned.(*v20231001preview.ExtenderResource); ok && len(model.Properties.Secrets) > 0 {
+		extender.Properties.Secrets = model.Properties.Secrets
+	}
+
+	return versioned, nil
+}
+
 // ExtenderDataModelFromVersioned unmarshals a JSON byte slice into a version-specific ExtenderResource struct, then
 // converts it to a datamodel.Extender struct and returns it, or returns an error if the unmarshal or conversion fails.
 func ExtenderDataModelFromVersioned(content []byte, version string) (*datamodel.Extender, error) {
@@ -25,6 +25,10 @@ const (
 	KubernetesMetadata           ExtensionKind = "kubernetesMetadata"
 	KubernetesNamespaceExtension ExtensionKind = "kubernetesNamespace"
 	ACIExtension                 ExtensionKind = "aci"
+	Autoscaling                  ExtensionKind = "autoscaling"
+	DisruptionBudget             ExtensionKind = "disruptionBudget"
+	NetworkPolicy                ExtensionKind = "networkPolicy"
+	Stateful                     ExtensionKind = "stateful"
 )
 
 // Extension of a resource.
@@ -35,6 +39,10 @@ type Extension struct {
 	KubernetesMetadata     *KubeMetadataExtension           `json:"kubernetesMetadata,omitempty"`
 	KubernetesNamespace    *KubeNamespaceExtension          `json:"kubernetesNamespace,omitempty"`
 	AzureContainerInstance *AzureContainerInstanceExtension `json:"aci,omitempty"`
+	Autoscaling            *AutoscalingExtension            `json:"autoscaling,omitempty"`
+	DisruptionBudget       *DisruptionBudgetExtension       `json:"disruptionBudget,omitempty"`
+	NetworkPolicy          *NetworkPolicyExtension          `json:"networkPolicy,omitempty"`
+	Stateful               *StatefulExtension               `json:"stateful,omitempty"`
 }
 
 // KubeMetadataExtension represents the extension of kubernetes resource.
@@ -47,6 +47,10 @@ const (
 	connectionsPath = "/properties/connections"
 	routesPath      = "/properties/routes"
 	portsPath       = "/properties/container/ports"
+
+	// referencesPath is where dynamic resources record their references to other resources. References are
+	// declared by the resource type schema with x-radius-reference.
+	referencesPath = "/properties/status/references"
 )
 
 // resolver is a function type to resolve appgraph connection.
@@ -310,6 +314,11 @@ func computeGraph(applicationResources []generated.GenericResource, environmentR
 		connections := resolveConnections(resource, connectionsPath, connectionsResolver(resources))
 		// Resolve Outbound connections based on 'routes'.
 		connections = append(connections, resolveConnections(resource, routesPath, routesPathResolver(resources))...)
+		// Resolve Outbound connections based on the references of dynamic resources.
+		connections = append(connections, resolveConnections(resource, referencesPath, referencesResolver(resources))...)
+
+		// A resource can both connect to and reference another resource.
+		connections = uniqueConnections(connections)
 
 		sort.Slice(connections, func(i, j int) bool {
 			return to.String(connections[i].ID) < to.String(connections[j].ID)
@@ -524,6 +533,10 @@ func resolveConnections(resource generated.GenericResource, jsonRefPath string,
 		raw = resource.Properties["connections"]
 	case routesPath:
 		raw = resource.Properties["routes"]
+	case referencesPath:
+		if status, ok := resource.Properties["status"].(map[string]any); ok {
+			raw = status["references"]
+		}
 	default:
 		p, err := jsonpointer.New(jsonRefPath)
 		if err != nil {
@@ -652,6 +665,42 @@ func routesPathResolver(resources []generated.GenericResource) resolver {
 	}
 }
 
+// referencesResolver resolves the outbound connections of a dynamic resource from the references to other resources
+// recorded in its status.
+func referencesResolver(resources []generated.GenericResource) resolver {
+	return func(item any) (string, corerpv20231001preview.Direction, error) {
+		data := &struct {
+			ID string `json:"id"`
+		}{}
+		err := toStronglyTypedData(item, data)
+		if err != nil {
+			return "", "", err
+		}
+		sourceID, err := findSourceResource(data.ID, resources)
+		if err != nil {
+			return "", "", err
+		}
+		return sourceID, corerpv20231001preview.DirectionOutbound, nil
+	}
+}
+
+// uniqueConnections removes the duplicate connections, keeping the first occurrence of each.
+func uniqueConnections(connections []*corerpv20231001preview.ApplicationGraphConnection) []*corerpv20231001preview.ApplicationGraphConnection {
+	seen := map[string]bool{}
+	result := []*corerpv20231001preview.ApplicationGraphConnection{}
+	for _, connection := range connections {
+		key := strings.ToLower(to.String(connection.ID)) + "|" + string(*connection.Direction)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		result = append(result, connection)
+	}
+
+	return result
+}
+
 // toStronglyTypedData uses JSON marshalling and unmarshalling to convert a weakly-typed
 // representation to a strongly-typed one.
 func toStronglyTypedData(data any, result any) error {
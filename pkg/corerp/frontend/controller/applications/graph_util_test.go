@@ -138,6 +138,12 @@ func Test_computeGraph(t *testing.T) {
 			envResourceDataFile: "",
 			expectedDataFile:    "graph-app-gw-out.json",
 		},
+		{
+			name:                "with references",
+			appResourceDataFile: "graph-app-references-in.json",
+			envResourceDataFile: "",
+			expectedDataFile:    "graph-app-references-out.json",
+		},
 	}
 
 	for _, tt := range tests {
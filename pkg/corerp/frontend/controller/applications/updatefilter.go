@@ -62,6 +62,15 @@ func CreateAppScopedNamespace(ctx context.Context, newResource, oldResource *dat
 	if err != nil {
 		return nil, err
 	}
+	// Applications of Docker environments run on the network of the environment, report it in the status so that
+	// clients know how to reach the containers.
+	if env.Properties.Compute.Kind == rpv1.DockerComputeKind {
+		newResource.Properties.Status.Compute = &rpv1.EnvironmentCompute{
+			Kind:          rpv1.DockerComputeKind,
+			DockerCompute: env.Properties.Compute.DockerCompute,
+		}
+	}
+
 	// We want to skip setting up a namespace for environments with non-Kubernetes compute
 	if env.Properties.Compute.Kind != rpv1.KubernetesComputeKind {
 		logger.Info("Skipping namespace creation for non-Kubernetes environment", "environment", newResource.Properties.Environment)
@@ -467,4 +467,40 @@ func TestCreateAppScopedNamespace_non_kubernetes_compute(t *testing.T) {
 		require.Nil(t, resp)
 		require.Nil(t, newResource.Properties.Status.Compute, "Compute status should not be set for ACI compute kind")
 	})
+
+	t.Run("sets docker compute status", func(t *testing.T) {
+		envdm := &datamodel.Environment{
+			Properties: datamodel.EnvironmentProperties{
+				Compute: rpv1.EnvironmentCompute{
+					Kind:          rpv1.DockerComputeKind,
+					DockerCompute: rpv1.DockerComputeProperties{Network: "shared"},
+				},
+			},
+		}
+
+		tCtx.MockSC.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any()).
+			Return(rpctest.FakeStoreObject(envdm), nil)
+
+		newResource := &datamodel.Application{
+			Properties: datamodel.ApplicationProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Environment: testEnvID,
+				},
+			},
+		}
+
+		id, err := resources.ParseResource(testAppID)
+		require.NoError(t, err)
+		ctx := v1.WithARMRequestContext(tCtx.Ctx, &v1.ARMRequestContext{ResourceID: id})
+
+		resp, err := CreateAppScopedNamespace(ctx, newResource, nil, &opts)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, &rpv1.EnvironmentCompute{
+			Kind:          rpv1.DockerComputeKind,
+			DockerCompute: rpv1.DockerComputeProperties{Network: "shared"},
+		}, newResource.Properties.Status.Compute)
+	})
 }
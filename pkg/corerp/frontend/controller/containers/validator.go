@@ -23,6 +23,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -36,6 +37,7 @@ import (
 const (
 	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
 	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	extensionsProperty     = "$.properties.extensions"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +54,10 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateExtensions(newResource.Properties.Extensions); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(*v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +94,77 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+// validateExtensions validates the scaling, availability and storage extensions of the container. The autoscaling
+// extension owns the replica count of the workload, so it cannot be combined with the manualScaling extension.
+func validateExtensions(extensions []datamodel.Extension) error {
+	manualScaling := datamodel.FindExtension(extensions, datamodel.ManualScaling)
+	autoscaling := datamodel.FindExtension(extensions, datamodel.Autoscaling)
+	if autoscaling != nil && autoscaling.Autoscaling != nil {
+		if manualScaling != nil {
+			return errInvalidExtension("autoscaling and manualScaling extensions cannot be used together.")
+		}
+
+		as := autoscaling.Autoscaling
+		if as.MaxReplicas < 1 {
+			return errInvalidExtension("autoscaling extension must specify maxReplicas greater than 0.")
+		}
+		if as.MinReplicas != nil && (*as.MinReplicas < 1 || *as.MinReplicas > as.MaxReplicas) {
+			return errInvalidExtension(fmt.Sprintf("autoscaling extension minReplicas must be between 1 and maxReplicas (%d).", as.MaxReplicas))
+		}
+
+		for _, metric := range as.Metrics {
+			switch metric.Kind {
+			case datamodel.AutoscalingMetricCPU, datamodel.AutoscalingMetricMemory:
+				if metric.TargetAverageUtilization == nil && metric.TargetAverageValue == "" {
+					return errInvalidExtension(fmt.Sprintf("autoscaling metric %q must specify targetAverageUtilization or targetAverageValue.", metric.Kind))
+				}
+			case datamodel.AutoscalingMetricCustom:
+				if metric.Name == "" || metric.TargetAverageValue == "" {
+					return errInvalidExtension("custom autoscaling metric must specify name and targetAverageValue.")
+				}
+			default:
+				return errInvalidExtension(fmt.Sprintf("autoscaling metric kind %q is not supported.", metric.Kind))
+			}
+
+			if metric.TargetAverageValue != "" {
+				if _, err := resource.ParseQuantity(metric.TargetAverageValue); err != nil {
+					return errInvalidExtension(fmt.Sprintf("autoscaling metric targetAverageValue %q is invalid: %s.", metric.TargetAverageValue, err.Error()))
+				}
+			}
+		}
+	}
+
+	budget := datamodel.FindExtension(extensions, datamodel.DisruptionBudget)
+	if budget != nil && budget.DisruptionBudget != nil {
+		db := budget.DisruptionBudget
+		if (db.MinAvailable == "") == (db.MaxUnavailable == "") {
+			return errInvalidExtension("disruptionBudget extension must specify exactly one of minAvailable or maxUnavailable.")
+		}
+	}
+
+	stateful := datamodel.FindExtension(extensions, datamodel.Stateful)
+	if stateful != nil && stateful.Stateful != nil {
+		for name, claim := range stateful.Stateful.VolumeClaims {
+			if claim.MountPath == "" {
+				return errInvalidExtension(fmt.Sprintf("stateful volume claim %q must specify mountPath.", name))
+			}
+			if _, err := resource.ParseQuantity(claim.Size); err != nil {
+				return errInvalidExtension(fmt.Sprintf("stateful volume claim %q size %q is invalid: %s.", name, claim.Size, err.Error()))
+			}
+		}
+	}
+
+	return nil
+}
+
+func errInvalidExtension(message string) *v1.ErrorDetails {
+	return &v1.ErrorDetails{
+		Code:    v1.CodeInvalidRequestContent,
+		Target:  extensionsProperty,
+		Message: message,
+	}
+}
+
 func errMultipleResources(typeName string, num int) *v1.ErrorDetails {
 	return &v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
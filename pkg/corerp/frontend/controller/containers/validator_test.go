@@ -444,3 +444,100 @@ func TestValidatePodSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateExtensions(t *testing.T) {
+	extensionTests := []struct {
+		name       string
+		extensions []datamodel.Extension
+		err        string
+	}{
+		{
+			name: "valid autoscaling and disruption budget",
+			extensions: []datamodel.Extension{
+				{
+					Kind: datamodel.Autoscaling,
+					Autoscaling: &datamodel.AutoscalingExtension{
+						MinReplicas: new(int32(2)),
+						MaxReplicas: 5,
+						Metrics: []datamodel.AutoscalingMetric{
+							{Kind: datamodel.AutoscalingMetricCPU, TargetAverageUtilization: new(int32(70))},
+							{Kind: datamodel.AutoscalingMetricCustom, Name: "requests_per_second", TargetAverageValue: "100"},
+						},
+					},
+				},
+				{
+					Kind:             datamodel.DisruptionBudget,
+					DisruptionBudget: &datamodel.DisruptionBudgetExtension{MinAvailable: "50%"},
+				},
+			},
+		},
+		{
+			name: "autoscaling with manual scaling",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.ManualScaling, ManualScaling: &datamodel.ManualScalingExtension{Replicas: new(int32(2))}},
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 3}},
+			},
+			err: "autoscaling and manualScaling extensions cannot be used together.",
+		},
+		{
+			name: "autoscaling minReplicas greater than maxReplicas",
+			extensions: []datamodel.Extension{
+				{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MinReplicas: new(int32(4)), MaxReplicas: 3}},
+			},
+			err: "autoscaling extension minReplicas must be between 1 and maxReplicas (3).",
+		},
+		{
+			name: "custom metric without target",
+			extensions: []datamodel.Extension{
+				{
+					Kind: datamodel.Autoscaling,
+					Autoscaling: &datamodel.AutoscalingExtension{
+						MaxReplicas: 3,
+						Metrics:     []datamodel.AutoscalingMetric{{Kind: datamodel.AutoscalingMetricCustom, Name: "queue_depth"}},
+					},
+				},
+			},
+			err: "custom autoscaling metric must specify name and targetAverageValue.",
+		},
+		{
+			name: "stateful volume claim with invalid size",
+			extensions: []datamodel.Extension{
+				{
+					Kind: datamodel.Stateful,
+					Stateful: &datamodel.StatefulExtension{
+						VolumeClaims: map[string]datamodel.StatefulVolumeClaim{
+							"data": {MountPath: "/data", Size: "ten gigs"},
+						},
+					},
+				},
+			},
+			err: "stateful volume claim \"data\" size \"ten gigs\" is invalid: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+		},
+		{
+			name: "disruption budget with both values",
+			extensions: []datamodel.Extension{
+				{
+					Kind:             datamodel.DisruptionBudget,
+					DisruptionBudget: &datamodel.DisruptionBudgetExtension{MinAvailable: "1", MaxUnavailable: "1"},
+				},
+			},
+			err: "disruptionBudget extension must specify exactly one of minAvailable or maxUnavailable.",
+		},
+	}
+
+	for _, tc := range extensionTests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateExtensions(tc.extensions)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, &v1.ErrorDetails{
+				Code:    v1.CodeInvalidRequestContent,
+				Target:  extensionsProperty,
+				Message: tc.err,
+			}, err)
+		})
+	}
+}
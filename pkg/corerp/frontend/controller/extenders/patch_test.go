@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extenders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/datamodel/converter"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatchExtender_KeepsSecrets(t *testing.T) {
+	patchCases := []struct {
+		desc     string
+		patch    map[string]any
+		expected map[string]any
+	}{
+		{
+			desc:  "secrets not in patch",
+			patch: map[string]any{"properties": map[string]any{"fromNumber": "333-333-3333"}},
+			expected: map[string]any{
+				"accountSid": "sid",
+				"authToken:": "token",
+			},
+		},
+		{
+			desc:  "secret updated by patch",
+			patch: map[string]any{"properties": map[string]any{"secrets": map[string]any{"accountSid": "new-sid"}}},
+			expected: map[string]any{
+				"accountSid": "new-sid",
+				"authToken:": "token",
+			},
+		},
+		{
+			desc:  "secret removed by patch",
+			patch: map[string]any{"properties": map[string]any{"secrets": map[string]any{"authToken:": nil}}},
+			expected: map[string]any{
+				"accountSid": "sid",
+			},
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+			mds := database.NewMockClient(mctrl)
+			msm := statusmanager.NewMockStatusManager(mctrl)
+
+			_, extenderDataModel, _ := getTestModels20231001preview()
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(context.Background(), http.MethodPatch, testHeaderfile, tt.patch)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", ctrl.MergePatchContentType)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+				Return(&database.Object{
+					Metadata: database.Metadata{ID: extenderDataModel.ID, ETag: "existing-etag"},
+					Data:     extenderDataModel,
+				}, nil).
+				Times(1)
+
+			var saved *datamodel.Extender
+			mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+					saved = obj.Data.(*datamodel.Extender)
+					return nil
+				}).
+				Times(1)
+			msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				Times(1)
+
+			opts := ctrl.Options{
+				DatabaseClient: mds,
+				StatusManager:  msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[datamodel.Extender]{
+				RequestConverter:   converter.ExtenderDataModelFromVersioned,
+				ResponseConverter:  converter.ExtenderDataModelToVersioned,
+				PatchBaseConverter: converter.ExtenderDataModelToVersionedWithSecrets,
+			}
+
+			ctl, err := defaultoperation.NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+			require.NotNil(t, saved)
+			require.Equal(t, tt.expected, saved.Properties.Secrets)
+		})
+	}
+}
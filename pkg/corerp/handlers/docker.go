@@ -0,0 +1,358 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/docker"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	resources_docker "github.com/radius-project/radius/pkg/ucp/resources/docker"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DockerConfigHashLabel is the label of the containers created by Radius that stores the hash of their
+	// configuration. Containers are immutable, so a container is replaced only when the hash changes.
+	DockerConfigHashLabel = "radapp.io/config-hash"
+
+	// DockerContainerIDKey is the property of a deployed container that stores its ID.
+	DockerContainerIDKey = "containerID"
+
+	// DockerHostPortKey is the property of a deployed container that stores the host port its first port is
+	// published on.
+	DockerHostPortKey = "hostPort"
+
+	// DockerURLKey is the property of a deployed container that stores the URL of its first published port.
+	DockerURLKey = "url"
+
+	// DefaultDockerWaitTimeout is the time to wait for a container to be running and healthy.
+	DefaultDockerWaitTimeout = 5 * time.Minute
+
+	dockerPollInterval = time.Second
+)
+
+// NewDockerHandler creates a ResourceHandler that deploys containers and volumes through the Docker Engine API.
+func NewDockerHandler(client docker.Client) ResourceHandler {
+	return &dockerHandler{
+		client:       client,
+		waitTimeout:  DefaultDockerWaitTimeout,
+		pollInterval: dockerPollInterval,
+	}
+}
+
+type dockerHandler struct {
+	client       docker.Client
+	waitTimeout  time.Duration
+	pollInterval time.Duration
+}
+
+// Put creates the Docker object of the output resource. Containers are replaced when their configuration changed
+// and then waited on until they are running and healthy.
+func (handler *dockerHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
+	name := options.Resource.ID.Name()
+	switch data := options.Resource.CreateResource.Data.(type) {
+	case *docker.ContainerConfig:
+		return handler.putContainer(ctx, name, data)
+	case *docker.VolumeConfig:
+		err := handler.client.CreateVolume(ctx, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create volume %q: %w", data.Name, err)
+		}
+		return map[string]string{ResourceName: data.Name}, nil
+	default:
+		return nil, fmt.Errorf("unsupported docker resource %T", data)
+	}
+}
+
+// Delete removes the Docker object of the output resource. Objects that don't exist are ignored.
+func (handler *dockerHandler) Delete(ctx context.Context, options *DeleteOptions) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	id := options.Resource.ID
+
+	var err error
+	switch id.Type() {
+	case resources_docker.ResourceTypeContainer:
+		err = handler.client.RemoveContainer(ctx, id.Name())
+	case resources_docker.ResourceTypeVolume:
+		err = handler.client.RemoveVolume(ctx, id.Name())
+	default:
+		return fmt.Errorf("unsupported docker resource type %q", id.Type())
+	}
+
+	if docker.IsNotFound(err) {
+		logger.Info("Docker object was already deleted", "id", id.String())
+		return nil
+	}
+
+	return err
+}
+
+func (handler *dockerHandler) putContainer(ctx context.Context, name string, config *docker.ContainerConfig) (map[string]string, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	err := handler.ensureNetworks(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := dockerConfigHash(config)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Labels = maps.Clone(config.Labels)
+	if config.Labels == nil {
+		config.Labels = map[string]string{}
+	}
+	config.Labels[DockerConfigHashLabel] = hash
+
+	existing, err := handler.client.InspectContainer(ctx, name)
+	if err != nil && !docker.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing != nil && existing.Config.Labels[DockerConfigHashLabel] == hash {
+		logger.Info("Container is up to date", "name", name)
+		err = handler.client.StartContainer(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start container %q: %w", name, err)
+		}
+
+		return handler.waitUntilReady(ctx, name)
+	}
+
+	if existing != nil {
+		// Keep the host ports chosen by Docker for the previous container, so that the URLs of the container
+		// don't change on each deployment.
+		reuseHostPorts(config, existing)
+
+		logger.Info("Replacing container", "name", name)
+		err = handler.client.RemoveContainer(ctx, name)
+		if err != nil && !docker.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to remove container %q: %w", name, err)
+		}
+	}
+
+	exists, err := handler.client.ImageExists(ctx, config.Image)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		logger.Info("Pulling image", "image", config.Image)
+		err = handler.client.PullImage(ctx, config.Image)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = handler.client.CreateContainer(ctx, name, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create container %q: %w", name, err)
+	}
+
+	err = handler.copySecrets(ctx, name, config.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	err = handler.client.StartContainer(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start container %q: %w", name, err)
+	}
+
+	return handler.waitUntilReady(ctx, name)
+}
+
+// copySecrets writes the secrets of a container to its filesystem before it's started. The files are kept when the
+// container is restarted.
+func (handler *dockerHandler) copySecrets(ctx context.Context, name string, secrets map[string]string) error {
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	archive, err := dockerSecretsArchive(secrets)
+	if err != nil {
+		return err
+	}
+
+	err = handler.client.CopyToContainer(ctx, name, "/", archive)
+	if err != nil {
+		return fmt.Errorf("failed to copy secrets to container %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// ensureNetworks creates the networks the container is attached to. Networks are shared by the containers of an
+// environment, so they are not output resources and are not deleted with the containers.
+func (handler *dockerHandler) ensureNetworks(ctx context.Context, config *docker.ContainerConfig) error {
+	for network := range config.NetworkingConfig.EndpointsConfig {
+		_, err := handler.client.InspectNetwork(ctx, network)
+		if err == nil {
+			continue
+		} else if !docker.IsNotFound(err) {
+			return err
+		}
+
+		err = handler.client.CreateNetwork(ctx, &docker.NetworkConfig{
+			Name:   network,
+			Driver: "bridge",
+			Labels: map[string]string{kubernetes.LabelManagedBy: kubernetes.LabelManagedByRadiusRP},
+		})
+		if err != nil && !docker.IsConflict(err) {
+			return fmt.Errorf("failed to create network %q: %w", network, err)
+		}
+	}
+
+	return nil
+}
+
+// waitUntilReady waits until the container is running, and healthy if it has a health check. It returns the
+// properties of the deployed container.
+func (handler *dockerHandler) waitUntilReady(ctx context.Context, name string) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, handler.waitTimeout)
+	defer cancel()
+
+	for {
+		container, err := handler.client.InspectContainer(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		state := container.State
+		switch {
+		case state.Status == "exited" || state.Status == "dead":
+			return nil, fmt.Errorf("container %q exited with code %d %s", name, state.ExitCode, state.Error)
+		case state.Health != nil && state.Health.Status == "unhealthy":
+			return nil, fmt.Errorf("container %q is unhealthy", name)
+		case state.Running && (state.Health == nil || state.Health.Status == "healthy"):
+			return dockerContainerProperties(name, container), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("container %q is not ready after %s, status: %s", name, handler.waitTimeout, state.Status)
+			}
+			return nil, ctx.Err()
+		case <-time.After(handler.pollInterval):
+		}
+	}
+}
+
+// dockerContainerProperties returns the properties of a deployed container, including the host port and URL of
+// its first published port.
+func dockerContainerProperties(name string, container *docker.ContainerInspect) map[string]string {
+	properties := map[string]string{
+		DockerContainerIDKey: container.ID,
+		ResourceName:         name,
+	}
+
+	for _, port := range slices.Sorted(maps.Keys(container.NetworkSettings.Ports)) {
+		for _, binding := range container.NetworkSettings.Ports[port] {
+			if binding.HostPort == "" {
+				continue
+			}
+
+			properties[DockerHostPortKey] = binding.HostPort
+			properties[DockerURLKey] = "http://localhost:" + binding.HostPort
+			return properties
+		}
+	}
+
+	return properties
+}
+
+// reuseHostPorts sets the host ports of the bindings that let Docker choose a port to the ports of the existing
+// container.
+func reuseHostPorts(config *docker.ContainerConfig, existing *docker.ContainerInspect) {
+	for port, bindings := range config.HostConfig.PortBindings {
+		for i := range bindings {
+			if bindings[i].HostPort != "" {
+				continue
+			}
+
+			for _, previous := range existing.NetworkSettings.Ports[port] {
+				if previous.HostPort != "" {
+					bindings[i].HostPort = previous.HostPort
+					break
+				}
+			}
+		}
+	}
+}
+
+// dockerSecretsArchive returns a tar archive of the secrets of a container, extracted at the root of its filesystem.
+// The files are readable by every user, since the user of the image is unknown. Docker creates the missing parent
+// directories.
+func dockerSecretsArchive(secrets map[string]string) (io.Reader, error) {
+	buf := &bytes.Buffer{}
+	writer := tar.NewWriter(buf)
+	for _, file := range slices.Sorted(maps.Keys(secrets)) {
+		name := strings.TrimPrefix(path.Clean(file), "/")
+		err := writer.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0444, Size: int64(len(secrets[file]))})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = writer.Write([]byte(secrets[file]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// dockerConfigHash returns the hash of the configuration of a container, including its secrets. Secrets are not
+// stored in the label, only their hash is.
+func dockerConfigHash(config *docker.ContainerConfig) (string, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	if len(config.Secrets) > 0 {
+		secrets, err := json.Marshal(config.Secrets)
+		if err != nil {
+			return "", err
+		}
+		b = append(b, secrets...)
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/docker"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_docker "github.com/radius-project/radius/pkg/ucp/resources/docker"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDockerContainerConfig() *docker.ContainerConfig {
+	return &docker.ContainerConfig{
+		Image:        "nginx:latest",
+		Env:          []string{"CONNECTION_BACKEND_URL=http://backend:3000"},
+		Labels:       map[string]string{"radapp.io/application": "myapp"},
+		ExposedPorts: map[string]struct{}{"80/tcp": {}},
+		HostConfig: docker.HostConfig{
+			PortBindings: map[string][]docker.PortBinding{"80/tcp": {{HostIP: "127.0.0.1"}}},
+		},
+		NetworkingConfig: docker.NetworkingConfig{
+			EndpointsConfig: map[string]docker.EndpointSettings{"myenv": {Aliases: []string{"frontend"}}},
+		},
+	}
+}
+
+func newTestDockerPutOptions(config *docker.ContainerConfig) *PutOptions {
+	return &PutOptions{
+		Resource: &rpv1.OutputResource{
+			LocalID: rpv1.LocalIDDockerContainer,
+			ID:      resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeContainer, "frontend"),
+			CreateResource: &rpv1.Resource{
+				ResourceType: resourcemodel.ResourceType{Type: resources_docker.ResourceTypeContainer, Provider: resourcemodel.ProviderDocker},
+				Data:         config,
+			},
+		},
+	}
+}
+
+func runningDockerContainer(hash string, hostPort string) *docker.ContainerInspect {
+	return &docker.ContainerInspect{
+		ID:     "abc",
+		Name:   "/frontend",
+		Config: docker.ContainerConfig{Labels: map[string]string{DockerConfigHashLabel: hash}},
+		State:  docker.ContainerState{Status: "running", Running: true},
+		NetworkSettings: docker.NetworkSettings{
+			Ports: map[string][]docker.PortBinding{"80/tcp": {{HostIP: "127.0.0.1", HostPort: hostPort}}},
+		},
+	}
+}
+
+func Test_DockerHandler_Put_Container(t *testing.T) {
+	hash, err := dockerConfigHash(newTestDockerContainerConfig())
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		DockerContainerIDKey: "abc",
+		ResourceName:         "frontend",
+		DockerHostPortKey:    "49153",
+		DockerURLKey:         "http://localhost:49153",
+	}
+
+	t.Run("create", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := docker.NewMockClient(ctrl)
+		handler := &dockerHandler{client: client, waitTimeout: time.Minute, pollInterval: time.Millisecond}
+
+		notFound := &docker.Error{StatusCode: 404, Message: "not found"}
+		client.EXPECT().InspectNetwork(gomock.Any(), "myenv").Return(nil, notFound)
+		client.EXPECT().CreateNetwork(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, config *docker.NetworkConfig) error {
+			require.Equal(t, "myenv", config.Name)
+			return nil
+		})
+		client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(nil, notFound)
+		client.EXPECT().ImageExists(gomock.Any(), "nginx:latest").Return(false, nil)
+		client.EXPECT().PullImage(gomock.Any(), "nginx:latest").Return(nil)
+		client.EXPECT().CreateContainer(gomock.Any(), "frontend", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, config *docker.ContainerConfig) (string, error) {
+			require.Equal(t, hash, config.Labels[DockerConfigHashLabel])
+			require.Equal(t, "myapp", config.Labels["radapp.io/application"])
+			return "abc", nil
+		})
+		client.EXPECT().StartContainer(gomock.Any(), "frontend").Return(nil)
+		gomock.InOrder(
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(&docker.ContainerInspect{State: docker.ContainerState{Status: "created"}}, nil),
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(runningDockerContainer(hash, "49153"), nil),
+		)
+
+		properties, err := handler.Put(testcontext.New(t), newTestDockerPutOptions(newTestDockerContainerConfig()))
+		require.NoError(t, err)
+		require.Equal(t, expected, properties)
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := docker.NewMockClient(ctrl)
+		handler := &dockerHandler{client: client, waitTimeout: time.Minute, pollInterval: time.Millisecond}
+
+		client.EXPECT().InspectNetwork(gomock.Any(), "myenv").Return(&docker.NetworkInspect{Name: "myenv"}, nil)
+		client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(runningDockerContainer(hash, "49153"), nil).Times(2)
+		client.EXPECT().StartContainer(gomock.Any(), "frontend").Return(nil)
+
+		properties, err := handler.Put(testcontext.New(t), newTestDockerPutOptions(newTestDockerContainerConfig()))
+		require.NoError(t, err)
+		require.Equal(t, expected, properties)
+	})
+
+	t.Run("replace keeps host port", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := docker.NewMockClient(ctrl)
+		handler := &dockerHandler{client: client, waitTimeout: time.Minute, pollInterval: time.Millisecond}
+
+		client.EXPECT().InspectNetwork(gomock.Any(), "myenv").Return(&docker.NetworkInspect{Name: "myenv"}, nil)
+		gomock.InOrder(
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(runningDockerContainer("previous", "49153"), nil),
+			client.EXPECT().RemoveContainer(gomock.Any(), "frontend").Return(nil),
+			client.EXPECT().ImageExists(gomock.Any(), "nginx:latest").Return(true, nil),
+			client.EXPECT().CreateContainer(gomock.Any(), "frontend", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, config *docker.ContainerConfig) (string, error) {
+				require.Equal(t, []docker.PortBinding{{HostIP: "127.0.0.1", HostPort: "49153"}}, config.HostConfig.PortBindings["80/tcp"])
+				return "abc", nil
+			}),
+			client.EXPECT().StartContainer(gomock.Any(), "frontend").Return(nil),
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(runningDockerContainer(hash, "49153"), nil),
+		)
+
+		properties, err := handler.Put(testcontext.New(t), newTestDockerPutOptions(newTestDockerContainerConfig()))
+		require.NoError(t, err)
+		require.Equal(t, expected, properties)
+	})
+
+	t.Run("secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := docker.NewMockClient(ctrl)
+		handler := &dockerHandler{client: client, waitTimeout: time.Minute, pollInterval: time.Millisecond}
+
+		config := newTestDockerContainerConfig()
+		config.Secrets = map[string]string{"/run/secrets/radius/CONNECTION_REDIS_PASSWORD": "p@ssw0rd"}
+		secretHash, err := dockerConfigHash(config)
+		require.NoError(t, err)
+		require.NotEqual(t, hash, secretHash)
+
+		client.EXPECT().InspectNetwork(gomock.Any(), "myenv").Return(&docker.NetworkInspect{Name: "myenv"}, nil)
+		gomock.InOrder(
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(nil, &docker.Error{StatusCode: 404}),
+			client.EXPECT().ImageExists(gomock.Any(), "nginx:latest").Return(true, nil),
+			client.EXPECT().CreateContainer(gomock.Any(), "frontend", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, config *docker.ContainerConfig) (string, error) {
+				require.NotContains(t, config.Labels[DockerConfigHashLabel], "p@ssw0rd")
+				return "abc", nil
+			}),
+			client.EXPECT().CopyToContainer(gomock.Any(), "frontend", "/", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ string, archive io.Reader) error {
+				reader := tar.NewReader(archive)
+				header, err := reader.Next()
+				require.NoError(t, err)
+				require.Equal(t, "run/secrets/radius/CONNECTION_REDIS_PASSWORD", header.Name)
+				content, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				require.Equal(t, "p@ssw0rd", string(content))
+				return nil
+			}),
+			client.EXPECT().StartContainer(gomock.Any(), "frontend").Return(nil),
+			client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(runningDockerContainer(secretHash, "49153"), nil),
+		)
+
+		properties, err := handler.Put(testcontext.New(t), newTestDockerPutOptions(config))
+		require.NoError(t, err)
+		require.Equal(t, expected, properties)
+	})
+
+	t.Run("exited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := docker.NewMockClient(ctrl)
+		handler := &dockerHandler{client: client, waitTimeout: time.Minute, pollInterval: time.Millisecond}
+
+		exited := runningDockerContainer(hash, "")
+		exited.State = docker.ContainerState{Status: "exited", ExitCode: 1}
+		client.EXPECT().InspectNetwork(gomock.Any(), "myenv").Return(&docker.NetworkInspect{Name: "myenv"}, nil)
+		client.EXPECT().InspectContainer(gomock.Any(), "frontend").Return(exited, nil).Times(2)
+		client.EXPECT().StartContainer(gomock.Any(), "frontend").Return(nil)
+
+		_, err := handler.Put(testcontext.New(t), newTestDockerPutOptions(newTestDockerContainerConfig()))
+		require.ErrorContains(t, err, `container "frontend" exited with code 1`)
+	})
+}
+
+func Test_DockerHandler_Put_Volume(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := docker.NewMockClient(ctrl)
+	handler := NewDockerHandler(client)
+
+	config := &docker.VolumeConfig{Name: "myapp-frontend-data"}
+	client.EXPECT().CreateVolume(gomock.Any(), config).Return(nil)
+
+	properties, err := handler.Put(testcontext.New(t), &PutOptions{
+		Resource: &rpv1.OutputResource{
+			ID: resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeVolume, "myapp-frontend-data"),
+			CreateResource: &rpv1.Resource{
+				ResourceType: resourcemodel.ResourceType{Type: resources_docker.ResourceTypeVolume, Provider: resourcemodel.ProviderDocker},
+				Data:         config,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{ResourceName: "myapp-frontend-data"}, properties)
+}
+
+func Test_DockerHandler_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := docker.NewMockClient(ctrl)
+	handler := NewDockerHandler(client)
+	ctx := testcontext.New(t)
+
+	client.EXPECT().RemoveContainer(gomock.Any(), "frontend").Return(&docker.Error{StatusCode: 404, Message: "No such container"})
+	err := handler.Delete(ctx, &DeleteOptions{
+		Resource: &rpv1.OutputResource{ID: resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeContainer, "frontend")},
+	})
+	require.NoError(t, err)
+
+	client.EXPECT().RemoveVolume(gomock.Any(), "data").Return(&docker.Error{StatusCode: 409, Message: "volume is in use"})
+	err = handler.Delete(ctx, &DeleteOptions{
+		Resource: &rpv1.OutputResource{ID: resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeVolume, "data")},
+	})
+	require.EqualError(t, err, "docker: volume is in use (status 409)")
+}
@@ -63,6 +63,7 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
+		statefulSetWaiter:  NewStatefulSetWaiter(clientSet),
 	}
 }
 
@@ -72,10 +73,11 @@ type kubernetesHandler struct {
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
 	deploymentWaiter   ResourceWaiter
+	statefulSetWaiter  ResourceWaiter
 }
 
 // Put stores the Kubernetes resource in the cluster and returns the properties of the resource. If the resource is a
-// deployment, it also waits until the deployment is ready.
+// deployment or statefulset, it also waits until the workload is ready.
 func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions) (map[string]string, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -134,6 +136,13 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("Deployment %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "statefulset":
+		err = handler.statefulSetWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("StatefulSet %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
+		return properties, nil
 	case "httpproxy":
 		err = handler.httpProxyWaiter.waitUntilReady(ctx, &item)
 		if err != nil {
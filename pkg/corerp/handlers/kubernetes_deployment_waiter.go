@@ -226,7 +226,7 @@ func (handler *deploymentWaiter) checkAllPodsReady(ctx context.Context, informer
 
 	allReady := true
 	for _, pod := range podsInDeployment {
-		podReady, err := handler.checkPodStatus(ctx, &pod)
+		podReady, err := checkPodStatus(ctx, &pod)
 		if err != nil {
 			// Terminate the deployment and return the error encountered
 			doneCh <- err
@@ -266,7 +266,9 @@ func (handler *deploymentWaiter) getPodsInDeployment(ctx context.Context, inform
 	return pods, nil
 }
 
-func (handler *deploymentWaiter) checkPodStatus(ctx context.Context, pod *corev1.Pod) (bool, error) {
+// checkPodStatus reports whether all containers of the pod are ready. It returns an error when a container
+// is in a state it will not recover from, such as a crash loop or an image pull failure.
+func checkPodStatus(ctx context.Context, pod *corev1.Pod) (bool, error) {
 	logger := ucplog.FromContextOrDiscard(ctx).WithValues("podName", pod.Name, "namespace", pod.Namespace)
 
 	conditionPodReady := true
@@ -559,11 +559,10 @@ func TestCheckPodStatus(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	deploymentWaiter := NewDeploymentWaiter(fake.NewClientset())
 	for _, tc := range podTests {
 		pod.Status.Conditions = tc.podCondition
 		pod.Status.ContainerStatuses = tc.containerStatus
-		isReady, err := deploymentWaiter.checkPodStatus(ctx, pod)
+		isReady, err := checkPodStatus(ctx, pod)
 		if tc.expectedError != "" {
 			require.Error(t, err)
 			require.Equal(t, tc.expectedError, err.Error())
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type statefulSetWaiter struct {
+	clientSet           k8s.Interface
+	statefulSetTimeOut  time.Duration
+	cacheResyncInterval time.Duration
+}
+
+// NewStatefulSetWaiter returns a new instance of statefulSetWaiter
+func NewStatefulSetWaiter(clientSet k8s.Interface) *statefulSetWaiter {
+	return &statefulSetWaiter{
+		clientSet:           clientSet,
+		statefulSetTimeOut:  MaxDeploymentTimeout,
+		cacheResyncInterval: DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *statefulSetWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkStatefulSetStatus(ctx, informerFactory, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkStatefulSetStatus(ctx, informerFactory, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addDynamicEventHandler is not implemented for statefulSetWaiter
+func (handler *statefulSetWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *statefulSetWaiter) waitUntilReady(ctx context.Context, item client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// When the statefulset is done, an error nil will be sent
+	// In case of an error, the error will be sent
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.statefulSetTimeOut)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(handler.clientSet, handler.cacheResyncInterval, informers.WithNamespace(item.GetNamespace()))
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Core().V1().Pods().Informer(), item, doneCh)
+	handler.addEventHandler(ctx, informerFactory, informerFactory.Apps().V1().StatefulSets().Informer(), item, doneCh)
+
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final statefulset status
+		sts, err := handler.clientSet.AppsV1().StatefulSets(item.GetNamespace()).Get(ctx, item.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("statefulset timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", item.GetName(), item.GetNamespace(), err)
+		}
+
+		return fmt.Errorf("statefulset timed out, name: %s, namespace %s, ready replicas: %d, updated replicas: %d", item.GetName(), item.GetNamespace(), sts.Status.ReadyReplicas, sts.Status.UpdatedReplicas)
+
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking statefulset %s in namespace %s as complete", item.GetName(), item.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkStatefulSetStatus checks if the rollout of the statefulset is complete. Replicas are rolled out in order, so
+// the statefulset is only ready when every replica runs the update revision and is ready.
+func (handler *statefulSetWaiter) checkStatefulSetStatus(ctx context.Context, informerFactory informers.SharedInformerFactory, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("statefulSetName", item.GetName(), "namespace", item.GetNamespace())
+
+	sts, err := informerFactory.Apps().V1().StatefulSets().Lister().StatefulSets(item.GetNamespace()).Get(item.GetName())
+	if err != nil {
+		logger.Info("Unable to find statefulset")
+		return false
+	}
+
+	if !handler.checkAllPodsReady(ctx, informerFactory, sts, doneCh) {
+		return false
+	}
+
+	if sts.Status.ObservedGeneration != sts.Generation {
+		logger.Info(fmt.Sprintf("StatefulSet status is not ready: Observed generation: %d, Generation: %d", sts.Status.ObservedGeneration, sts.Generation))
+		return false
+	}
+
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	if sts.Status.ReadyReplicas < replicas || sts.Status.UpdatedReplicas < replicas {
+		logger.Info(fmt.Sprintf("StatefulSet status is not ready: Ready replicas: %d, Updated replicas: %d, Desired replicas: %d", sts.Status.ReadyReplicas, sts.Status.UpdatedReplicas, replicas))
+		return false
+	}
+
+	if sts.Status.UpdateRevision != "" && sts.Status.CurrentRevision != sts.Status.UpdateRevision {
+		logger.Info(fmt.Sprintf("StatefulSet rollout in progress: Current revision: %s, Update revision: %s", sts.Status.CurrentRevision, sts.Status.UpdateRevision))
+		return false
+	}
+
+	logger.Info(fmt.Sprintf("StatefulSet is ready. Observed generation: %d, Revision: %s", sts.Status.ObservedGeneration, sts.Status.CurrentRevision))
+	doneCh <- nil
+	return true
+}
+
+func (handler *statefulSetWaiter) checkAllPodsReady(ctx context.Context, informerFactory informers.SharedInformerFactory, sts *v1.StatefulSet, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("statefulSetName", sts.GetName(), "namespace", sts.GetNamespace())
+
+	pl, err := informerFactory.Core().V1().Pods().Lister().Pods(sts.GetNamespace()).List(labels.Set(sts.Spec.Selector.MatchLabels).AsSelector())
+	if err != nil {
+		logger.Info(fmt.Sprintf("Unable to find pods for statefulset: %s", err.Error()))
+		return false
+	}
+
+	allReady := true
+	for _, pod := range pl {
+		if !metav1.IsControlledBy(pod, sts) {
+			continue
+		}
+
+		podReady, err := checkPodStatus(ctx, pod)
+		if err != nil {
+			// Terminate the deployment and return the error encountered
+			doneCh <- err
+			return false
+		}
+		if !podReady {
+			allReady = false
+		}
+	}
+
+	return allReady
+}
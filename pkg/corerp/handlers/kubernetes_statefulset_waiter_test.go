@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStatefulSetWaitUntilReady_Success(t *testing.T) {
+	ctx := context.Background()
+	statefulSet := &v1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-statefulset",
+			Namespace:  "test-namespace",
+			Generation: 2,
+		},
+		Spec: v1.StatefulSetSpec{
+			Replicas: new(int32(2)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+		},
+		Status: v1.StatefulSetStatus{
+			ObservedGeneration: 2,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    2,
+			CurrentRevision:    "test-statefulset-1",
+			UpdateRevision:     "test-statefulset-1",
+		},
+	}
+
+	waiter := &statefulSetWaiter{
+		clientSet:           fake.NewClientset(statefulSet),
+		statefulSetTimeOut:  time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, statefulSet)
+	require.NoError(t, err)
+}
+
+func TestStatefulSetWaitUntilReady_RolloutInProgress(t *testing.T) {
+	ctx := context.Background()
+	statefulSet := &v1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-statefulset",
+			Namespace:  "test-namespace",
+			Generation: 2,
+		},
+		Spec: v1.StatefulSetSpec{
+			Replicas: new(int32(2)),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+		},
+		Status: v1.StatefulSetStatus{
+			ObservedGeneration: 2,
+			ReadyReplicas:      2,
+			UpdatedReplicas:    1,
+			CurrentRevision:    "test-statefulset-1",
+			UpdateRevision:     "test-statefulset-2",
+		},
+	}
+
+	waiter := &statefulSetWaiter{
+		clientSet:           fake.NewClientset(statefulSet),
+		statefulSetTimeOut:  time.Duration(1) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, statefulSet)
+	require.Error(t, err)
+	require.Equal(t, "statefulset timed out, name: test-statefulset, namespace test-namespace, ready replicas: 2, updated replicas: 1", err.Error())
+}
+
+func TestStatefulSetWaitUntilReady_PodFailure(t *testing.T) {
+	ctx := context.Background()
+	statefulSet := &v1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-statefulset",
+			Namespace: "test-namespace",
+			UID:       "test-uid",
+		},
+		Spec: v1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "test"},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-statefulset-0",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "test"},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(statefulSet, v1.SchemeGroupVersion.WithKind("StatefulSet")),
+			},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	waiter := &statefulSetWaiter{
+		clientSet:           fake.NewClientset(statefulSet, pod),
+		statefulSetTimeOut:  time.Duration(50) * time.Second,
+		cacheResyncInterval: time.Duration(10) * time.Second,
+	}
+
+	err := waiter.waitUntilReady(ctx, statefulSet)
+	require.Error(t, err)
+	require.Equal(t, "Container state is 'Waiting' Reason: ImagePullBackOff, Message: Back-off pulling image", err.Error())
+}
@@ -26,14 +26,20 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/aci"
 	aci_gateway "github.com/radius-project/radius/pkg/corerp/renderers/aci/gateway"
 	aci_manualscale "github.com/radius-project/radius/pkg/corerp/renderers/aci/manualscale"
+	"github.com/radius-project/radius/pkg/corerp/renderers/autoscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	azcontainer "github.com/radius-project/radius/pkg/corerp/renderers/container/azure"
 	"github.com/radius-project/radius/pkg/corerp/renderers/daprextension"
+	"github.com/radius-project/radius/pkg/corerp/renderers/disruptionbudget"
+	renderers_docker "github.com/radius-project/radius/pkg/corerp/renderers/docker"
+	docker_gateway "github.com/radius-project/radius/pkg/corerp/renderers/docker/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/gateway"
 	"github.com/radius-project/radius/pkg/corerp/renderers/kubernetesmetadata"
 	"github.com/radius-project/radius/pkg/corerp/renderers/manualscale"
 	"github.com/radius-project/radius/pkg/corerp/renderers/mux"
+	"github.com/radius-project/radius/pkg/corerp/renderers/networkpolicy"
 	"github.com/radius-project/radius/pkg/corerp/renderers/volume"
+	"github.com/radius-project/radius/pkg/docker"
 	"github.com/radius-project/radius/pkg/resourcemodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	resources_azure "github.com/radius-project/radius/pkg/ucp/resources/azure"
@@ -52,8 +58,9 @@ const (
 )
 
 // NewApplicationModel configures RBAC support on connections based on connection kind, configures the providers supported by the appmodel,
-// registers the renderers and handlers for various resources, and checks for duplicate registrations.
-func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sClientSet kubernetes.Interface, discoveryClient discovery.ServerResourcesInterface, k8sDynamicClientSet dynamic.Interface) (ApplicationModel, error) {
+// registers the renderers and handlers for various resources, and checks for duplicate registrations. The Docker
+// provider is supported when dockerClient is not nil.
+func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sClientSet kubernetes.Interface, discoveryClient discovery.ServerResourcesInterface, k8sDynamicClientSet dynamic.Interface, dockerClient docker.Client) (ApplicationModel, error) {
 	// Configure RBAC support on connections based connection kind.
 	// Role names can be user input or default roles assigned by Radius.
 	// Leave RoleNames field empty if no default roles are supported for a connection kind.
@@ -87,6 +94,9 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 	if arm != nil {
 		supportedProviders[resourcemodel.ProviderAzure] = true
 	}
+	if dockerClient != nil {
+		supportedProviders[resourcemodel.ProviderDocker] = true
+	}
 
 	radiusResourceModel := []RadiusResourceModel{
 		{
@@ -94,10 +104,16 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			Renderer: &mux.Renderer{
 				Inners: map[rpv1.EnvironmentComputeKind]renderers.Renderer{
 					rpv1.KubernetesComputeKind: &kubernetesmetadata.Renderer{
-						Inner: &manualscale.Renderer{
-							Inner: &daprextension.Renderer{
-								Inner: &container.Renderer{
-									RoleAssignmentMap: roleAssignmentMap,
+						Inner: &networkpolicy.Renderer{
+							Inner: &disruptionbudget.Renderer{
+								Inner: &autoscale.Renderer{
+									Inner: &manualscale.Renderer{
+										Inner: &daprextension.Renderer{
+											Inner: &container.Renderer{
+												RoleAssignmentMap: roleAssignmentMap,
+											},
+										},
+									},
 								},
 							},
 						},
@@ -105,6 +121,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 					rpv1.ACIComputeKind: &aci_manualscale.Renderer{
 						Inner: &aci.Renderer{},
 					},
+					rpv1.DockerComputeKind: &renderers_docker.Renderer{},
 				},
 			},
 		},
@@ -114,6 +131,7 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 				Inners: map[rpv1.EnvironmentComputeKind]renderers.Renderer{
 					rpv1.KubernetesComputeKind: &gateway.Renderer{},
 					rpv1.ACIComputeKind:        &aci_gateway.Renderer{},
+					rpv1.DockerComputeKind:     &docker_gateway.Renderer{},
 				},
 			},
 		},
@@ -149,6 +167,16 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 		},
 	}
 
+	dockerOutputResourceModel := []OutputResourceModel{
+		{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     AnyResourceType,
+				Provider: resourcemodel.ProviderDocker,
+			},
+			ResourceHandler: handlers.NewDockerHandler(dockerClient),
+		},
+	}
+
 	azureOutputResourceModel := []OutputResourceModel{
 		{
 			ResourceType: resourcemodel.ResourceType{
@@ -244,6 +272,9 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 	if arm != nil {
 		outputResourceModel = append(outputResourceModel, azureOutputResourceModel...)
 	}
+	if dockerClient != nil {
+		outputResourceModel = append(outputResourceModel, dockerOutputResourceModel...)
+	}
 	return NewModel(radiusResourceModel, outputResourceModel, supportedProviders), nil
 }
 
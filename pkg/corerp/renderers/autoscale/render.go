@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Renderer is the renderers.Renderer implementation for the autoscaling extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for an Autoscaling
+// extension and adds a HorizontalPodAutoscaler targeting the rendered deployment.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.Autoscaling)
+	if ext == nil || ext.Autoscaling == nil {
+		return output, nil
+	}
+
+	workload, _, workloadOutput := kubernetes.FindWorkload(output.Resources)
+	if workload == nil {
+		// Nothing to scale, for example a manually provisioned container.
+		return output, nil
+	}
+
+	// The autoscaler owns the replica count. Leaving the replicas unset on the workload avoids
+	// resetting the scale on every deployment.
+	scaleTarget := autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Name:       workload.GetName(),
+	}
+	switch w := workload.(type) {
+	case *appsv1.Deployment:
+		w.Spec.Replicas = nil
+		scaleTarget.Kind = "Deployment"
+	case *appsv1.StatefulSet:
+		w.Spec.Replicas = nil
+		scaleTarget.Kind = "StatefulSet"
+	}
+
+	appID, err := resources.ParseResource(resource.Properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	hpa, err := makeHorizontalPodAutoscaler(scaleTarget, workload.GetNamespace(), appID.Name(), resource, ext.Autoscaling)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	hpaOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDHorizontalPodAutoscaler, hpa, hpa.ObjectMeta)
+	hpaOutput.CreateResource.Dependencies = []string{workloadOutput.LocalID}
+	output.Resources = append(output.Resources, hpaOutput)
+
+	return output, nil
+}
+
+func makeHorizontalPodAutoscaler(scaleTarget autoscalingv2.CrossVersionObjectReference, namespace, applicationName string, resource *datamodel.ContainerResource, ext *datamodel.AutoscalingExtension) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	metrics := []autoscalingv2.MetricSpec{}
+	for _, m := range ext.Metrics {
+		metric, err := makeMetricSpec(m)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scaleTarget.Name,
+			Namespace: namespace,
+			Labels:    kubernetes.MakeDescriptiveLabels(applicationName, resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: scaleTarget,
+			MinReplicas:    ext.MinReplicas,
+			MaxReplicas:    ext.MaxReplicas,
+			// When no metrics are specified Kubernetes defaults to 80% average CPU utilization.
+			Metrics: metrics,
+		},
+	}, nil
+}
+
+func makeMetricSpec(m datamodel.AutoscalingMetric) (autoscalingv2.MetricSpec, error) {
+	target, err := makeMetricTarget(m)
+	if err != nil {
+		return autoscalingv2.MetricSpec{}, err
+	}
+
+	switch m.Kind {
+	case datamodel.AutoscalingMetricCPU, datamodel.AutoscalingMetricMemory:
+		name := corev1.ResourceCPU
+		if m.Kind == datamodel.AutoscalingMetricMemory {
+			name = corev1.ResourceMemory
+		}
+
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   name,
+				Target: target,
+			},
+		}, nil
+	case datamodel.AutoscalingMetricCustom:
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: m.Name},
+				Target: target,
+			},
+		}, nil
+	default:
+		return autoscalingv2.MetricSpec{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("autoscaling metric kind %q is not supported", m.Kind))
+	}
+}
+
+func makeMetricTarget(m datamodel.AutoscalingMetric) (autoscalingv2.MetricTarget, error) {
+	if m.TargetAverageUtilization != nil {
+		return autoscalingv2.MetricTarget{
+			Type:               autoscalingv2.UtilizationMetricType,
+			AverageUtilization: m.TargetAverageUtilization,
+		}, nil
+	}
+
+	value, err := resource.ParseQuantity(m.TargetAverageValue)
+	if err != nil {
+		return autoscalingv2.MetricTarget{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid targetAverageValue %q for autoscaling metric %q: %s", m.TargetAverageValue, m.Kind, err.Error()))
+	}
+
+	return autoscalingv2.MetricTarget{
+		Type:         autoscalingv2.AverageValueMetricType,
+		AverageValue: &value,
+	}, nil
+}
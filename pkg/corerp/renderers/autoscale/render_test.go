@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscale
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Return a deployment so the autoscale extension can target it
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-container",
+			Namespace: "test-namespace",
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: new(int32(1)),
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+// statefulNoop returns a statefulset, as rendered for a container with the stateful extension.
+type statefulNoop struct {
+	noop
+}
+
+func (r *statefulNoop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	statefulSet := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-container",
+			Namespace: "test-namespace",
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: new(int32(1)),
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDStatefulSet, &statefulSet, statefulSet.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_Success(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	container := makeResource([]datamodel.Extension{
+		{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MinReplicas: new(int32(2)),
+				MaxReplicas: 10,
+				Metrics: []datamodel.AutoscalingMetric{
+					{Kind: datamodel.AutoscalingMetricCPU, TargetAverageUtilization: new(int32(70))},
+					{Kind: datamodel.AutoscalingMetricMemory, TargetAverageValue: "512Mi"},
+					{Kind: datamodel.AutoscalingMetricCustom, Name: "requests_per_second", TargetAverageValue: "100"},
+				},
+			},
+		},
+	})
+
+	output, err := renderer.Render(context.Background(), container, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Nil(t, deployment.Spec.Replicas)
+
+	hpaOutput := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDHorizontalPodAutoscaler, hpaOutput.LocalID)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, hpaOutput.CreateResource.Dependencies)
+
+	hpa, ok := hpaOutput.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.True(t, ok)
+	require.Equal(t, "test-container", hpa.Name)
+	require.Equal(t, "test-namespace", hpa.Namespace)
+	require.Equal(t, autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "test-container"}, hpa.Spec.ScaleTargetRef)
+	require.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	require.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+
+	memory := resource.MustParse("512Mi")
+	rps := resource.MustParse("100")
+	require.Equal(t, []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: new(int32(70))},
+			},
+		},
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name:   corev1.ResourceMemory,
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &memory},
+			},
+		},
+		{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: "requests_per_second"},
+				Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &rps},
+			},
+		},
+	}, hpa.Spec.Metrics)
+}
+
+func Test_Render_StatefulSet(t *testing.T) {
+	renderer := &Renderer{Inner: &statefulNoop{}}
+
+	container := makeResource([]datamodel.Extension{
+		{Kind: datamodel.Autoscaling, Autoscaling: &datamodel.AutoscalingExtension{MaxReplicas: 3}},
+	})
+
+	output, err := renderer.Render(context.Background(), container, renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	statefulSet, _ := kubernetes.FindStatefulSet(output.Resources)
+	require.NotNil(t, statefulSet)
+	require.Nil(t, statefulSet.Spec.Replicas)
+
+	hpaOutput := output.Resources[1]
+	require.Equal(t, []string{rpv1.LocalIDStatefulSet}, hpaOutput.CreateResource.Dependencies)
+
+	hpa := hpaOutput.CreateResource.Data.(*autoscalingv2.HorizontalPodAutoscaler)
+	require.Equal(t, autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "test-container"}, hpa.Spec.ScaleTargetRef)
+}
+
+func Test_Render_InvalidTargetValue(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	container := makeResource([]datamodel.Extension{
+		{
+			Kind: datamodel.Autoscaling,
+			Autoscaling: &datamodel.AutoscalingExtension{
+				MaxReplicas: 3,
+				Metrics:     []datamodel.AutoscalingMetric{{Kind: datamodel.AutoscalingMetricCustom, Name: "queue", TargetAverageValue: "lots"}},
+			},
+		},
+	})
+
+	_, err := renderer.Render(context.Background(), container, renderers.RenderOptions{})
+	require.Error(t, err)
+	require.ErrorAs(t, err, new(*v1.ErrClientRP))
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	output, err := renderer.Render(context.Background(), makeResource(nil), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.NotNil(t, deployment)
+	require.Equal(t, int32(1), *deployment.Spec.Replicas)
+}
+
+func makeResource(extensions []datamodel.Extension) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+			Extensions: extensions,
+		},
+	}
+}
@@ -37,7 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 )
 
-var errDeploymentNotFound = errors.New("deployment resource must be in outputResources")
+var errDeploymentNotFound = errors.New("deployment or statefulset resource must be in outputResources")
 
 // fetchBaseManifest fetches the base manifest from the container resource.
 func fetchBaseManifest(r *datamodel.ContainerResource) (kubeutil.ObjectManifest, error) {
@@ -166,10 +166,10 @@ func getServiceAccountBase(manifest kubeutil.ObjectManifest, appName string, r *
 func populateAllBaseResources(ctx context.Context, base kubeutil.ObjectManifest, outputResources []rpv1.OutputResource, options renderers.RenderOptions) []rpv1.OutputResource {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	// Find deployment resource from outputResources to add base manifest resources as a dependency.
+	// Find workload resource from outputResources to add base manifest resources as a dependency.
 	var deploymentResource *rpv1.Resource
 	for _, r := range outputResources {
-		if r.LocalID == rpv1.LocalIDDeployment {
+		if r.LocalID == rpv1.LocalIDDeployment || r.LocalID == rpv1.LocalIDStatefulSet {
 			deploymentResource = r.CreateResource
 			break
 		}
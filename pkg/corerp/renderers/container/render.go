@@ -274,6 +274,11 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 		outputResources = append(outputResources, serviceResource)
 	}
 
+	// A stateful container always needs a headless service to provide the network identity of its replicas.
+	if getStatefulExtension(resource) != nil {
+		outputResources = append(outputResources, makeHeadlessService(appId.Name(), resource, options))
+	}
+
 	// Populate the remaining resources from the base manifest.
 	outputResources = populateAllBaseResources(ctx, baseManifest, outputResources, options)
 
@@ -629,6 +634,20 @@ func (r Renderer) makeDeployment(
 		deployment.Spec.Template.Spec = *patchedPodSpec
 	}
 
+	// Stateful containers run as a StatefulSet instead of a Deployment.
+	if stateful := getStatefulExtension(resource); stateful != nil {
+		statefulSet, err := makeStatefulSet(deployment, stateful, normalizedName)
+		if err != nil {
+			return []rpv1.OutputResource{}, nil, err
+		}
+
+		statefulSetOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDStatefulSet, statefulSet, statefulSet.ObjectMeta)
+		statefulSetOutput.CreateResource.Dependencies = deps
+
+		outputResources = append(outputResources, statefulSetOutput)
+		return outputResources, secretData, nil
+	}
+
 	deploymentOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, deployment, deployment.ObjectMeta)
 	deploymentOutput.CreateResource.Dependencies = deps
 
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"maps"
 	"sort"
+	"strings"
 	"testing"
 
 	apiv1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -46,6 +47,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -1793,6 +1795,175 @@ func Test_DNS_Service_Generation(t *testing.T) {
 	})
 }
 
+func Test_Render_Stateful(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "postgres:16",
+		},
+		Extensions: []datamodel.Extension{
+			{
+				Kind: datamodel.Stateful,
+				Stateful: &datamodel.StatefulExtension{
+					VolumeClaims: map[string]datamodel.StatefulVolumeClaim{
+						"data": {
+							MountPath:    "/var/lib/postgresql/data",
+							Size:         "10Gi",
+							StorageClass: "managed-csi",
+						},
+					},
+				},
+			},
+		},
+	}
+	resource := makeResource(properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	deployment, _ := kubernetes.FindDeployment(output.Resources)
+	require.Nil(t, deployment)
+
+	t.Run("verify statefulset", func(t *testing.T) {
+		statefulSet, outputResource := kubernetes.FindStatefulSet(output.Resources)
+		require.NotNil(t, statefulSet)
+		require.Equal(t, rpv1.LocalIDStatefulSet, outputResource.LocalID)
+		require.Equal(t, kubernetes.NormalizeResourceName(resource.Name), statefulSet.Name)
+		require.Equal(t, statefulSet.Name+"-headless", statefulSet.Spec.ServiceName)
+		require.Equal(t, appsv1.OrderedReadyPodManagement, statefulSet.Spec.PodManagementPolicy)
+		require.Equal(t, kubernetes.MakeSelectorLabels(applicationName, resource.Name), statefulSet.Spec.Selector.MatchLabels)
+
+		require.Len(t, statefulSet.Spec.VolumeClaimTemplates, 1)
+		claim := statefulSet.Spec.VolumeClaimTemplates[0]
+		require.Equal(t, "data", claim.Name)
+		require.Equal(t, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}, claim.Spec.AccessModes)
+		require.Equal(t, "managed-csi", *claim.Spec.StorageClassName)
+		require.Equal(t, "10Gi", claim.Spec.Resources.Requests.Storage().String())
+
+		container := statefulSet.Spec.Template.Spec.Containers[0]
+		require.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/var/lib/postgresql/data"}}, container.VolumeMounts)
+	})
+
+	t.Run("verify headless service", func(t *testing.T) {
+		var service *corev1.Service
+		for _, r := range output.Resources {
+			if r.LocalID == rpv1.LocalIDHeadlessService {
+				service = r.CreateResource.Data.(*corev1.Service)
+			}
+		}
+		require.NotNil(t, service)
+		require.Equal(t, kubernetes.NormalizeResourceName(resource.Name)+"-headless", service.Name)
+		require.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+		require.Equal(t, kubernetes.MakeSelectorLabels(applicationName, resource.Name), service.Spec.Selector)
+	})
+}
+
+func Test_headlessServiceName(t *testing.T) {
+	t.Run("short name", func(t *testing.T) {
+		require.Equal(t, "db-headless", headlessServiceName("db"))
+	})
+
+	t.Run("long name", func(t *testing.T) {
+		name := strings.Repeat("a", 30) + "-" + strings.Repeat("b", 30)
+		serviceName := headlessServiceName(name)
+		require.LessOrEqual(t, len(serviceName), 63)
+		require.Empty(t, validation.IsDNS1123Label(serviceName))
+		require.True(t, strings.HasPrefix(serviceName, strings.Repeat("a", 30)+"-"))
+		require.Contains(t, serviceName, "-headless-")
+
+		// Names that only differ after the truncated prefix get different service names.
+		other := headlessServiceName(name[:len(name)-1] + "c")
+		require.NotEqual(t, serviceName, other)
+
+		// The name is stable.
+		require.Equal(t, serviceName, headlessServiceName(name))
+	})
+
+	t.Run("render long name", func(t *testing.T) {
+		// Use a short application name, since the application name prefixes the name of the Azure identity.
+		properties := datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/a",
+			},
+			Container: datamodel.Container{
+				Image: "postgres:16",
+			},
+			Extensions: []datamodel.Extension{
+				{Kind: datamodel.Stateful, Stateful: &datamodel.StatefulExtension{}},
+			},
+		}
+		resource := makeResource(properties)
+		resource.Name = "a-very-long-container-name-for-the-primary-postgres-databases"
+		require.Greater(t, len(resource.Name), 60)
+
+		output, err := Renderer{}.Render(testcontext.New(t), resource, renderers.RenderOptions{})
+		require.NoError(t, err)
+
+		statefulSet, _ := kubernetes.FindStatefulSet(output.Resources)
+		require.NotNil(t, statefulSet)
+
+		var service *corev1.Service
+		for _, r := range output.Resources {
+			if r.LocalID == rpv1.LocalIDHeadlessService {
+				service = r.CreateResource.Data.(*corev1.Service)
+			}
+		}
+		require.NotNil(t, service)
+		require.Equal(t, statefulSet.Spec.ServiceName, service.Name)
+		require.Empty(t, validation.IsDNS1123Label(service.Name))
+	})
+}
+
+func Test_Render_StatefulKeepsClusterIPService(t *testing.T) {
+	properties := datamodel.ContainerProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: applicationResourceID,
+		},
+		Container: datamodel.Container{
+			Image: "postgres:16",
+			Ports: map[string]datamodel.ContainerPort{
+				"db": {
+					ContainerPort: 5432,
+					Port:          80,
+				},
+			},
+		},
+		Extensions: []datamodel.Extension{
+			{Kind: datamodel.Stateful, Stateful: &datamodel.StatefulExtension{}},
+		},
+	}
+	resource := makeResource(properties)
+	ctx := testcontext.New(t)
+	renderer := Renderer{}
+	output, err := renderer.Render(ctx, resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	services := map[string]*corev1.Service{}
+	for _, r := range output.Resources {
+		if service, ok := r.CreateResource.Data.(*corev1.Service); ok {
+			services[r.LocalID] = service
+		}
+	}
+	require.Len(t, services, 2)
+
+	// The regular service keeps its cluster IP and maps the port to the container port.
+	service := services[rpv1.LocalIDService]
+	require.Equal(t, kubernetes.NormalizeResourceName(resource.Name), service.Name)
+	require.Empty(t, service.Spec.ClusterIP)
+	require.Equal(t, []corev1.ServicePort{
+		{Name: "db", Port: 80, TargetPort: intstr.FromInt(5432), Protocol: corev1.ProtocolTCP},
+	}, service.Spec.Ports)
+
+	headless := services[rpv1.LocalIDHeadlessService]
+	require.Equal(t, corev1.ClusterIPNone, headless.Spec.ClusterIP)
+	require.Equal(t, []corev1.ServicePort{
+		{Name: "db", Port: 5432, TargetPort: intstr.FromInt(5432), Protocol: corev1.ProtocolTCP},
+	}, headless.Spec.Ports)
+}
+
 func Test_Render_ImagePullPolicySpecified(t *testing.T) {
 	properties := datamodel.ContainerProperties{
 		BasicResourceProperties: rpv1.BasicResourceProperties{
@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// headlessServiceSuffix is appended to the name of a stateful container to name its headless service.
+	headlessServiceSuffix = "-headless"
+
+	// maxServiceNameLength is the maximum length of the name of a Kubernetes service, which must be a DNS label.
+	maxServiceNameLength = 63
+
+	// serviceNameHashLength is the number of hex characters of the hash appended to a truncated service name.
+	serviceNameHashLength = 8
+)
+
+// getStatefulExtension returns the stateful extension of the container, or nil if the container is not stateful.
+func getStatefulExtension(resource *datamodel.ContainerResource) *datamodel.StatefulExtension {
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.Stateful)
+	if ext == nil {
+		return nil
+	}
+
+	if ext.Stateful == nil {
+		return &datamodel.StatefulExtension{}
+	}
+
+	return ext.Stateful
+}
+
+// headlessServiceName returns the name of the headless service governing the StatefulSet with the given name. Names
+// that would exceed the maximum length of a service name are truncated, and a hash of the full name is appended so
+// that truncated names stay unique.
+func headlessServiceName(name string) string {
+	serviceName := name + headlessServiceSuffix
+	if len(serviceName) <= maxServiceNameLength {
+		return serviceName
+	}
+
+	hash := fmt.Sprintf("%x", sha1.Sum([]byte(serviceName)))[:serviceNameHashLength]
+	prefix := strings.TrimRight(name[:maxServiceNameLength-len(headlessServiceSuffix)-len(hash)-1], "-")
+	return prefix + headlessServiceSuffix + "-" + hash
+}
+
+// makeHeadlessService creates the headless service that provides the stable network identities of the replicas. It is
+// created in addition to the regular service of the container, which keeps its cluster IP and port mapping, because
+// headless services don't map ports and the cluster IP of an existing service can't be changed.
+func makeHeadlessService(applicationName string, resource *datamodel.ContainerResource, options renderers.RenderOptions) rpv1.OutputResource {
+	// Sort the port names so that the output is deterministic.
+	names := make([]string, 0, len(resource.Properties.Container.Ports))
+	for name := range resource.Properties.Container.Ports {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	ports := []corev1.ServicePort{}
+	for _, name := range names {
+		port := resource.Properties.Container.Ports[name]
+		ports = append(ports, corev1.ServicePort{
+			Name:       name,
+			Port:       port.ContainerPort,
+			TargetPort: intstr.FromInt(int(port.ContainerPort)),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
+	meta := getObjectMeta(metav1.ObjectMeta{}, applicationName, resource.Name, resource.ResourceTypeName(), options)
+	meta.Name = headlessServiceName(meta.Name)
+
+	service := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: meta,
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  kubernetes.MakeSelectorLabels(applicationName, resource.Name),
+			Ports:     ports,
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDHeadlessService, service, service.ObjectMeta)
+}
+
+// makeStatefulSet converts the rendered deployment into a StatefulSet. The pod template, selector and metadata are
+// kept as-is so that the other renderers can treat both workload kinds the same way. Each volume claim becomes a
+// volumeClaimTemplate mounted into the container, which gives every replica its own persistent volume.
+func makeStatefulSet(deployment *appsv1.Deployment, stateful *datamodel.StatefulExtension, containerName string) (*appsv1.StatefulSet, error) {
+	policy := appsv1.OrderedReadyPodManagement
+	if stateful.PodManagementPolicy == datamodel.StatefulPodManagementParallel {
+		policy = appsv1.ParallelPodManagement
+	}
+
+	statefulSet := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: deployment.ObjectMeta,
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:            deployment.Spec.Replicas,
+			Selector:            deployment.Spec.Selector,
+			Template:            deployment.Spec.Template,
+			ServiceName:         headlessServiceName(deployment.Name),
+			PodManagementPolicy: policy,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			},
+		},
+	}
+
+	podSpec := &statefulSet.Spec.Template.Spec
+	container := &podSpec.Containers[0]
+	for i, c := range podSpec.Containers {
+		if strings.EqualFold(c.Name, containerName) {
+			container = &podSpec.Containers[i]
+			break
+		}
+	}
+
+	// Sort the claim names so that the output is deterministic.
+	names := make([]string, 0, len(stateful.VolumeClaims))
+	for name := range stateful.VolumeClaims {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		claim := stateful.VolumeClaims[name]
+		template, err := makeVolumeClaimTemplate(name, claim)
+		if err != nil {
+			return nil, err
+		}
+
+		statefulSet.Spec.VolumeClaimTemplates = append(statefulSet.Spec.VolumeClaimTemplates, template)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: claim.MountPath,
+		})
+	}
+
+	return statefulSet, nil
+}
+
+func makeVolumeClaimTemplate(name string, claim datamodel.StatefulVolumeClaim) (corev1.PersistentVolumeClaim, error) {
+	size, err := resource.ParseQuantity(claim.Size)
+	if err != nil {
+		return corev1.PersistentVolumeClaim{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid size %q for volume claim %s: %s", claim.Size, name, err.Error()))
+	}
+
+	accessModes := []corev1.PersistentVolumeAccessMode{}
+	for _, mode := range claim.AccessModes {
+		accessModes = append(accessModes, corev1.PersistentVolumeAccessMode(mode))
+	}
+	if len(accessModes) == 0 {
+		accessModes = append(accessModes, corev1.ReadWriteOnce)
+	}
+
+	pvc := corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: accessModes,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: size,
+				},
+			},
+		},
+	}
+
+	if claim.StorageClass != "" {
+		pvc.Spec.StorageClassName = new(claim.StorageClass)
+	}
+
+	return pvc, nil
+}
@@ -139,6 +139,15 @@ func (r *Renderer) getAnnotations(o runtime.Object) (map[string]string, bool) {
 		return dep.Spec.Template.Annotations, true
 	}
 
+	statefulSet, ok := o.(*appsv1.StatefulSet)
+	if ok {
+		if statefulSet.Spec.Template.Annotations == nil {
+			statefulSet.Spec.Template.Annotations = map[string]string{}
+		}
+
+		return statefulSet.Spec.Template.Annotations, true
+	}
+
 	un, ok := o.(*unstructured.Unstructured)
 	if ok {
 		if a := un.GetAnnotations(); a != nil {
@@ -0,0 +1,108 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruptionbudget
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Renderer is the renderers.Renderer implementation for the disruptionBudget extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for a DisruptionBudget
+// extension and adds a PodDisruptionBudget selecting the pods of the rendered deployment.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.DisruptionBudget)
+	if ext == nil || ext.DisruptionBudget == nil {
+		return output, nil
+	}
+
+	workload, _, workloadOutput := kubernetes.FindWorkload(output.Resources)
+	if workload == nil {
+		return output, nil
+	}
+
+	appID, err := resources.ParseResource(resource.Properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: policyv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workload.GetName(),
+			Namespace: workload.GetNamespace(),
+			Labels:    kubernetes.MakeDescriptiveLabels(appID.Name(), resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: kubernetes.MakeSelectorLabels(appID.Name(), resource.Name),
+			},
+			MinAvailable:   parseIntOrPercent(ext.DisruptionBudget.MinAvailable),
+			MaxUnavailable: parseIntOrPercent(ext.DisruptionBudget.MaxUnavailable),
+		},
+	}
+
+	pdbOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDPodDisruptionBudget, pdb, pdb.ObjectMeta)
+	pdbOutput.CreateResource.Dependencies = []string{workloadOutput.LocalID}
+	output.Resources = append(output.Resources, pdbOutput)
+
+	return output, nil
+}
+
+// parseIntOrPercent converts a replica count such as "2" or a percentage such as "50%" to an IntOrString.
+func parseIntOrPercent(value string) *intstr.IntOrString {
+	if value == "" {
+		return nil
+	}
+
+	v := intstr.Parse(value)
+	return &v
+}
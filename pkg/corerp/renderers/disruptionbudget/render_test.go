@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disruptionbudget
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-container",
+			Namespace: "test-namespace",
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render(t *testing.T) {
+	tests := []struct {
+		name           string
+		ext            datamodel.DisruptionBudgetExtension
+		minAvailable   *intstr.IntOrString
+		maxUnavailable *intstr.IntOrString
+	}{
+		{
+			name:         "min available count",
+			ext:          datamodel.DisruptionBudgetExtension{MinAvailable: "2"},
+			minAvailable: new(intstr.FromInt(2)),
+		},
+		{
+			name:           "max unavailable percentage",
+			ext:            datamodel.DisruptionBudgetExtension{MaxUnavailable: "25%"},
+			maxUnavailable: new(intstr.FromString("25%")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+			resource := makeResource([]datamodel.Extension{{Kind: datamodel.DisruptionBudget, DisruptionBudget: &tc.ext}})
+
+			output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+			require.NoError(t, err)
+			require.Len(t, output.Resources, 2)
+
+			pdbOutput := output.Resources[1]
+			require.Equal(t, rpv1.LocalIDPodDisruptionBudget, pdbOutput.LocalID)
+			require.Equal(t, []string{rpv1.LocalIDDeployment}, pdbOutput.CreateResource.Dependencies)
+
+			pdb, ok := pdbOutput.CreateResource.Data.(*policyv1.PodDisruptionBudget)
+			require.True(t, ok)
+			require.Equal(t, "test-container", pdb.Name)
+			require.Equal(t, "test-namespace", pdb.Namespace)
+			require.Equal(t, kubernetes.MakeSelectorLabels("test-app", "test-container"), pdb.Spec.Selector.MatchLabels)
+			require.Equal(t, tc.minAvailable, pdb.Spec.MinAvailable)
+			require.Equal(t, tc.maxUnavailable, pdb.Spec.MaxUnavailable)
+		})
+	}
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	output, err := renderer.Render(context.Background(), makeResource(nil), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+}
+
+func makeResource(extensions []datamodel.Extension) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+				Name: "test-container",
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app",
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+			},
+			Extensions: extensions,
+		},
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	renderers_docker "github.com/radius-project/radius/pkg/corerp/renderers/docker"
+	"github.com/radius-project/radius/pkg/docker"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_docker "github.com/radius-project/radius/pkg/ucp/resources/docker"
+)
+
+const (
+	// ProxyImage is the image of the reverse proxy that implements the gateway.
+	ProxyImage = "caddy:2-alpine"
+
+	// caddyfileEnvVar is the environment variable the configuration of the proxy is passed in. The proxy writes it
+	// to its configuration file on startup, which avoids mounting files from the host of the control plane.
+	caddyfileEnvVar = "CADDYFILE"
+
+	proxyPort = "80/tcp"
+)
+
+// Renderer renders Applications.Core/gateways resources to a reverse proxy container running on Docker.
+type Renderer struct {
+}
+
+// GetDependencyIDs returns no dependencies. The destinations of the routes are reached through the network of the
+// environment, so the containers don't need to be deployed first.
+func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) (radiusResourceIDs []resources.ID, azureResourceIDs []resources.ID, err error) {
+	return radiusResourceIDs, azureResourceIDs, nil
+}
+
+// Render creates the reverse proxy container of the gateway, and returns the URL it's published on as a computed
+// value.
+func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	gateway, ok := dm.(*datamodel.Gateway)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	appID, err := resources.ParseResource(gateway.Properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	if gateway.Properties.TLS != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest("TLS is not supported by the Docker runtime")
+	}
+
+	caddyfile, err := MakeCaddyfile(gateway.Properties.Routes)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(err.Error())
+	}
+
+	config := &docker.ContainerConfig{
+		Image: ProxyImage,
+		Entrypoint: []string{
+			"sh", "-c",
+			`printf "%s" "$` + caddyfileEnvVar + `" > /etc/caddy/Caddyfile && exec caddy run --config /etc/caddy/Caddyfile --adapter caddyfile`,
+		},
+		Env:    []string{caddyfileEnvVar + "=" + caddyfile},
+		Labels: kubernetes.MakeDescriptiveLabels(appID.Name(), gateway.Name, gateway.ResourceTypeName()),
+		ExposedPorts: map[string]struct{}{
+			proxyPort: {},
+		},
+		HostConfig: docker.HostConfig{
+			PortBindings: map[string][]docker.PortBinding{
+				// Let Docker choose the host port, it's kept when the gateway is updated.
+				proxyPort: {{HostIP: renderers_docker.LocalhostIP}},
+			},
+			RestartPolicy: docker.RestartPolicy{Name: renderers_docker.RestartPolicyUnlessStopped},
+		},
+		NetworkingConfig: docker.NetworkingConfig{
+			EndpointsConfig: map[string]docker.EndpointSettings{
+				renderers_docker.NetworkName(options.Environment): {Aliases: []string{kubernetes.NormalizeResourceName(gateway.Name)}},
+			},
+		},
+	}
+
+	containerName := renderers_docker.ContainerName(appID.Name(), gateway.Name)
+	outputResource := rpv1.OutputResource{
+		LocalID: rpv1.LocalIDDockerContainer,
+		ID:      resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeContainer, containerName),
+		CreateResource: &rpv1.Resource{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_docker.ResourceTypeContainer,
+				Provider: resourcemodel.ProviderDocker,
+			},
+			Data: config,
+		},
+	}
+
+	return renderers.RendererOutput{
+		Resources:      []rpv1.OutputResource{outputResource},
+		RadiusResource: dm,
+		ComputedValues: map[string]rpv1.ComputedValueReference{
+			"url": {
+				LocalID:           rpv1.LocalIDDockerContainer,
+				PropertyReference: "url",
+			},
+		},
+	}, nil
+}
+
+// MakeCaddyfile returns the configuration of the reverse proxy for the routes of a gateway. Routes match the
+// requests whose path starts with their path, and the most specific route wins like with the Kubernetes runtime.
+func MakeCaddyfile(routes []datamodel.GatewayRoute) (string, error) {
+	if len(routes) == 0 {
+		return "", errors.New("gateway must have at least one route")
+	}
+
+	sorted := slices.Clone(routes)
+	slices.SortStableFunc(sorted, func(a, b datamodel.GatewayRoute) int {
+		return len(routePath(b)) - len(routePath(a))
+	})
+
+	builder := strings.Builder{}
+	builder.WriteString(":80 {\n\troute {\n")
+	for i, route := range sorted {
+		upstream, err := makeUpstream(route.Destination)
+		if err != nil {
+			return "", err
+		}
+
+		path := routePath(route)
+		if path == "/" {
+			builder.WriteString("\t\thandle {\n")
+		} else {
+			matcher := "@route" + strconv.Itoa(i)
+			fmt.Fprintf(&builder, "\t\t%s path %s %s/*\n", matcher, path, path)
+			fmt.Fprintf(&builder, "\t\thandle %s {\n", matcher)
+		}
+
+		if route.ReplacePrefix != "" {
+			if path != "/" {
+				fmt.Fprintf(&builder, "\t\t\turi strip_prefix %s\n", path)
+			}
+			if replace := strings.TrimSuffix(route.ReplacePrefix, "/"); replace != "" {
+				fmt.Fprintf(&builder, "\t\t\turi path_regexp ^ %s\n", replace)
+			}
+		}
+
+		fmt.Fprintf(&builder, "\t\t\treverse_proxy %s\n", upstream)
+		builder.WriteString("\t\t}\n")
+	}
+	builder.WriteString("\t}\n}\n")
+
+	return builder.String(), nil
+}
+
+// routePath returns the path of a route without its trailing slash, or "/" for the root.
+func routePath(route datamodel.GatewayRoute) string {
+	path := strings.TrimSuffix(route.Path, "/")
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+// makeUpstream returns the address of the destination of a route. Destinations use the names of the containers,
+// which are aliases in the network of the environment.
+func makeUpstream(destination string) (string, error) {
+	u, err := url.Parse(destination)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("invalid destination %q: must be a URL such as http://frontend:3000", destination)
+	}
+
+	switch u.Scheme {
+	case "http":
+		return u.Host, nil
+	case "https":
+		return "https://" + u.Host, nil
+	default:
+		return "", fmt.Errorf("invalid destination %q: unsupported scheme %q", destination, u.Scheme)
+	}
+}
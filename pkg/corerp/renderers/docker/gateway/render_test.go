@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/docker"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	environmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+)
+
+func makeGateway(properties datamodel.GatewayProperties) *datamodel.Gateway {
+	properties.Application = applicationID
+	return &datamodel.Gateway{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/public",
+				Name: "public",
+				Type: "Applications.Core/gateways",
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func makeOptions() renderers.RenderOptions {
+	return renderers.RenderOptions{
+		Environment: renderers.EnvironmentOptions{
+			Resource: resources.MustParse(environmentID),
+			Compute:  &rpv1.EnvironmentCompute{Kind: rpv1.DockerComputeKind},
+		},
+	}
+}
+
+func Test_Render(t *testing.T) {
+	gateway := makeGateway(datamodel.GatewayProperties{
+		Routes: []datamodel.GatewayRoute{
+			{Path: "/", Destination: "http://frontend:3000"},
+		},
+	})
+
+	output, err := Renderer{}.Render(testcontext.New(t), gateway, makeOptions())
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+	require.Equal(t, map[string]rpv1.ComputedValueReference{
+		"url": {LocalID: rpv1.LocalIDDockerContainer, PropertyReference: "url"},
+	}, output.ComputedValues)
+
+	resource := output.Resources[0]
+	require.Equal(t, rpv1.LocalIDDockerContainer, resource.LocalID)
+	require.Equal(t, "/planes/docker/local/providers/Docker.Engine/containers/test-app-public", resource.ID.String())
+
+	config := resource.CreateResource.Data.(*docker.ContainerConfig)
+	require.Equal(t, ProxyImage, config.Image)
+	require.Equal(t, []string{"CADDYFILE=:80 {\n\troute {\n\t\thandle {\n\t\t\treverse_proxy frontend:3000\n\t\t}\n\t}\n}\n"}, config.Env)
+	require.Equal(t, map[string][]docker.PortBinding{"80/tcp": {{HostIP: "127.0.0.1"}}}, config.HostConfig.PortBindings)
+	require.Equal(t, map[string]docker.EndpointSettings{"test-env": {Aliases: []string{"public"}}}, config.NetworkingConfig.EndpointsConfig)
+}
+
+func Test_Render_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties datamodel.GatewayProperties
+		err        string
+	}{
+		{
+			name:       "no routes",
+			properties: datamodel.GatewayProperties{},
+			err:        "gateway must have at least one route",
+		},
+		{
+			name: "tls",
+			properties: datamodel.GatewayProperties{
+				TLS:    &datamodel.GatewayPropertiesTLS{SSLPassthrough: true},
+				Routes: []datamodel.GatewayRoute{{Path: "/", Destination: "http://frontend:3000"}},
+			},
+			err: "TLS is not supported by the Docker runtime",
+		},
+		{
+			name: "invalid destination",
+			properties: datamodel.GatewayProperties{
+				Routes: []datamodel.GatewayRoute{{Path: "/", Destination: "frontend"}},
+			},
+			err: "invalid destination \"frontend\": must be a URL such as http://frontend:3000",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Renderer{}.Render(testcontext.New(t), makeGateway(tc.properties), makeOptions())
+			require.Equal(t, v1.NewClientErrInvalidRequest(tc.err), err)
+		})
+	}
+}
+
+func Test_MakeCaddyfile(t *testing.T) {
+	caddyfile, err := MakeCaddyfile([]datamodel.GatewayRoute{
+		{Path: "/", Destination: "http://frontend:3000"},
+		{Path: "/api/", Destination: "http://backend:8080", ReplacePrefix: "/"},
+		{Path: "/api/v2", Destination: "https://backend-v2:8443", ReplacePrefix: "/v2"},
+	})
+	require.NoError(t, err)
+
+	expected := `:80 {
+	route {
+		@route0 path /api/v2 /api/v2/*
+		handle @route0 {
+			uri strip_prefix /api/v2
+			uri path_regexp ^ /v2
+			reverse_proxy https://backend-v2:8443
+		}
+		@route1 path /api /api/*
+		handle @route1 {
+			uri strip_prefix /api
+			reverse_proxy backend:8080
+		}
+		handle {
+			reverse_proxy frontend:3000
+		}
+	}
+}
+`
+	require.Equal(t, expected, caddyfile)
+}
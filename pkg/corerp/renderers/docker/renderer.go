@@ -0,0 +1,415 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/docker"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	"github.com/radius-project/radius/pkg/resourceutil"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_docker "github.com/radius-project/radius/pkg/ucp/resources/docker"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+const (
+	// RestartPolicyUnlessStopped restarts the containers when they exit or when the Docker daemon restarts, unless
+	// they were stopped explicitly.
+	RestartPolicyUnlessStopped = "unless-stopped"
+
+	// LocalhostIP is the host address the ports of the containers are published on. Containers are only reachable
+	// from the machine running Docker.
+	LocalhostIP = "127.0.0.1"
+
+	// SecretsPath is the directory of the containers the secrets of their connections are written to. The
+	// environment variables of secrets are suffixed with _FILE and set to the path of the file instead of the
+	// value, so that the values don't show up when the container is inspected.
+	SecretsPath = "/run/secrets/radius"
+)
+
+// Renderer renders Applications.Core/containers resources to Docker containers and volumes.
+type Renderer struct {
+}
+
+// GetDependencyIDs returns the IDs of the Radius resources the container connects to. URL connections are not
+// resources, and Azure connections are not supported by the Docker runtime.
+func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) (radiusResourceIDs []resources.ID, azureResourceIDs []resources.ID, err error) {
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return nil, nil, v1.ErrInvalidModelConversion
+	}
+
+	for _, connection := range resource.Properties.Connections {
+		if renderers.IsURL(connection.Source) {
+			continue
+		}
+
+		resourceID, err := resources.ParseResource(connection.Source)
+		if err != nil {
+			return nil, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid source: %s. Must be either a URL or a valid resourceID", connection.Source))
+		}
+
+		if resources_radius.IsRadiusResource(resourceID) {
+			radiusResourceIDs = append(radiusResourceIDs, resourceID)
+		}
+	}
+
+	return radiusResourceIDs, azureResourceIDs, nil
+}
+
+// Render creates the Docker container of the resource and the volumes it mounts. Connections are passed to the
+// container as CONNECTION_<NAME>_<PROPERTY> environment variables, like the Kubernetes renderer does. Secrets of
+// connections are written to files under SecretsPath and passed as CONNECTION_<NAME>_<PROPERTY>_FILE instead.
+func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	properties := resource.Properties
+
+	appID, err := resources.ParseResource(properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	if properties.ResourceProvisioning == datamodel.ContainerResourceProvisioningManual {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest("manual resource provisioning is not supported by the Docker runtime")
+	}
+
+	env, secrets, err := getEnvVars(resource, options.Dependencies)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	labels := kubernetes.MakeDescriptiveLabels(appID.Name(), resource.Name, resource.ResourceTypeName())
+	network := NetworkName(options.Environment)
+
+	config := &docker.ContainerConfig{
+		Image:      properties.Container.Image,
+		Entrypoint: properties.Container.Command,
+		Cmd:        properties.Container.Args,
+		WorkingDir: properties.Container.WorkingDir,
+		Env:        env,
+		Secrets:    secrets,
+		Labels:     labels,
+		HostConfig: docker.HostConfig{
+			RestartPolicy: docker.RestartPolicy{Name: restartPolicy(properties.RestartPolicy)},
+		},
+		NetworkingConfig: docker.NetworkingConfig{
+			EndpointsConfig: map[string]docker.EndpointSettings{
+				// Other containers of the environment reach the container by the name of its resource, which is
+				// also the hostname used by the Kubernetes runtime.
+				network: {Aliases: []string{kubernetes.NormalizeResourceName(resource.Name)}},
+			},
+		},
+	}
+
+	for _, portName := range slices.Sorted(maps.Keys(properties.Container.Ports)) {
+		port := properties.Container.Ports[portName]
+		if port.ContainerPort == 0 {
+			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid ports definition: must define a ContainerPort, but ContainerPort is: %d.", port.ContainerPort))
+		}
+
+		protocol := "tcp"
+		if port.Protocol == datamodel.ProtocolUDP {
+			protocol = "udp"
+		}
+
+		key := fmt.Sprintf("%d/%s", port.ContainerPort, protocol)
+		if config.ExposedPorts == nil {
+			config.ExposedPorts = map[string]struct{}{}
+			config.HostConfig.PortBindings = map[string][]docker.PortBinding{}
+		}
+		config.ExposedPorts[key] = struct{}{}
+
+		// Let Docker choose the host port, since the ports of several containers often collide. The handler keeps
+		// the host port when the container is replaced.
+		config.HostConfig.PortBindings[key] = []docker.PortBinding{{HostIP: LocalhostIP}}
+	}
+
+	healthcheck, err := makeHealthcheck(properties.Container.ReadinessProbe)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+	config.Healthcheck = healthcheck
+
+	containerName := ContainerName(appID.Name(), resource.Name)
+	outputResources := []rpv1.OutputResource{}
+	dependencies := []string{}
+	for _, volumeName := range slices.Sorted(maps.Keys(properties.Container.Volumes)) {
+		volume := properties.Container.Volumes[volumeName]
+		switch volume.Kind {
+		case datamodel.Ephemeral:
+			if volume.Ephemeral == nil {
+				return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("volume %q must define ephemeralVolume", volumeName))
+			}
+
+			if volume.Ephemeral.ManagedStore == datamodel.ManagedStoreMemory {
+				config.HostConfig.Mounts = append(config.HostConfig.Mounts, docker.Mount{
+					Type:   "tmpfs",
+					Target: volume.Ephemeral.MountPath,
+				})
+				continue
+			}
+
+			name := containerName + "-" + kubernetes.NormalizeResourceName(volumeName)
+			localID := rpv1.NewLocalID(rpv1.LocalIDDockerVolumePrefix, volumeName)
+			outputResources = append(outputResources, rpv1.OutputResource{
+				LocalID: localID,
+				ID:      resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeVolume, name),
+				CreateResource: &rpv1.Resource{
+					ResourceType: resourcemodel.ResourceType{
+						Type:     resources_docker.ResourceTypeVolume,
+						Provider: resourcemodel.ProviderDocker,
+					},
+					Data: &docker.VolumeConfig{
+						Name:   name,
+						Labels: labels,
+					},
+				},
+			})
+			dependencies = append(dependencies, localID)
+
+			config.HostConfig.Mounts = append(config.HostConfig.Mounts, docker.Mount{
+				Type:   "volume",
+				Source: name,
+				Target: volume.Ephemeral.MountPath,
+			})
+		case datamodel.Persistent:
+			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("volume %q: persistent volumes are not supported by the Docker runtime", volumeName))
+		default:
+			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("volume %q: unsupported volume kind %q", volumeName, volume.Kind))
+		}
+	}
+
+	outputResources = append(outputResources, rpv1.OutputResource{
+		LocalID: rpv1.LocalIDDockerContainer,
+		ID:      resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeContainer, containerName),
+		CreateResource: &rpv1.Resource{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_docker.ResourceTypeContainer,
+				Provider: resourcemodel.ProviderDocker,
+			},
+			Data:         config,
+			Dependencies: dependencies,
+		},
+	})
+
+	return renderers.RendererOutput{
+		Resources:      outputResources,
+		RadiusResource: dm,
+		ComputedValues: map[string]rpv1.ComputedValueReference{},
+	}, nil
+}
+
+// ContainerName returns the name of the Docker container of a resource. Names of Docker containers are global to
+// the Docker host, so they are prefixed with the name of the application.
+func ContainerName(application string, resource string) string {
+	return kubernetes.NormalizeResourceName(application) + "-" + kubernetes.NormalizeResourceName(resource)
+}
+
+// NetworkName returns the name of the Docker network the containers of the environment are attached to. The
+// network of the environment compute is used if set, otherwise the network is named after the environment.
+func NetworkName(environment renderers.EnvironmentOptions) string {
+	if environment.Compute != nil && environment.Compute.DockerCompute.Network != "" {
+		return environment.Compute.DockerCompute.Network
+	}
+
+	return kubernetes.NormalizeResourceName(environment.Resource.Name())
+}
+
+// getEnvVars returns the environment variables of the container in a stable order, including the variables of
+// its connections, and the files of the secrets of its connections keyed by their path.
+func getEnvVars(resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) ([]string, map[string]string, error) {
+	env := map[string]string{}
+	for name, value := range resource.Properties.Container.Env {
+		if value.ValueFrom != nil {
+			return nil, nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("environment variable %q: valueFrom is not supported by the Docker runtime", name))
+		}
+
+		if value.Value != nil {
+			env[name] = *value.Value
+		}
+	}
+
+	secrets := map[string]string{}
+	for name, connection := range resource.Properties.Connections {
+		if connection.GetDisableDefaultEnvVars() || connection.Source == "" {
+			continue
+		}
+
+		if renderers.IsURL(connection.Source) {
+			scheme, hostname, port, err := renderers.ParseURL(connection.Source)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to parse source URL: %w", err)
+			}
+
+			prefix := "CONNECTION_" + strings.ToUpper(name) + "_"
+			env[prefix+"SCHEME"] = scheme
+			env[prefix+"HOSTNAME"] = hostname
+			env[prefix+"PORT"] = port
+			continue
+		}
+
+		dependency := dependencies[connection.Source]
+		addConnectionEnvVars(env, secrets, name, dependency.ComputedValues, dependency.SecretKeys)
+
+		if !resources.IsBuiltInType(connection.Source) {
+			partialResource, err := resourceutil.GetPropertiesFromResource(dependency.Resource)
+			if err != nil {
+				return nil, nil, err
+			}
+			addConnectionEnvVars(env, secrets, name, partialResource, nil)
+		}
+	}
+
+	result := []string{}
+	for _, key := range slices.Sorted(maps.Keys(env)) {
+		result = append(result, key+"="+env[key])
+	}
+
+	if len(secrets) == 0 {
+		secrets = nil
+	}
+
+	return result, secrets, nil
+}
+
+// addConnectionEnvVars adds the environment variables of the values of a connection. The values of secretKeys are
+// added to secrets and their variables point to the file of the secret. Values that are already set are not
+// overwritten, so computed values take precedence over resource properties.
+func addConnectionEnvVars(env map[string]string, secrets map[string]string, connectionName string, values map[string]any, secretKeys []string) {
+	for key, value := range values {
+		if slices.Contains(resourceutil.BasicProperties, key) {
+			continue
+		}
+
+		name := "CONNECTION_" + strings.ToUpper(connectionName) + "_" + strings.ToUpper(key)
+		if _, exists := env[name]; exists {
+			continue
+		}
+		if _, exists := env[name+"_FILE"]; exists {
+			continue
+		}
+
+		formatted, ok := formatEnvValue(value)
+		if !ok {
+			continue
+		}
+
+		if slices.Contains(secretKeys, key) {
+			file := path.Join(SecretsPath, name)
+			env[name+"_FILE"] = file
+			secrets[file] = formatted
+			continue
+		}
+
+		env[name] = formatted
+	}
+}
+
+// formatEnvValue formats a connection value the same way the Kubernetes renderer stores it in the secret of the
+// container: primitives as strings, number arrays as comma separated lists and other values as JSON.
+func formatEnvValue(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case []int:
+		values := make([]string, len(v))
+		for i, val := range v {
+			values[i] = strconv.Itoa(val)
+		}
+		return strings.Join(values, ","), true
+	case []float64:
+		values := make([]string, len(v))
+		for i, val := range v {
+			values[i] = strconv.FormatFloat(val, 'f', -1, 64)
+		}
+		return strings.Join(values, ","), true
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+}
+
+// makeHealthcheck converts the readiness probe of the container to a Docker health check. Docker only supports
+// commands, so HTTP and TCP probes are not checked and the container is ready once it's running.
+func makeHealthcheck(probe datamodel.HealthProbeProperties) (*docker.HealthConfig, error) {
+	if probe.IsEmpty() || probe.Kind != datamodel.ExecHealthProbe {
+		return nil, nil
+	}
+
+	if probe.Exec == nil || probe.Exec.Command == "" {
+		return nil, v1.NewClientErrInvalidRequest("exec readiness probe must define a command")
+	}
+
+	healthcheck := &docker.HealthConfig{
+		Test:        []string{"CMD-SHELL", probe.Exec.Command},
+		Interval:    seconds(probe.Exec.PeriodSeconds),
+		Timeout:     seconds(probe.Exec.TimeoutSeconds),
+		StartPeriod: seconds(probe.Exec.InitialDelaySeconds),
+	}
+	if probe.Exec.FailureThreshold != nil {
+		healthcheck.Retries = int(*probe.Exec.FailureThreshold)
+	}
+
+	return healthcheck, nil
+}
+
+// restartPolicy converts the restart policy of the container to a Docker restart policy. Containers are
+// restarted unless they were stopped by default, like the pods of the Kubernetes runtime.
+func restartPolicy(policy string) string {
+	switch strings.ToLower(policy) {
+	case "onfailure":
+		return "on-failure"
+	case "never":
+		return "no"
+	default:
+		return RestartPolicyUnlessStopped
+	}
+}
+
+// seconds converts a probe duration in seconds to nanoseconds, the unit of the Docker Engine API.
+func seconds(value *float32) int64 {
+	if value == nil {
+		return 0
+	}
+
+	return int64(float64(*value) * float64(time.Second))
+}
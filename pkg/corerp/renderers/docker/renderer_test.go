@@ -0,0 +1,279 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/docker"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourcemodel"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_docker "github.com/radius-project/radius/pkg/ucp/resources/docker"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	applicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	environmentID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+	redisID       = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"
+	resourceName  = "frontend"
+)
+
+func makeResource(properties datamodel.ContainerProperties) *datamodel.ContainerResource {
+	properties.Application = applicationID
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/" + resourceName,
+				Name: resourceName,
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func makeOptions(dependencies map[string]renderers.RendererDependency) renderers.RenderOptions {
+	return renderers.RenderOptions{
+		Dependencies: dependencies,
+		Environment: renderers.EnvironmentOptions{
+			Resource: resources.MustParse(environmentID),
+			Compute: &rpv1.EnvironmentCompute{
+				Kind: rpv1.DockerComputeKind,
+			},
+		},
+	}
+}
+
+func Test_GetDependencyIDs(t *testing.T) {
+	resource := makeResource(datamodel.ContainerProperties{
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis":   {Source: redisID},
+			"backend": {Source: "http://backend:3000"},
+		},
+	})
+
+	radiusIDs, azureIDs, err := Renderer{}.GetDependencyIDs(testcontext.New(t), resource)
+	require.NoError(t, err)
+	require.Equal(t, []resources.ID{resources.MustParse(redisID)}, radiusIDs)
+	require.Empty(t, azureIDs)
+
+	resource.Properties.Connections["invalid"] = datamodel.ConnectionProperties{Source: "invalid"}
+	_, _, err = Renderer{}.GetDependencyIDs(testcontext.New(t), resource)
+	require.Error(t, err)
+}
+
+func Test_Render(t *testing.T) {
+	resource := makeResource(datamodel.ContainerProperties{
+		Connections: map[string]datamodel.ConnectionProperties{
+			"redis":    {Source: redisID},
+			"backend":  {Source: "http://backend:3000"},
+			"disabled": {Source: redisID, DisableDefaultEnvVars: to.Ptr(true)},
+		},
+		Container: datamodel.Container{
+			Image: "frontend:latest",
+			Env: map[string]datamodel.EnvironmentVariable{
+				"PORT": {Value: to.Ptr("3000")},
+			},
+			Ports: map[string]datamodel.ContainerPort{
+				"web": {ContainerPort: 3000},
+			},
+			ReadinessProbe: datamodel.HealthProbeProperties{
+				Kind: datamodel.ExecHealthProbe,
+				Exec: &datamodel.ExecHealthProbeProperties{
+					HealthProbeBase: datamodel.HealthProbeBase{PeriodSeconds: to.Ptr[float32](2)},
+					Command:         "ls /tmp",
+				},
+			},
+			Volumes: map[string]datamodel.VolumeProperties{
+				"cache": {
+					Kind:      datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{VolumeBase: datamodel.VolumeBase{MountPath: "/cache"}, ManagedStore: datamodel.ManagedStoreDisk},
+				},
+				"scratch": {
+					Kind:      datamodel.Ephemeral,
+					Ephemeral: &datamodel.EphemeralVolume{VolumeBase: datamodel.VolumeBase{MountPath: "/scratch"}, ManagedStore: datamodel.ManagedStoreMemory},
+				},
+			},
+			Command: []string{"node"},
+			Args:    []string{"server.js"},
+		},
+	})
+
+	options := makeOptions(map[string]renderers.RendererDependency{
+		redisID: {
+			ResourceID:     resources.MustParse(redisID),
+			ComputedValues: map[string]any{"host": "redis", "port": float64(6379), "password": "p@ssw0rd"},
+			SecretKeys:     []string{"password"},
+		},
+	})
+
+	output, err := Renderer{}.Render(testcontext.New(t), resource, options)
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	labels := kubernetes.MakeDescriptiveLabels("test-app", resourceName, "Applications.Core/containers")
+
+	volumeLocalID := rpv1.NewLocalID(rpv1.LocalIDDockerVolumePrefix, "cache")
+	require.Equal(t, rpv1.OutputResource{
+		LocalID: volumeLocalID,
+		ID:      resources_docker.IDFromParts(resources_docker.PlaneNameTODO, resources_docker.ResourceTypeVolume, "test-app-frontend-cache"),
+		CreateResource: &rpv1.Resource{
+			ResourceType: resourcemodel.ResourceType{
+				Type:     resources_docker.ResourceTypeVolume,
+				Provider: resourcemodel.ProviderDocker,
+			},
+			Data: &docker.VolumeConfig{Name: "test-app-frontend-cache", Labels: labels},
+		},
+	}, output.Resources[0])
+
+	container := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDDockerContainer, container.LocalID)
+	require.Equal(t, "/planes/docker/local/providers/Docker.Engine/containers/test-app-frontend", container.ID.String())
+	require.Equal(t, resourcemodel.ResourceType{Type: resources_docker.ResourceTypeContainer, Provider: resourcemodel.ProviderDocker}, container.CreateResource.ResourceType)
+	require.Equal(t, []string{volumeLocalID}, container.CreateResource.Dependencies)
+
+	expected := &docker.ContainerConfig{
+		Image:      "frontend:latest",
+		Entrypoint: []string{"node"},
+		Cmd:        []string{"server.js"},
+		Env: []string{
+			"CONNECTION_BACKEND_HOSTNAME=backend",
+			"CONNECTION_BACKEND_PORT=3000",
+			"CONNECTION_BACKEND_SCHEME=http",
+			"CONNECTION_REDIS_HOST=redis",
+			"CONNECTION_REDIS_PASSWORD_FILE=/run/secrets/radius/CONNECTION_REDIS_PASSWORD",
+			"CONNECTION_REDIS_PORT=6379",
+			"PORT=3000",
+		},
+		Secrets: map[string]string{
+			"/run/secrets/radius/CONNECTION_REDIS_PASSWORD": "p@ssw0rd",
+		},
+		Labels:       labels,
+		ExposedPorts: map[string]struct{}{"3000/tcp": {}},
+		Healthcheck: &docker.HealthConfig{
+			Test:     []string{"CMD-SHELL", "ls /tmp"},
+			Interval: 2_000_000_000,
+		},
+		HostConfig: docker.HostConfig{
+			PortBindings: map[string][]docker.PortBinding{
+				"3000/tcp": {{HostIP: LocalhostIP}},
+			},
+			Mounts: []docker.Mount{
+				{Type: "volume", Source: "test-app-frontend-cache", Target: "/cache"},
+				{Type: "tmpfs", Target: "/scratch"},
+			},
+			RestartPolicy: docker.RestartPolicy{Name: RestartPolicyUnlessStopped},
+		},
+		NetworkingConfig: docker.NetworkingConfig{
+			EndpointsConfig: map[string]docker.EndpointSettings{
+				"test-env": {Aliases: []string{resourceName}},
+			},
+		},
+	}
+	require.Equal(t, expected, container.CreateResource.Data)
+}
+
+func Test_Render_Network(t *testing.T) {
+	resource := makeResource(datamodel.ContainerProperties{
+		Container: datamodel.Container{Image: "frontend:latest"},
+	})
+
+	options := makeOptions(nil)
+	options.Environment.Compute.DockerCompute.Network = "shared"
+
+	output, err := Renderer{}.Render(testcontext.New(t), resource, options)
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+
+	config := output.Resources[0].CreateResource.Data.(*docker.ContainerConfig)
+	require.Equal(t, map[string]docker.EndpointSettings{"shared": {Aliases: []string{resourceName}}}, config.NetworkingConfig.EndpointsConfig)
+	require.Nil(t, config.Healthcheck)
+	require.Nil(t, config.HostConfig.PortBindings)
+}
+
+func Test_Render_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties datamodel.ContainerProperties
+		err        string
+	}{
+		{
+			name: "valueFrom",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Image: "frontend:latest",
+					Env: map[string]datamodel.EnvironmentVariable{
+						"SECRET": {ValueFrom: &datamodel.EnvironmentVariableReference{}},
+					},
+				},
+			},
+			err: "environment variable \"SECRET\": valueFrom is not supported by the Docker runtime",
+		},
+		{
+			name: "persistent volume",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Image: "frontend:latest",
+					Volumes: map[string]datamodel.VolumeProperties{
+						"data": {Kind: datamodel.Persistent, Persistent: &datamodel.PersistentVolume{}},
+					},
+				},
+			},
+			err: "volume \"data\": persistent volumes are not supported by the Docker runtime",
+		},
+		{
+			name: "missing container port",
+			properties: datamodel.ContainerProperties{
+				Container: datamodel.Container{
+					Image: "frontend:latest",
+					Ports: map[string]datamodel.ContainerPort{"web": {}},
+				},
+			},
+			err: "invalid ports definition: must define a ContainerPort, but ContainerPort is: 0.",
+		},
+		{
+			name: "manual provisioning",
+			properties: datamodel.ContainerProperties{
+				ResourceProvisioning: datamodel.ContainerResourceProvisioningManual,
+			},
+			err: "manual resource provisioning is not supported by the Docker runtime",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Renderer{}.Render(testcontext.New(t), makeResource(tc.properties), makeOptions(nil))
+			require.Error(t, err)
+			require.Equal(t, v1.NewClientErrInvalidRequest(tc.err), err)
+		})
+	}
+}
+
+func Test_restartPolicy(t *testing.T) {
+	require.Equal(t, RestartPolicyUnlessStopped, restartPolicy(""))
+	require.Equal(t, RestartPolicyUnlessStopped, restartPolicy("Always"))
+	require.Equal(t, "on-failure", restartPolicy("OnFailure"))
+	require.Equal(t, "no", restartPolicy("Never"))
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/radius-project/radius/pkg/rp/kube"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Renderer is the renderers.Renderer implementation for the kubernetesmetadata extension.
@@ -77,20 +79,26 @@ func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 			continue
 		}
 
-		dep, ok := ores.CreateResource.Data.(*appsv1.Deployment)
-		if !ok {
+		var obj metav1.Object
+		var template *corev1.PodTemplateSpec
+		switch workload := ores.CreateResource.Data.(type) {
+		case *appsv1.Deployment:
+			obj, template = workload, &workload.Spec.Template
+		case *appsv1.StatefulSet:
+			obj, template = workload, &workload.Spec.Template
+		default:
 			continue
 		}
 
-		processAnnotations(options, dep, kubeMetadataExt)
-		processLabels(options, dep, kubeMetadataExt)
+		processAnnotations(options, obj, template, kubeMetadataExt)
+		processLabels(options, obj, template, kubeMetadataExt)
 	}
 
 	return output, nil
 }
 
-func processAnnotations(options renderers.RenderOptions, dep *appsv1.Deployment, kubeMetadataExt *datamodel.KubeMetadataExtension) {
-	existingMetaAnnotations, existingSpecAnnotations := getAnnotations(dep)
+func processAnnotations(options renderers.RenderOptions, obj metav1.Object, template *corev1.PodTemplateSpec, kubeMetadataExt *datamodel.KubeMetadataExtension) {
+	existingMetaAnnotations, existingSpecAnnotations := getAnnotations(obj, template)
 
 	// Create KubernetesMetadata struct to merge annotations
 	ann := &kube.Metadata{
@@ -113,11 +121,11 @@ func processAnnotations(options renderers.RenderOptions, dep *appsv1.Deployment,
 
 	// Merge cumulative annotation values from Env->App->Container->InputExt kubernetes metadata. In case of collisions, rightmost entity wins
 	metaAnnotations, specAnnotations := ann.Merge()
-	setAnnotations(dep, metaAnnotations, specAnnotations)
+	setAnnotations(obj, template, metaAnnotations, specAnnotations)
 }
 
-func processLabels(options renderers.RenderOptions, dep *appsv1.Deployment, kubeMetadataExt *datamodel.KubeMetadataExtension) {
-	existingMetaLabels, existingSpecLabels := getLabels(dep)
+func processLabels(options renderers.RenderOptions, obj metav1.Object, template *corev1.PodTemplateSpec, kubeMetadataExt *datamodel.KubeMetadataExtension) {
+	existingMetaLabels, existingSpecLabels := getLabels(obj, template)
 
 	// Create KubernetesMetadata struct to merge labels
 	lbl := &kube.Metadata{
@@ -140,55 +148,55 @@ func processLabels(options renderers.RenderOptions, dep *appsv1.Deployment, kube
 
 	// Merge cumulative label values from Env->App->Container->InputExt kubernetes metadata. In case of collisions, rightmost entity wins
 	metaLabels, specLabels := lbl.Merge()
-	setLabels(dep, metaLabels, specLabels)
+	setLabels(obj, template, metaLabels, specLabels)
 }
 
-func getAnnotations(dep *appsv1.Deployment) (map[string]string, map[string]string) {
+func getAnnotations(obj metav1.Object, template *corev1.PodTemplateSpec) (map[string]string, map[string]string) {
 	depMetaAnnotations := map[string]string{}
 	depSpecAnnotations := map[string]string{}
 
-	if dep.Annotations != nil {
-		depMetaAnnotations = dep.Annotations
+	if obj.GetAnnotations() != nil {
+		depMetaAnnotations = obj.GetAnnotations()
 	}
-	if dep.Spec.Template.Annotations != nil {
-		depSpecAnnotations = dep.Spec.Template.Annotations
+	if template.Annotations != nil {
+		depSpecAnnotations = template.Annotations
 	}
 
 	return depMetaAnnotations, depSpecAnnotations
 }
 
-func getLabels(dep *appsv1.Deployment) (map[string]string, map[string]string) {
+func getLabels(obj metav1.Object, template *corev1.PodTemplateSpec) (map[string]string, map[string]string) {
 	depMetaLabels := map[string]string{}
 	depSpecLabels := map[string]string{}
 
-	if dep.Labels != nil {
-		depMetaLabels = dep.Labels
+	if obj.GetLabels() != nil {
+		depMetaLabels = obj.GetLabels()
 	}
-	if dep.Spec.Template.Labels != nil {
-		depSpecLabels = dep.Spec.Template.Labels
+	if template.Labels != nil {
+		depSpecLabels = template.Labels
 	}
 
 	return depMetaLabels, depSpecLabels
 }
 
 // setLabels sets the value of labels
-func setLabels(dep *appsv1.Deployment, metaLabels map[string]string, specLabels map[string]string) {
+func setLabels(obj metav1.Object, template *corev1.PodTemplateSpec, metaLabels map[string]string, specLabels map[string]string) {
 	if len(metaLabels) > 0 {
-		dep.SetLabels(metaLabels)
+		obj.SetLabels(metaLabels)
 	}
 
 	if len(specLabels) > 0 {
-		dep.Spec.Template.Labels = specLabels
+		template.Labels = specLabels
 	}
 }
 
 // setAnnotations sets the value of annotations/labels
-func setAnnotations(dep *appsv1.Deployment, metaAnnotations map[string]string, specAnnotations map[string]string) {
+func setAnnotations(obj metav1.Object, template *corev1.PodTemplateSpec, metaAnnotations map[string]string, specAnnotations map[string]string) {
 	if len(metaAnnotations) > 0 {
-		dep.SetAnnotations(metaAnnotations)
+		obj.SetAnnotations(metaAnnotations)
 	}
 
 	if len(specAnnotations) > 0 {
-		dep.Spec.Template.Annotations = specAnnotations
+		template.Annotations = specAnnotations
 	}
 }
@@ -84,7 +84,10 @@ func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 
 // setReplicas sets the value of replica
 func (r Renderer) setReplicas(o runtime.Object, replicas *int32) {
-	if dep, ok := o.(*appsv1.Deployment); ok {
-		dep.Spec.Replicas = replicas
+	switch workload := o.(type) {
+	case *appsv1.Deployment:
+		workload.Spec.Replicas = replicas
+	case *appsv1.StatefulSet:
+		workload.Spec.Replicas = replicas
 	}
 }
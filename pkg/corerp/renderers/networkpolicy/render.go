@@ -0,0 +1,351 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/resourceutil"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// namespaceNameLabel is the label automatically set by Kubernetes on every namespace.
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+
+	// dnsPort is the port used for name resolution. Egress to DNS is always allowed so that connections can be resolved.
+	dnsPort = 53
+
+	// portProperty is the name of the property or computed value holding the port of a connected resource.
+	portProperty = "port"
+
+	// hostProperty is the name of the property or computed value holding the host of a connected resource.
+	hostProperty = "host"
+)
+
+// Renderer is the renderers.Renderer implementation for the networkPolicy extension.
+//
+// The extension compiles the connections of a container into a default-deny NetworkPolicy:
+//
+//   - Egress is only allowed to DNS and to the declared connections. Connections to other containers are restricted
+//     to the pods of that container, and URL connections to their IP address and port. Connections to other Radius
+//     resources, such as portable resources deployed by recipes, are restricted to the port of the resource and to
+//     its host: its IP address, or the namespace of a Kubernetes service host such as redis.app.svc.cluster.local.
+//     Rendering fails for connections whose host or port can't be restricted this way, since a rule with only a
+//     port would allow traffic to any destination.
+//   - Ingress is only allowed on the declared container ports, from the pods of the same application and from the
+//     namespaces listed in the extension.
+type Renderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the dependencies of the given resource.
+func (r *Renderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render checks if the DataModelInterface is a ContainerResource and if so, checks for a NetworkPolicy
+// extension and adds a NetworkPolicy that only allows the traffic of the declared connections and ports.
+func (r *Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	ext := datamodel.FindExtension(resource.Properties.Extensions, datamodel.NetworkPolicy)
+	if ext == nil || ext.NetworkPolicy == nil {
+		return output, nil
+	}
+
+	workload, _, workloadOutput := kubernetes.FindWorkload(output.Resources)
+	if workload == nil {
+		return output, nil
+	}
+
+	appID, err := resources.ParseResource(resource.Properties.Application)
+	if err != nil {
+		return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid application id: %s ", err.Error()))
+	}
+
+	egress, err := makeEgressRules(appID.Name(), resource, options.Dependencies)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	policy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workload.GetName(),
+			Namespace: workload.GetNamespace(),
+			Labels:    kubernetes.MakeDescriptiveLabels(appID.Name(), resource.Name, resource.ResourceTypeName()),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: kubernetes.MakeSelectorLabels(appID.Name(), resource.Name),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     makeIngressRules(appID.Name(), resource, ext.NetworkPolicy),
+			Egress:      egress,
+		},
+	}
+
+	policyOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDNetworkPolicy, policy, policy.ObjectMeta)
+	policyOutput.CreateResource.Dependencies = []string{workloadOutput.LocalID}
+	output.Resources = append(output.Resources, policyOutput)
+
+	return output, nil
+}
+
+// makeIngressRules allows traffic to the container ports from the application and the allowed namespaces. A container
+// without ports does not accept any traffic.
+func makeIngressRules(applicationName string, resource *datamodel.ContainerResource, ext *datamodel.NetworkPolicyExtension) []networkingv1.NetworkPolicyIngressRule {
+	ports := containerPorts(resource)
+	if len(ports) == 0 {
+		return []networkingv1.NetworkPolicyIngressRule{}
+	}
+
+	peers := []networkingv1.NetworkPolicyPeer{
+		{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: kubernetes.MakeSelectorLabels(applicationName, ""),
+			},
+		},
+	}
+
+	for _, ns := range ext.IngressNamespaces {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{namespaceNameLabel: ns},
+			},
+		})
+	}
+
+	return []networkingv1.NetworkPolicyIngressRule{{From: peers, Ports: ports}}
+}
+
+// makeEgressRules creates an egress rule for DNS and one rule per connection, in the order of the connection names.
+func makeEgressRules(applicationName string, resource *datamodel.ContainerResource, dependencies map[string]renderers.RendererDependency) ([]networkingv1.NetworkPolicyEgressRule, error) {
+	rules := []networkingv1.NetworkPolicyEgressRule{
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				makePort(corev1.ProtocolUDP, dnsPort),
+				makePort(corev1.ProtocolTCP, dnsPort),
+			},
+		},
+	}
+
+	names := []string{}
+	for name := range resource.Properties.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		connection := resource.Properties.Connections[name]
+		if connection.Source == "" {
+			continue
+		}
+
+		rule, err := makeConnectionEgressRule(applicationName, name, connection.Source, dependencies)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func makeConnectionEgressRule(applicationName string, name string, source string, dependencies map[string]renderers.RendererDependency) (networkingv1.NetworkPolicyEgressRule, error) {
+	if renderers.IsURL(source) {
+		_, hostname, port, err := renderers.ParseURL(source)
+		if err != nil {
+			return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid source: %s. %s", source, err.Error()))
+		}
+
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid port in source: %s", source))
+		}
+
+		// Host names can't be expressed in a NetworkPolicy. A rule with only a port would allow traffic to any
+		// destination on that port, so the connection must use an IP address.
+		ip := net.ParseIP(hostname)
+		if ip == nil {
+			return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(
+				"the networkPolicy extension can't restrict the egress of connection %q to %s because host names can't be used in a network policy. Use the IP address of the destination in the URL instead.", name, source))
+		}
+
+		return networkingv1.NetworkPolicyEgressRule{
+			To:    []networkingv1.NetworkPolicyPeer{makeIPPeer(ip)},
+			Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, p)},
+		}, nil
+	}
+
+	dependency, ok := dependencies[source]
+	if !ok || dependency.Resource == nil {
+		// The dependency is not a Radius resource, for example an Azure resource. An egress rule without a destination
+		// and a port would allow all egress, so the connection must be declared with a URL instead.
+		return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(
+			"the networkPolicy extension can't restrict the egress of connection %q to %s because it is not a Radius resource. Use the URL of the resource, including its port, as the source of the connection.", name, source))
+	}
+
+	if container, ok := dependency.Resource.(*datamodel.ContainerResource); ok {
+		return networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: kubernetes.MakeSelectorLabels(applicationName, container.Name),
+					},
+				},
+			},
+			Ports: containerPorts(container),
+		}, nil
+	}
+
+	port, ok := findPort(dependency)
+	if !ok {
+		return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(
+			"the networkPolicy extension can't restrict the egress of connection %q to %s because the resource has no port. Use the URL of the resource, including its port, as the source of the connection.", name, source))
+	}
+
+	peer, ok := findPeer(dependency)
+	if !ok {
+		return networkingv1.NetworkPolicyEgressRule{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(
+			"the networkPolicy extension can't restrict the egress of connection %q to %s because the host of the resource is neither an IP address nor a Kubernetes service. Use the URL of the resource, with its IP address and port, as the source of the connection.", name, source))
+	}
+
+	return networkingv1.NetworkPolicyEgressRule{
+		To:    []networkingv1.NetworkPolicyPeer{peer},
+		Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, port)},
+	}, nil
+}
+
+// findPeer returns the destination of the egress to a connected resource from its host: the IP address of the host,
+// or the namespace of a Kubernetes service host (<service>.<namespace>.svc[.<cluster domain>]).
+func findPeer(dependency renderers.RendererDependency) (networkingv1.NetworkPolicyPeer, bool) {
+	host, ok := dependency.ComputedValues[hostProperty].(string)
+	if !ok || host == "" {
+		properties, err := resourceutil.GetPropertiesFromResource(dependency.Resource)
+		if err != nil {
+			return networkingv1.NetworkPolicyPeer{}, false
+		}
+
+		host, _ = properties[hostProperty].(string)
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+	if ip := net.ParseIP(host); ip != nil {
+		return makeIPPeer(ip), true
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) >= 3 && labels[0] != "" && labels[1] != "" && labels[2] == "svc" {
+		return networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: labels[1]}},
+		}, true
+	}
+
+	return networkingv1.NetworkPolicyPeer{}, false
+}
+
+// makeIPPeer returns a peer that only matches the given IP address.
+func makeIPPeer(ip net.IP) networkingv1.NetworkPolicyPeer {
+	cidr := ip.String() + "/32"
+	if ip.To4() == nil {
+		cidr = ip.String() + "/128"
+	}
+
+	return networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}}
+}
+
+// findPort looks up the port of a connected resource from its computed values or from its properties.
+func findPort(dependency renderers.RendererDependency) (int, bool) {
+	if port, ok := toPort(dependency.ComputedValues[portProperty]); ok {
+		return port, true
+	}
+
+	properties, err := resourceutil.GetPropertiesFromResource(dependency.Resource)
+	if err != nil {
+		return 0, false
+	}
+
+	return toPort(properties[portProperty])
+}
+
+func toPort(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, v > 0
+	case int32:
+		return int(v), v > 0
+	case int64:
+		return int(v), v > 0
+	case float64:
+		return int(v), v > 0
+	case string:
+		p, err := strconv.Atoi(strings.TrimSpace(v))
+		return p, err == nil && p > 0
+	default:
+		return 0, false
+	}
+}
+
+// containerPorts returns the container ports of the container in a stable order.
+func containerPorts(resource *datamodel.ContainerResource) []networkingv1.NetworkPolicyPort {
+	values := []int{}
+	for _, port := range resource.Properties.Container.Ports {
+		values = append(values, int(port.ContainerPort))
+	}
+	sort.Ints(values)
+
+	ports := []networkingv1.NetworkPolicyPort{}
+	for _, p := range values {
+		ports = append(ports, makePort(corev1.ProtocolTCP, p))
+	}
+
+	return ports
+}
+
+func makePort(protocol corev1.Protocol, port int) networkingv1.NetworkPolicyPort {
+	p := intstr.FromInt(port)
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &p}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	dynamicrpdm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	applicationID = "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-app"
+	backendID     = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/backend"
+	extenderID    = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/extenders/cache"
+)
+
+var _ renderers.Renderer = (*noop)(nil)
+
+type noop struct {
+}
+
+func (r *noop) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *noop) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "frontend",
+			Namespace: "test-namespace",
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+	}
+	resources := []rpv1.OutputResource{rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, &deployment, deployment.ObjectMeta)}
+	return renderers.RendererOutput{Resources: resources}, nil
+}
+
+func Test_Render_Success(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource("frontend", map[string]datamodel.ContainerPort{"web": {ContainerPort: 3000}})
+	resource.Properties.Connections = map[string]datamodel.ConnectionProperties{
+		"backend": {Source: backendID},
+		"cache":   {Source: extenderID},
+		"search":  {Source: "http://10.0.0.8:9200"},
+	}
+	resource.Properties.Extensions = []datamodel.Extension{
+		{Kind: datamodel.NetworkPolicy, NetworkPolicy: &datamodel.NetworkPolicyExtension{IngressNamespaces: []string{"radius-system"}}},
+	}
+
+	dependencies := map[string]renderers.RendererDependency{
+		backendID: {
+			Resource: makeResource("backend", map[string]datamodel.ContainerPort{"api": {ContainerPort: 8080}}),
+		},
+		extenderID: {
+			Resource:       &datamodel.Extender{},
+			ComputedValues: map[string]any{"port": float64(6379), "host": "redis.cache-ns.svc.cluster.local"},
+		},
+	}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	policyOutput := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDNetworkPolicy, policyOutput.LocalID)
+	require.Equal(t, []string{rpv1.LocalIDDeployment}, policyOutput.CreateResource.Dependencies)
+
+	policy, ok := policyOutput.CreateResource.Data.(*networkingv1.NetworkPolicy)
+	require.True(t, ok)
+	require.Equal(t, "frontend", policy.Name)
+	require.Equal(t, "test-namespace", policy.Namespace)
+	require.Equal(t, kubernetes.MakeSelectorLabels("test-app", "frontend"), policy.Spec.PodSelector.MatchLabels)
+	require.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+
+	expectedIngress := []networkingv1.NetworkPolicyIngressRule{
+		{
+			From: []networkingv1.NetworkPolicyPeer{
+				{PodSelector: &metav1.LabelSelector{MatchLabels: kubernetes.MakeSelectorLabels("test-app", "")}},
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "radius-system"}}},
+			},
+			Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 3000)},
+		},
+	}
+	require.Equal(t, expectedIngress, policy.Spec.Ingress)
+
+	expectedEgress := []networkingv1.NetworkPolicyEgressRule{
+		{Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolUDP, dnsPort), makePort(corev1.ProtocolTCP, dnsPort)}},
+		{
+			To:    []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: kubernetes.MakeSelectorLabels("test-app", "backend")}}},
+			Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 8080)},
+		},
+		{
+			To:    []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{namespaceNameLabel: "cache-ns"}}}},
+			Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 6379)},
+		},
+		{
+			To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.8/32"}}},
+			Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 9200)},
+		},
+	}
+	require.Equal(t, expectedEgress, policy.Spec.Egress)
+}
+
+func Test_Render_NoPortsDeniesIngress(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource("frontend", nil)
+	resource.Properties.Extensions = []datamodel.Extension{
+		{Kind: datamodel.NetworkPolicy, NetworkPolicy: &datamodel.NetworkPolicyExtension{}},
+	}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	policy := output.Resources[1].CreateResource.Data.(*networkingv1.NetworkPolicy)
+	require.Empty(t, policy.Spec.Ingress)
+	require.Len(t, policy.Spec.Egress, 1)
+}
+
+func Test_Render_IPAddressRestrictsDestination(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource("frontend", nil)
+	resource.Properties.Connections = map[string]datamodel.ConnectionProperties{
+		"database": {Source: "tcp://10.0.0.4:5432"},
+	}
+	resource.Properties.Extensions = []datamodel.Extension{
+		{Kind: datamodel.NetworkPolicy, NetworkPolicy: &datamodel.NetworkPolicyExtension{}},
+	}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	policy := output.Resources[1].CreateResource.Data.(*networkingv1.NetworkPolicy)
+	require.Equal(t, networkingv1.NetworkPolicyEgressRule{
+		To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.4/32"}}},
+		Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 5432)},
+	}, policy.Spec.Egress[1])
+}
+
+func Test_Render_ResourceIPAddressRestrictsDestination(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	resource := makeResource("frontend", nil)
+	resource.Properties.Connections = map[string]datamodel.ConnectionProperties{
+		"cache": {Source: extenderID},
+	}
+	resource.Properties.Extensions = []datamodel.Extension{
+		{Kind: datamodel.NetworkPolicy, NetworkPolicy: &datamodel.NetworkPolicyExtension{}},
+	}
+
+	dependencies := map[string]renderers.RendererDependency{
+		extenderID: {
+			Resource: &dynamicrpdm.DynamicResource{
+				Properties: map[string]any{"host": "10.0.0.5", "port": 6379},
+			},
+		},
+	}
+
+	output, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies})
+	require.NoError(t, err)
+
+	policy := output.Resources[1].CreateResource.Data.(*networkingv1.NetworkPolicy)
+	require.Equal(t, networkingv1.NetworkPolicyEgressRule{
+		To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.5/32"}}},
+		Ports: []networkingv1.NetworkPolicyPort{makePort(corev1.ProtocolTCP, 6379)},
+	}, policy.Spec.Egress[1])
+}
+
+func Test_Render_UnrestrictableConnection(t *testing.T) {
+	const azureID = "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Microsoft.Cache/redis/cache"
+
+	tests := []struct {
+		name         string
+		source       string
+		dependencies map[string]renderers.RendererDependency
+		expectedErr  string
+	}{
+		{
+			name:        "non-Radius resource",
+			source:      azureID,
+			expectedErr: `can't restrict the egress of connection "cache" to ` + azureID + " because it is not a Radius resource",
+		},
+		{
+			name:        "URL with host name",
+			source:      "http://cache.example.com:6379",
+			expectedErr: `can't restrict the egress of connection "cache" to http://cache.example.com:6379 because host names can't be used in a network policy`,
+		},
+		{
+			name:   "resource without port",
+			source: extenderID,
+			dependencies: map[string]renderers.RendererDependency{
+				extenderID: {Resource: &datamodel.Extender{}},
+			},
+			expectedErr: `can't restrict the egress of connection "cache" to ` + extenderID + " because the resource has no port",
+		},
+		{
+			name:   "resource without host",
+			source: extenderID,
+			dependencies: map[string]renderers.RendererDependency{
+				extenderID: {Resource: &datamodel.Extender{}, ComputedValues: map[string]any{"port": float64(6379)}},
+			},
+			expectedErr: `can't restrict the egress of connection "cache" to ` + extenderID + " because the host of the resource is neither an IP address nor a Kubernetes service",
+		},
+		{
+			name:   "resource with external host name",
+			source: extenderID,
+			dependencies: map[string]renderers.RendererDependency{
+				extenderID: {Resource: &datamodel.Extender{}, ComputedValues: map[string]any{"port": float64(6379), "host": "cache.redis.cache.windows.net"}},
+			},
+			expectedErr: `can't restrict the egress of connection "cache" to ` + extenderID + " because the host of the resource is neither an IP address nor a Kubernetes service",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := &Renderer{Inner: &noop{}}
+
+			resource := makeResource("frontend", nil)
+			resource.Properties.Connections = map[string]datamodel.ConnectionProperties{
+				"cache": {Source: tt.source},
+			}
+			resource.Properties.Extensions = []datamodel.Extension{
+				{Kind: datamodel.NetworkPolicy, NetworkPolicy: &datamodel.NetworkPolicyExtension{}},
+			}
+
+			_, err := renderer.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: tt.dependencies})
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_Render_NoExtension(t *testing.T) {
+	renderer := &Renderer{Inner: &noop{}}
+
+	output, err := renderer.Render(context.Background(), makeResource("frontend", nil), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 1)
+}
+
+func makeResource(name string, ports map[string]datamodel.ContainerPort) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/subscriptions/test-sub-id/resourceGroups/test-group/providers/Applications.Core/containers/" + name,
+				Name: name,
+				Type: "Applications.Core/containers",
+			},
+		},
+		Properties: datamodel.ContainerProperties{
+			BasicResourceProperties: rpv1.BasicResourceProperties{
+				Application: applicationID,
+			},
+			Container: datamodel.Container{
+				Image: "someimage:latest",
+				Ports: ports,
+			},
+		},
+	}
+}
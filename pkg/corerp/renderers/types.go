@@ -56,6 +56,9 @@ type RendererDependency struct {
 	// ComputedValues is a map of the computed values and secrets of the dependency.
 	ComputedValues map[string]any
 
+	// SecretKeys are the keys of ComputedValues whose values are secrets of the dependency.
+	SecretKeys []string
+
 	// OutputResources is a map of the output resource IDs of the dependency. The map is keyed on the LocalID of the output resource.
 	OutputResources map[string]resources.ID
 }
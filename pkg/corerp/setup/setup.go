@@ -208,8 +208,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	})
 
 	_ = ns.AddResource("extenders", &builder.ResourceOption[*datamodel.Extender, datamodel.Extender]{
-		RequestConverter:  converter.ExtenderDataModelFromVersioned,
-		ResponseConverter: converter.ExtenderDataModelToVersioned,
+		RequestConverter:   converter.ExtenderDataModelFromVersioned,
+		ResponseConverter:  converter.ExtenderDataModelToVersioned,
+		PatchBaseConverter: converter.ExtenderDataModelToVersionedWithSecrets,
 
 		Put: builder.Operation[datamodel.Extender]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.Extender]{
@@ -95,10 +95,22 @@ func NewSensitiveDataHandlerFromProvider(ctx context.Context, provider KeyProvid
 // Returns an error if any field encryption fails. In case of error, partial encryption may have occurred.
 // Fields that are not found are skipped - this allows optional sensitive fields to be absent.
 func (h *SensitiveDataHandler) EncryptSensitiveFields(data map[string]any, sensitiveFieldPaths []string, resourceID string) error {
+	return h.EncryptSensitiveFieldsWithPrevious(data, nil, sensitiveFieldPaths, resourceID)
+}
+
+// EncryptSensitiveFieldsWithPrevious encrypts all sensitive fields in the data like EncryptSensitiveFields,
+// except for values that are identical to the encrypted value stored at the same path of previous. previous
+// is the stored (encrypted) version of the same resource, and may be nil.
+//
+// This keeps the values that an update carried over from the stored resource, for example the fields that a
+// merge patch didn't change, without encrypting them twice. Every other value of a sensitive field is encrypted,
+// whatever its shape.
+func (h *SensitiveDataHandler) EncryptSensitiveFieldsWithPrevious(data map[string]any, previous map[string]any, sensitiveFieldPaths []string, resourceID string) error {
 	for _, path := range sensitiveFieldPaths {
 		// Build associated data from resource ID and field path
 		ad := buildAssociatedData(resourceID, path)
-		if err := h.encryptFieldAtPath(data, path, ad); err != nil {
+		stored := h.storedValuesAtPath(previous, path)
+		if err := h.encryptFieldAtPath(data, path, ad, stored); err != nil {
 			// Skip fields that are not found - they may not exist in this resource instance
 			// (e.g., optional sensitive properties)
 			if errors.Is(err, ErrFieldNotFound) {
@@ -110,6 +122,23 @@ func (h *SensitiveDataHandler) EncryptSensitiveFields(data map[string]any, sensi
 	return nil
 }
 
+// storedValuesAtPath returns the JSON encoding of the encrypted values stored at the given field path of previous.
+func (h *SensitiveDataHandler) storedValuesAtPath(previous map[string]any, path string) map[string]struct{} {
+	stored := map[string]struct{}{}
+	if previous == nil {
+		return stored
+	}
+
+	_ = h.processFieldAtPath(previous, path, func(value any) (any, error) {
+		if b, err := json.Marshal(value); err == nil && IsEncryptedData(b) {
+			stored[string(b)] = struct{}{}
+		}
+		return value, nil
+	})
+
+	return stored
+}
+
 // DecryptSensitiveFields decrypts all sensitive fields in the data based on the provided field paths.
 // The data is modified in place. Field paths support dot notation and [*] for arrays/maps.
 //
@@ -190,9 +219,17 @@ func (h *SensitiveDataHandler) getEncryptorForDecryption(ctx context.Context, en
 	return NewEncryptorWithVersion(key, version)
 }
 
-// encryptFieldAtPath encrypts the value at the given field path in the data.
-func (h *SensitiveDataHandler) encryptFieldAtPath(data map[string]any, path string, associatedData []byte) error {
+// encryptFieldAtPath encrypts the value at the given field path in the data. Values that are in stored are
+// kept as they are.
+func (h *SensitiveDataHandler) encryptFieldAtPath(data map[string]any, path string, associatedData []byte, stored map[string]struct{}) error {
 	processor := func(value any) (any, error) {
+		if len(stored) > 0 && value != nil {
+			if b, err := json.Marshal(value); err == nil {
+				if _, ok := stored[string(b)]; ok {
+					return value, nil
+				}
+			}
+		}
 		return h.encryptValue(value, associatedData)
 	}
 	return h.processFieldAtPath(data, path, processor)
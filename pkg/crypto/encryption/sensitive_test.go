@@ -64,6 +64,42 @@ func TestSensitiveDataHandler_EncryptDecrypt_SimpleField(t *testing.T) {
 	require.Equal(t, "admin", data["username"])
 }
 
+func TestSensitiveDataHandler_EncryptWithPrevious(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	handler, err := NewSensitiveDataHandlerFromKey(key)
+	require.NoError(t, err)
+
+	previous := map[string]any{
+		"password": "super-secret-password",
+		"token":    "super-secret-token",
+	}
+	err = handler.EncryptSensitiveFields(previous, []string{"password", "token"}, testResourceID)
+	require.NoError(t, err)
+
+	// password is carried over from the stored resource, token is set by the client to a value that
+	// looks like an encrypted value.
+	lookalike := map[string]any{}
+	for k, v := range previous["password"].(map[string]any) {
+		lookalike[k] = v
+	}
+	data := map[string]any{
+		"password": previous["password"],
+		"token":    lookalike,
+	}
+
+	err = handler.EncryptSensitiveFieldsWithPrevious(data, previous, []string{"password", "token"}, testResourceID)
+	require.NoError(t, err)
+
+	require.Equal(t, previous["password"], data["password"])
+	require.NotEqual(t, lookalike, data["token"])
+
+	err = handler.DecryptSensitiveFields(context.Background(), data, []string{"password"}, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, "super-secret-password", data["password"])
+}
+
 func TestSensitiveDataHandler_EncryptDecrypt_NestedField(t *testing.T) {
 	key, err := GenerateKey()
 	require.NoError(t, err)
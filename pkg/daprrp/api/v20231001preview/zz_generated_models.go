@@ -413,6 +413,30 @@ type DaprStateStoreResourceUpdate struct {
 	Type *string
 }
 
+// DockerCompute - The Docker compute configuration
+type DockerCompute struct {
+	// REQUIRED; Discriminator property for EnvironmentCompute.
+	Kind *string
+
+	// Configuration for supported external identity providers
+	Identity *IdentitySettings
+
+	// The Docker network the containers of the environment are attached to. Defaults to the name of the environment.
+	Network *string
+
+	// The resource id of the compute resource for application environment.
+	ResourceID *string
+}
+
+// GetEnvironmentCompute implements the EnvironmentComputeClassification interface for type DockerCompute.
+func (d *DockerCompute) GetEnvironmentCompute() *EnvironmentCompute {
+	return &EnvironmentCompute{
+		Identity:   d.Identity,
+		Kind:       d.Kind,
+		ResourceID: d.ResourceID,
+	}
+}
+
 // EnvironmentCompute - Represents backing compute resource
 type EnvironmentCompute struct {
 	// REQUIRED; Discriminator property for EnvironmentCompute.
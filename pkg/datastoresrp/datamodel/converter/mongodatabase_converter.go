@@ -37,6 +37,25 @@ func MongoDatabaseDataModelToVersioned(model *datamodel.MongoDatabase, version s
 	}
 }
 
+// MongoDatabaseDataModelToVersionedWithSecrets converts a datamodel.MongoDatabase to a versioned model like
+// MongoDatabaseDataModelToVersioned, but keeps the secrets so that the result can be used as the base of a PATCH request.
+func MongoDatabaseDataModelToVersionedWithSecrets(model *datamodel.MongoDatabase, version string) (v1.VersionedModelInterface, error) {
+	versioned, err := MongoDatabaseDataModelToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource, ok := versioned.(*v20231001preview.MongoDatabaseResource); ok && !model.Properties.Secrets.IsEmpty() {
+		secrets := &v20231001preview.MongoDatabaseSecrets{}
+		if err := secrets.ConvertFrom(&model.Properties.Secrets); err != nil {
+			return nil, err
+		}
+		resource.Properties.Secrets = secrets
+	}
+
+	return versioned, nil
+}
+
 // MongoDatabaseDataModelFromVersioned takes in a byte slice and a version string and returns a Mongo database instance and
 // an error if the version is unsupported.
 func MongoDatabaseDataModelFromVersioned(content []byte, version string) (*datamodel.MongoDatabase, error) {
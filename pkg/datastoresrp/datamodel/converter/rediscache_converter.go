@@ -42,6 +42,25 @@ func RedisCacheDataModelToVersioned(model *datamodel.RedisCache, version string)
 	}
 }
 
+// RedisCacheDataModelToVersionedWithSecrets converts a datamodel.RedisCache to a versioned model like
+// RedisCacheDataModelToVersioned, but keeps the secrets so that the result can be used as the base of a PATCH request.
+func RedisCacheDataModelToVersionedWithSecrets(model *datamodel.RedisCache, version string) (v1.VersionedModelInterface, error) {
+	versioned, err := RedisCacheDataModelToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource, ok := versioned.(*v20231001preview.RedisCacheResource); ok && !model.Properties.Secrets.IsEmpty() {
+		secrets := &v20231001preview.RedisCacheSecrets{}
+		if err := secrets.ConvertFrom(&model.Properties.Secrets); err != nil {
+			return nil, err
+		}
+		resource.Properties.Secrets = secrets
+	}
+
+	return versioned, nil
+}
+
 // RedisCacheDataModelFromVersioned converts a versioned Redis cache resource to a datamodel.RedisCache and returns an error
 // if the conversion fails.
 func RedisCacheDataModelFromVersioned(content []byte, version string) (*datamodel.RedisCache, error) {
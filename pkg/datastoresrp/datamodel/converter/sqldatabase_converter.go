@@ -38,6 +38,25 @@ func SqlDatabaseDataModelToVersioned(model *datamodel.SqlDatabase, version strin
 	}
 }
 
+// SqlDatabaseDataModelToVersionedWithSecrets converts a datamodel.SqlDatabase to a versioned model like
+// SqlDatabaseDataModelToVersioned, but keeps the secrets so that the result can be used as the base of a PATCH request.
+func SqlDatabaseDataModelToVersionedWithSecrets(model *datamodel.SqlDatabase, version string) (v1.VersionedModelInterface, error) {
+	versioned, err := SqlDatabaseDataModelToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource, ok := versioned.(*v20231001preview.SQLDatabaseResource); ok && !model.Properties.Secrets.IsEmpty() {
+		secrets := &v20231001preview.SQLDatabaseSecrets{}
+		if err := secrets.ConvertFrom(&model.Properties.Secrets); err != nil {
+			return nil, err
+		}
+		resource.Properties.Secrets = secrets
+	}
+
+	return versioned, nil
+}
+
 // SqlDatabaseDataModelFromVersioned takes in a byte slice and a version string and returns a SqlDatabase object and an
 // error if one occurs.
 func SqlDatabaseDataModelFromVersioned(content []byte, version string) (*datamodel.SqlDatabase, error) {
@@ -47,8 +47,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	ns := builder.NewNamespace("Applications.Datastores")
 
 	_ = ns.AddResource("redisCaches", &builder.ResourceOption[*datamodel.RedisCache, datamodel.RedisCache]{
-		RequestConverter:  converter.RedisCacheDataModelFromVersioned,
-		ResponseConverter: converter.RedisCacheDataModelToVersioned,
+		RequestConverter:   converter.RedisCacheDataModelFromVersioned,
+		ResponseConverter:  converter.RedisCacheDataModelToVersioned,
+		PatchBaseConverter: converter.RedisCacheDataModelToVersionedWithSecrets,
 
 		Put: builder.Operation[datamodel.RedisCache]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RedisCache]{
@@ -85,8 +86,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	})
 
 	_ = ns.AddResource("mongoDatabases", &builder.ResourceOption[*datamodel.MongoDatabase, datamodel.MongoDatabase]{
-		RequestConverter:  converter.MongoDatabaseDataModelFromVersioned,
-		ResponseConverter: converter.MongoDatabaseDataModelToVersioned,
+		RequestConverter:   converter.MongoDatabaseDataModelFromVersioned,
+		ResponseConverter:  converter.MongoDatabaseDataModelToVersioned,
+		PatchBaseConverter: converter.MongoDatabaseDataModelToVersionedWithSecrets,
 
 		Put: builder.Operation[datamodel.MongoDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.MongoDatabase]{
@@ -123,8 +125,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	})
 
 	_ = ns.AddResource("sqlDatabases", &builder.ResourceOption[*datamodel.SqlDatabase, datamodel.SqlDatabase]{
-		RequestConverter:  converter.SqlDatabaseDataModelFromVersioned,
-		ResponseConverter: converter.SqlDatabaseDataModelToVersioned,
+		RequestConverter:   converter.SqlDatabaseDataModelFromVersioned,
+		ResponseConverter:  converter.SqlDatabaseDataModelToVersioned,
+		PatchBaseConverter: converter.SqlDatabaseDataModelToVersionedWithSecrets,
 
 		Put: builder.Operation[datamodel.SqlDatabase]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.SqlDatabase]{
@@ -0,0 +1,413 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package docker implements a minimal client for the Docker Engine API, which is also served by Podman. It is used
+// by the Docker runtime to run the containers of applications on a developer machine without Kubernetes.
+package docker
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	dockerparser "github.com/novln/docker-parser"
+)
+
+const (
+	// APIVersion is the version of the Docker Engine API used by the client. It is supported by Docker 20.10 and
+	// later, and by the Docker compatible API of Podman.
+	APIVersion = "1.41"
+
+	// HostEnvVar is the environment variable that configures the Docker host.
+	HostEnvVar = "DOCKER_HOST"
+
+	// CertPathEnvVar is the environment variable that configures the directory of the TLS files of the Docker host:
+	// the CA certificate of the host (ca.pem), and the client certificate (cert.pem) and key (key.pem).
+	CertPathEnvVar = "DOCKER_CERT_PATH"
+
+	// TLSVerifyEnvVar is the environment variable that enables TLS for the Docker host.
+	TLSVerifyEnvVar = "DOCKER_TLS_VERIFY"
+)
+
+// Client is the interface for the operations of the Docker Engine API used by Radius.
+//
+//go:generate mockgen -typed -destination=./mock_client.go -package=docker -self_package github.com/radius-project/radius/pkg/docker github.com/radius-project/radius/pkg/docker Client
+type Client interface {
+	// ImageExists returns true if the image is present on the Docker host.
+	ImageExists(ctx context.Context, image string) (bool, error)
+
+	// PullImage pulls the image from its registry.
+	PullImage(ctx context.Context, image string) error
+
+	// InspectContainer returns the state of the container with the given name or ID.
+	InspectContainer(ctx context.Context, name string) (*ContainerInspect, error)
+
+	// CreateContainer creates a container with the given name and returns its ID.
+	CreateContainer(ctx context.Context, name string, config *ContainerConfig) (string, error)
+
+	// StartContainer starts the container with the given name or ID.
+	StartContainer(ctx context.Context, name string) error
+
+	// CopyToContainer extracts a tar archive into the directory at path in the container with the given name or ID.
+	CopyToContainer(ctx context.Context, name string, path string, archive io.Reader) error
+
+	// RemoveContainer stops and removes the container with the given name or ID.
+	RemoveContainer(ctx context.Context, name string) error
+
+	// InspectNetwork returns the network with the given name or ID.
+	InspectNetwork(ctx context.Context, name string) (*NetworkInspect, error)
+
+	// CreateNetwork creates a network.
+	CreateNetwork(ctx context.Context, config *NetworkConfig) error
+
+	// CreateVolume creates a volume. Creating a volume that already exists is a no-op.
+	CreateVolume(ctx context.Context, config *VolumeConfig) error
+
+	// RemoveVolume removes the volume with the given name.
+	RemoveVolume(ctx context.Context, name string) error
+}
+
+// Error is an error returned by the Docker Engine API.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Message is the error message returned by the Docker host.
+	Message string
+}
+
+// Error returns the error message.
+func (e *Error) Error() string {
+	return fmt.Sprintf("docker: %s (status %d)", e.Message, e.StatusCode)
+}
+
+// IsNotFound returns true if the error is returned by the Docker Engine API for an object that doesn't exist.
+func IsNotFound(err error) bool {
+	apiErr := &Error{}
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict returns true if the error is returned by the Docker Engine API for an object that already exists.
+func IsConflict(err error) bool {
+	apiErr := &Error{}
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewClientFromEnv creates a client for the Docker host configured by the DOCKER_HOST environment variable. A nil
+// client is returned if it's not set, since the Docker socket is not available to the Radius control plane unless
+// it's configured explicitly. Like the Docker CLI, TLS is used if DOCKER_TLS_VERIFY or DOCKER_CERT_PATH is set.
+// No connection is made until the client is used.
+func NewClientFromEnv() (Client, error) {
+	host := os.Getenv(HostEnvVar)
+	if host == "" {
+		return nil, nil
+	}
+
+	if os.Getenv(TLSVerifyEnvVar) == "" && os.Getenv(CertPathEnvVar) == "" {
+		return NewClient(host)
+	}
+
+	config, err := LoadTLSConfig(os.Getenv(CertPathEnvVar))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTLSClient(host, config)
+}
+
+// NewClient creates a client for the Docker host, which is either a unix socket (unix:///var/run/docker.sock)
+// or a TCP address (tcp://localhost:2375). No connection is made until the client is used.
+func NewClient(host string) (Client, error) {
+	return newClient(host, nil)
+}
+
+// NewTLSClient creates a client for a Docker host that is reached over TLS with the given configuration, for example
+// tcp://docker.example.com:2376. No connection is made until the client is used.
+func NewTLSClient(host string, config *tls.Config) (Client, error) {
+	return newClient(host, config)
+}
+
+func newClient(host string, config *tls.Config) (Client, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid docker host %q: %w", host, err)
+	}
+
+	switch u.Scheme {
+	case "unix":
+		if config != nil {
+			return nil, fmt.Errorf("docker host %q is a unix socket, TLS is only supported for tcp hosts", host)
+		}
+
+		socket := u.Path
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		}
+		return &client{httpClient: &http.Client{Transport: transport}, baseURL: "http://docker/v" + APIVersion}, nil
+
+	case "tcp", "http", "https":
+		if config == nil && u.Scheme != "https" {
+			return &client{httpClient: &http.Client{}, baseURL: "http://" + u.Host + "/v" + APIVersion}, nil
+		}
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		return &client{httpClient: &http.Client{Transport: transport}, baseURL: "https://" + u.Host + "/v" + APIVersion}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported docker host %q, only unix and tcp hosts are supported", host)
+	}
+}
+
+// LoadTLSConfig loads the TLS configuration of a Docker host from the files in dir, with the names used by the
+// Docker CLI: the CA certificate of the host (ca.pem) and the client certificate (cert.pem) and key (key.pem). The
+// certificate of the host is always verified, against ca.pem if it exists or the system roots otherwise.
+func LoadTLSConfig(dir string) (*tls.Config, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("%s must be set to the directory of the TLS files of the docker host", CertPathEnvVar)
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	ca, err := os.ReadFile(filepath.Join(dir, "ca.pem"))
+	if err == nil {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("failed to parse the docker CA certificate %q", filepath.Join(dir, "ca.pem"))
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read the docker CA certificate: %w", err)
+	}
+
+	certificate, err := tls.LoadX509KeyPair(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the docker client certificate: %w", err)
+	}
+	config.Certificates = []tls.Certificate{certificate}
+
+	return config, nil
+}
+
+// ImageExists returns true if the image is present on the Docker host.
+func (c *client) ImageExists(ctx context.Context, image string) (bool, error) {
+	err := c.do(ctx, http.MethodGet, "/images/"+image+"/json", nil, nil, nil)
+	if IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// PullImage pulls the image from its registry. The tag defaults to latest, since the Docker Engine API pulls every
+// tag of the repository otherwise.
+func (c *client) PullImage(ctx context.Context, image string) error {
+	reference, err := dockerparser.Parse(image)
+	if err != nil {
+		return fmt.Errorf("invalid image %q: %w", image, err)
+	}
+
+	query := url.Values{"fromImage": []string{reference.Remote()}}
+	response, err := c.send(ctx, http.MethodPost, "/images/create", query, nil)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	// The progress of the pull is streamed as JSON messages, and errors that happen after the response is
+	// started are reported in the stream.
+	decoder := json.NewDecoder(response.Body)
+	for {
+		message := struct {
+			Error string `json:"error"`
+		}{}
+		err := decoder.Decode(&message)
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read the progress of the pull of %q: %w", image, err)
+		}
+
+		if message.Error != "" {
+			return fmt.Errorf("failed to pull %q: %s", image, message.Error)
+		}
+	}
+}
+
+// InspectContainer returns the state of the container with the given name or ID.
+func (c *client) InspectContainer(ctx context.Context, name string) (*ContainerInspect, error) {
+	container := &ContainerInspect{}
+	err := c.do(ctx, http.MethodGet, "/containers/"+name+"/json", nil, nil, container)
+	if err != nil {
+		return nil, err
+	}
+
+	return container, nil
+}
+
+// CreateContainer creates a container with the given name and returns its ID.
+func (c *client) CreateContainer(ctx context.Context, name string, config *ContainerConfig) (string, error) {
+	created := struct {
+		ID string `json:"Id"`
+	}{}
+	err := c.do(ctx, http.MethodPost, "/containers/create", url.Values{"name": []string{name}}, config, &created)
+	if err != nil {
+		return "", err
+	}
+
+	return created.ID, nil
+}
+
+// StartContainer starts the container with the given name or ID. Starting a running container is a no-op.
+func (c *client) StartContainer(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodPost, "/containers/"+name+"/start", nil, nil, nil)
+}
+
+// CopyToContainer extracts a tar archive into the directory at path in the container with the given name or ID.
+func (c *client) CopyToContainer(ctx context.Context, name string, path string, archive io.Reader) error {
+	return c.do(ctx, http.MethodPut, "/containers/"+name+"/archive", url.Values{"path": []string{path}}, archive, nil)
+}
+
+// RemoveContainer stops and removes the container with the given name or ID, along with its anonymous volumes.
+func (c *client) RemoveContainer(ctx context.Context, name string) error {
+	query := url.Values{"force": []string{"true"}, "v": []string{"true"}}
+	return c.do(ctx, http.MethodDelete, "/containers/"+name, query, nil, nil)
+}
+
+// InspectNetwork returns the network with the given name or ID.
+func (c *client) InspectNetwork(ctx context.Context, name string) (*NetworkInspect, error) {
+	network := &NetworkInspect{}
+	err := c.do(ctx, http.MethodGet, "/networks/"+name, nil, nil, network)
+	if err != nil {
+		return nil, err
+	}
+
+	return network, nil
+}
+
+// CreateNetwork creates a network.
+func (c *client) CreateNetwork(ctx context.Context, config *NetworkConfig) error {
+	return c.do(ctx, http.MethodPost, "/networks/create", nil, config, nil)
+}
+
+// CreateVolume creates a volume. Creating a volume that already exists is a no-op.
+func (c *client) CreateVolume(ctx context.Context, config *VolumeConfig) error {
+	return c.do(ctx, http.MethodPost, "/volumes/create", nil, config, nil)
+}
+
+// RemoveVolume removes the volume with the given name.
+func (c *client) RemoveVolume(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, "/volumes/"+name, nil, nil, nil)
+}
+
+// do sends a request and decodes the JSON response into out if it's not nil.
+func (c *client) do(ctx context.Context, method string, path string, query url.Values, in any, out any) error {
+	response, err := c.send(ctx, method, path, query, in)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, response.Body)
+		return nil
+	}
+
+	err = json.NewDecoder(response.Body).Decode(out)
+	if err != nil {
+		return fmt.Errorf("failed to decode the response of %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// send sends a request and returns the response if it's successful, or an *Error otherwise. Readers are sent as
+// tar archives, other values as JSON.
+func (c *client) send(ctx context.Context, method string, path string, query url.Values, in any) (*http.Response, error) {
+	var body io.Reader
+	contentType := ""
+	switch in := in.(type) {
+	case nil:
+	case io.Reader:
+		body = in
+		contentType = "application/x-tar"
+	default:
+		b, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+		contentType = "application/json"
+	}
+
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the docker host: %w", err)
+	}
+
+	// Starting a running container returns 304 Not Modified.
+	if response.StatusCode < 300 || response.StatusCode == http.StatusNotModified {
+		return response, nil
+	}
+	defer response.Body.Close()
+
+	apiErr := &Error{StatusCode: response.StatusCode}
+	b, _ := io.ReadAll(response.Body)
+	message := struct {
+		Message string `json:"message"`
+	}{}
+	if json.Unmarshal(b, &message) == nil && message.Message != "" {
+		apiErr.Message = message.Message
+	} else {
+		apiErr.Message = strings.TrimSpace(string(b))
+	}
+
+	return nil, apiErr
+}
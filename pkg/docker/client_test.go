@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewClient(t *testing.T) {
+	tests := []struct {
+		host    string
+		baseURL string
+		err     string
+	}{
+		{host: "unix:///var/run/docker.sock", baseURL: "http://docker/v1.41"},
+		{host: "tcp://localhost:2375", baseURL: "http://localhost:2375/v1.41"},
+		{host: "https://docker.example.com:2376", baseURL: "https://docker.example.com:2376/v1.41"},
+		{host: "npipe:////./pipe/docker_engine", err: "unsupported docker host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			c, err := NewClient(tt.host)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.baseURL, c.(*client).baseURL)
+		})
+	}
+}
+
+func Test_NewClientFromEnv(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		t.Setenv(HostEnvVar, "")
+		c, err := NewClientFromEnv()
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv(HostEnvVar, "tcp://localhost:2375")
+		c, err := NewClientFromEnv()
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost:2375/v1.41", c.(*client).baseURL)
+	})
+}
+
+func Test_NewClientFromEnv_TLS(t *testing.T) {
+	// The Docker host requires a client certificate, like a Docker daemon started with --tlsverify.
+	clientCertificate, clientKey := newTestCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCertificate)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1.41/containers/frontend/json", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(ContainerInspect{ID: "abc"})
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	writePEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", server.Certificate().Raw)
+	writePEM(t, filepath.Join(dir, "cert.pem"), "CERTIFICATE", clientCertificate.Raw)
+	keyBytes, err := x509.MarshalECPrivateKey(clientKey)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "key.pem"), "EC PRIVATE KEY", keyBytes)
+
+	t.Setenv(HostEnvVar, "tcp://"+server.Listener.Addr().String())
+	t.Setenv(TLSVerifyEnvVar, "1")
+	t.Setenv(CertPathEnvVar, dir)
+
+	c, err := NewClientFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, "https://"+server.Listener.Addr().String()+"/v1.41", c.(*client).baseURL)
+
+	container, err := c.InspectContainer(testcontext.New(t), "frontend")
+	require.NoError(t, err)
+	require.Equal(t, "abc", container.ID)
+
+	t.Run("missing client certificate", func(t *testing.T) {
+		t.Setenv(CertPathEnvVar, t.TempDir())
+		_, err := NewClientFromEnv()
+		require.ErrorContains(t, err, "failed to load the docker client certificate")
+	})
+
+	t.Run("missing cert path", func(t *testing.T) {
+		t.Setenv(CertPathEnvVar, "")
+		_, err := NewClientFromEnv()
+		require.ErrorContains(t, err, "DOCKER_CERT_PATH must be set")
+	})
+
+	t.Run("unix socket", func(t *testing.T) {
+		_, err := NewTLSClient("unix:///var/run/docker.sock", &tls.Config{})
+		require.ErrorContains(t, err, "TLS is only supported for tcp hosts")
+	})
+}
+
+func newTestCertificate(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "radius"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return certificate, key
+}
+
+func writePEM(t *testing.T, path string, blockType string, bytes []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600))
+}
+
+func Test_Client(t *testing.T) {
+	requests := []string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1.41/containers/frontend/json":
+			_ = json.NewEncoder(w).Encode(ContainerInspect{ID: "abc", State: ContainerState{Status: "running", Running: true}})
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1.41/containers/"):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"message":"No such container"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.41/containers/create":
+			config := ContainerConfig{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+			require.Equal(t, "nginx", config.Image)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"Id":"def"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.41/containers/frontend/start":
+			w.WriteHeader(http.StatusNotModified)
+		case r.Method == http.MethodPut && r.URL.Path == "/v1.41/containers/frontend/archive":
+			require.Equal(t, "application/x-tar", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, "archive", string(body))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1.41/images/create":
+			if r.URL.Query().Get("fromImage") == "docker.io/library/missing:latest" {
+				_, _ = fmt.Fprint(w, `{"status":"Pulling"}`+"\n"+`{"error":"manifest unknown"}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"status":"Pulling"}`+"\n"+`{"status":"Downloaded"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = fmt.Fprint(w, "unexpected request")
+		}
+	})
+
+	// The client is tested over a unix socket, like the default Docker host.
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+	server := httptest.NewUnstartedServer(mux)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	c, err := NewClient("unix://" + socket)
+	require.NoError(t, err)
+	ctx := testcontext.New(t)
+
+	container, err := c.InspectContainer(ctx, "frontend")
+	require.NoError(t, err)
+	require.Equal(t, "abc", container.ID)
+	require.True(t, container.State.Running)
+
+	_, err = c.InspectContainer(ctx, "backend")
+	require.True(t, IsNotFound(err))
+	require.EqualError(t, err, "docker: No such container (status 404)")
+
+	id, err := c.CreateContainer(ctx, "frontend", &ContainerConfig{Image: "nginx"})
+	require.NoError(t, err)
+	require.Equal(t, "def", id)
+
+	require.NoError(t, c.CopyToContainer(ctx, "frontend", "/", strings.NewReader("archive")))
+
+	require.NoError(t, c.StartContainer(ctx, "frontend"))
+
+	require.NoError(t, c.PullImage(ctx, "nginx"))
+	require.EqualError(t, c.PullImage(ctx, "missing"), `failed to pull "missing": manifest unknown`)
+
+	err = c.RemoveVolume(ctx, "data")
+	require.False(t, IsNotFound(err))
+	require.EqualError(t, err, "docker: unexpected request (status 500)")
+
+	require.Equal(t, []string{
+		"GET /v1.41/containers/frontend/json",
+		"GET /v1.41/containers/backend/json",
+		"POST /v1.41/containers/create?name=frontend",
+		"PUT /v1.41/containers/frontend/archive?path=%2F",
+		"POST /v1.41/containers/frontend/start",
+		"POST /v1.41/images/create?fromImage=docker.io%2Flibrary%2Fnginx%3Alatest",
+		"POST /v1.41/images/create?fromImage=docker.io%2Flibrary%2Fmissing%3Alatest",
+		"DELETE /v1.41/volumes/data",
+	}, requests)
+}
+
+func Test_IsValidObjectName(t *testing.T) {
+	require.True(t, IsValidObjectName("my-env"))
+	require.True(t, IsValidObjectName("My_env.1"))
+	require.False(t, IsValidObjectName("-env"))
+	require.False(t, IsValidObjectName("my env"))
+	require.False(t, IsValidObjectName(""))
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"regexp"
+)
+
+// objectNamePattern is the pattern of the names of containers, networks and volumes accepted by Docker.
+var objectNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// IsValidObjectName returns true if the name is a valid name for a Docker container, network or volume.
+func IsValidObjectName(name string) bool {
+	return objectNamePattern.MatchString(name)
+}
+
+// ContainerConfig is the configuration of a container passed to the create operation of the Docker Engine API.
+type ContainerConfig struct {
+	Image            string              `json:"Image"`
+	Entrypoint       []string            `json:"Entrypoint,omitempty"`
+	Cmd              []string            `json:"Cmd,omitempty"`
+	WorkingDir       string              `json:"WorkingDir,omitempty"`
+	Env              []string            `json:"Env,omitempty"`
+	Labels           map[string]string   `json:"Labels,omitempty"`
+	ExposedPorts     map[string]struct{} `json:"ExposedPorts,omitempty"`
+	Healthcheck      *HealthConfig       `json:"Healthcheck,omitempty"`
+	HostConfig       HostConfig          `json:"HostConfig"`
+	NetworkingConfig NetworkingConfig    `json:"NetworkingConfig"`
+
+	// Secrets are the contents of the files written to the container before it's started, keyed by their absolute
+	// path. They are not part of the configuration sent to the Docker host, so they don't show up when the
+	// container is inspected.
+	Secrets map[string]string `json:"-"`
+}
+
+// HealthConfig is the health check of a container. Durations are in nanoseconds.
+type HealthConfig struct {
+	Test        []string `json:"Test,omitempty"`
+	Interval    int64    `json:"Interval,omitempty"`
+	Timeout     int64    `json:"Timeout,omitempty"`
+	StartPeriod int64    `json:"StartPeriod,omitempty"`
+	Retries     int      `json:"Retries,omitempty"`
+}
+
+// HostConfig is the host dependent configuration of a container.
+type HostConfig struct {
+	PortBindings  map[string][]PortBinding `json:"PortBindings,omitempty"`
+	Mounts        []Mount                  `json:"Mounts,omitempty"`
+	RestartPolicy RestartPolicy            `json:"RestartPolicy"`
+}
+
+// PortBinding binds a port of a container to a port of the host. An empty HostPort lets Docker choose a free port.
+type PortBinding struct {
+	HostIP   string `json:"HostIp,omitempty"`
+	HostPort string `json:"HostPort"`
+}
+
+// Mount is a volume or tmpfs mounted into a container.
+type Mount struct {
+	// Type is either "volume" or "tmpfs".
+	Type     string `json:"Type"`
+	Source   string `json:"Source,omitempty"`
+	Target   string `json:"Target"`
+	ReadOnly bool   `json:"ReadOnly,omitempty"`
+}
+
+// RestartPolicy is the restart policy of a container.
+type RestartPolicy struct {
+	Name string `json:"Name,omitempty"`
+}
+
+// NetworkingConfig is the configuration of the networks a container is attached to when it's created.
+type NetworkingConfig struct {
+	EndpointsConfig map[string]EndpointSettings `json:"EndpointsConfig,omitempty"`
+}
+
+// EndpointSettings is the configuration of the attachment of a container to a network.
+type EndpointSettings struct {
+	// Aliases are the DNS names of the container in the network.
+	Aliases []string `json:"Aliases,omitempty"`
+}
+
+// ContainerInspect is the state of a container returned by the inspect operation of the Docker Engine API.
+type ContainerInspect struct {
+	ID              string          `json:"Id"`
+	Name            string          `json:"Name"`
+	Config          ContainerConfig `json:"Config"`
+	State           ContainerState  `json:"State"`
+	NetworkSettings NetworkSettings `json:"NetworkSettings"`
+}
+
+// ContainerState is the runtime state of a container.
+type ContainerState struct {
+	// Status is one of "created", "running", "paused", "restarting", "removing", "exited" or "dead".
+	Status   string        `json:"Status"`
+	Running  bool          `json:"Running"`
+	ExitCode int           `json:"ExitCode"`
+	Error    string        `json:"Error"`
+	Health   *HealthStatus `json:"Health,omitempty"`
+}
+
+// HealthStatus is the result of the health check of a container.
+type HealthStatus struct {
+	// Status is one of "starting", "healthy" or "unhealthy".
+	Status string `json:"Status"`
+}
+
+// NetworkSettings are the network settings of a running container.
+type NetworkSettings struct {
+	// Ports maps the ports of the container, such as "80/tcp", to the ports of the host they are published on.
+	Ports map[string][]PortBinding `json:"Ports"`
+}
+
+// NetworkConfig is the configuration of a network passed to the create operation of the Docker Engine API.
+type NetworkConfig struct {
+	Name   string            `json:"Name"`
+	Driver string            `json:"Driver,omitempty"`
+	Labels map[string]string `json:"Labels,omitempty"`
+}
+
+// NetworkInspect is a network returned by the inspect operation of the Docker Engine API.
+type NetworkInspect struct {
+	ID     string            `json:"Id"`
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels"`
+}
+
+// VolumeConfig is the configuration of a volume passed to the create operation of the Docker Engine API.
+type VolumeConfig struct {
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels,omitempty"`
+}
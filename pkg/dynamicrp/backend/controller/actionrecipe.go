@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+// RecipeActionController is the async operation controller to run the custom actions of dynamic resources that are
+// handled by recipes.
+type RecipeActionController struct {
+	ctrl.BaseController
+	engine engine.Engine
+}
+
+// NewRecipeActionController creates a new RecipeActionController.
+func NewRecipeActionController(opts ctrl.Options, engine engine.Engine) (ctrl.Controller, error) {
+	return &RecipeActionController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		engine:         engine,
+	}, nil
+}
+
+// Run executes the recipe of the pending action of the resource, and records the resources and values output by the
+// recipe in status.actions of the resource. Resources output by a previous run of the same action that are not
+// output anymore are deleted.
+func (c *RecipeActionController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	action := resource.PendingAction
+	if action == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: "The resource has no pending action to run.",
+		}), nil
+	}
+
+	actionStatuses, err := resource.ActionStatuses()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	previousState := []string{}
+	for _, outputResource := range actionStatuses[action.Name].OutputResources {
+		previousState = append(previousState, outputResource.ID.String())
+	}
+
+	metadata := resource.ResourceMetadata()
+	recipeOutput, err := c.engine.Execute(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: recipes.ResourceMetadata{
+				Name:          action.RecipeName,
+				ApplicationID: metadata.ApplicationID(),
+				EnvironmentID: metadata.EnvironmentID(),
+				ResourceID:    request.ResourceID,
+				Properties:    resource.Properties,
+				Parameters:    action.Input,
+			},
+		},
+		PreviousState: previousState,
+	})
+	if err != nil {
+		var recipeErr *recipes.RecipeError
+		if errors.As(err, &recipeErr) {
+			return c.clearPendingAction(ctx, request, resource, obj.ETag, ctrl.NewFailedResult(recipeErr.ErrorDetails))
+		}
+		return ctrl.Result{}, err
+	}
+
+	actionStatus := datamodel.ActionStatus{RecipeName: action.RecipeName}
+	if recipeOutput != nil {
+		actionStatus.OutputResources, err = processors.GetOutputResourcesFromRecipe(recipeOutput)
+		if err != nil {
+			return c.clearPendingAction(ctx, request, resource, obj.ETag, ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeInvalid, Message: err.Error()}))
+		}
+		actionStatus.ComputedValues = recipeOutput.Values
+	}
+
+	if err := resource.SetActionStatus(action.Name, actionStatus); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return c.clearPendingAction(ctx, request, resource, obj.ETag, ctrl.Result{})
+}
+
+// clearPendingAction removes the pending action from the resource and saves it, then returns the given result.
+func (c *RecipeActionController) clearPendingAction(ctx context.Context, request *ctrl.Request, resource *datamodel.DynamicResource, etag string, result ctrl.Result) (ctrl.Result, error) {
+	resource.PendingAction = nil
+
+	update := &database.Object{
+		Metadata: database.Metadata{ID: request.ResourceID},
+		Data:     resource,
+	}
+	if err := c.DatabaseClient().Save(ctx, update, database.WithETag(etag)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return result, nil
+}
+
+// deleteActionOutputResources deletes the resources deployed by the recipes of the custom actions of the resource
+// when the resource is deleted.
+func (c *DynamicResourceController) deleteActionOutputResources(ctx context.Context, request *ctrl.Request) error {
+	operationType, ok := v1.ParseOperationType(request.OperationType)
+	if !ok || operationType.Method != v1.OperationDelete {
+		return nil
+	}
+
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	actionStatuses, err := resource.ActionStatuses()
+	if err != nil {
+		return err
+	}
+
+	metadata := resource.ResourceMetadata()
+	for _, name := range slices.Sorted(maps.Keys(actionStatuses)) {
+		actionStatus := actionStatuses[name]
+		if len(actionStatus.OutputResources) == 0 {
+			continue
+		}
+
+		err := c.engine.Delete(ctx, engine.DeleteOptions{
+			BaseOptions: engine.BaseOptions{
+				Recipe: recipes.ResourceMetadata{
+					Name:          actionStatus.RecipeName,
+					ApplicationID: metadata.ApplicationID(),
+					EnvironmentID: metadata.EnvironmentID(),
+					ResourceID:    request.ResourceID,
+					Properties:    resource.Properties,
+				},
+			},
+			OutputResources: actionStatus.OutputResources,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete the resources deployed by action %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testActionOutputID         = "/planes/kubernetes/local/namespaces/default/providers/core/Secret/rotated"
+	testPreviousActionOutputID = "/planes/kubernetes/local/namespaces/default/providers/core/Secret/previous"
+)
+
+func saveTestActionResource(t *testing.T, databaseClient database.Client, resource *datamodel.DynamicResource) {
+	t.Helper()
+
+	resource.ID = testResourceID
+	err := databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: testResourceID},
+		Data:     resource,
+	})
+	require.NoError(t, err)
+}
+
+func getTestActionResource(t *testing.T, databaseClient database.Client) *datamodel.DynamicResource {
+	t.Helper()
+
+	obj, err := databaseClient.Get(context.Background(), testResourceID)
+	require.NoError(t, err)
+
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	return resource
+}
+
+func Test_RecipeActionController_Run(t *testing.T) {
+	request := &ctrl.Request{
+		ResourceID:    testResourceID,
+		OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPost}.String(),
+	}
+
+	t.Run("runs the pending action", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := inmemory.NewClient()
+
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"environment": "env"},
+			PendingAction: &datamodel.ResourceAction{
+				Name:       "rotate",
+				RecipeName: "rotate",
+				Input:      map[string]any{"reason": "expired"},
+			},
+		}
+		require.NoError(t, resource.SetActionStatus("rotate", datamodel.ActionStatus{
+			RecipeName:      "rotate",
+			OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(testPreviousActionOutputID)}},
+		}))
+		saveTestActionResource(t, databaseClient, resource)
+
+		recipeEngine := engine.NewMockEngine(mctrl)
+		recipeEngine.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.ExecuteOptions) (*recipes.RecipeOutput, error) {
+				require.Equal(t, "rotate", opts.Recipe.Name)
+				require.Equal(t, map[string]any{"reason": "expired"}, opts.Recipe.Parameters)
+				require.Equal(t, []string{testPreviousActionOutputID}, opts.PreviousState)
+
+				return &recipes.RecipeOutput{
+					Resources: []string{testActionOutputID},
+					Values:    map[string]any{"rotatedAt": "2025-01-01T00:00:00Z"},
+					Secrets:   map[string]any{"password": "new-secret"},
+				}, nil
+			})
+
+		controller, err := NewRecipeActionController(ctrl.Options{DatabaseClient: databaseClient}, recipeEngine)
+		require.NoError(t, err)
+
+		result, err := controller.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, ctrl.Result{}, result)
+
+		saved := getTestActionResource(t, databaseClient)
+		require.Nil(t, saved.PendingAction)
+
+		actionStatuses, err := saved.ActionStatuses()
+		require.NoError(t, err)
+		require.Equal(t, "rotate", actionStatuses["rotate"].RecipeName)
+		require.Len(t, actionStatuses["rotate"].OutputResources, 1)
+		require.Equal(t, testActionOutputID, actionStatuses["rotate"].OutputResources[0].ID.String())
+
+		// Secrets output by the recipe are not stored.
+		require.Equal(t, map[string]any{"rotatedAt": "2025-01-01T00:00:00Z"}, actionStatuses["rotate"].ComputedValues)
+	})
+
+	t.Run("recipe failure", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		databaseClient := inmemory.NewClient()
+		saveTestActionResource(t, databaseClient, &datamodel.DynamicResource{
+			PendingAction: &datamodel.ResourceAction{Name: "rotate", RecipeName: "rotate"},
+		})
+
+		recipeErr := &recipes.RecipeError{ErrorDetails: v1.ErrorDetails{Code: recipes.RecipeDeploymentFailed, Message: "failed"}}
+		recipeEngine := engine.NewMockEngine(mctrl)
+		recipeEngine.EXPECT().
+			Execute(gomock.Any(), gomock.Any()).
+			Return(nil, recipeErr)
+
+		controller, err := NewRecipeActionController(ctrl.Options{DatabaseClient: databaseClient}, recipeEngine)
+		require.NoError(t, err)
+
+		result, err := controller.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, ctrl.NewFailedResult(recipeErr.ErrorDetails), result)
+		require.Nil(t, getTestActionResource(t, databaseClient).PendingAction)
+	})
+
+	t.Run("no pending action", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		saveTestActionResource(t, databaseClient, &datamodel.DynamicResource{})
+
+		controller, err := NewRecipeActionController(ctrl.Options{DatabaseClient: databaseClient}, nil)
+		require.NoError(t, err)
+
+		result, err := controller.Run(context.Background(), request)
+		require.NoError(t, err)
+		require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	})
+}
+
+func Test_DynamicResourceController_deleteActionOutputResources(t *testing.T) {
+	setup := func(t *testing.T, recipeEngine engine.Engine) *DynamicResourceController {
+		databaseClient := inmemory.NewClient()
+
+		resource := &datamodel.DynamicResource{}
+		require.NoError(t, resource.SetActionStatus("rotate", datamodel.ActionStatus{
+			RecipeName:      "rotate",
+			OutputResources: []rpv1.OutputResource{{ID: resources.MustParse(testActionOutputID)}},
+		}))
+		require.NoError(t, resource.SetActionStatus("notify", datamodel.ActionStatus{RecipeName: "notify"}))
+		saveTestActionResource(t, databaseClient, resource)
+
+		controller, err := NewDynamicResourceController(ctrl.Options{DatabaseClient: databaseClient}, nil, recipeEngine, nil)
+		require.NoError(t, err)
+		return controller.(*DynamicResourceController)
+	}
+
+	t.Run("DELETE deletes the output resources of actions", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		recipeEngine := engine.NewMockEngine(mctrl)
+		recipeEngine.EXPECT().
+			Delete(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, opts engine.DeleteOptions) error {
+				require.Equal(t, "rotate", opts.Recipe.Name)
+				require.Len(t, opts.OutputResources, 1)
+				require.Equal(t, testActionOutputID, opts.OutputResources[0].ID.String())
+				return nil
+			})
+
+		controller := setup(t, recipeEngine)
+		request := &ctrl.Request{
+			ResourceID:    testResourceID,
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationDelete}.String(),
+		}
+
+		require.NoError(t, controller.deleteActionOutputResources(context.Background(), request))
+	})
+
+	t.Run("PUT does not delete", func(t *testing.T) {
+		mctrl := gomock.NewController(t)
+		controller := setup(t, engine.NewMockEngine(mctrl))
+		request := &ctrl.Request{
+			ResourceID:    testResourceID,
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPut}.String(),
+		}
+
+		require.NoError(t, controller.deleteActionOutputResources(context.Background(), request))
+	})
+}
@@ -21,10 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/schema"
@@ -43,15 +45,20 @@ type DynamicResourceController struct {
 	ucp                 *v20231001preview.ClientFactory
 	engine              engine.Engine
 	configurationLoader configloader.ConfigurationLoader
+
+	// referenceRetryInterval is the delay before an operation waiting for the resources referenced by the resource
+	// runs again.
+	referenceRetryInterval time.Duration
 }
 
 // NewDynamicResourceController creates a new DynamicResourcePutController.
 func NewDynamicResourceController(opts ctrl.Options, ucp *v20231001preview.ClientFactory, engine engine.Engine, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
 	return &DynamicResourceController{
-		BaseController:      ctrl.NewBaseAsyncController(opts),
-		ucp:                 ucp,
-		engine:              engine,
-		configurationLoader: configurationLoader,
+		BaseController:         ctrl.NewBaseAsyncController(opts),
+		ucp:                    ucp,
+		engine:                 engine,
+		configurationLoader:    configurationLoader,
+		referenceRetryInterval: defaultReferenceRetryInterval,
 	}, nil
 }
 
@@ -65,6 +72,31 @@ func (c *DynamicResourceController) Run(ctx context.Context, request *ctrl.Reque
 		}), nil
 	}
 
+	// Resources are deployed after the resources they reference. While a referenced resource is being provisioned, the
+	// operation runs again later so that it doesn't hold a worker or the lease on the resource.
+	pending, err := c.checkReferences(ctx, request)
+	if err != nil {
+		var clientErr *v1.ErrClientRP
+		if errors.As(err, &clientErr) {
+			return ctrl.NewFailedResult(v1.ErrorDetails{
+				Code:    clientErr.Code,
+				Message: clientErr.Message,
+			}), nil
+		}
+		return ctrl.Result{}, err
+	} else if pending {
+		return ctrl.NewRequeueAfterResult(c.referenceRetryInterval), nil
+	}
+
+	// Resources deployed by the recipes of custom actions are deleted with the resource.
+	if err := c.deleteActionOutputResources(ctx, request); err != nil {
+		var recipeErr *recipes.RecipeError
+		if errors.As(err, &recipeErr) {
+			return ctrl.NewFailedResult(recipeErr.ErrorDetails), nil
+		}
+		return ctrl.Result{}, err
+	}
+
 	// This is where we have the opportunity to branch out to different controllers based on:
 	// - The operation type. (eg: PUT, DELETE, etc)
 	// - The capabilities of the resource type. (eg: Does it support recipes?)
@@ -102,12 +134,16 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipeDeleteController(options, c.engine, c.configurationLoader)
 
-	case v1.OperationPut:
+	case v1.OperationPut, v1.OperationPatch:
 		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
 			return NewInertPutController(options)
 		}
 		return NewRecipePutController(options, c.engine, c.configurationLoader)
 
+	case v1.OperationPost:
+		// Custom actions are queued by the frontend only when they are handled by a recipe.
+		return NewRecipeActionController(options, c.engine)
+
 	default:
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
@@ -120,7 +156,8 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return err
 	}
 
-	if operationContext.Method != v1.OperationPut {
+	// PATCH requests are validated after the merge patch has been applied to the stored resource.
+	if operationContext.Method != v1.OperationPut && operationContext.Method != v1.OperationPatch {
 		return nil
 	}
 
@@ -65,6 +65,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &InertPutController{}, selected)
 	})
 
+	t.Run("inert PATCH", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + inertResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: inertResourceType, Method: v1.OperationPatch}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &InertPutController{}, selected)
+	})
+
 	t.Run("inert DELETE", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
@@ -91,6 +104,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipePutController{}, selected)
 	})
 
+	t.Run("recipe PATCH", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPatch}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipePutController{}, selected)
+	})
+
 	t.Run("recipe DELETE", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
@@ -104,6 +130,19 @@ func Test_DynamicResourceController_selectController(t *testing.T) {
 		require.IsType(t, &RecipeDeleteController{}, selected)
 	})
 
+	t.Run("recipe action POST", func(t *testing.T) {
+		controller := setup()
+		request := &ctrl.Request{
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource",
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPost}.String(),
+		}
+
+		selected, err := controller.selectController(context.Background(), request)
+		require.NoError(t, err)
+
+		require.IsType(t, &RecipeActionController{}, selected)
+	})
+
 	t.Run("unknown operation", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
@@ -277,7 +316,7 @@ func TestDynamicResourceController_validateRequestSchema(t *testing.T) {
 		return controller.(*DynamicResourceController)
 	}
 
-	t.Run("skip validation for non-PUT operations", func(t *testing.T) {
+	t.Run("skip validation for non-PUT and non-PATCH operations", func(t *testing.T) {
 		controller := setup()
 		request := &ctrl.Request{
 			ResourceID:    "/planes/radius/local/resourceGroups/test-group/providers/" + inertResourceType + "/test-resource",
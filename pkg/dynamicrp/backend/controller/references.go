@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	dynamicdatamodel "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// defaultReferenceRetryInterval is the default delay before an operation waiting for the resources referenced by
+// the resource runs again.
+const defaultReferenceRetryInterval = 5 * time.Second
+
+// checkReferences checks whether the resources referenced by the resource have been provisioned, so that a resource
+// is deployed after the resources it references. Returns true when a referenced resource is still being provisioned.
+// References are declared by the resource type schema with x-radius-reference and recorded in the status of the
+// resource when it is saved.
+//
+// An error is returned when a referenced resource no longer exists or failed to provision.
+func (c *DynamicResourceController) checkReferences(ctx context.Context, request *ctrl.Request) (bool, error) {
+	operationType, ok := v1.ParseOperationType(request.OperationType)
+	if !ok || (operationType.Method != v1.OperationPut && operationType.Method != v1.OperationPatch) {
+		return false, nil
+	}
+
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get resource %q: %w", request.ResourceID, err)
+	}
+
+	resource := &dynamicdatamodel.DynamicResource{}
+	err = obj.As(resource)
+	if err != nil {
+		return false, fmt.Errorf("failed to read resource %q: %w", request.ResourceID, err)
+	}
+
+	pending := false
+	for _, reference := range resource.References() {
+		// The resource is being provisioned, so it would wait for itself.
+		if strings.EqualFold(reference.ID, request.ResourceID) {
+			continue
+		}
+
+		provisioned, err := c.checkReference(ctx, reference)
+		if err != nil {
+			return false, err
+		}
+
+		pending = pending || !provisioned
+	}
+
+	return pending, nil
+}
+
+// checkReference returns true when the referenced resource has reached a terminal provisioning state.
+func (c *DynamicResourceController) checkReference(ctx context.Context, reference dynamicdatamodel.ResourceReference) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	obj, err := c.DatabaseClient().Get(ctx, reference.ID)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return false, &v1.ErrClientRP{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("The resource %q referenced by the field %q does not exist.", reference.ID, reference.Path),
+		}
+	} else if err != nil {
+		return false, fmt.Errorf("failed to get resource %q referenced by the field %q: %w", reference.ID, reference.Path, err)
+	}
+
+	referenced := &v1.BaseResource{}
+	err = obj.As(referenced)
+	if err != nil {
+		return false, fmt.Errorf("failed to read resource %q referenced by the field %q: %w", reference.ID, reference.Path, err)
+	}
+
+	state := referenced.ProvisioningState()
+	if !state.IsTerminal() {
+		logger.Info("Waiting for referenced resource to be provisioned", "resourceId", reference.ID, "provisioningState", state)
+		return false, nil
+	}
+
+	if state == v1.ProvisioningStateFailed || state == v1.ProvisioningStateCanceled {
+		return false, &v1.ErrClientRP{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("The resource %q referenced by the field %q is in the %q state.", reference.ID, reference.Path, state),
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID   = "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+	testReferencedID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/db"
+)
+
+func Test_DynamicResourceController_checkReferences(t *testing.T) {
+	setup := func(t *testing.T, references []any, referencedState v1.ProvisioningState) *DynamicResourceController {
+		databaseClient := inmemory.NewClient()
+		err := databaseClient.Save(context.Background(), &database.Object{
+			Metadata: database.Metadata{ID: testResourceID},
+			Data: map[string]any{
+				"id":         testResourceID,
+				"properties": map[string]any{"status": map[string]any{"references": references}},
+			},
+		})
+		require.NoError(t, err)
+
+		if referencedState != "" {
+			err = databaseClient.Save(context.Background(), &database.Object{
+				Metadata: database.Metadata{ID: testReferencedID},
+				Data:     map[string]any{"id": testReferencedID, "provisioningState": string(referencedState)},
+			})
+			require.NoError(t, err)
+		}
+
+		controller, err := NewDynamicResourceController(ctrl.Options{DatabaseClient: databaseClient}, nil, nil, nil)
+		require.NoError(t, err)
+		return controller.(*DynamicResourceController)
+	}
+
+	putRequest := &ctrl.Request{
+		ResourceID:    testResourceID,
+		OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationPut}.String(),
+	}
+	references := []any{map[string]any{"path": "database", "id": testReferencedID}}
+
+	t.Run("no references", func(t *testing.T) {
+		controller := setup(t, []any{}, "")
+		pending, err := controller.checkReferences(context.Background(), putRequest)
+		require.NoError(t, err)
+		require.False(t, pending)
+	})
+
+	t.Run("referenced resource provisioned", func(t *testing.T) {
+		controller := setup(t, references, v1.ProvisioningStateSucceeded)
+		pending, err := controller.checkReferences(context.Background(), putRequest)
+		require.NoError(t, err)
+		require.False(t, pending)
+	})
+
+	t.Run("referenced resource is being provisioned", func(t *testing.T) {
+		controller := setup(t, references, v1.ProvisioningStateUpdating)
+		pending, err := controller.checkReferences(context.Background(), putRequest)
+		require.NoError(t, err)
+		require.True(t, pending)
+	})
+
+	t.Run("referenced resource failed", func(t *testing.T) {
+		controller := setup(t, references, v1.ProvisioningStateFailed)
+
+		_, err := controller.checkReferences(context.Background(), putRequest)
+		require.Equal(t, &v1.ErrClientRP{
+			Code:    v1.CodeInvalid,
+			Message: `The resource "` + testReferencedID + `" referenced by the field "database" is in the "Failed" state.`,
+		}, err)
+	})
+
+	t.Run("referenced resource deleted", func(t *testing.T) {
+		controller := setup(t, references, "")
+
+		_, err := controller.checkReferences(context.Background(), putRequest)
+		require.Equal(t, &v1.ErrClientRP{
+			Code:    v1.CodeInvalid,
+			Message: `The resource "` + testReferencedID + `" referenced by the field "database" does not exist.`,
+		}, err)
+	})
+
+	t.Run("DELETE does not wait", func(t *testing.T) {
+		controller := setup(t, references, v1.ProvisioningStateUpdating)
+		request := &ctrl.Request{
+			ResourceID:    testResourceID,
+			OperationType: v1.OperationType{Type: recipeResourceType, Method: v1.OperationDelete}.String(),
+		}
+
+		pending, err := controller.checkReferences(context.Background(), request)
+		require.NoError(t, err)
+		require.False(t, pending)
+	})
+}
@@ -22,10 +22,13 @@ import (
 	"fmt"
 	"strings"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/resourceutil"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"golang.org/x/exp/slices"
@@ -81,7 +84,8 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 }
 
 // addOutputValuestoResourceProperties adds the computed values and secret values to the resource properties.
-// It retrieves the schema of the resource type and filters out the values that are not part of the schema.
+// It retrieves the schema of the resource type and filters out the values that are not part of the schema. Values
+// that don't match the schema of their property are rejected.
 func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resource *datamodel.DynamicResource, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference) error {
 
 	ID, err := resources.Parse(resource.ID)
@@ -101,9 +105,9 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 	// Filter out the basic properties from the resource properties
 	// This is to avoid overwriting the properties like application, environment etc when they are added as computed values or secret values.
 	resourceProps := []string{}
-	schema := apiVersionResource.APIVersionResource.Properties.Schema
-	if schema != nil {
-		if properties, ok := schema["properties"].(map[string]any); ok {
+	resourceSchema := apiVersionResource.APIVersionResource.Properties.Schema
+	if resourceSchema != nil {
+		if properties, ok := resourceSchema["properties"].(map[string]any); ok {
 			for key := range properties {
 				if !slices.Contains(resourceutil.BasicProperties, key) {
 					resourceProps = append(resourceProps, key)
@@ -112,9 +116,13 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 		}
 	}
 
-	// Add the computed values to the resource properties if they are part of the schema.
+	// Add the computed values to the resource properties if they are part of the schema. The schema of the property
+	// is the type of the output, so values that don't match it are rejected.
 	for key, value := range computedValues {
 		if slices.Contains(resourceProps, key) {
+			if err := schema.ValidateOutputValue(key, value, resourceSchema); err != nil {
+				return &v1.ErrClientRP{Code: recipes.InvalidRecipeOutputs, Message: err.Error()}
+			}
 			resource.Properties[key] = value
 		}
 	}
@@ -122,6 +130,9 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 	// Add the secret values to the resource properties if they are part of the schema.
 	for key, value := range secretValues {
 		if slices.Contains(resourceProps, key) {
+			if err := schema.ValidateOutputValue(key, value.Value, resourceSchema); err != nil {
+				return &v1.ErrClientRP{Code: recipes.InvalidRecipeOutputs, Message: err.Error()}
+			}
 			resource.Properties[key] = value.Value
 		}
 	}
@@ -170,6 +170,54 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, application, properties["application"])
 	})
 
+	t.Run("typed outputs", func(t *testing.T) {
+		typedClientFactory, err := testUCPClientFactoryWithSchema(map[string]any{
+			"properties": map[string]any{
+				"host": map[string]any{"type": "string", "readOnly": true},
+				"port": map[string]any{"type": "integer", "readOnly": true},
+			},
+		})
+		require.NoError(t, err)
+
+		newResource := func() *datamodel.DynamicResource {
+			return &datamodel.DynamicResource{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testRecipeResources/test-resource",
+						Type: "Applications.Test/testRecipeResources",
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: "2024-01-01",
+					},
+				},
+				Properties: map[string]any{},
+			}
+		}
+
+		resource := newResource()
+		err = processor.Process(context.Background(), resource, processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{Values: map[string]any{"host": hostname, "port": port}},
+			UcpClient:    typedClientFactory,
+		})
+		require.NoError(t, err)
+
+		bs, err := json.Marshal(resource.Properties)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"host": "test-hostname", "port": 1234, "status": {"computedValues": {"host": "test-hostname", "port": 1234}}}`, string(bs))
+
+		resource = newResource()
+		err = processor.Process(context.Background(), resource, processors.Options{
+			RecipeOutput: &recipes.RecipeOutput{Values: map[string]any{"host": hostname, "port": "not-a-port"}},
+			UcpClient:    typedClientFactory,
+		})
+		require.Error(t, err)
+
+		clientErr, ok := err.(*v1.ErrClientRP)
+		require.True(t, ok)
+		require.Equal(t, recipes.InvalidRecipeOutputs, clientErr.Code)
+		require.Contains(t, clientErr.Message, `output "port" does not match the schema of the resource type`)
+	})
+
 	t.Run("invalid resource id", func(t *testing.T) {
 		resource := &datamodel.DynamicResource{}
 		options := processors.Options{
@@ -199,21 +247,25 @@ func Test_Process(t *testing.T) {
 }
 
 func testUCPClientFactory() (*v20231001preview.ClientFactory, error) {
+	return testUCPClientFactoryWithSchema(map[string]any{
+		"properties": map[string]any{
+			"environment": map[string]any{},
+			"application": map[string]any{},
+			"host":        map[string]any{},
+			"database":    map[string]any{},
+			"port":        map[string]any{},
+			"username":    map[string]any{},
+		},
+	})
+}
+
+func testUCPClientFactoryWithSchema(schema map[string]any) (*v20231001preview.ClientFactory, error) {
 	apiVersionServer := fake.APIVersionsServer{
 		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
 			response := v20231001preview.APIVersionsClientGetResponse{
 				APIVersionResource: v20231001preview.APIVersionResource{
 					Properties: &v20231001preview.APIVersionProperties{
-						Schema: map[string]any{
-							"properties": map[string]any{
-								"environment": map[string]any{},
-								"application": map[string]any{},
-								"host":        map[string]any{},
-								"database":    map[string]any{},
-								"port":        map[string]any{},
-								"username":    map[string]any{},
-							},
-						},
+						Schema: schema,
 					},
 				},
 			}
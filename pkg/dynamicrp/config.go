@@ -27,6 +27,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/components/trace/traceservice"
+	"github.com/radius-project/radius/pkg/policy"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"gopkg.in/yaml.v3"
@@ -36,6 +37,9 @@ import (
 //
 // For testability, all fields on this struct MUST be parsable from YAML without any further initialization required.
 type Config struct {
+	// Actions configures the custom actions of resource types.
+	Actions ActionsOptions `yaml:"actions"`
+
 	// Bicep configures properties for the Bicep recipe driver.
 	Bicep hostoptions.BicepOptions `yaml:"bicep"`
 
@@ -54,6 +58,9 @@ type Config struct {
 	// Metrics is the configuration for the metrics endpoint.
 	Metrics metricsservice.Options `yaml:"metricsProvider"`
 
+	// Policies is the list of organization policies evaluated when dynamic resources are created or updated.
+	Policies []policy.Policy `yaml:"policies"`
+
 	// Profiler is the configuration for the profiler endpoint.
 	Profiler profilerservice.Options `yaml:"profilerProvider"`
 
@@ -79,6 +86,22 @@ type Config struct {
 	Worker hostoptions.WorkerServerOptions `yaml:"workerServer"`
 }
 
+// ActionsOptions configures the custom actions of resource types.
+type ActionsOptions struct {
+	// Webhook configures the actions that are handled by webhooks.
+	Webhook WebhookOptions `yaml:"webhook"`
+}
+
+// WebhookOptions configures the calls to the webhooks that handle custom actions. Webhook actions are rejected
+// unless both AllowedHosts and SigningKeyFile are set.
+type WebhookOptions struct {
+	// AllowedHosts is the list of host names that webhooks may be called on. A leading "*." matches any subdomain.
+	AllowedHosts []string `yaml:"allowedHosts"`
+
+	// SigningKeyFile is the path of the file holding the key used to sign the body of webhook requests with HMAC-SHA256.
+	SigningKeyFile string `yaml:"signingKeyFile"`
+}
+
 // LoadConfig loads a Config from bytes.
 func LoadConfig(bs []byte) (*Config, error) {
 	decoder := yaml.NewDecoder(bytes.NewBuffer(bs))
@@ -40,6 +40,35 @@ type DynamicResource struct {
 
 	// Properties stores the properties of the resource being tracked.
 	Properties map[string]any `json:"properties"`
+
+	// PendingAction is the custom action that has been queued for the backend to run on the resource.
+	// It is not part of the API model.
+	PendingAction *ResourceAction `json:"pendingAction,omitempty"`
+}
+
+// ResourceAction is a custom action of a resource that is handled by a recipe and run by the backend.
+type ResourceAction struct {
+	// Name is the name of the action.
+	Name string `json:"name"`
+
+	// RecipeName is the name of the recipe that handles the action.
+	RecipeName string `json:"recipeName"`
+
+	// Input is the input of the action, passed to the recipe as parameters.
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// ActionStatus is the status of the last run of a custom action handled by a recipe. It is stored in
+// status.actions.<action name> of the resource.
+type ActionStatus struct {
+	// RecipeName is the name of the recipe that handles the action.
+	RecipeName string `json:"recipeName"`
+
+	// OutputResources are the resources deployed by the recipe. They are deleted with the resource.
+	OutputResources []rpv1.OutputResource `json:"outputResources,omitempty"`
+
+	// ComputedValues are the values output by the recipe. Secrets output by the recipe are not stored.
+	ComputedValues map[string]any `json:"computedValues,omitempty"`
 }
 
 // Status() returns the status of the resource.
@@ -68,6 +97,50 @@ func (d *DynamicResource) Status() map[string]any {
 	return status
 }
 
+// ActionStatuses returns the status of the custom actions that have been run on the resource, by action name.
+func (d *DynamicResource) ActionStatuses() (map[string]ActionStatus, error) {
+	result := map[string]ActionStatus{}
+	obj, ok := d.Status()["actions"]
+	if !ok {
+		return result, nil
+	}
+
+	bs, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal action status: %w", err)
+	}
+
+	if err := json.Unmarshal(bs, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal action status: %w", err)
+	}
+
+	return result, nil
+}
+
+// SetActionStatus stores the status of the last run of the named custom action in status.actions.<name>.
+func (d *DynamicResource) SetActionStatus(name string, actionStatus ActionStatus) error {
+	status := d.Status()
+
+	bs, err := json.Marshal(actionStatus)
+	if err != nil {
+		return fmt.Errorf("failed to marshal action status: %w", err)
+	}
+
+	store := map[string]any{}
+	if err := json.Unmarshal(bs, &store); err != nil {
+		return fmt.Errorf("failed to unmarshal action status: %w", err)
+	}
+
+	actions, ok := status["actions"].(map[string]any)
+	if !ok {
+		actions = map[string]any{}
+		status["actions"] = actions
+	}
+	actions[name] = store
+
+	return nil
+}
+
 // GetRecipe implements datamodel.RecipeDataModel.
 func (d *DynamicResource) GetRecipe() *portableresources.ResourceRecipe {
 	defaultRecipe := &portableresources.ResourceRecipe{Name: portableresources.DefaultRecipeName}
@@ -262,6 +335,18 @@ func (d *dynamicResourceBasicPropertiesAdapter) SetResourceStatus(status rpv1.Re
 	maps.Copy(existingStatus, marshaledResourceStatus)
 }
 
+// SetPolicyViolations records the violations of audit-mode policies in the status of the resource. They are stored
+// with the rest of the status managed by Radius, so that they are kept when the backend updates the status.
+func (d *DynamicResource) SetPolicyViolations(violations []rpv1.PolicyViolation) {
+	adapter := d.ResourceMetadata()
+	status := adapter.GetResourceStatus()
+	status.PolicyViolations = violations
+
+	// The status is merged into the existing status, so violations that no longer apply must be removed first.
+	delete(d.Status(), "policyViolations")
+	adapter.SetResourceStatus(status)
+}
+
 // GetComputedValues returns the computed values from the status map.
 func (d *DynamicResource) GetComputedValues() map[string]any {
 	status := d.Status()
@@ -301,3 +386,65 @@ func (d *DynamicResource) GetSecrets() map[string]rpv1.SecretValueReference {
 
 	return secretsMap
 }
+
+// referencesStatusKey is the key in the resource status where the references to other resources are recorded.
+const referencesStatusKey = "references"
+
+// ResourceReference is a reference from a property of a dynamic resource to another resource. References are
+// declared by the resource type schema with x-radius-reference.
+type ResourceReference struct {
+	// Path is the path of the property that holds the reference.
+	Path string `json:"path"`
+
+	// ID is the resource ID of the referenced resource.
+	ID string `json:"id"`
+}
+
+// References returns the references to other resources recorded in the status map.
+func (d *DynamicResource) References() []ResourceReference {
+	status := d.Status()
+	items, ok := status[referencesStatusKey].([]any)
+	if !ok {
+		return []ResourceReference{}
+	}
+
+	references := []ResourceReference{}
+	for _, item := range items {
+		reference, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path, _ := reference["path"].(string)
+		id, _ := reference["id"].(string)
+		if id != "" {
+			references = append(references, ResourceReference{Path: path, ID: id})
+		}
+	}
+
+	return references
+}
+
+// SetReferences records the references to other resources in the status map. The references are removed from the
+// status when there are none.
+func (d *DynamicResource) SetReferences(references []ResourceReference) {
+	if len(references) == 0 {
+		// Avoid adding an empty status to resources that don't have one.
+		if status, ok := d.Properties["status"].(map[string]any); ok {
+			delete(status, referencesStatusKey)
+		}
+		return
+	}
+
+	status := d.Status()
+
+	// Store the references as JSON values, the same as the rest of the properties.
+	items := []any{}
+	for _, reference := range references {
+		items = append(items, map[string]any{
+			"path": reference.Path,
+			"id":   reference.ID,
+		})
+	}
+	status[referencesStatusKey] = items
+}
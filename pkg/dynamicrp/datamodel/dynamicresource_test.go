@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/portableresources"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 )
 
@@ -497,3 +498,48 @@ func Test_DynamicResource_GetSecrets(t *testing.T) {
 		})
 	}
 }
+
+func Test_DynamicResource_References(t *testing.T) {
+	references := []ResourceReference{
+		{Path: "database", ID: "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db"},
+		{Path: "caches[0]", ID: "/planes/radius/local/resourceGroups/test/providers/Radius.Data/redisCaches/cache"},
+	}
+
+	resource := DynamicResource{Properties: map[string]any{}}
+	require.Empty(t, resource.References())
+
+	resource.SetReferences(references)
+	require.Equal(t, references, resource.References())
+	require.Equal(t, []any{
+		map[string]any{"path": "database", "id": "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db"},
+		map[string]any{"path": "caches[0]", "id": "/planes/radius/local/resourceGroups/test/providers/Radius.Data/redisCaches/cache"},
+	}, resource.Status()["references"])
+
+	resource.SetReferences(nil)
+	require.Empty(t, resource.References())
+	require.NotContains(t, resource.Status(), "references")
+}
+
+func Test_DynamicResource_ActionStatuses(t *testing.T) {
+	resource := &DynamicResource{}
+
+	actionStatuses, err := resource.ActionStatuses()
+	require.NoError(t, err)
+	require.Empty(t, actionStatuses)
+
+	actionStatus := ActionStatus{
+		RecipeName: "rotate",
+		OutputResources: []rpv1.OutputResource{
+			{ID: resources.MustParse("/planes/kubernetes/local/namespaces/default/providers/core/Secret/rotated")},
+		},
+		ComputedValues: map[string]any{"rotatedAt": "2025-01-01T00:00:00Z"},
+	}
+	require.NoError(t, resource.SetActionStatus("rotate", actionStatus))
+
+	// The status is stored as plain JSON data so that it can be returned by the API.
+	require.IsType(t, map[string]any{}, resource.Status()["actions"].(map[string]any)["rotate"])
+
+	actionStatuses, err = resource.ActionStatuses()
+	require.NoError(t, err)
+	require.Equal(t, map[string]ActionStatus{"rotate": actionStatus}, actionStatuses)
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ ctrl.Controller = (*ResourceAction)(nil)
+
+// ResourceAction is the controller for the custom actions declared by the API version of a resource type.
+//
+// Actions are invoked with a POST request to the resource ID followed by the action name. For example:
+//
+//	POST /planes/radius/local/resourceGroups/my-rg/providers/MyCompany.Resources/widgets/my-widget/rotate
+type ResourceAction struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient *v20231001preview.ClientFactory
+	handler   *encryption.SensitiveDataHandler
+	webhook   *ActionWebhook
+}
+
+// NewResourceAction creates a new ResourceAction controller.
+func NewResourceAction(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+	handler *encryption.SensitiveDataHandler,
+	webhook *ActionWebhook,
+) (ctrl.Controller, error) {
+	return &ResourceAction{
+		Operation: ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient: ucpClient,
+		handler:   handler,
+		webhook:   webhook,
+	}, nil
+}
+
+// Run invokes the requested action on the resource and returns the output of the action handler. Actions handled
+// by a recipe are run asynchronously by the backend, like PUT and DELETE operations.
+func (c *ResourceAction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// The resource ID in the request context has already had the action name removed.
+	actionName := path.Base(req.URL.Path)
+
+	resource, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	apiVersion, err := getAPIVersion(ctx, c.ucpClient, serviceCtx.ResourceID, serviceCtx.APIVersion)
+	if clientv2.Is404Error(err) {
+		return rest.NewNotFoundAPIVersionResponse(serviceCtx.ResourceID.Type(), serviceCtx.ResourceID.ProviderNamespace(), serviceCtx.APIVersion), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	action, ok := findAction(apiVersion, actionName)
+	if !ok {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The action %q is not defined for resource type %q and API version %q.", actionName, serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)), nil
+	}
+
+	input, err := readActionInput(req)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	if action.Input != nil {
+		if err := validateActionData(ctx, input, action.Input); err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The input for action %q is invalid: %s", actionName, err.Error())), nil
+		}
+	}
+
+	logger.Info("Invoking resource action", "action", actionName, "kind", to.String(action.Handler.Kind), "resourceID", serviceCtx.ResourceID.String())
+
+	switch to.String(action.Handler.Kind) {
+	case ucpdatamodel.ActionHandlerKindSensitiveFields:
+		return c.runSensitiveFields(ctx, serviceCtx.ResourceID, resource)
+	case ucpdatamodel.ActionHandlerKindRecipe:
+		return c.runRecipe(ctx, resource, etag, actionName, to.String(action.Handler.RecipeName), input)
+	case ucpdatamodel.ActionHandlerKindWebhook:
+		return c.runWebhook(ctx, serviceCtx.ResourceID, resource, actionName, action, input)
+	default:
+		return rest.NewBadRequestResponse(fmt.Sprintf("The action %q has an unsupported handler kind %q.", actionName, to.String(action.Handler.Kind))), nil
+	}
+}
+
+// runSensitiveFields returns the top-level properties of the resource that contain sensitive fields, with
+// the sensitive values decrypted. Sensitive values that have already been redacted are omitted.
+func (c *ResourceAction) runSensitiveFields(ctx context.Context, id resources.ID, resource *datamodel.DynamicResource) (rest.Response, error) {
+	// Sensitive fields are encrypted using the schema of the API version the resource was last updated with.
+	resourceSchema, err := schema.GetSchema(ctx, c.ucpClient, id.String(), id.Type(), resource.InternalMetadata.UpdatedAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]any{}
+	if resourceSchema == nil || resource.Properties == nil {
+		return rest.NewOKResponse(output), nil
+	}
+
+	sensitiveFieldPaths := schema.ExtractSensitiveFieldPaths(resourceSchema, "")
+	if len(sensitiveFieldPaths) == 0 {
+		return rest.NewOKResponse(output), nil
+	}
+
+	if c.handler == nil {
+		return nil, fmt.Errorf("sensitive data handler is not configured")
+	}
+
+	if err := c.handler.DecryptSensitiveFieldsWithSchema(ctx, resource.Properties, sensitiveFieldPaths, id.String(), resourceSchema); err != nil {
+		return nil, err
+	}
+
+	for _, fieldPath := range sensitiveFieldPaths {
+		segments := schema.ParseFieldPath(fieldPath)
+		if len(segments) == 0 || !segments[0].IsField() {
+			continue
+		}
+
+		if value, ok := resource.Properties[segments[0].Value]; ok && value != nil {
+			output[segments[0].Value] = value
+		}
+	}
+
+	return rest.NewOKResponse(output), nil
+}
+
+// runRecipe queues the action for the backend, which executes the named recipe for the resource type with the action
+// input as recipe parameters. The resources and values output by the recipe are recorded in status.actions of the
+// resource, and the resources are deleted with the resource.
+func (c *ResourceAction) runRecipe(ctx context.Context, resource *datamodel.DynamicResource, etag string, actionName string, recipeName string, input map[string]any) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	if state := resource.ProvisioningState(); !state.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf(ctrl.InProgressStateMessageFormat, state)), nil
+	}
+
+	resource.PendingAction = &datamodel.ResourceAction{
+		Name:       actionName,
+		RecipeName: recipeName,
+		Input:      input,
+	}
+
+	if r, err := c.PrepareAsyncOperation(ctx, resource, v1.ProvisioningStateAccepted, c.AsyncOperationTimeout(), &etag); r != nil || err != nil {
+		return r, err
+	}
+
+	response := rest.NewAsyncOperationResponse(map[string]any{}, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", "")
+	if c.AsyncOperationRetryAfter() != 0 {
+		response.RetryAfter = c.AsyncOperationRetryAfter()
+	}
+
+	return response, nil
+}
+
+// actionStatusFilter keeps the status of the custom actions run on the resource when the resource is updated.
+// The status records the resources deployed by action recipes, which must be deleted with the resource, so it can
+// only be set by the backend.
+func actionStatusFilter(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *ctrl.Options) (rest.Response, error) {
+	if status, ok := newResource.Properties["status"].(map[string]any); ok {
+		delete(status, "actions")
+	}
+
+	if oldResource == nil {
+		return nil, nil
+	}
+
+	if status, ok := oldResource.Properties["status"].(map[string]any); ok && status["actions"] != nil {
+		newResource.Status()["actions"] = status["actions"]
+	}
+
+	return nil, nil
+}
+
+// actionWebhookRequest is the request body sent to a webhook action handler.
+type actionWebhookRequest struct {
+	// Action is the name of the action.
+	Action string `json:"action"`
+
+	// ResourceID is the ID of the resource the action is invoked on.
+	ResourceID string `json:"resourceId"`
+
+	// Properties are the properties of the resource. Sensitive fields are not included.
+	Properties map[string]any `json:"properties"`
+
+	// Input is the input of the action.
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// runWebhook calls the webhook with the resource and action input, and returns the response of the webhook. The
+// request is signed with the webhook signing key, and the webhook must be on one of the allowed hosts. Failures of the
+// webhook and responses that don't match the output schema of the action are reported as 502 Bad Gateway.
+func (c *ResourceAction) runWebhook(ctx context.Context, id resources.ID, resource *datamodel.DynamicResource, actionName string, action *v20231001preview.APIVersionAction, input map[string]any) (rest.Response, error) {
+	url := to.String(action.Handler.URL)
+	if c.webhook == nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The action %q is handled by a webhook, but webhook actions are not enabled. Set the allowed hosts and the signing key of action webhooks in the Radius configuration.", actionName)), nil
+	}
+
+	if err := c.webhook.ValidateURL(url); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The webhook of action %q can't be called: %s.", actionName, err.Error())), nil
+	}
+
+	// Sensitive fields must never be sent to the webhook, even if they are still encrypted.
+	sensitiveFieldPaths, err := schema.GetSensitiveFieldPaths(ctx, c.ucpClient, id.String(), id.Type(), resource.InternalMetadata.UpdatedAPIVersion)
+	if err != nil {
+		return nil, err
+	}
+	schema.RedactFields(resource.Properties, sensitiveFieldPaths)
+
+	body, err := json.Marshal(actionWebhookRequest{
+		Action:     actionName,
+		ResourceID: id.String(),
+		Properties: resource.Properties,
+		Input:      input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.webhook.Post(ctx, url, body)
+	if err != nil {
+		return rest.NewBadGatewayResponse(fmt.Sprintf("Failed to call the webhook of action %q: %s.", actionName, err.Error())), nil
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return rest.NewBadGatewayResponse(fmt.Sprintf("The webhook of action %q returned status code %d.", actionName, response.StatusCode)), nil
+	}
+
+	// Read one byte more than the limit to detect responses that exceed it.
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxActionWebhookResponseSize+1))
+	if err != nil {
+		return rest.NewBadGatewayResponse(fmt.Sprintf("Failed to read the response of the webhook of action %q: %s.", actionName, err.Error())), nil
+	}
+	if len(data) > maxActionWebhookResponseSize {
+		return rest.NewBadGatewayResponse(fmt.Sprintf("The response of the webhook of action %q exceeds the limit of %d bytes.", actionName, maxActionWebhookResponseSize)), nil
+	}
+
+	output := map[string]any{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &output); err != nil {
+			return rest.NewBadGatewayResponse(fmt.Sprintf("The webhook of action %q returned an invalid response: %s.", actionName, err.Error())), nil
+		}
+	}
+
+	if action.Output != nil {
+		if err := validateActionData(ctx, output, action.Output); err != nil {
+			return rest.NewBadGatewayResponse(fmt.Sprintf("The output of the webhook of action %q is invalid: %s", actionName, err.Error())), nil
+		}
+	}
+
+	return rest.NewOKResponse(output), nil
+}
+
+// validateActionData validates the input or output of an action against its schema.
+func validateActionData(ctx context.Context, data map[string]any, actionSchema map[string]any) error {
+	// The schema describes the data itself, not a resource with the data in its properties.
+	return schema.ValidateResourceAgainstSchema(ctx, map[string]any{"properties": data}, actionSchema)
+}
+
+// getAPIVersion fetches the API version resource for the resource type of the given resource ID.
+func getAPIVersion(ctx context.Context, ucpClient *v20231001preview.ClientFactory, id resources.ID, apiVersion string) (*v20231001preview.APIVersionResource, error) {
+	planeName := strings.Split(id.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, _ := strings.Cut(id.Type(), "/")
+
+	response, err := ucpClient.NewAPIVersionsClient().Get(ctx, planeName, resourceProvider, resourceTypeName, apiVersion, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.APIVersionResource, nil
+}
+
+// findAction looks up an action by name. Action names are case-insensitive.
+func findAction(apiVersion *v20231001preview.APIVersionResource, actionName string) (*v20231001preview.APIVersionAction, bool) {
+	if apiVersion.Properties == nil {
+		return nil, false
+	}
+
+	for name, action := range apiVersion.Properties.Actions {
+		if strings.EqualFold(name, actionName) && action != nil && action.Handler != nil {
+			return action, true
+		}
+	}
+
+	return nil, false
+}
+
+// readActionInput reads the optional JSON input of an action from the request body.
+func readActionInput(req *http.Request) (map[string]any, error) {
+	if req.Body == nil || req.ContentLength == 0 {
+		return map[string]any{}, nil
+	}
+
+	data, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	input := map[string]any{}
+	if len(data) == 0 {
+		return input, nil
+	}
+
+	if err := json.Unmarshal(data, &input); err != nil {
+		return nil, fmt.Errorf("invalid action input: %w", err)
+	}
+
+	return input, nil
+}
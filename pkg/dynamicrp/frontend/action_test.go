@@ -0,0 +1,488 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/crypto/encryption"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testActionURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource/%s?api-version=2023-10-01-preview"
+)
+
+var testActionSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"environment": map[string]any{
+			"type": "string",
+		},
+		"password": map[string]any{
+			"type":               "string",
+			"x-radius-sensitive": true,
+		},
+	},
+}
+
+func createFakeUCPClientFactoryWithActions(actions map[string]*v20231001preview.APIVersionAction) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			response := v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: new(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema:  testActionSchema,
+						Actions: actions,
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+}
+
+func newTestActionController(t *testing.T, databaseClient database.Client, statusManager statusmanager.StatusManager, ucpClient *v20231001preview.ClientFactory, handler *encryption.SensitiveDataHandler, webhook *ActionWebhook) controller.Controller {
+	t.Helper()
+
+	opts := controller.Options{
+		DatabaseClient: databaseClient,
+		StatusManager:  statusManager,
+	}
+	resourceOpts := controller.ResourceOptions[datamodel.DynamicResource]{
+		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+	}
+
+	c, err := NewResourceAction(opts, resourceOpts, ucpClient, handler, webhook)
+	require.NoError(t, err)
+
+	return c
+}
+
+func newTestActionRequest(t *testing.T, actionName string, input map[string]any) (context.Context, *http.Request) {
+	t.Helper()
+
+	var body []byte
+	if input != nil {
+		var err error
+		body, err = json.Marshal(input)
+		require.NoError(t, err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf(testActionURL, actionName), bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	return rpctest.NewARMRequestContext(req), req
+}
+
+func expectGetActionResource(databaseClient *database.MockClient, properties map[string]any) {
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, properties)
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+}
+
+func runTestAction(t *testing.T, c controller.Controller, ctx context.Context, req *http.Request) (int, map[string]any) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.NoError(t, resp.Apply(ctx, w, req))
+
+	body := map[string]any{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+
+	return w.Result().StatusCode, body
+}
+
+func TestResourceAction_ResourceNotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(nil)
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "listSecrets", nil)
+
+	statusCode, _ := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func TestResourceAction_ActionNotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, map[string]any{})
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(nil)
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "listSecrets", nil)
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusNotFound, statusCode)
+	require.Contains(t, body["error"].(map[string]any)["message"], `"listSecrets"`)
+}
+
+func TestResourceAction_InvalidInput(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, map[string]any{})
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(map[string]*v20231001preview.APIVersionAction{
+		"rotate": {
+			Input: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"reason": map[string]any{
+						"type": "string",
+					},
+				},
+			},
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind:       new("recipe"),
+				RecipeName: new("rotate"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "rotate", map[string]any{"reason": 42})
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, body["error"].(map[string]any)["message"], `"reason"`)
+}
+
+func TestResourceAction_SensitiveFields(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	handler := createTestHandler(t)
+	properties := map[string]any{
+		"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+		"password":    "secret123",
+	}
+	require.NoError(t, handler.EncryptSensitiveFields(properties, []string{"password"}, testResourceID))
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, properties)
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(map[string]*v20231001preview.APIVersionAction{
+		"listSecrets": {
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind: new("sensitiveFields"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, handler, nil)
+	ctx, req := newTestActionRequest(t, "listsecrets", nil)
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, map[string]any{"password": "secret123"}, body)
+}
+
+func TestResourceAction_Recipe(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	properties := map[string]any{
+		"environment": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env",
+	}
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, properties)
+
+	var saved *datamodel.DynamicResource
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*datamodel.DynamicResource)
+			return nil
+		})
+
+	statusManager := statusmanager.NewMockStatusManager(mctrl)
+	statusManager.EXPECT().
+		QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(map[string]*v20231001preview.APIVersionAction{
+		"rotate": {
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind:       new("recipe"),
+				RecipeName: new("rotate"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, statusManager, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "rotate", map[string]any{"reason": "expired"})
+
+	statusCode, _ := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusAccepted, statusCode)
+
+	require.NotNil(t, saved)
+	require.Equal(t, v1.ProvisioningStateAccepted, saved.ProvisioningState())
+	require.Equal(t, &datamodel.ResourceAction{
+		Name:       "rotate",
+		RecipeName: "rotate",
+		Input:      map[string]any{"reason": "expired"},
+	}, saved.PendingAction)
+}
+
+func TestResourceAction_Recipe_InProgress(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateUpdating, map[string]any{})
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(map[string]*v20231001preview.APIVersionAction{
+		"rotate": {
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind:       new("recipe"),
+				RecipeName: new("rotate"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "rotate", nil)
+
+	statusCode, _ := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusConflict, statusCode)
+}
+
+func createFakeUCPClientFactoryWithWebhook(t *testing.T, url string) *v20231001preview.ClientFactory {
+	t.Helper()
+
+	ucpClient, err := createFakeUCPClientFactoryWithActions(map[string]*v20231001preview.APIVersionAction{
+		"notify": {
+			Handler: &v20231001preview.APIVersionActionHandler{
+				Kind: new("webhook"),
+				URL:  new(url),
+			},
+			Output: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"notified": map[string]any{"type": "boolean"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	return ucpClient
+}
+
+func TestResourceAction_Webhook(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	signingKey := []byte("test-signing-key")
+
+	var received actionWebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		mac := hmac.New(sha256.New, signingKey)
+		_, _ = mac.Write(body)
+		require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(WebhookSignatureHeader))
+
+		require.NoError(t, json.Unmarshal(body, &received))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"notified": true}`))
+	}))
+	defer server.Close()
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, map[string]any{
+		"environment": "env",
+		"password":    "secret123",
+	})
+
+	ucpClient := createFakeUCPClientFactoryWithWebhook(t, server.URL)
+	webhook := NewActionWebhook(server.Client(), []string{"127.0.0.1"}, signingKey)
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, webhook)
+	ctx, req := newTestActionRequest(t, "notify", map[string]any{"message": "hello"})
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusOK, statusCode, body)
+	require.Equal(t, map[string]any{"notified": true}, body)
+
+	require.Equal(t, "notify", received.Action)
+	require.Equal(t, testResourceID, received.ResourceID)
+	require.Equal(t, map[string]any{"message": "hello"}, received.Input)
+
+	// Sensitive fields are never sent to the webhook.
+	require.Nil(t, received.Properties["password"])
+	require.Equal(t, "env", received.Properties["environment"])
+}
+
+func TestResourceAction_Webhook_BadGateway(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		message    string
+	}{
+		{name: "error status code", statusCode: http.StatusInternalServerError, response: `{}`, message: "returned status code 500"},
+		{name: "invalid json", statusCode: http.StatusOK, response: `not json`, message: "returned an invalid response"},
+		{name: "invalid output", statusCode: http.StatusOK, response: `{"notified": "yes"}`, message: "output of the webhook of action \"notify\" is invalid"},
+		{name: "too large", statusCode: http.StatusOK, response: `{"padding": "` + strings.Repeat("a", maxActionWebhookResponseSize) + `"}`, message: "exceeds the limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mctrl := gomock.NewController(t)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			databaseClient := database.NewMockClient(mctrl)
+			expectGetActionResource(databaseClient, map[string]any{})
+
+			ucpClient := createFakeUCPClientFactoryWithWebhook(t, server.URL)
+			webhook := NewActionWebhook(server.Client(), []string{"127.0.0.1"}, []byte("key"))
+
+			c := newTestActionController(t, databaseClient, nil, ucpClient, nil, webhook)
+			ctx, req := newTestActionRequest(t, "notify", nil)
+
+			statusCode, body := runTestAction(t, c, ctx, req)
+			require.Equal(t, http.StatusBadGateway, statusCode)
+			require.Equal(t, v1.CodeBadGateway, body["error"].(map[string]any)["code"])
+			require.Contains(t, body["error"].(map[string]any)["message"], tt.message)
+		})
+	}
+}
+
+func TestResourceAction_Webhook_NotEnabled(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, map[string]any{})
+
+	ucpClient := createFakeUCPClientFactoryWithWebhook(t, "https://hooks.example.com/notify")
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, nil)
+	ctx, req := newTestActionRequest(t, "notify", nil)
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, body["error"].(map[string]any)["message"], "webhook actions are not enabled")
+}
+
+func TestResourceAction_Webhook_HostNotAllowed(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	expectGetActionResource(databaseClient, map[string]any{})
+
+	ucpClient := createFakeUCPClientFactoryWithWebhook(t, "http://169.254.169.254/latest/meta-data")
+	webhook := NewActionWebhook(nil, []string{"hooks.example.com"}, []byte("key"))
+
+	c := newTestActionController(t, databaseClient, nil, ucpClient, nil, webhook)
+	ctx, req := newTestActionRequest(t, "notify", nil)
+
+	statusCode, body := runTestAction(t, c, ctx, req)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, body["error"].(map[string]any)["message"], "not in the list of hosts allowed")
+}
+
+func TestActionStatusFilter(t *testing.T) {
+	actions := map[string]any{"rotate": map[string]any{"recipeName": "rotate"}}
+
+	t.Run("keeps the action status on update", func(t *testing.T) {
+		oldResource := &datamodel.DynamicResource{Properties: map[string]any{"status": map[string]any{"actions": actions}}}
+		newResource := &datamodel.DynamicResource{Properties: map[string]any{}}
+
+		resp, err := actionStatusFilter(context.Background(), newResource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.Equal(t, actions, newResource.Status()["actions"])
+	})
+
+	t.Run("action status can't be set by the client", func(t *testing.T) {
+		newResource := &datamodel.DynamicResource{Properties: map[string]any{"status": map[string]any{"actions": actions}}}
+
+		resp, err := actionStatusFilter(context.Background(), newResource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		require.NotContains(t, newResource.Status(), "actions")
+	})
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/dynamicrp"
+)
+
+const (
+	// WebhookSignatureHeader is the header of webhook requests that holds the HMAC-SHA256 signature of the
+	// request body, in the form "sha256=<hex encoded signature>".
+	WebhookSignatureHeader = "X-Radius-Signature-256"
+
+	// maxActionWebhookResponseSize is the maximum size of the response of an action webhook, in bytes.
+	maxActionWebhookResponseSize = 1 << 20
+)
+
+// ActionWebhook calls the webhooks that handle custom actions. Webhooks can only be called on the allowed hosts,
+// and every request is signed with the signing key so that the webhook can verify it was sent by Radius.
+type ActionWebhook struct {
+	client       *http.Client
+	allowedHosts []string
+	signingKey   []byte
+}
+
+// NewActionWebhook creates a new ActionWebhook. Redirects returned by webhooks are not followed, so that requests
+// can't be sent to hosts that are not allowed.
+func NewActionWebhook(client *http.Client, allowedHosts []string, signingKey []byte) *ActionWebhook {
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	copied := *client
+	copied.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	return &ActionWebhook{
+		client:       &copied,
+		allowedHosts: allowedHosts,
+		signingKey:   signingKey,
+	}
+}
+
+// NewActionWebhookFromOptions creates a new ActionWebhook from the webhook options of the DynamicRP configuration.
+// It returns nil if webhook actions are not configured.
+func NewActionWebhookFromOptions(client *http.Client, options dynamicrp.WebhookOptions) (*ActionWebhook, error) {
+	if len(options.AllowedHosts) == 0 || options.SigningKeyFile == "" {
+		return nil, nil
+	}
+
+	key, err := os.ReadFile(options.SigningKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook signing key: %w", err)
+	}
+
+	key = bytes.TrimSpace(key)
+	if len(key) == 0 {
+		return nil, fmt.Errorf("the webhook signing key file %q is empty", options.SigningKeyFile)
+	}
+
+	return NewActionWebhook(client, options.AllowedHosts, key), nil
+}
+
+// ValidateURL returns an error if the webhook URL can't be called.
+func (w *ActionWebhook) ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("the URL %q is invalid: %w", rawURL, err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("the URL %q must use http or https", rawURL)
+	}
+
+	if u.User != nil {
+		return fmt.Errorf("the URL %q must not contain credentials", rawURL)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range w.allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if suffix, ok := strings.CutPrefix(allowed, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return nil
+			}
+		} else if host == allowed {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the host %q of the URL is not in the list of hosts allowed for webhooks", host)
+}
+
+// Post sends the body to the webhook, signed with the signing key.
+func (w *ActionWebhook) Post(ctx context.Context, rawURL string, body []byte) (*http.Response, error) {
+	if err := w.ValidateURL(rawURL); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookSignatureHeader, "sha256="+w.sign(body))
+
+	return w.client.Do(request)
+}
+
+// sign returns the hex encoded HMAC-SHA256 signature of the body.
+func (w *ActionWebhook) sign(body []byte) string {
+	mac := hmac.New(sha256.New, w.signingKey)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// apiVersionConverter serves resources in the requested API version.
+//
+// Resources are stored in the API version they were last updated with. When a different API version is requested,
+// the properties are converted using the conversions declared by the API versions of the resource type. Requests fail
+// with a bad request when the API versions are not connected by any conversion, since the stored properties may not
+// match the schema of the requested API version.
+//
+// The conversions are fetched from UCP with the context of the request, at most once per resource type, so a
+// converter must be created for each request.
+type apiVersionConverter struct {
+	ctx         context.Context
+	ucpClient   *v20231001preview.ClientFactory
+	conversions map[string]map[string][]ucpdatamodel.APIVersionConversion
+}
+
+// newAPIVersionConverter creates a new apiVersionConverter for the request with the given context.
+func newAPIVersionConverter(ctx context.Context, ucpClient *v20231001preview.ClientFactory) *apiVersionConverter {
+	return &apiVersionConverter{
+		ctx:         ctx,
+		ucpClient:   ucpClient,
+		conversions: map[string]map[string][]ucpdatamodel.APIVersionConversion{},
+	}
+}
+
+// Convert converts the resource to the versioned model of the given API version. It implements
+// v1.ConvertToAPIModel.
+func (c *apiVersionConverter) Convert(resource *datamodel.DynamicResource, version string) (v1.VersionedModelInterface, error) {
+	from := resource.InternalMetadata.UpdatedAPIVersion
+	if c.ucpClient == nil || from == "" || strings.EqualFold(from, version) || resource.Properties == nil {
+		return converter.DynamicResourceDataModelToVersioned(resource, version)
+	}
+
+	id, err := resources.Parse(resource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.ToLower(id.PlaneNamespace() + "/" + id.Type())
+	conversions, ok := c.conversions[key]
+	if !ok {
+		conversions, err = getAPIVersionConversions(c.ctx, c.ucpClient, id)
+		if err != nil {
+			return nil, err
+		}
+		c.conversions[key] = conversions
+	}
+
+	properties, err := schema.ConvertAPIVersion(resource.Properties, from, version, conversions)
+	if errors.Is(err, schema.ErrNoConversionPath) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(
+			"resource %s was last updated with API version %q and can't be returned in API version %q because no conversion is declared between them. Use API version %q or declare a conversion between the API versions of the resource type.",
+			resource.ID, from, version, from))
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Convert a copy so that the stored resource is not modified.
+	converted := *resource
+	converted.Properties = properties
+	return converter.DynamicResourceDataModelToVersioned(&converted, version)
+}
+
+// getAPIVersionConversions returns the conversions declared by the API versions of the resource's type, keyed by
+// API version.
+func getAPIVersionConversions(ctx context.Context, ucpClient *v20231001preview.ClientFactory, id resources.ID) (map[string][]ucpdatamodel.APIVersionConversion, error) {
+	planeName := strings.Split(id.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceType, ok := strings.Cut(id.Type(), "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type %q", id.Type())
+	}
+
+	conversions := map[string][]ucpdatamodel.APIVersionConversion{}
+	pager := ucpClient.NewAPIVersionsClient().NewListPager(planeName, resourceProvider, resourceType, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range page.Value {
+			if item == nil || item.Properties == nil || len(item.Properties.Conversions) == 0 {
+				continue
+			}
+
+			dm, err := item.ConvertTo()
+			if err != nil {
+				return nil, err
+			}
+
+			apiVersion := dm.(*ucpdatamodel.APIVersion)
+			conversions[apiVersion.Name] = apiVersion.Properties.Conversions
+		}
+	}
+
+	return conversions, nil
+}
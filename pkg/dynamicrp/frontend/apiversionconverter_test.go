@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func createFakeUCPClientFactoryWithConversions(t *testing.T) *v20231001preview.ClientFactory {
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			require.Equal(t, "local", planeName)
+			require.Equal(t, "Applications.Test", resourceProviderName)
+			require.Equal(t, "testResources", resourceTypeName)
+
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+				APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+					Value: []*v20231001preview.APIVersionResource{
+						{
+							Name:       new(testAPIVersion),
+							Properties: &v20231001preview.APIVersionProperties{Schema: map[string]any{}},
+						},
+						{
+							Name: new("2025-01-01-preview"),
+							Properties: &v20231001preview.APIVersionProperties{
+								Schema: map[string]any{},
+								Conversions: []*v20231001preview.APIVersionConversion{
+									{
+										From: new(testAPIVersion),
+										Rules: []*v20231001preview.APIVersionConversionRule{
+											{Kind: new("rename"), From: new("size"), To: new("capacity")},
+											{Kind: new("default"), Path: new("tier"), Value: "standard"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+	require.NoError(t, err)
+
+	return ucpClient
+}
+
+func TestAPIVersionConverter(t *testing.T) {
+	tests := []struct {
+		name       string
+		stored     string
+		requested  string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "same API version",
+			stored:     testAPIVersion,
+			requested:  testAPIVersion,
+			properties: map[string]any{"size": 3},
+			expected:   map[string]any{"size": float64(3), "provisioningState": "Succeeded"},
+		},
+		{
+			name:       "newer API version",
+			stored:     testAPIVersion,
+			requested:  "2025-01-01-preview",
+			properties: map[string]any{"size": 3},
+			expected:   map[string]any{"capacity": float64(3), "tier": "standard", "provisioningState": "Succeeded"},
+		},
+		{
+			name:       "older API version",
+			stored:     "2025-01-01-preview",
+			requested:  testAPIVersion,
+			properties: map[string]any{"capacity": 3, "tier": "premium"},
+			expected:   map[string]any{"size": float64(3), "provisioningState": "Succeeded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, tt.properties)
+			resource.InternalMetadata.UpdatedAPIVersion = tt.stored
+
+			converter := newAPIVersionConverter(context.Background(), createFakeUCPClientFactoryWithConversions(t))
+			versioned, err := converter.Convert(resource, tt.requested)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, versioned.(*api.DynamicResource).Properties)
+
+			// The stored resource is not modified.
+			require.Equal(t, tt.properties, resource.Properties)
+		})
+	}
+}
+
+func TestAPIVersionConverter_NoConversion(t *testing.T) {
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"capacity": 3})
+	resource.InternalMetadata.UpdatedAPIVersion = "2024-01-01-preview"
+
+	converter := newAPIVersionConverter(context.Background(), createFakeUCPClientFactoryWithConversions(t))
+	_, err := converter.Convert(resource, testAPIVersion)
+
+	clientErr := &v1.ErrClientRP{}
+	require.ErrorAs(t, err, &clientErr)
+	require.Equal(t, v1.CodeInvalid, clientErr.Code)
+	require.Contains(t, clientErr.Message, `last updated with API version "2024-01-01-preview" and can't be returned in API version "`+testAPIVersion+`"`)
+}
+
+func TestAPIVersionConverter_ListError(t *testing.T) {
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			resp.AddResponseError(http.StatusInternalServerError, "Internal")
+			return
+		},
+	}
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+	require.NoError(t, err)
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"size": 3})
+	_, err = newAPIVersionConverter(context.Background(), ucpClient).Convert(resource, "2025-01-01-preview")
+	require.Error(t, err)
+}
+
+func TestAPIVersionConverter_FetchesConversionsOnce(t *testing.T) {
+	listCalls := 0
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			listCalls++
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{}, nil)
+			return
+		},
+	}
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+	require.NoError(t, err)
+
+	converter := newAPIVersionConverter(context.Background(), ucpClient)
+	for range 3 {
+		resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"size": 3})
+		resource.InternalMetadata.UpdatedAPIVersion = testAPIVersion
+
+		// No conversions are declared, so the conversion fails after fetching them.
+		_, err := converter.Convert(resource, "2025-01-01-preview")
+		require.Error(t, err)
+	}
+
+	require.Equal(t, 1, listCalls)
+}
@@ -48,14 +48,16 @@ func makeEncryptionFilter(
 		oldResource *datamodel.DynamicResource,
 		options *controller.Options,
 	) (rest.Response, error) {
-		return encryptSensitiveFields(ctx, newResource, ucpClient, handler)
+		return encryptSensitiveFields(ctx, newResource, oldResource, ucpClient, handler)
 	}
 }
 
 // encryptSensitiveFields encrypts fields marked with x-radius-sensitive annotation in the resource schema.
+// Encrypted values that the update carried over unchanged from oldResource are kept as they are.
 func encryptSensitiveFields(
 	ctx context.Context,
 	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
 	ucpClient *v20231001preview.ClientFactory,
 	handler *encryption.SensitiveDataHandler,
 ) (rest.Response, error) {
@@ -106,10 +108,16 @@ func encryptSensitiveFields(
 		return nil, nil
 	}
 
+	var previous map[string]any
+	if oldResource != nil {
+		previous = oldResource.Properties
+	}
+
 	// Encrypt sensitive fields in the Properties map
 	// Field paths from schema are relative to "properties", so we operate on Properties directly
-	if err := handler.EncryptSensitiveFields(
+	if err := handler.EncryptSensitiveFieldsWithPrevious(
 		newResource.Properties,
+		previous,
 		sensitiveFieldPaths,
 		resourceID,
 	); err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -44,10 +45,12 @@ import (
 // URL: /planes/radius/myplane/resourceGroups/my-rg/providers/Applications.Example/customService/my-service
 // Resource Type: Applications.Example/customService
 //
-// This code ensures that the controller will be provided with the correct resource type.
-func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
+// This code ensures that the controller will be provided with the correct resource type. The factory is called for each
+// request with the context of the request.
+func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(ctx context.Context, opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		id, err := resources.Parse(r.URL.Path)
+		// Custom actions are POST requests where the last segment of the URL is the action name.
+		id, err := resources.ParseByMethod(r.URL.Path, r.Method)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
@@ -71,7 +74,7 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			opts.ResourceType = id.ProviderNamespace() + "/operationstatuses"
 		}
 
-		ctrl, err := factory(opts)
+		ctrl, err := factory(r.Context(), opts)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
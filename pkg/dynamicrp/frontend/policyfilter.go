@@ -0,0 +1,242 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/policy"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// codeRequestDisallowedByPolicy is the ARM error code for requests denied by a policy.
+	codeRequestDisallowedByPolicy = "RequestDisallowedByPolicy"
+)
+
+// makePolicyFilter creates an UpdateFilter that evaluates organization policies against the resource before it is
+// saved to the database. The policies are those of the DynamicRP configuration and those declared by the resource
+// group of the resource.
+//
+// Violations of policies in enforce mode deny the request. Violations of policies in audit mode are recorded in the
+// status of the resource.
+func makePolicyFilter(engine *policy.Engine, ucpClient *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	resourceGroupPolicies := newResourceGroupPolicies(ucpClient)
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		resourceGroupEngine, err := resourceGroupPolicies.Engine(ctx, serviceCtx.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		return evaluatePolicies(ctx, newResource, oldResource, engine, resourceGroupEngine)
+	}
+}
+
+// evaluatePolicies evaluates the policies of the engines against the resource.
+func evaluatePolicies(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	engines ...*policy.Engine,
+) (rest.Response, error) {
+	if !slices.ContainsFunc(engines, func(engine *policy.Engine) bool { return !engine.IsEmpty() }) {
+		return nil, nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	input := &policy.Input{
+		Operation:     serviceCtx.HTTPMethod,
+		ResourceType:  serviceCtx.ResourceID.Type(),
+		ResourceGroup: serviceCtx.ResourceID.FindScope("resourceGroups"),
+	}
+	input.Environment, _ = newResource.Properties["environment"].(string)
+
+	var err error
+	input.Resource, err = policyResource(serviceCtx, newResource)
+	if err != nil {
+		return nil, err
+	}
+	if oldResource != nil {
+		input.OldResource, err = policyResource(serviceCtx, oldResource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	denied := []*v1.ErrorDetails{}
+	audited := []rpv1.PolicyViolation{}
+	for _, engine := range engines {
+		for _, violation := range engine.Evaluate(ctx, input) {
+			if violation.Mode == policy.ModeAudit {
+				logger.Info("Resource violates audit policy", "resourceId", serviceCtx.ResourceID.String(), "policy", violation.Policy)
+				audited = append(audited, rpv1.PolicyViolation{Policy: violation.Policy, Message: violation.Message})
+				continue
+			}
+
+			denied = append(denied, &v1.ErrorDetails{
+				Code:    codeRequestDisallowedByPolicy,
+				Message: violation.Message,
+				Target:  violation.Policy,
+			})
+		}
+	}
+
+	if len(denied) == 1 {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: denied[0]}), nil
+	} else if len(denied) > 1 {
+		policies := []string{}
+		for _, detail := range denied {
+			policies = append(policies, detail.Target)
+		}
+
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    codeRequestDisallowedByPolicy,
+				Message: fmt.Sprintf("The resource violates policies %s.", strings.Join(policies, ", ")),
+				Details: denied,
+			},
+		}), nil
+	}
+
+	newResource.SetPolicyViolations(audited)
+	return nil, nil
+}
+
+// resourceGroupPolicies loads the policies declared by resource groups. The compiled policies of each resource group
+// are cached, so that rules are only compiled again when the policies of the resource group change.
+type resourceGroupPolicies struct {
+	ucpClient *v20231001preview.ClientFactory
+
+	mu      sync.Mutex
+	engines map[string]resourceGroupEngine
+}
+
+// resourceGroupEngine is the compiled policies of a resource group.
+type resourceGroupEngine struct {
+	// policies is the JSON representation of the policies the engine was compiled from.
+	policies string
+	engine   *policy.Engine
+}
+
+// newResourceGroupPolicies creates a new resourceGroupPolicies.
+func newResourceGroupPolicies(ucpClient *v20231001preview.ClientFactory) *resourceGroupPolicies {
+	return &resourceGroupPolicies{
+		ucpClient: ucpClient,
+		engines:   map[string]resourceGroupEngine{},
+	}
+}
+
+// Engine returns the compiled policies of the resource group of the resource, or nil if the resource group doesn't
+// declare policies.
+func (p *resourceGroupPolicies) Engine(ctx context.Context, id resources.ID) (*policy.Engine, error) {
+	resourceGroupName := id.FindScope(resources_radius.ScopeResourceGroups)
+	if p.ucpClient == nil || resourceGroupName == "" {
+		return nil, nil
+	}
+
+	planeName := strings.Split(id.PlaneNamespace(), "/")[1]
+	response, err := p.ucpClient.NewResourceGroupsClient().Get(ctx, planeName, resourceGroupName, nil)
+	if clientv2.Is404Error(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get the policies of resource group %q: %w", resourceGroupName, err)
+	}
+
+	dm, err := response.ResourceGroupResource.ConvertTo()
+	if err != nil {
+		return nil, err
+	}
+
+	resourceGroup := dm.(*ucpdatamodel.ResourceGroup)
+	if len(resourceGroup.Properties.Policies) == 0 {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(resourceGroup.Properties.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.ToLower(id.PlaneNamespace() + "/" + resourceGroupName)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cached, ok := p.engines[key]; ok && cached.policies == string(bs) {
+		return cached.engine, nil
+	}
+
+	engine, err := policy.NewEngine(policy.FromResourceGroup(resourceGroup))
+	if err != nil {
+		return nil, fmt.Errorf("the policies of resource group %q are invalid: %w", resourceGroupName, err)
+	}
+
+	p.engines[key] = resourceGroupEngine{policies: string(bs), engine: engine}
+	return engine, nil
+}
+
+// policyResource returns the representation of a resource that policy rules are evaluated against.
+func policyResource(serviceCtx *v1.ARMRequestContext, resource *datamodel.DynamicResource) (map[string]any, error) {
+	// Round-trip the properties through JSON so that rules see the same values as clients.
+	bs, err := json.Marshal(resource.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource properties: %w", err)
+	}
+
+	properties := map[string]any{}
+	err = json.Unmarshal(bs, &properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource properties: %w", err)
+	}
+
+	tags := map[string]any{}
+	for key, value := range resource.Tags {
+		tags[key] = value
+	}
+
+	return map[string]any{
+		"id":         serviceCtx.ResourceID.String(),
+		"name":       serviceCtx.ResourceID.Name(),
+		"type":       serviceCtx.ResourceID.Type(),
+		"location":   resource.Location,
+		"tags":       tags,
+		"properties": properties,
+	}, nil
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	azpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/policy"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPolicyEngine(t *testing.T) *policy.Engine {
+	engine, err := policy.NewEngine([]policy.Policy{
+		{
+			Name:    "backup-retention",
+			Rule:    "has(resource.properties.backupRetention) && resource.properties.backupRetention >= 7",
+			Message: "Databases must set backupRetention to at least 7.",
+		},
+		{
+			Name: "no-public-endpoints",
+			Rule: "!has(resource.properties.publicEndpoint) || !resource.properties.publicEndpoint",
+		},
+		{
+			Name:    "small-only",
+			Rule:    "!has(resource.properties.size) || resource.properties.size == 'S'",
+			Message: "Only small databases are allowed.",
+			Mode:    policy.ModeAudit,
+		},
+	})
+	require.NoError(t, err)
+	return engine
+}
+
+func TestMakePolicyFilter_Compliant(t *testing.T) {
+	filter := makePolicyFilter(newTestPolicyEngine(t), nil)
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"backupRetention": 7,
+			"status": map[string]any{
+				"policyViolations": []any{map[string]any{"policy": "small-only"}},
+			},
+		},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{}, resource.Properties["status"])
+}
+
+func TestMakePolicyFilter_Denied(t *testing.T) {
+	filter := makePolicyFilter(newTestPolicyEngine(t), nil)
+
+	t.Run("single policy", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"backupRetention": 3},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, codeRequestDisallowedByPolicy, body.Error.Code)
+		require.Equal(t, "backup-retention", body.Error.Target)
+		require.Equal(t, "Databases must set backupRetention to at least 7.", body.Error.Message)
+	})
+
+	t.Run("multiple policies", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"backupRetention": 3, "publicEndpoint": true},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, codeRequestDisallowedByPolicy, body.Error.Code)
+		require.Equal(t, "The resource violates policies backup-retention, no-public-endpoints.", body.Error.Message)
+		require.Len(t, body.Error.Details, 2)
+		require.Equal(t, "backup-retention", body.Error.Details[0].Target)
+		require.Equal(t, "no-public-endpoints", body.Error.Details[1].Target)
+	})
+}
+
+func TestMakePolicyFilter_Audited(t *testing.T) {
+	filter := makePolicyFilter(newTestPolicyEngine(t), nil)
+	oldResource := &datamodel.DynamicResource{
+		Properties: map[string]any{"backupRetention": 7, "size": "S"},
+	}
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{"backupRetention": 7, "size": "L"},
+	}
+
+	response, err := filter(createTestContext(), resource, oldResource, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{
+		"policyViolations": []any{
+			map[string]any{"policy": "small-only", "message": "Only small databases are allowed."},
+		},
+	}, resource.Properties["status"])
+}
+
+func TestMakePolicyFilter_NoPolicies(t *testing.T) {
+	engine, err := policy.NewEngine(nil)
+	require.NoError(t, err)
+
+	filter := makePolicyFilter(engine, nil)
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{"publicEndpoint": true},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{"publicEndpoint": true}, resource.Properties)
+}
+
+func createFakeUCPClientFactoryWithResourceGroupPolicies(t *testing.T, getCount *int, policies ...*v20231001preview.ResourceGroupPolicy) *v20231001preview.ClientFactory {
+	resourceGroupsServer := fake.ResourceGroupsServer{
+		Get: func(ctx context.Context, planeName string, resourceGroupName string, options *v20231001preview.ResourceGroupsClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientGetResponse], errResp azfake.ErrorResponder) {
+			*getCount++
+			if resourceGroupName != "test-group" {
+				errResp.SetResponseError(http.StatusNotFound, "NotFound")
+				return
+			}
+
+			resp.SetResponse(http.StatusOK, v20231001preview.ResourceGroupsClientGetResponse{
+				ResourceGroupResource: v20231001preview.ResourceGroupResource{
+					ID:         new("/planes/radius/" + planeName + "/resourceGroups/" + resourceGroupName),
+					Name:       new(resourceGroupName),
+					Type:       new("System.Resources/resourceGroups"),
+					Location:   new("global"),
+					Properties: &v20231001preview.ResourceGroupProperties{Policies: policies},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	ucpClient, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: azpolicy.ClientOptions{
+			Transport: fake.NewResourceGroupsServerTransport(&resourceGroupsServer),
+		},
+	})
+	require.NoError(t, err)
+	return ucpClient
+}
+
+func TestMakePolicyFilter_ResourceGroupPolicies(t *testing.T) {
+	engine, err := policy.NewEngine(nil)
+	require.NoError(t, err)
+
+	t.Run("denied", func(t *testing.T) {
+		getCount := 0
+		ucpClient := createFakeUCPClientFactoryWithResourceGroupPolicies(t, &getCount, &v20231001preview.ResourceGroupPolicy{
+			Name:    new("no-public-endpoints"),
+			Rule:    new("!has(resource.properties.publicEndpoint) || !resource.properties.publicEndpoint"),
+			Message: new("Public endpoints are not allowed in this resource group."),
+		})
+
+		filter := makePolicyFilter(engine, ucpClient)
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"publicEndpoint": true},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, codeRequestDisallowedByPolicy, body.Error.Code)
+		require.Equal(t, "no-public-endpoints", body.Error.Target)
+		require.Equal(t, "Public endpoints are not allowed in this resource group.", body.Error.Message)
+
+		// The compiled policies are reused while the policies of the resource group don't change.
+		resource.Properties["publicEndpoint"] = false
+		response, err = filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, 2, getCount)
+	})
+
+	t.Run("audited", func(t *testing.T) {
+		getCount := 0
+		ucpClient := createFakeUCPClientFactoryWithResourceGroupPolicies(t, &getCount, &v20231001preview.ResourceGroupPolicy{
+			Name:    new("small-only"),
+			Rule:    new("!has(resource.properties.size) || resource.properties.size == 'S'"),
+			Message: new("Only small databases are allowed."),
+			Mode:    new("audit"),
+		})
+
+		filter := makePolicyFilter(engine, ucpClient)
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"size": "L"},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, map[string]any{
+			"policyViolations": []any{
+				map[string]any{"policy": "small-only", "message": "Only small databases are allowed."},
+			},
+		}, resource.Properties["status"])
+	})
+
+	t.Run("resource group without policies", func(t *testing.T) {
+		getCount := 0
+		ucpClient := createFakeUCPClientFactoryWithResourceGroupPolicies(t, &getCount)
+
+		filter := makePolicyFilter(engine, ucpClient)
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"publicEndpoint": true},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, map[string]any{"publicEndpoint": true}, resource.Properties)
+	})
+}
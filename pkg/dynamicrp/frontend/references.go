@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// resolveReferences validates the references to other resources declared by the resource type schema with
+// x-radius-reference, and records them in the status of the resource. Each reference must be the ID of an existing
+// resource of the declared type.
+func resolveReferences(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	resourceSchema map[string]any,
+	databaseClient database.Client,
+) (rest.Response, error) {
+	references := schema.ExtractReferences(newResource.Properties, resourceSchema)
+
+	details := []*v1.ErrorDetails{}
+	resolved := []datamodel.ResourceReference{}
+	for _, reference := range references {
+		message, err := validateReference(ctx, reference, databaseClient)
+		if err != nil {
+			return nil, err
+		}
+
+		if message != "" {
+			details = append(details, &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: message,
+				Target:  "properties." + reference.Path,
+			})
+			continue
+		}
+
+		resolved = append(resolved, datamodel.ResourceReference{Path: reference.Path, ID: reference.ID})
+	}
+
+	if len(details) == 1 {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: details[0]}), nil
+	} else if len(details) > 1 {
+		paths := []string{}
+		for _, detail := range details {
+			paths = append(paths, strings.TrimPrefix(detail.Target, "properties."))
+		}
+
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("The fields %s contain invalid references.", strings.Join(paths, ", ")),
+				Details: details,
+			},
+		}), nil
+	}
+
+	newResource.SetReferences(resolved)
+	return nil, nil
+}
+
+// validateReference returns a message describing why the reference is invalid, or an empty string when the
+// reference is valid.
+func validateReference(ctx context.Context, reference schema.ResourceReference, databaseClient database.Client) (string, error) {
+	id, err := resources.ParseResource(reference.ID)
+	if err != nil {
+		return fmt.Sprintf("The field %q must be the ID of a %q resource, got %q.", reference.Path, reference.ResourceType, reference.ID), nil
+	}
+
+	if !strings.EqualFold(id.Type(), reference.ResourceType) {
+		return fmt.Sprintf("The field %q must be the ID of a %q resource, got a %q resource.", reference.Path, reference.ResourceType, id.Type()), nil
+	}
+
+	_, err = databaseClient.Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return fmt.Sprintf("The resource %q referenced by the field %q does not exist.", reference.ID, reference.Path), nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get resource %q referenced by the field %q: %w", reference.ID, reference.Path, err)
+	}
+
+	return "", nil
+}
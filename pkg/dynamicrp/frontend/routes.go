@@ -17,6 +17,7 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -27,15 +28,23 @@ import (
 	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/policy"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
+const (
+	// webhookTimeout is the timeout for calls to webhook action handlers.
+	webhookTimeout = time.Minute
+)
+
 func (s *Service) registerRoutes(
 	r *chi.Mux,
 	controllerOptions controller.Options,
 	ucpClient *v20231001preview.ClientFactory,
 	handler *encryption.SensitiveDataHandler,
+	webhook *ActionWebhook,
+	policyEngine *policy.Engine,
 ) error {
 	// Return ARM errors for invalid requests.
 	r.NotFound(validator.APINotFoundHandler())
@@ -50,20 +59,38 @@ func (s *Service) registerRoutes(
 		pathBase = pathBase + "/"
 	}
 
+	// Create filter for schema defaults, readOnly and immutable fields
+	schemaFilter := makeSchemaFilter(ucpClient)
+
+	// Create filter for organization policies
+	policyFilter := makePolicyFilter(policyEngine, ucpClient)
+
 	// Create encryption filter for sensitive fields
 	encryptionFilter := makeEncryptionFilter(ucpClient, handler)
 
-	// Resource options with encryption filter applied to PUT operations
-	resourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
-		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
-		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+	// Resource options with action status, schema, policy and encryption filters applied to PUT and PATCH operations.
+	// The schema filter runs first so that policies see defaults, and defaults are encrypted when they are sensitive.
+	// The policy filter runs before the encryption filter so that policies see sensitive values in plain text.
+	//
+	// The response converter serves resources in the requested API version, and is created for each request.
+	baseResourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
+		RequestConverter: converter.DynamicResourceDataModelFromVersioned,
 		UpdateFilters: []controller.UpdateFilter[datamodel.DynamicResource]{
+			actionStatusFilter,
+			schemaFilter,
+			policyFilter,
 			encryptionFilter,
 		},
 		AsyncOperationRetryAfter: time.Second * 5,
 		AsyncOperationTimeout:    time.Hour * 24,
 	}
 
+	resourceOptionsForRequest := func(ctx context.Context) controller.ResourceOptions[datamodel.DynamicResource] {
+		resourceOptions := baseResourceOptions
+		resourceOptions.ResponseConverter = newAPIVersionConverter(ctx, ucpClient).Convert
+		return resourceOptions
+	}
+
 	r.Route(pathBase+"planes/radius/{planeName}", func(r chi.Router) {
 
 		// Plane-scoped
@@ -71,8 +98,8 @@ func (s *Service) registerRoutes(
 
 			// Plane-scoped LIST operation
 			r.Get("/{resourceType}", dynamicOperationHandler(v1.OperationPlaneScopeList, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
-					optsCopy := resourceOptions
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					optsCopy := resourceOptionsForRequest(ctx)
 					optsCopy.ListRecursiveQuery = true
 					return NewListResourcesWithRedaction(opts, optsCopy, ucpClient)
 				}))
@@ -87,20 +114,28 @@ func (s *Service) registerRoutes(
 		// Resource-group-scoped
 		r.Route("/{rg:resource[gG]roups}/{resourceGroupName}/providers/{providerNamespace}/{resourceType}", func(r chi.Router) {
 			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
-					return NewListResourcesWithRedaction(opts, resourceOptions, ucpClient)
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return NewListResourcesWithRedaction(opts, resourceOptionsForRequest(ctx), ucpClient)
 				}))
 			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
-					return NewGetResourceWithRedaction(opts, resourceOptions, ucpClient)
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return NewGetResourceWithRedaction(opts, resourceOptionsForRequest(ctx), ucpClient)
 				}))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
-					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptionsForRequest(ctx))
+				}))
+			r.Patch("/{resourceName}", dynamicOperationHandler(v1.OperationPatch, controllerOptions,
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultAsyncPatch(opts, resourceOptionsForRequest(ctx))
 				}))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
-					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptionsForRequest(ctx))
+				}))
+			r.Post("/{resourceName}/{actionName}", dynamicOperationHandler(v1.OperationPost, controllerOptions,
+				func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+					return NewResourceAction(opts, resourceOptionsForRequest(ctx), ucpClient, handler, webhook)
 				}))
 		})
 	})
@@ -108,10 +143,10 @@ func (s *Service) registerRoutes(
 	return nil
 }
 
-func makeGetOperationResultController(opts controller.Options) (controller.Controller, error) {
+func makeGetOperationResultController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationResult(opts)
 }
 
-func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
+func makeGetOperationStatusController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// makeSchemaFilter creates an UpdateFilter that applies the field semantics declared by the resource type schema
+// before the resource is saved to the database.
+//
+// The filter:
+// 1. Replaces readOnly fields supplied by the client with their stored values
+// 2. Sets default values for fields that are missing from the request
+// 3. Rejects updates that change fields marked with x-radius-immutable
+// 4. Validates the references to other resources declared with x-radius-reference and records them in the status
+func makeSchemaFilter(ucpClient *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return applySchemaSemantics(ctx, newResource, oldResource, ucpClient, options)
+	}
+}
+
+// applySchemaSemantics applies the readOnly, default, x-radius-immutable and x-radius-reference semantics of the
+// resource type schema.
+func applySchemaSemantics(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+	options *controller.Options,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	resourceSchema, err := schema.GetSchema(ctx, ucpClient, serviceCtx.ResourceID.String(), resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema", "resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema for resource validation",
+			},
+		}), nil
+	}
+
+	if resourceSchema == nil {
+		return nil, nil
+	}
+
+	if newResource.Properties == nil {
+		newResource.Properties = map[string]any{}
+	}
+
+	var oldProperties map[string]any
+	if oldResource != nil {
+		oldProperties = oldResource.Properties
+	}
+
+	schema.PreserveReadOnlyFields(newResource.Properties, oldProperties, resourceSchema)
+	schema.ApplyDefaults(newResource.Properties, resourceSchema)
+
+	if oldResource != nil {
+		changed := schema.CheckImmutableFields(newResource.Properties, oldProperties, resourceSchema)
+		if len(changed) > 0 {
+			return immutableFieldsResponse(changed), nil
+		}
+	}
+
+	return resolveReferences(ctx, newResource, resourceSchema, options.DatabaseClient)
+}
+
+// immutableFieldsResponse returns the response for a request that changes fields marked with x-radius-immutable.
+func immutableFieldsResponse(changed []string) rest.Response {
+	details := []*v1.ErrorDetails{}
+	for _, path := range changed {
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("The field %q cannot be changed after the resource is created.", path),
+			Target:  "properties." + path,
+		})
+	}
+
+	if len(details) == 1 {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: details[0]})
+	}
+
+	return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("The fields %s cannot be changed after the resource is created.", strings.Join(changed, ", ")),
+			Details: details,
+		},
+	})
+}
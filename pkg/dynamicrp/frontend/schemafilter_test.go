@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchemaFilterSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"tier":     map[string]any{"type": "string", "default": "standard"},
+		"region":   map[string]any{"type": "string", "x-radius-immutable": true},
+		"zone":     map[string]any{"type": "string", "x-radius-immutable": true},
+		"endpoint": map[string]any{"type": "string", "readOnly": true},
+	},
+}
+
+func newTestSchemaFilterOptions() *controller.Options {
+	return &controller.Options{DatabaseClient: inmemory.NewClient()}
+}
+
+func TestMakeSchemaFilter_Create(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(testSchemaFilterSchema)
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"region":   "westus",
+			"endpoint": "http://client",
+		},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, newTestSchemaFilterOptions())
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{"region": "westus", "tier": "standard"}, resource.Properties)
+}
+
+func TestMakeSchemaFilter_UpdatePreservesReadOnlyFields(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(testSchemaFilterSchema)
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+	oldResource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"region":   "westus",
+			"tier":     "standard",
+			"endpoint": "http://server",
+		},
+	}
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"region":   "westus",
+			"tier":     "premium",
+			"endpoint": "http://client",
+		},
+	}
+
+	response, err := filter(createTestContext(), resource, oldResource, newTestSchemaFilterOptions())
+	require.NoError(t, err)
+	require.Nil(t, response)
+	require.Equal(t, map[string]any{"region": "westus", "tier": "premium", "endpoint": "http://server"}, resource.Properties)
+}
+
+func TestMakeSchemaFilter_UpdateChangesImmutableField(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactory(testSchemaFilterSchema)
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+	oldResource := &datamodel.DynamicResource{
+		Properties: map[string]any{"region": "westus", "zone": "1"},
+	}
+
+	t.Run("single field", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"region": "eastus", "zone": "1"},
+		}
+
+		response, err := filter(createTestContext(), resource, oldResource, newTestSchemaFilterOptions())
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, "BadRequest", body.Error.Code)
+		require.Equal(t, "properties.region", body.Error.Target)
+	})
+
+	t.Run("multiple fields", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"region": "eastus"},
+		}
+
+		response, err := filter(createTestContext(), resource, oldResource, newTestSchemaFilterOptions())
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Len(t, body.Error.Details, 2)
+		require.Equal(t, "properties.region", body.Error.Details[0].Target)
+		require.Equal(t, "properties.zone", body.Error.Details[1].Target)
+	})
+}
+
+func TestMakeSchemaFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{"region": "westus"},
+	}
+
+	response, err := filter(createTestContext(), resource, nil, newTestSchemaFilterOptions())
+	require.NoError(t, err)
+	require.NotNil(t, response)
+}
+
+func TestMakeSchemaFilter_References(t *testing.T) {
+	const databaseID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/db"
+	const missingID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/missing"
+	const cacheID = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/redisCaches/cache"
+
+	resourceSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"database": map[string]any{"type": "string", "x-radius-reference": "Radius.Data/postgreSqlDatabases"},
+			"replica":  map[string]any{"type": "string", "x-radius-reference": "Radius.Data/postgreSqlDatabases"},
+		},
+	}
+	ucpClient, err := createFakeUCPClientFactory(resourceSchema)
+	require.NoError(t, err)
+
+	databaseClient := inmemory.NewClient()
+	err = databaseClient.Save(context.Background(), &database.Object{
+		Metadata: database.Metadata{ID: databaseID},
+		Data:     map[string]any{"id": databaseID},
+	})
+	require.NoError(t, err)
+
+	filter := makeSchemaFilter(ucpClient)
+	options := &controller.Options{DatabaseClient: databaseClient}
+
+	t.Run("valid", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"database": databaseID},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, []datamodel.ResourceReference{{Path: "database", ID: databaseID}}, resource.References())
+	})
+
+	t.Run("references are removed", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{}}
+		resource.SetReferences([]datamodel.ResourceReference{{Path: "database", ID: databaseID}})
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Empty(t, resource.References())
+	})
+
+	t.Run("missing resource", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"database": missingID},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Equal(t, "properties.database", body.Error.Target)
+		require.Contains(t, body.Error.Message, "does not exist")
+	})
+
+	t.Run("wrong type and invalid ID", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"database": cacheID, "replica": "db"},
+		}
+
+		response, err := filter(createTestContext(), resource, nil, options)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+
+		body := response.(*rest.BadRequestResponse).Body
+		require.Len(t, body.Error.Details, 2)
+		require.Equal(t, "properties.database", body.Error.Details[0].Target)
+		require.Contains(t, body.Error.Details[0].Message, `got a "Radius.Data/redisCaches" resource`)
+		require.Equal(t, "properties.replica", body.Error.Details[1].Target)
+	})
+}
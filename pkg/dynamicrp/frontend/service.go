@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/crypto/encryption"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/policy"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
@@ -79,6 +80,18 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 		return nil, fmt.Errorf("failed to create sensitive data handler: %w", err)
 	}
 
+	// Create the client for custom actions handled by webhooks
+	webhook, err := NewActionWebhookFromOptions(&http.Client{Timeout: webhookTimeout}, s.options.Config.Actions.Webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create action webhook client: %w", err)
+	}
+
+	// Create policy engine for organization policies
+	policyEngine, err := policy.NewEngine(s.options.Config.Policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy engine: %w", err)
+	}
+
 	controllerOptions := controller.Options{
 		Address:        s.options.Config.Server.Address(),
 		PathBase:       s.options.Config.Server.PathBase,
@@ -89,7 +102,7 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 		ResourceType: "",  // Set dynamically
 	}
 
-	err = s.registerRoutes(r, controllerOptions, ucpClient, sensitiveDataHandler)
+	err = s.registerRoutes(r, controllerOptions, ucpClient, sensitiveDataHandler, webhook, policyEngine)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register routes: %w", err)
 	}
@@ -27,6 +27,7 @@ import (
 	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -49,6 +50,44 @@ func FindDeployment(resources []rpv1.OutputResource) (*appsv1.Deployment, rpv1.O
 	return nil, rpv1.OutputResource{}
 }
 
+// FindStatefulSet searches through a slice of OutputResource objects and returns the first StatefulSet object and its
+// associated OutputResource object.
+func FindStatefulSet(resources []rpv1.OutputResource) (*appsv1.StatefulSet, rpv1.OutputResource) {
+	for _, r := range resources {
+		if r.GetResourceType().Type != resources_kubernetes.ResourceTypeStatefulSet {
+			continue
+		}
+
+		statefulSet, ok := r.CreateResource.Data.(*appsv1.StatefulSet)
+		if !ok {
+			continue
+		}
+
+		return statefulSet, r
+	}
+
+	return nil, rpv1.OutputResource{}
+}
+
+// FindWorkload searches through a slice of OutputResource objects and returns the first workload (Deployment or
+// StatefulSet) found, its pod template, and the OutputResource object it was found in.
+func FindWorkload(resources []rpv1.OutputResource) (metav1.Object, *corev1.PodTemplateSpec, rpv1.OutputResource) {
+	for _, r := range resources {
+		switch obj := r.CreateResource.Data.(type) {
+		case *appsv1.Deployment:
+			if r.GetResourceType().Type == resources_kubernetes.ResourceTypeDeployment {
+				return obj, &obj.Spec.Template, r
+			}
+		case *appsv1.StatefulSet:
+			if r.GetResourceType().Type == resources_kubernetes.ResourceTypeStatefulSet {
+				return obj, &obj.Spec.Template, r
+			}
+		}
+	}
+
+	return nil, nil, rpv1.OutputResource{}
+}
+
 // FindService searches through a slice of OutputResource objects and returns the first Service object found and the
 // OutputResource object it was found in.
 func FindService(resources []rpv1.OutputResource) (*corev1.Service, rpv1.OutputResource) {
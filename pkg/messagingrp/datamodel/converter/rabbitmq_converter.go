@@ -37,6 +37,25 @@ func RabbitMQQueueDataModelToVersioned(model *datamodel.RabbitMQQueue, version s
 	}
 }
 
+// RabbitMQQueueDataModelToVersionedWithSecrets converts a datamodel.RabbitMQQueue to a versioned model like
+// RabbitMQQueueDataModelToVersioned, but keeps the secrets so that the result can be used as the base of a PATCH request.
+func RabbitMQQueueDataModelToVersionedWithSecrets(model *datamodel.RabbitMQQueue, version string) (v1.VersionedModelInterface, error) {
+	versioned, err := RabbitMQQueueDataModelToVersioned(model, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if queue, ok := versioned.(*v20231001preview.RabbitMQQueueResource); ok && model.Properties.Secrets != (datamodel.RabbitMQSecrets{}) {
+		secrets := &v20231001preview.RabbitMQSecrets{}
+		if err := secrets.ConvertFrom(&model.Properties.Secrets); err != nil {
+			return nil, err
+		}
+		queue.Properties.Secrets = secrets
+	}
+
+	return versioned, nil
+}
+
 // RabbitMQQueueDataModelFromVersioned takes in a byte slice and a version string and returns a version-agnostic
 // RabbitMQQueue datamodel and an error if the version is unsupported.
 func RabbitMQQueueDataModelFromVersioned(content []byte, version string) (*datamodel.RabbitMQQueue, error) {
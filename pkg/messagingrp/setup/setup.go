@@ -40,8 +40,9 @@ func SetupNamespace(recipeControllerConfig *controllerconfig.RecipeControllerCon
 	ns := builder.NewNamespace("Applications.Messaging")
 
 	_ = ns.AddResource("rabbitMQQueues", &builder.ResourceOption[*datamodel.RabbitMQQueue, datamodel.RabbitMQQueue]{
-		RequestConverter:  converter.RabbitMQQueueDataModelFromVersioned,
-		ResponseConverter: converter.RabbitMQQueueDataModelToVersioned,
+		RequestConverter:   converter.RabbitMQQueueDataModelFromVersioned,
+		ResponseConverter:  converter.RabbitMQQueueDataModelToVersioned,
+		PatchBaseConverter: converter.RabbitMQQueueDataModelToVersionedWithSecrets,
 
 		Put: builder.Operation[datamodel.RabbitMQQueue]{
 			UpdateFilters: []apictrl.UpdateFilter[datamodel.RabbitMQQueue]{
@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+const (
+	variableResource    = "resource"
+	variableOldResource = "oldResource"
+	variableOperation   = "operation"
+
+	// ruleCostLimit is the maximum cost of evaluating a policy rule, so that a rule iterating over large or nested
+	// collections can't exhaust the CPU of the resource provider.
+	ruleCostLimit = 1_000_000
+
+	// ruleInterruptCheckFrequency is the number of comprehension iterations between checks for the cancellation of
+	// the evaluation of a policy rule.
+	ruleInterruptCheckFrequency = 100
+
+	// ruleEvaluationTimeout is the maximum duration of the evaluation of a policy rule.
+	ruleEvaluationTimeout = 1 * time.Second
+)
+
+// Input describes a request to create or update a resource.
+type Input struct {
+	// Resource is the resource being created or updated, as JSON-compatible values.
+	Resource map[string]any
+
+	// OldResource is the existing resource, or nil when the resource is being created.
+	OldResource map[string]any
+
+	// Operation is the HTTP method of the request.
+	Operation string
+
+	// ResourceType is the fully-qualified type of the resource.
+	ResourceType string
+
+	// ResourceGroup is the name of the resource group of the resource.
+	ResourceGroup string
+
+	// Environment is the resource ID of the environment of the resource, if it has one.
+	Environment string
+}
+
+// Violation is a policy that a resource does not satisfy.
+type Violation struct {
+	// Policy is the name of the policy.
+	Policy string `json:"policy"`
+
+	// Mode is the mode of the policy.
+	Mode Mode `json:"mode"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
+}
+
+// Engine evaluates a set of policies.
+type Engine struct {
+	policies []compiledPolicy
+}
+
+type compiledPolicy struct {
+	Policy
+	program cel.Program
+}
+
+// NewEngine validates and compiles a set of policies.
+func NewEngine(policies []Policy) (*Engine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(variableResource, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(variableOldResource, cel.DynType),
+		cel.Variable(variableOperation, cel.StringType),
+
+		// Resource properties are decoded from JSON, so numbers are doubles. Allow them to be compared with integers.
+		cel.CrossTypeNumericComparisons(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy environment: %w", err)
+	}
+
+	engine := &Engine{}
+	names := map[string]bool{}
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, err
+		}
+
+		if names[policy.Name] {
+			return nil, fmt.Errorf("policy %q is declared more than once", policy.Name)
+		}
+		names[policy.Name] = true
+
+		ast, issues := env.Compile(policy.Rule)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("policy %q has an invalid rule: %w", policy.Name, issues.Err())
+		}
+
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("policy %q has a rule of type %s, rules must evaluate to a bool", policy.Name, ast.OutputType())
+		}
+
+		program, err := env.Program(ast, cel.CostLimit(ruleCostLimit), cel.InterruptCheckFrequency(ruleInterruptCheckFrequency))
+		if err != nil {
+			return nil, fmt.Errorf("policy %q has an invalid rule: %w", policy.Name, err)
+		}
+
+		engine.policies = append(engine.policies, compiledPolicy{Policy: policy, program: program})
+	}
+
+	return engine, nil
+}
+
+// IsEmpty returns true if the engine has no policies.
+func (e *Engine) IsEmpty() bool {
+	return e == nil || len(e.policies) == 0
+}
+
+// Evaluate evaluates the policies whose scope selects the resource, and returns the policies it violates in the
+// order they were declared.
+//
+// A rule that fails to evaluate, for example because it refers to a property the resource doesn't set, exceeds its cost
+// limit or evaluation timeout, or doesn't evaluate to a bool, is reported as a violation.
+func (e *Engine) Evaluate(ctx context.Context, input *Input) []Violation {
+	if e.IsEmpty() {
+		return nil
+	}
+
+	var oldResource any
+	if input.OldResource != nil {
+		oldResource = input.OldResource
+	}
+
+	activation := map[string]any{
+		variableResource:    input.Resource,
+		variableOldResource: oldResource,
+		variableOperation:   input.Operation,
+	}
+
+	violations := []Violation{}
+	for _, policy := range e.policies {
+		if !policy.Scope.Matches(input) {
+			continue
+		}
+
+		result, err := policy.eval(ctx, activation)
+		if err != nil {
+			violations = append(violations, policy.violation(fmt.Sprintf("the policy rule could not be evaluated: %v", err)))
+			continue
+		}
+
+		allowed, ok := result.Value().(bool)
+		if !ok {
+			violations = append(violations, policy.violation(fmt.Sprintf("the policy rule evaluated to %v, rules must evaluate to a bool", result.Value())))
+			continue
+		}
+
+		if !allowed {
+			violations = append(violations, policy.violation(""))
+		}
+	}
+
+	return violations
+}
+
+// eval evaluates the rule of the policy, cancelling the evaluation after ruleEvaluationTimeout.
+func (p *compiledPolicy) eval(ctx context.Context, activation map[string]any) (ref.Val, error) {
+	ctx, cancel := context.WithTimeout(ctx, ruleEvaluationTimeout)
+	defer cancel()
+
+	result, _, err := p.program.ContextEval(ctx, activation)
+	return result, err
+}
+
+// violation creates a violation of the policy. The detail is appended to the message of the policy.
+func (p *compiledPolicy) violation(detail string) Violation {
+	message := p.Message
+	if message == "" {
+		message = fmt.Sprintf("The resource violates policy %q.", p.Name)
+	}
+	if detail != "" {
+		message = fmt.Sprintf("%s Cause: %s.", message, detail)
+	}
+
+	return Violation{
+		Policy:  p.Name,
+		Mode:    p.EffectiveMode(),
+		Message: message,
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceType = "MyCompany.Resources/databases"
+	testEnvironment  = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"
+)
+
+func testInput(properties map[string]any) *Input {
+	return &Input{
+		Resource: map[string]any{
+			"name":       "db",
+			"type":       testResourceType,
+			"properties": properties,
+		},
+		Operation:     "PUT",
+		ResourceType:  testResourceType,
+		ResourceGroup: "prod",
+		Environment:   testEnvironment,
+	}
+}
+
+func TestNewEngine_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Policy
+		errMsg   string
+	}{
+		{
+			name:     "missing name",
+			policies: []Policy{{Rule: "true"}},
+			errMsg:   "policy name is required",
+		},
+		{
+			name:     "missing rule",
+			policies: []Policy{{Name: "p"}},
+			errMsg:   `policy "p" must specify a rule`,
+		},
+		{
+			name:     "unsupported language",
+			policies: []Policy{{Name: "p", Rule: "allow", Language: "rego"}},
+			errMsg:   `policy "p" has unsupported language "rego", supported languages are: cel`,
+		},
+		{
+			name:     "unsupported mode",
+			policies: []Policy{{Name: "p", Rule: "true", Mode: "warn"}},
+			errMsg:   `policy "p" has unsupported mode "warn", supported modes are: enforce, audit`,
+		},
+		{
+			name:     "duplicate name",
+			policies: []Policy{{Name: "p", Rule: "true"}, {Name: "p", Rule: "false"}},
+			errMsg:   `policy "p" is declared more than once`,
+		},
+		{
+			name:     "invalid rule",
+			policies: []Policy{{Name: "p", Rule: "resource.properties.size >="}},
+			errMsg:   `policy "p" has an invalid rule`,
+		},
+		{
+			name:     "rule is not a bool",
+			policies: []Policy{{Name: "p", Rule: "operation"}},
+			errMsg:   `policy "p" has a rule of type string, rules must evaluate to a bool`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewEngine(tt.policies)
+			require.ErrorContains(t, err, tt.errMsg)
+		})
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	engine, err := NewEngine([]Policy{
+		{
+			Name:    "backup-retention",
+			Rule:    "has(resource.properties.backupRetention) && resource.properties.backupRetention >= 7",
+			Message: "Databases in prod must set backupRetention to at least 7.",
+			Scope: Scope{
+				ResourceTypes: []string{"mycompany.resources/databases"},
+				Environments:  []string{"prod"},
+			},
+		},
+		{
+			Name: "no-public-endpoints",
+			Rule: "!has(resource.properties.publicEndpoint) || resource.properties.publicEndpoint == false",
+			Mode: ModeAudit,
+		},
+		{
+			Name: "staging-only",
+			Rule: "false",
+			Scope: Scope{
+				ResourceGroups: []string{"staging"},
+			},
+		},
+		{
+			Name: "immutable-size",
+			Rule: "oldResource == null || resource.properties.size == oldResource.properties.size",
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("compliant", func(t *testing.T) {
+		violations := engine.Evaluate(context.Background(), testInput(map[string]any{"backupRetention": float64(7), "size": "S"}))
+		require.Empty(t, violations)
+	})
+
+	t.Run("violations in declaration order", func(t *testing.T) {
+		violations := engine.Evaluate(context.Background(), testInput(map[string]any{"backupRetention": float64(3), "publicEndpoint": true, "size": "S"}))
+		require.Equal(t, []Violation{
+			{Policy: "backup-retention", Mode: ModeEnforce, Message: "Databases in prod must set backupRetention to at least 7."},
+			{Policy: "no-public-endpoints", Mode: ModeAudit, Message: `The resource violates policy "no-public-endpoints".`},
+		}, violations)
+	})
+
+	t.Run("out of scope", func(t *testing.T) {
+		input := testInput(map[string]any{"size": "S"})
+		input.Environment = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/dev"
+		violations := engine.Evaluate(context.Background(), input)
+		require.Empty(t, violations)
+	})
+
+	t.Run("old resource", func(t *testing.T) {
+		input := testInput(map[string]any{"backupRetention": float64(7), "size": "L"})
+		input.OldResource = map[string]any{"properties": map[string]any{"size": "S"}}
+		violations := engine.Evaluate(context.Background(), input)
+		require.Equal(t, []Violation{
+			{Policy: "immutable-size", Mode: ModeEnforce, Message: `The resource violates policy "immutable-size".`},
+		}, violations)
+	})
+
+	t.Run("evaluation error", func(t *testing.T) {
+		input := testInput(map[string]any{"backupRetention": float64(7)})
+		input.OldResource = map[string]any{"properties": map[string]any{"size": "S"}}
+		violations := engine.Evaluate(context.Background(), input)
+		require.Len(t, violations, 1)
+		require.Equal(t, "immutable-size", violations[0].Policy)
+		require.Contains(t, violations[0].Message, "Cause: the policy rule could not be evaluated")
+	})
+}
+
+func TestEngine_Evaluate_Limits(t *testing.T) {
+	engine, err := NewEngine([]Policy{
+		{
+			Name: "nested-loops",
+			Rule: "[0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(a, [0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(b, [0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(c, " +
+				"[0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(d, [0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(e, [0, 1, 2, 3, 4, 5, 6, 7, 8, 9].all(f, a + b + c + d + e + f >= 0))))))",
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("cost limit", func(t *testing.T) {
+		violations := engine.Evaluate(context.Background(), testInput(map[string]any{}))
+		require.Len(t, violations, 1)
+		require.Contains(t, violations[0].Message, "Cause: the policy rule could not be evaluated")
+		require.Contains(t, violations[0].Message, "cost limit exceeded")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		engine, err := NewEngine([]Policy{{Name: "positive-items", Rule: "resource.properties.items.all(x, x >= 0)"}})
+		require.NoError(t, err)
+
+		items := make([]any, 1000)
+		for i := range items {
+			items[i] = float64(i)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		violations := engine.Evaluate(ctx, testInput(map[string]any{"items": items}))
+		require.Len(t, violations, 1)
+		require.Contains(t, violations[0].Message, "operation interrupted")
+	})
+}
+
+func TestEngine_Evaluate_Empty(t *testing.T) {
+	var engine *Engine
+	require.True(t, engine.IsEmpty())
+	require.Empty(t, engine.Evaluate(context.Background(), testInput(map[string]any{})))
+}
+
+func TestScope_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		scope    Scope
+		expected bool
+	}{
+		{name: "empty scope", scope: Scope{}, expected: true},
+		{name: "resource type", scope: Scope{ResourceTypes: []string{testResourceType}}, expected: true},
+		{name: "other resource type", scope: Scope{ResourceTypes: []string{"MyCompany.Resources/caches"}}, expected: false},
+		{name: "resource group", scope: Scope{ResourceGroups: []string{"PROD"}}, expected: true},
+		{name: "other resource group", scope: Scope{ResourceGroups: []string{"dev"}}, expected: false},
+		{name: "environment name", scope: Scope{Environments: []string{"prod"}}, expected: true},
+		{name: "environment ID", scope: Scope{Environments: []string{testEnvironment}}, expected: true},
+		{name: "other environment", scope: Scope{Environments: []string{"dev"}}, expected: false},
+		{name: "all selectors must match", scope: Scope{ResourceTypes: []string{testResourceType}, ResourceGroups: []string{"dev"}}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.scope.Matches(testInput(nil)))
+		})
+	}
+}
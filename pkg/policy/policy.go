@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy evaluates organization policies against resources when they are created or updated.
+//
+// A policy is a rule, written in the Common Expression Language (CEL), that a resource must satisfy. Policies are
+// attached to resource types, environments and resource groups using a scope. A policy in enforce mode denies
+// requests that violate it. A policy in audit mode allows them and reports the violation on the resource.
+package policy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// Mode is the mode of a policy.
+type Mode string
+
+const (
+	// ModeEnforce denies requests that violate the policy.
+	ModeEnforce Mode = "enforce"
+
+	// ModeAudit allows requests that violate the policy and reports the violation on the resource.
+	ModeAudit Mode = "audit"
+)
+
+// Language is the language a policy rule is written in.
+type Language string
+
+const (
+	// LanguageCEL is the Common Expression Language. The rule is an expression that evaluates to true when the
+	// resource satisfies the policy.
+	LanguageCEL Language = "cel"
+)
+
+// Policy is an organization policy for resources.
+type Policy struct {
+	// Name is the name of the policy. It is used as the target of the errors reported for the policy.
+	Name string `yaml:"name"`
+
+	// Description of the policy.
+	Description string `yaml:"description,omitempty"`
+
+	// Language is the language of the rule. Defaults to 'cel'.
+	Language Language `yaml:"language,omitempty"`
+
+	// Rule evaluates to true when the resource satisfies the policy. A CEL rule can refer to:
+	//   - resource: the resource being created or updated, including its properties.
+	//   - oldResource: the existing resource, or null when the resource is being created.
+	//   - operation: the HTTP method of the request, 'PUT' or 'PATCH'.
+	Rule string `yaml:"rule"`
+
+	// Message is reported when the resource violates the policy.
+	Message string `yaml:"message,omitempty"`
+
+	// Mode is the mode of the policy. Defaults to 'enforce'.
+	Mode Mode `yaml:"mode,omitempty"`
+
+	// Scope selects the resources the policy applies to.
+	Scope Scope `yaml:"scope,omitempty"`
+}
+
+// Scope selects the resources a policy applies to. A resource is selected when it matches every non-empty list.
+// An empty scope selects all resources.
+type Scope struct {
+	// ResourceTypes is a list of fully-qualified resource types (e.g. 'MyCompany.Resources/databases').
+	ResourceTypes []string `yaml:"resourceTypes,omitempty"`
+
+	// Environments is a list of environments, by name or resource ID. A resource matches when its
+	// 'environment' property refers to one of them.
+	Environments []string `yaml:"environments,omitempty"`
+
+	// ResourceGroups is a list of resource group names.
+	ResourceGroups []string `yaml:"resourceGroups,omitempty"`
+}
+
+// Validate checks that a policy is well-formed, without compiling its rule.
+func (p *Policy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("policy name is required")
+	}
+
+	if p.Rule == "" {
+		return fmt.Errorf("policy %q must specify a rule", p.Name)
+	}
+
+	if p.Language != "" && p.Language != LanguageCEL {
+		return fmt.Errorf("policy %q has unsupported language %q, supported languages are: %s", p.Name, p.Language, LanguageCEL)
+	}
+
+	if p.Mode != "" && p.Mode != ModeEnforce && p.Mode != ModeAudit {
+		return fmt.Errorf("policy %q has unsupported mode %q, supported modes are: %s, %s", p.Name, p.Mode, ModeEnforce, ModeAudit)
+	}
+
+	return nil
+}
+
+// EffectiveMode returns the mode of the policy, defaulting to enforce.
+func (p *Policy) EffectiveMode() Mode {
+	if p.Mode == "" {
+		return ModeEnforce
+	}
+
+	return p.Mode
+}
+
+// Matches returns true if the scope selects the resource described by input.
+func (s *Scope) Matches(input *Input) bool {
+	if len(s.ResourceTypes) > 0 && !containsFold(s.ResourceTypes, input.ResourceType) {
+		return false
+	}
+
+	if len(s.ResourceGroups) > 0 && !containsFold(s.ResourceGroups, input.ResourceGroup) {
+		return false
+	}
+
+	if len(s.Environments) > 0 {
+		if input.Environment == "" {
+			return false
+		}
+
+		// Environments can be selected by name or by resource ID.
+		environmentName := input.Environment[strings.LastIndex(input.Environment, "/")+1:]
+		if !containsFold(s.Environments, input.Environment) && !containsFold(s.Environments, environmentName) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+}
+
+// FromResourceGroup returns the policies declared by a resource group. The policies only apply to the resources of
+// the resource group.
+func FromResourceGroup(resourceGroup *ucpdatamodel.ResourceGroup) []Policy {
+	policies := []Policy{}
+	for _, p := range resourceGroup.Properties.Policies {
+		policies = append(policies, Policy{
+			Name:        p.Name,
+			Description: p.Description,
+			Language:    Language(p.Language),
+			Rule:        p.Rule,
+			Message:     p.Message,
+			Mode:        Mode(p.Mode),
+			Scope: Scope{
+				ResourceTypes:  p.Scope.ResourceTypes,
+				Environments:   p.Scope.Environments,
+				ResourceGroups: []string{resourceGroup.Name},
+			},
+		})
+	}
+
+	return policies
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ucpdatamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromResourceGroup(t *testing.T) {
+	resourceGroup := &ucpdatamodel.ResourceGroup{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{Name: "prod"},
+		},
+		Properties: ucpdatamodel.ResourceGroupProperties{
+			Policies: []ucpdatamodel.ResourceGroupPolicy{
+				{
+					Name:    "small-only",
+					Rule:    "resource.properties.size == 'S'",
+					Message: "Only small databases are allowed.",
+					Mode:    "audit",
+					Scope: ucpdatamodel.ResourceGroupPolicyScope{
+						ResourceTypes: []string{testResourceType},
+					},
+				},
+			},
+		},
+	}
+
+	policies := FromResourceGroup(resourceGroup)
+	require.Equal(t, []Policy{
+		{
+			Name:    "small-only",
+			Rule:    "resource.properties.size == 'S'",
+			Message: "Only small databases are allowed.",
+			Mode:    ModeAudit,
+			Scope: Scope{
+				ResourceTypes:  []string{testResourceType},
+				ResourceGroups: []string{"prod"},
+			},
+		},
+	}, policies)
+
+	engine, err := NewEngine(policies)
+	require.NoError(t, err)
+
+	// The policies only apply to the resources of the resource group.
+	input := testInput(map[string]any{"size": "L"})
+	require.Len(t, engine.Evaluate(context.Background(), input), 1)
+
+	input.ResourceGroup = "dev"
+	require.Empty(t, engine.Evaluate(context.Background(), input))
+}
@@ -27,10 +27,12 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/kubernetes"
 	"github.com/radius-project/radius/pkg/recipes"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
 	"github.com/radius-project/radius/pkg/rp/kube"
 	"github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/resources/radius"
 )
@@ -97,7 +99,7 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 		RecipeConfig: datamodel.RecipeConfigProperties{},
 	}
 
-	switch environment.Properties.Compute.(type) {
+	switch c := environment.Properties.Compute.(type) {
 	case *v20231001preview.KubernetesCompute:
 		config.Runtime.Kubernetes = &recipes.KubernetesRuntime{}
 		var err error
@@ -120,6 +122,12 @@ func getConfiguration(environment *v20231001preview.EnvironmentResource, applica
 
 	case *v20231001preview.AzureContainerInstanceCompute:
 		config.Runtime.AzureContainerInstances = &recipes.AzureContainerInstancesRuntime{}
+	case *v20231001preview.DockerCompute:
+		// Containers of the environment are attached to the network named after the environment by default.
+		config.Runtime.Docker = &recipes.DockerRuntime{Network: to.String(c.Network)}
+		if config.Runtime.Docker.Network == "" && environment.Name != nil {
+			config.Runtime.Docker.Network = kubernetes.NormalizeResourceName(*environment.Name)
+		}
 	default:
 		return nil, ErrUnsupportedComputeKind
 	}
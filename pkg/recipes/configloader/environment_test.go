@@ -231,6 +231,43 @@ func TestGetConfiguration(t *testing.T) {
 			},
 			errString: "invalid model conversion",
 		},
+		{
+			name: "docker compute",
+			envResource: &model.EnvironmentResource{
+				Name: new("Test-Env"),
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.DockerCompute{
+						Kind: new("docker"),
+					},
+				},
+			},
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Docker: &recipes.DockerRuntime{
+						Network: "test-env",
+					},
+				},
+			},
+		},
+		{
+			name: "docker compute with network",
+			envResource: &model.EnvironmentResource{
+				Name: new("test-env"),
+				Properties: &model.EnvironmentProperties{
+					Compute: &model.DockerCompute{
+						Kind:    new("docker"),
+						Network: new("shared"),
+					},
+				},
+			},
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Docker: &recipes.DockerRuntime{
+						Network: "shared",
+					},
+				},
+			},
+		},
 		{
 			name: "invalid env resource",
 			envResource: &model.EnvironmentResource{
@@ -27,10 +27,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 	"oras.land/oras-go/v2/registry/remote"
 
 	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/components/trace"
 	coredm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
@@ -105,7 +107,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	downloadCtx, span := trace.StartInternalSpan(ctx, "recipe.download", trace.RecipeTracerName, driver.TraceAttributes(opts.Recipe, &opts.Definition)...)
+	err = util.ReadFromRegistry(downloadCtx, opts.Definition, &recipeData, registryClient)
+	trace.EndSpan(span, err)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
@@ -145,27 +149,9 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 		logger.Info("using Azure provider", "deploymentID", deploymentID, "scope", providerConfig.Az.Value.Scope)
 	}
 
-	poller, err := d.DeploymentClient.CreateOrUpdate(
-		ctx,
-		clients.Deployment{
-			Properties: &clients.DeploymentProperties{
-				Mode:           armresources.DeploymentModeIncremental,
-				ProviderConfig: &providerConfig,
-				Parameters:     parameters,
-				Template:       recipeData,
-			},
-		},
-		deploymentID.String(),
-		clients.DeploymentsClientAPIVersion,
-	)
-
-	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
-	}
-
-	resp, err := poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	resp, err := d.deploy(ctx, deploymentID, providerConfig, parameters, recipeData, opts.BaseOptions)
 	if err != nil {
-		return nil, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.BaseOptions.Recipe.Name, opts.BaseOptions.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		return nil, err
 	}
 
 	recipeResponse, err := d.prepareRecipeResponse(opts.BaseOptions.Definition.TemplatePath, resp.Properties.Outputs, resp.Properties.OutputResources)
@@ -197,6 +183,38 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 	return recipeResponse, nil
 }
 
+// deploy creates the nested deployment of the recipe template and waits for it to complete.
+func (d *bicepDriver) deploy(ctx context.Context, deploymentID resources.ID, providerConfig clients.ProviderConfig, parameters map[string]any, recipeData map[string]any, opts driver.BaseOptions) (resp clients.ClientCreateOrUpdateResponse, err error) {
+	attrs := append(driver.TraceAttributes(opts.Recipe, &opts.Definition), attribute.String("radius.deployment_id", deploymentID.String()))
+	ctx, span := trace.StartClientSpan(ctx, "bicep.deployment", trace.RecipeTracerName, attrs...)
+	defer func() { trace.EndSpan(span, err) }()
+
+	poller, err := d.DeploymentClient.CreateOrUpdate(
+		ctx,
+		clients.Deployment{
+			Properties: &clients.DeploymentProperties{
+				Mode:           armresources.DeploymentModeIncremental,
+				ProviderConfig: &providerConfig,
+				Parameters:     parameters,
+				Template:       recipeData,
+			},
+		},
+		deploymentID.String(),
+		clients.DeploymentsClientAPIVersion,
+	)
+
+	if err != nil {
+		return resp, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	resp, err = poller.PollUntilDone(ctx, &clients.PollUntilDoneOptions{Frequency: pollFrequency})
+	if err != nil {
+		return resp, recipes.NewRecipeError(recipes.RecipeDeploymentFailed, fmt.Sprintf("failed to deploy recipe %s of type %s", opts.Recipe.Name, opts.Definition.ResourceType), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return resp, nil
+}
+
 // Delete deletes all of the output resources that are marked as managed by Radius.
 // It will create a goroutine for each resource to be deleted and wait for them to finish,
 // retrying if necessary.
@@ -228,7 +246,7 @@ func (d *bicepDriver) Delete(ctx context.Context, opts driver.DeleteOptions) err
 				ctx := logr.NewContext(groupCtx, logger)
 				logger.V(ucplog.LevelDebug).Info("beginning attempt")
 
-				err = d.ResourceClient.Delete(ctx, id)
+				err = d.deleteResource(ctx, id)
 				if err != nil {
 					if attempt <= d.options.DeleteRetryCount {
 						logger.V(ucplog.LevelInfo).Error(err, "attempt failed", "delay", d.options.DeleteRetryDelaySeconds)
@@ -256,6 +274,14 @@ func (d *bicepDriver) Delete(ctx context.Context, opts driver.DeleteOptions) err
 	return nil
 }
 
+// deleteResource deletes a single output resource of the recipe.
+func (d *bicepDriver) deleteResource(ctx context.Context, id string) error {
+	ctx, span := trace.StartClientSpan(ctx, "recipe.deleteResource", trace.RecipeTracerName, trace.ResourceIDAttrKey.String(id))
+	err := d.ResourceClient.Delete(ctx, id)
+	trace.EndSpan(span, err)
+	return err
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
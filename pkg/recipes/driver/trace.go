@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/radius-project/radius/pkg/recipes"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// RecipeNameAttrKey is the attribute key for the recipe name.
+	RecipeNameAttrKey = attribute.Key("radius.recipe_name")
+	// RecipeDriverAttrKey is the attribute key for the recipe driver.
+	RecipeDriverAttrKey = attribute.Key("radius.recipe_driver")
+	// RecipeTemplatePathAttrKey is the attribute key for the recipe template path.
+	RecipeTemplatePathAttrKey = attribute.Key("radius.recipe_template_path")
+)
+
+// TraceAttributes returns the span attributes describing the recipe and the resource it is deployed for.
+// definition can be nil if the recipe definition has not been loaded yet.
+func TraceAttributes(recipe recipes.ResourceMetadata, definition *recipes.EnvironmentDefinition) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if recipe.Name != "" {
+		attrs = append(attrs, RecipeNameAttrKey.String(recipe.Name))
+	}
+	if recipe.ResourceID != "" {
+		attrs = append(attrs, trace.ResourceIDAttrKey.String(recipe.ResourceID))
+	}
+	if definition != nil && definition.Driver != "" {
+		attrs = append(attrs, RecipeDriverAttrKey.String(definition.Driver))
+	}
+	if definition != nil && definition.TemplatePath != "" {
+		attrs = append(attrs, RecipeTemplatePathAttrKey.String(definition.TemplatePath))
+	}
+	return attrs
+}
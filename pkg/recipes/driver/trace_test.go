@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"github.com/radius-project/radius/pkg/recipes"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestTraceAttributes(t *testing.T) {
+	recipe := recipes.ResourceMetadata{
+		Name:       "redis",
+		ResourceID: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis",
+	}
+
+	tests := []struct {
+		name       string
+		recipe     recipes.ResourceMetadata
+		definition *recipes.EnvironmentDefinition
+		expected   []attribute.KeyValue
+	}{
+		{
+			name:     "empty",
+			expected: []attribute.KeyValue{},
+		},
+		{
+			name:   "without definition",
+			recipe: recipe,
+			expected: []attribute.KeyValue{
+				RecipeNameAttrKey.String("redis"),
+				trace.ResourceIDAttrKey.String(recipe.ResourceID),
+			},
+		},
+		{
+			name:       "with definition",
+			recipe:     recipe,
+			definition: &recipes.EnvironmentDefinition{Driver: "terraform", TemplatePath: "Azure/redis/azurerm"},
+			expected: []attribute.KeyValue{
+				RecipeNameAttrKey.String("redis"),
+				trace.ResourceIDAttrKey.String(recipe.ResourceID),
+				RecipeDriverAttrKey.String("terraform"),
+				RecipeTemplatePathAttrKey.String("Azure/redis/azurerm"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, TraceAttributes(tt.recipe, tt.definition))
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -32,6 +33,9 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config/backends"
@@ -42,6 +46,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// planFileName is the name of the file, in the working directory, that the Terraform plan is saved to before it
+	// is applied.
+	planFileName = "radius.tfplan"
+)
+
 var (
 	// ErrRecipeNameEmpty is the error when the recipe name is empty.
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
@@ -67,7 +77,10 @@ type executor struct {
 
 // Deploy ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init and
 // apply in the working directory, returning an error if any of these steps fail.
-func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State, error) {
+func (e *executor) Deploy(ctx context.Context, options Options) (state *tfjson.State, err error) {
+	ctx, span := trace.StartInternalSpan(ctx, "terraform.deploy", trace.RecipeTracerName, spanAttributes(options)...)
+	defer func() { trace.EndSpan(span, err) }()
+
 	// Install Terraform
 	i := install.NewInstaller()
 	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
@@ -91,7 +104,7 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 	// Run TF Init and Apply in the working directory
 	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
-	state, err := initAndApply(ctx, tf, stateLockTimeout)
+	state, err = initAndApply(ctx, tf, stateLockTimeout)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +128,10 @@ func (e *executor) Deploy(ctx context.Context, options Options) (*tfjson.State,
 
 // Delete ensures Terraform is available, creates a working directory, generates a config, and runs Terraform destroy
 // in the working directory, returning an error if any of these steps fail.
-func (e *executor) Delete(ctx context.Context, options Options) error {
+func (e *executor) Delete(ctx context.Context, options Options) (err error) {
+	ctx, span := trace.StartInternalSpan(ctx, "terraform.delete", trace.RecipeTracerName, spanAttributes(options)...)
+	defer func() { trace.EndSpan(span, err) }()
+
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Install Terraform
@@ -370,7 +386,7 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := runStep(ctx, "terraform.init", func(ctx context.Context) error { return tf.Init(ctx) }); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -379,9 +395,24 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
 
-	// Apply Terraform configuration with state lock timeout
+	// Plan the changes to the Terraform configuration with state lock timeout, and save the plan so that its duration
+	// is traced separately from the apply.
+	logger.Info("Running Terraform plan with state lock timeout: " + stateLockTimeout)
+	planFile := filepath.Join(tf.WorkingDir(), planFileName)
+	err := runStep(ctx, "terraform.plan", func(ctx context.Context) error {
+		_, err := tf.Plan(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout), tfexec.Out(planFile))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	// Apply the saved plan with state lock timeout
 	logger.Info("Running Terraform apply with state lock timeout: " + stateLockTimeout)
-	if err := tf.Apply(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+	err = runStep(ctx, "terraform.apply", func(ctx context.Context) error {
+		return tf.Apply(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout), tfexec.DirOrPlan(planFile))
+	})
+	if err != nil {
 		return nil, fmt.Errorf("terraform apply failure: %w", err)
 	}
 
@@ -411,7 +442,7 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout
 	// Initialize Terraform
 	logger.Info("Initializing Terraform")
 	terraformInitStartTime := time.Now()
-	if err := tf.Init(ctx); err != nil {
+	if err := runStep(ctx, "terraform.init", func(ctx context.Context) error { return tf.Init(ctx) }); err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
 			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
 
@@ -421,9 +452,33 @@ func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout
 
 	// Destroy Terraform configuration with state lock timeout
 	logger.Info("Running Terraform destroy with state lock timeout: " + stateLockTimeout)
-	if err := tf.Destroy(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+	err := runStep(ctx, "terraform.destroy", func(ctx context.Context) error {
+		return tf.Destroy(ctx, tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout))
+	})
+	if err != nil {
 		return fmt.Errorf("terraform destroy failure: %w", err)
 	}
 
 	return nil
 }
+
+// runStep runs a Terraform command in a child span of the recipe execution.
+func runStep(ctx context.Context, spanName string, step func(ctx context.Context) error) error {
+	ctx, span := trace.StartInternalSpan(ctx, spanName, trace.RecipeTracerName)
+	err := step(ctx)
+	trace.EndSpan(span, err)
+	return err
+}
+
+// spanAttributes returns the span attributes describing the recipe executed with the given options.
+func spanAttributes(options Options) []attribute.KeyValue {
+	recipe := recipes.ResourceMetadata{}
+	if options.ResourceRecipe != nil {
+		recipe = *options.ResourceRecipe
+	}
+	if recipe.Name == "" && options.EnvRecipe != nil {
+		recipe.Name = options.EnvRecipe.Name
+	}
+
+	return driver.TraceAttributes(recipe, options.EnvRecipe)
+}
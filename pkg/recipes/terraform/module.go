@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/components/trace"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
@@ -64,7 +65,10 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 	// The downloaded module is stored in the working directory.
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
 	downloadStartTime := time.Now()
-	if err := tf.Get(ctx); err != nil {
+	downloadCtx, span := trace.StartInternalSpan(ctx, "recipe.download", trace.RecipeTracerName, spanAttributes(options)...)
+	err := tf.Get(downloadCtx)
+	trace.EndSpan(span, err)
+	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 				options.EnvRecipe, recipes.RecipeDownloadFailed))
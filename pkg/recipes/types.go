@@ -52,6 +52,7 @@ type Configuration struct {
 type RuntimeConfiguration struct {
 	Kubernetes              *KubernetesRuntime              `json:"kubernetes,omitempty"`
 	AzureContainerInstances *AzureContainerInstancesRuntime `json:"azureContainerInstances,omitempty"`
+	Docker                  *DockerRuntime                  `json:"docker,omitempty"`
 }
 
 // KubernetesRuntime represents application and environment namespaces.
@@ -67,6 +68,12 @@ type AzureContainerInstancesRuntime struct {
 	// TODO: Add runtime configuration for Azure Container Instances
 }
 
+// DockerRuntime represents the Docker runtime configuration.
+type DockerRuntime struct {
+	// Network is the name of the Docker network the containers of the environment are attached to.
+	Network string `json:"network"`
+}
+
 // EnvironmentDefinition represents the recipe configuration details.
 type EnvironmentDefinition struct {
 	// Name represents the name of the recipe within the environment
@@ -27,6 +27,7 @@ const (
 	ProviderAWS        = "aws"
 	ProviderRadius     = "radius"
 	ProviderKubernetes = "kubernetes"
+	ProviderDocker     = "docker"
 )
 
 // ResourceType determines the type of the resource and the provider domain for the resource
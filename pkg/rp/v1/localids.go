@@ -52,6 +52,7 @@ const (
 	LocalIDDaprSecretStoreAzureKeyVault   = "DaprSecretStoreAzureKeyVault"
 	LocalIDDaprPubSubBrokerKafka          = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                     = "Deployment"
+	LocalIDStatefulSet                    = "StatefulSet"
 	LocalIDGateway                        = "Gateway"
 	LocalIDHttpProxy                      = "HttpProxy"
 	LocalIDKeyVault                       = "KeyVault"
@@ -62,6 +63,7 @@ const (
 	LocalIDKubernetesRole                 = "KubernetesRole"
 	LocalIDKubernetesRoleBinding          = "KubernetesRoleBinding"
 	LocalIDService                        = "Service"
+	LocalIDHeadlessService                = "HeadlessService"
 	LocalIDUserAssignedManagedIdentity    = "UserAssignedManagedIdentity"
 	LocalIDFederatedIdentity              = "FederatedIdentity"
 	LocalIDRoleAssignmentPrefix           = "RoleAssignment"
@@ -74,6 +76,11 @@ const (
 	LocalIDAzureNetworkSecurityGroup      = "AzureNetworkSecurityGroup"
 	LocalIDHttpRoute                      = "HttpRoute"
 	LocalIDAzureAppGWNetworkSecurityGroup = "AzureAppGWNetworkSecurityGroup"
+	LocalIDHorizontalPodAutoscaler        = "HorizontalPodAutoscaler"
+	LocalIDPodDisruptionBudget            = "PodDisruptionBudget"
+	LocalIDNetworkPolicy                  = "NetworkPolicy"
+	LocalIDDockerContainer                = "DockerContainer"
+	LocalIDDockerVolumePrefix             = "DockerVolume"
 
 	// Obsolete when we remove AppModelV1
 	LocalIDRoleAssignmentKVKeys = "RoleAssignment-KVKeys"
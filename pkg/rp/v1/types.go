@@ -32,6 +32,8 @@ const (
 	KubernetesComputeKind EnvironmentComputeKind = "kubernetes"
 	// ACIComputeKind represents ACI compute resource type.
 	ACIComputeKind EnvironmentComputeKind = "aci"
+	// DockerComputeKind represents Docker compute resource type.
+	DockerComputeKind EnvironmentComputeKind = "docker"
 )
 
 // BasicDaprResourceProperties is the basic resource properties for dapr resources.
@@ -103,6 +105,19 @@ type ResourceStatus struct {
 	// OutputResources represents the output resources associated with the radius resource.
 	OutputResources []OutputResource `json:"outputResources,omitempty"`
 	Recipe          *RecipeStatus    `json:"recipe,omitempty"`
+
+	// PolicyViolations are the violations of audit-mode organization policies found when the resource was last
+	// created or updated.
+	PolicyViolations []PolicyViolation `json:"policyViolations,omitempty"`
+}
+
+// PolicyViolation represents a violation of an audit-mode organization policy.
+type PolicyViolation struct {
+	// Policy is the name of the policy.
+	Policy string `json:"policy"`
+
+	// Message describes the violation.
+	Message string `json:"message"`
 }
 
 // DeepCopyRecipeStatus creates a copy of ResourceStatus.
@@ -128,6 +143,7 @@ type EnvironmentCompute struct {
 	Kind              EnvironmentComputeKind      `json:"kind"`
 	KubernetesCompute KubernetesComputeProperties `json:"kubernetes"`
 	ACICompute        ACIComputeProperties        `json:"aci"`
+	DockerCompute     DockerComputeProperties     `json:"docker"`
 
 	// Environment-level identity that can be used by any resource in the environment.
 	// Resources can specify its own identities and they will override the environment-level identity.
@@ -147,6 +163,12 @@ type ACIComputeProperties struct {
 	ResourceGroup string `json:"resourceGroup"`
 }
 
+// DockerComputeProperties represents the Docker compute of the environment.
+type DockerComputeProperties struct {
+	// Network represents the Docker network the containers of the environment are attached to.
+	Network string `json:"network,omitempty"`
+}
+
 // RadiusResourceModel represents the interface of radius resource type.
 // TODO: Replace DeploymentDataModel with RadiusResourceModel later when link rp leverages generic.
 type RadiusResourceModel interface {
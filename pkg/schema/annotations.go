@@ -18,6 +18,7 @@ package schema
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -80,10 +81,16 @@ func GetSchema(ctx context.Context, ucpClient *v20231001preview.ClientFactory, r
 // ExtractSensitiveFieldPaths recursively walks the schema and returns paths to fields marked with x-radius-sensitive.
 // The prefix parameter builds up the path as we traverse nested objects.
 // Supports object properties, array items, and additionalProperties (maps).
+// oneOf and anyOf variants describe the same value, so the paths of their sensitive fields share the same prefix.
 // If a field is marked sensitive, its nested properties are not checked since the entire field is considered sensitive.
 func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 	var paths []string
 
+	// Sensitive fields declared by the variants of the schema itself
+	for _, variant := range schemaVariants(schema) {
+		paths = appendUnique(paths, ExtractSensitiveFieldPaths(variant, prefix)...)
+	}
+
 	properties, ok := schema["properties"].(map[string]any)
 	if !ok {
 		return paths
@@ -117,6 +124,16 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 			paths = append(paths, nestedPaths...)
 		}
 
+		// Handle oneOf and anyOf variants - check each variant's nested properties using the field's path
+		// If a variant is marked sensitive, the whole field is treated as sensitive
+		for _, variant := range schemaVariants(fieldSchemaMap) {
+			if isSensitive, ok := variant[annotationRadiusSensitive].(bool); ok && isSensitive {
+				paths = appendUnique(paths, fullPath)
+				continue
+			}
+			paths = appendUnique(paths, ExtractSensitiveFieldPaths(variant, fullPath)...)
+		}
+
 		// Handle array types - check items schema
 		// Path uses [*] to indicate all array elements, e.g., "secrets[*].value"
 		if items, ok := fieldSchemaMap["items"].(map[string]any); ok {
@@ -127,12 +144,9 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 			if isSensitive, ok := items[annotationRadiusSensitive].(bool); ok && isSensitive {
 				paths = append(paths, arrayItemPath)
 			} else {
-				// Recursively check nested properties within array items
-				if itemProps, ok := items["properties"].(map[string]any); ok {
-					itemSchema := map[string]any{"properties": itemProps}
-					nestedPaths := ExtractSensitiveFieldPaths(itemSchema, arrayItemPath)
-					paths = append(paths, nestedPaths...)
-				}
+				// Recursively check nested properties and variants within array items
+				nestedPaths := ExtractSensitiveFieldPaths(items, arrayItemPath)
+				paths = append(paths, nestedPaths...)
 			}
 		}
 
@@ -146,12 +160,9 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 			if isSensitive, ok := additionalProps[annotationRadiusSensitive].(bool); ok && isSensitive {
 				paths = append(paths, mapValuePath)
 			} else {
-				// Recursively check nested properties within additionalProperties
-				if addProps, ok := additionalProps["properties"].(map[string]any); ok {
-					addPropsSchema := map[string]any{"properties": addProps}
-					nestedPaths := ExtractSensitiveFieldPaths(addPropsSchema, mapValuePath)
-					paths = append(paths, nestedPaths...)
-				}
+				// Recursively check nested properties and variants within additionalProperties
+				nestedPaths := ExtractSensitiveFieldPaths(additionalProps, mapValuePath)
+				paths = append(paths, nestedPaths...)
 			}
 		}
 	}
@@ -159,6 +170,30 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 	return paths
 }
 
+// schemaVariants returns the oneOf and anyOf variants of a schema.
+func schemaVariants(schema map[string]any) []map[string]any {
+	var variants []map[string]any
+	for _, keyword := range []string{"oneOf", "anyOf"} {
+		items, _ := schema[keyword].([]any)
+		for _, item := range items {
+			if variant, ok := item.(map[string]any); ok {
+				variants = append(variants, variant)
+			}
+		}
+	}
+	return variants
+}
+
+// appendUnique appends the paths that are not already present. Variants often declare the same field.
+func appendUnique(paths []string, newPaths ...string) []string {
+	for _, path := range newPaths {
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
 // FieldPathSegment represents a single segment in a field path.
 // A field path can contain field names, wildcards, and array indices.
 type FieldPathSegment struct {
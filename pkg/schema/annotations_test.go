@@ -406,6 +406,83 @@ func TestExtractSensitiveFieldPaths(t *testing.T) {
 			},
 			expected: []string{"secretMap[*]"},
 		},
+		{
+			name: "sensitive fields in oneOf variants",
+			schema: map[string]any{
+				"properties": map[string]any{
+					"backend": map[string]any{
+						"type": "object",
+						"oneOf": []any{
+							map[string]any{
+								"properties": map[string]any{
+									"kind":      map[string]any{"type": "string", "enum": []any{"s3"}},
+									"secretKey": map[string]any{"type": "string", annotationRadiusSensitive: true},
+									"password":  map[string]any{"type": "string", annotationRadiusSensitive: true},
+								},
+							},
+							map[string]any{
+								"properties": map[string]any{
+									"kind":             map[string]any{"type": "string", "enum": []any{"azureBlob"}},
+									"connectionString": map[string]any{"type": "string", annotationRadiusSensitive: true},
+									"password":         map[string]any{"type": "string", annotationRadiusSensitive: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"backend.secretKey", "backend.password", "backend.connectionString"},
+		},
+		{
+			name: "sensitive fields in top-level anyOf variants",
+			schema: map[string]any{
+				"anyOf": []any{
+					map[string]any{
+						"properties": map[string]any{
+							"token": map[string]any{"type": "string", annotationRadiusSensitive: true},
+						},
+					},
+				},
+				"properties": map[string]any{
+					"password": map[string]any{"type": "string", annotationRadiusSensitive: true},
+				},
+			},
+			expected: []string{"token", "password"},
+		},
+		{
+			name: "sensitive variant",
+			schema: map[string]any{
+				"properties": map[string]any{
+					"credential": map[string]any{
+						"oneOf": []any{
+							map[string]any{"type": "string", annotationRadiusSensitive: true},
+							map[string]any{"type": "object", annotationRadiusSensitive: true},
+						},
+					},
+				},
+			},
+			expected: []string{"credential"},
+		},
+		{
+			name: "sensitive fields in array item variants",
+			schema: map[string]any{
+				"properties": map[string]any{
+					"connections": map[string]any{
+						"type": "array",
+						"items": map[string]any{
+							"oneOf": []any{
+								map[string]any{
+									"properties": map[string]any{
+										"password": map[string]any{"type": "string", annotationRadiusSensitive: true},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"connections[*].password"},
+		},
 	}
 
 	for _, tt := range tests {
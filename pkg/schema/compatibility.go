@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"slices"
+)
+
+// CheckBackwardCompatibility compares the schema of an API version of a resource type with the schema of the API
+// version it replaces, and returns a description of each backward-incompatible change. A change is backward-incompatible
+// when a resource that is valid for the old schema may be rejected by, or lose data under, the new schema:
+//
+//   - a property is removed.
+//   - the type of a property changes.
+//   - a property becomes required.
+//   - an allowed enum value is removed.
+//
+// Returns an empty slice if the schemas are compatible.
+func CheckBackwardCompatibility(oldSchema map[string]any, newSchema map[string]any) []string {
+	changes := []string{}
+	checkSchemaCompatibility(oldSchema, newSchema, "", &changes)
+	return changes
+}
+
+func checkSchemaCompatibility(oldSchema map[string]any, newSchema map[string]any, path string, changes *[]string) {
+	if oldSchema == nil || newSchema == nil {
+		return
+	}
+
+	location := path
+	if location == "" {
+		location = "the schema"
+	}
+
+	oldType, _ := oldSchema["type"].(string)
+	newType, _ := newSchema["type"].(string)
+	if oldType != "" && newType != "" && oldType != newType {
+		*changes = append(*changes, fmt.Sprintf("%s: type changed from %q to %q", location, oldType, newType))
+		return
+	}
+
+	if oldEnum, ok := oldSchema["enum"].([]any); ok {
+		newEnum, _ := newSchema["enum"].([]any)
+		if newEnum != nil {
+			for _, value := range oldEnum {
+				if !slices.Contains(newEnum, value) {
+					*changes = append(*changes, fmt.Sprintf("%s: enum value %v was removed", location, value))
+				}
+			}
+		}
+	}
+
+	oldRequired := stringSlice(oldSchema["required"])
+	for _, name := range stringSlice(newSchema["required"]) {
+		if !slices.Contains(oldRequired, name) {
+			*changes = append(*changes, fmt.Sprintf("%s: property is now required", joinPath(path, name)))
+		}
+	}
+
+	oldProperties, _ := oldSchema["properties"].(map[string]any)
+	newProperties, _ := newSchema["properties"].(map[string]any)
+	names := make([]string, 0, len(oldProperties))
+	for name := range oldProperties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		newProperty, ok := newProperties[name]
+		if !ok {
+			*changes = append(*changes, fmt.Sprintf("%s: property was removed", joinPath(path, name)))
+			continue
+		}
+
+		oldPropertySchema, _ := oldProperties[name].(map[string]any)
+		newPropertySchema, _ := newProperty.(map[string]any)
+		checkSchemaCompatibility(oldPropertySchema, newPropertySchema, joinPath(path, name), changes)
+	}
+
+	oldItems, _ := oldSchema["items"].(map[string]any)
+	newItems, _ := newSchema["items"].(map[string]any)
+	checkSchemaCompatibility(oldItems, newItems, path+"[*]", changes)
+
+	oldAdditional, _ := oldSchema["additionalProperties"].(map[string]any)
+	newAdditional, _ := newSchema["additionalProperties"].(map[string]any)
+	checkSchemaCompatibility(oldAdditional, newAdditional, joinPath(path, "*"), changes)
+}
+
+func stringSlice(value any) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
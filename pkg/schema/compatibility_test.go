@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckBackwardCompatibility(t *testing.T) {
+	base := func() map[string]any {
+		return map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"environment": map[string]any{"type": "string"},
+				"size":        map[string]any{"type": "integer"},
+				"tier": map[string]any{
+					"type": "string",
+					"enum": []any{"basic", "standard"},
+				},
+				"tags": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "string"},
+				},
+			},
+			"required": []any{"environment"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(schema map[string]any)
+		expected []string
+	}{
+		{
+			name:     "unchanged",
+			modify:   func(schema map[string]any) {},
+			expected: []string{},
+		},
+		{
+			name: "optional property added",
+			modify: func(schema map[string]any) {
+				schema["properties"].(map[string]any)["port"] = map[string]any{"type": "integer"}
+			},
+			expected: []string{},
+		},
+		{
+			name: "enum value added",
+			modify: func(schema map[string]any) {
+				schema["properties"].(map[string]any)["tier"].(map[string]any)["enum"] = []any{"basic", "standard", "premium"}
+			},
+			expected: []string{},
+		},
+		{
+			name: "property removed",
+			modify: func(schema map[string]any) {
+				delete(schema["properties"].(map[string]any), "size")
+			},
+			expected: []string{"size: property was removed"},
+		},
+		{
+			name: "type changed",
+			modify: func(schema map[string]any) {
+				schema["properties"].(map[string]any)["size"] = map[string]any{"type": "string"}
+			},
+			expected: []string{`size: type changed from "integer" to "string"`},
+		},
+		{
+			name: "property required",
+			modify: func(schema map[string]any) {
+				schema["required"] = []any{"environment", "size"}
+			},
+			expected: []string{"size: property is now required"},
+		},
+		{
+			name: "enum value removed",
+			modify: func(schema map[string]any) {
+				schema["properties"].(map[string]any)["tier"].(map[string]any)["enum"] = []any{"standard"}
+			},
+			expected: []string{"tier: enum value basic was removed"},
+		},
+		{
+			name: "array item type changed",
+			modify: func(schema map[string]any) {
+				schema["properties"].(map[string]any)["tags"].(map[string]any)["items"] = map[string]any{"type": "object"}
+			},
+			expected: []string{`tags[*]: type changed from "string" to "object"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSchema := base()
+			tt.modify(newSchema)
+			require.Equal(t, tt.expected, CheckBackwardCompatibility(base(), newSchema))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ErrNoConversionPath is returned by ConvertAPIVersion when the declared conversions do not connect the two API versions.
+var ErrNoConversionPath = errors.New("no conversion is declared between the API versions")
+
+// conversionStep is a single step of a conversion path between two API versions.
+type conversionStep struct {
+	rules   []datamodel.APIVersionConversionRule
+	inverse bool
+}
+
+// ConvertAPIVersion converts resource properties from one API version of a resource type to another, using the
+// conversions declared by the API versions of the resource type. The conversions map is keyed by the name of the API
+// version that declares them.
+//
+// Conversions are declared on the newer API version and describe how to convert from an older API version. They are
+// inverted to convert in the other direction, and chained when there is no direct conversion between the API versions.
+//
+// Returns a converted copy of the properties, or ErrNoConversionPath if the API versions are not connected.
+func ConvertAPIVersion(properties map[string]any, from string, to string, conversions map[string][]datamodel.APIVersionConversion) (map[string]any, error) {
+	if from == to || properties == nil {
+		return properties, nil
+	}
+
+	steps, ok := findConversionPath(from, to, conversions)
+	if !ok {
+		return nil, ErrNoConversionPath
+	}
+
+	properties = deepCopyValue(properties).(map[string]any)
+
+	for _, step := range steps {
+		if step.inverse {
+			for i := len(step.rules) - 1; i >= 0; i-- {
+				applyInverseConversionRule(properties, step.rules[i])
+			}
+			continue
+		}
+
+		for _, rule := range step.rules {
+			applyConversionRule(properties, rule)
+		}
+	}
+
+	return properties, nil
+}
+
+// findConversionPath finds the shortest path of conversions between two API versions.
+func findConversionPath(from string, to string, conversions map[string][]datamodel.APIVersionConversion) ([]conversionStep, bool) {
+	type edge struct {
+		target string
+		step   conversionStep
+	}
+
+	edges := map[string][]edge{}
+	for apiVersion, declared := range conversions {
+		for _, conversion := range declared {
+			edges[conversion.From] = append(edges[conversion.From], edge{target: apiVersion, step: conversionStep{rules: conversion.Rules}})
+			edges[apiVersion] = append(edges[apiVersion], edge{target: conversion.From, step: conversionStep{rules: conversion.Rules, inverse: true}})
+		}
+	}
+
+	paths := map[string][]conversionStep{from: nil}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == to {
+			return paths[current], true
+		}
+
+		for _, e := range edges[current] {
+			if _, visited := paths[e.target]; visited {
+				continue
+			}
+
+			path := append([]conversionStep{}, paths[current]...)
+			paths[e.target] = append(path, e.step)
+			queue = append(queue, e.target)
+		}
+	}
+
+	return nil, false
+}
+
+// applyConversionRule applies a conversion rule to convert properties to the API version that declares the rule.
+func applyConversionRule(properties map[string]any, rule datamodel.APIVersionConversionRule) {
+	switch rule.Kind {
+	case datamodel.ConversionRuleKindRename, datamodel.ConversionRuleKindMove:
+		moveProperty(properties, rule.From, rule.To)
+	case datamodel.ConversionRuleKindDefault:
+		if _, ok := getProperty(properties, rule.Path); !ok {
+			setProperty(properties, rule.Path, deepCopyValue(rule.Value))
+		}
+	}
+}
+
+// applyInverseConversionRule applies a conversion rule in reverse to convert properties from the API version that
+// declares the rule.
+func applyInverseConversionRule(properties map[string]any, rule datamodel.APIVersionConversionRule) {
+	switch rule.Kind {
+	case datamodel.ConversionRuleKindRename, datamodel.ConversionRuleKindMove:
+		moveProperty(properties, rule.To, rule.From)
+	case datamodel.ConversionRuleKindDefault:
+		// The property was added by the newer API version, so it does not exist in the older API version.
+		deleteProperty(properties, rule.Path)
+	}
+}
+
+func moveProperty(properties map[string]any, from string, to string) {
+	value, ok := getProperty(properties, from)
+	if !ok {
+		return
+	}
+
+	deleteProperty(properties, from)
+	setProperty(properties, to, value)
+}
+
+func getProperty(properties map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	value, ok := current[segments[len(segments)-1]]
+	return value, ok
+}
+
+func setProperty(properties map[string]any, path string, value any) {
+	segments := strings.Split(path, ".")
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+}
+
+// deleteProperty deletes the property at the given path, along with any parent objects left empty by the deletion.
+func deleteProperty(properties map[string]any, path string) {
+	segments := strings.Split(path, ".")
+	parents := []map[string]any{properties}
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return
+		}
+		parents = append(parents, next)
+		current = next
+	}
+
+	delete(current, segments[len(segments)-1])
+
+	for i := len(parents) - 1; i > 0; i-- {
+		if len(parents[i]) > 0 {
+			break
+		}
+		delete(parents[i-1], segments[i-1])
+	}
+}
+
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = deepCopyValue(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = deepCopyValue(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
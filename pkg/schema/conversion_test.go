@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAPIVersion(t *testing.T) {
+	// 2025-06-01 renames size to capacity, moves config.port to port, and adds tier with a default value.
+	// 2025-09-01 renames capacity to maxCapacity.
+	conversions := map[string][]datamodel.APIVersionConversion{
+		"2025-06-01": {
+			{
+				From: "2025-01-01",
+				Rules: []datamodel.APIVersionConversionRule{
+					{Kind: datamodel.ConversionRuleKindRename, From: "size", To: "capacity"},
+					{Kind: datamodel.ConversionRuleKindMove, From: "config.port", To: "port"},
+					{Kind: datamodel.ConversionRuleKindDefault, Path: "tier", Value: "standard"},
+				},
+			},
+		},
+		"2025-09-01": {
+			{
+				From: "2025-06-01",
+				Rules: []datamodel.APIVersionConversionRule{
+					{Kind: datamodel.ConversionRuleKindRename, From: "capacity", To: "maxCapacity"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		from       string
+		to         string
+		properties map[string]any
+		expected   map[string]any
+		err        error
+	}{
+		{
+			name:       "same version",
+			from:       "2025-01-01",
+			to:         "2025-01-01",
+			properties: map[string]any{"size": 3},
+			expected:   map[string]any{"size": 3},
+		},
+		{
+			name: "forward",
+			from: "2025-01-01",
+			to:   "2025-06-01",
+			properties: map[string]any{
+				"environment": "env",
+				"size":        3,
+				"config":      map[string]any{"port": 8080},
+			},
+			expected: map[string]any{
+				"environment": "env",
+				"capacity":    3,
+				"port":        8080,
+				"tier":        "standard",
+			},
+		},
+		{
+			name: "forward keeps existing value over default",
+			from: "2025-01-01",
+			to:   "2025-06-01",
+			properties: map[string]any{
+				"tier": "premium",
+			},
+			expected: map[string]any{
+				"tier": "premium",
+			},
+		},
+		{
+			name: "inverse",
+			from: "2025-06-01",
+			to:   "2025-01-01",
+			properties: map[string]any{
+				"environment": "env",
+				"capacity":    3,
+				"port":        8080,
+				"tier":        "premium",
+			},
+			expected: map[string]any{
+				"environment": "env",
+				"size":        3,
+				"config":      map[string]any{"port": 8080},
+			},
+		},
+		{
+			name: "chained",
+			from: "2025-01-01",
+			to:   "2025-09-01",
+			properties: map[string]any{
+				"size": 3,
+			},
+			expected: map[string]any{
+				"maxCapacity": 3,
+				"tier":        "standard",
+			},
+		},
+		{
+			name: "chained inverse",
+			from: "2025-09-01",
+			to:   "2025-01-01",
+			properties: map[string]any{
+				"maxCapacity": 3,
+				"tier":        "standard",
+			},
+			expected: map[string]any{
+				"size": 3,
+			},
+		},
+		{
+			name:       "no conversion path",
+			from:       "2024-01-01",
+			to:         "2025-06-01",
+			properties: map[string]any{"size": 3},
+			err:        ErrNoConversionPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := deepCopyValue(tt.properties)
+			result, err := ConvertAPIVersion(tt.properties, tt.from, tt.to, conversions)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, result)
+			}
+
+			// The input properties are not modified.
+			require.Equal(t, original, tt.properties)
+		})
+	}
+}
+
+func TestConvertAPIVersion_DefaultIsCopied(t *testing.T) {
+	conversions := map[string][]datamodel.APIVersionConversion{
+		"2025-06-01": {
+			{
+				From: "2025-01-01",
+				Rules: []datamodel.APIVersionConversionRule{
+					{Kind: datamodel.ConversionRuleKindDefault, Path: "labels", Value: map[string]any{"team": "platform"}},
+				},
+			},
+		},
+	}
+
+	properties, err := ConvertAPIVersion(map[string]any{}, "2025-01-01", "2025-06-01", conversions)
+	require.NoError(t, err)
+
+	// Modifying the converted properties must not modify the declared default.
+	properties["labels"].(map[string]any)["team"] = "other"
+	require.Equal(t, map[string]any{"team": "platform"}, conversions["2025-06-01"][0].Rules[0].Value)
+}
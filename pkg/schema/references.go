@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ResourceReference is a property of a resource that references another resource. References are declared in the
+// schema by marking string properties with x-radius-reference, whose value is the fully qualified type of the
+// referenced resource.
+type ResourceReference struct {
+	// Path is the path of the property, for example "database" or "replicas[0].source".
+	Path string
+
+	// ResourceType is the type of resource the property must reference, for example "Radius.Data/postgreSqlDatabases".
+	ResourceType string
+
+	// ID is the resource ID stored in the property.
+	ID string
+}
+
+// ExtractReferences walks the resource properties using the schema and returns the references stored in properties
+// marked with x-radius-reference. Object properties, array items, map values and the oneOf and anyOf variants of
+// the schema are visited. Empty values are ignored. The references are sorted by path.
+func ExtractReferences(properties map[string]any, schema map[string]any) []ResourceReference {
+	references := map[string]ResourceReference{}
+	collectReferences(properties, schema, "", references)
+
+	result := []ResourceReference{}
+	for _, reference := range references {
+		result = append(result, reference)
+	}
+
+	slices.SortFunc(result, func(a, b ResourceReference) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return result
+}
+
+func collectReferences(value any, schema map[string]any, path string, references map[string]ResourceReference) {
+	if value == nil || schema == nil {
+		return
+	}
+
+	if resourceType, ok := schema[annotationRadiusReference].(string); ok {
+		if id, ok := value.(string); ok && id != "" {
+			references[path] = ResourceReference{Path: path, ResourceType: resourceType, ID: id}
+		}
+		return
+	}
+
+	// Variants describe the same value, so they share the path of the schema.
+	for _, variant := range schemaVariants(schema) {
+		collectReferences(value, variant, path, references)
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		declared, _ := schema["properties"].(map[string]any)
+		for name, item := range v {
+			if propertySchema, ok := declared[name].(map[string]any); ok {
+				collectReferences(item, propertySchema, joinPath(path, name), references)
+			} else if additionalProperties, ok := schema["additionalProperties"].(map[string]any); ok {
+				collectReferences(item, additionalProperties, joinPath(path, name), references)
+			}
+		}
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range v {
+				collectReferences(item, items, fmt.Sprintf("%s[%d]", path, i), references)
+			}
+		}
+	}
+}
+
+// ValidateOutputValue validates a value produced by a recipe against the schema of the resource property it is stored
+// in. Recipe outputs are only typed by the schema of the resource type, so this is how typed outputs are enforced.
+// Values for properties that are not declared by the schema are not validated.
+func ValidateOutputValue(name string, value any, schema map[string]any) error {
+	properties, _ := schema["properties"].(map[string]any)
+	propertySchema, ok := properties[name].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(propertySchema)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema of property %q: %w", name, err)
+	}
+
+	normalizePlatformOptionsAny(openAPISchema)
+
+	// Recipe outputs are not always JSON values (for example, integers), so round-trip them through JSON first.
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal output %q: %w", name, err)
+	}
+
+	var data any
+	err = json.Unmarshal(bs, &data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal output %q: %w", name, err)
+	}
+
+	err = openAPISchema.VisitJSON(data)
+	if err != nil {
+		return fmt.Errorf("output %q does not match the schema of the resource type: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testReferenceSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"database": map[string]any{
+			"type":               "string",
+			"x-radius-reference": "Radius.Data/postgreSqlDatabases",
+		},
+		"caches": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type":               "string",
+				"x-radius-reference": "Radius.Data/redisCaches",
+			},
+		},
+		"queues": map[string]any{
+			"type": "object",
+			"additionalProperties": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"source": map[string]any{
+						"type":               "string",
+						"x-radius-reference": "Radius.Messaging/queues",
+					},
+				},
+			},
+		},
+		"name": map[string]any{"type": "string"},
+		"port": map[string]any{"type": "integer", "readOnly": true},
+	},
+}
+
+func TestExtractReferences(t *testing.T) {
+	properties := map[string]any{
+		"database": "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db",
+		"caches": []any{
+			"/planes/radius/local/resourceGroups/test/providers/Radius.Data/redisCaches/cache",
+			"",
+		},
+		"queues": map[string]any{
+			"orders": map[string]any{
+				"source": "/planes/radius/local/resourceGroups/test/providers/Radius.Messaging/queues/orders",
+			},
+		},
+		"name": "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/other",
+	}
+
+	expected := []ResourceReference{
+		{
+			Path:         "caches[0]",
+			ResourceType: "Radius.Data/redisCaches",
+			ID:           "/planes/radius/local/resourceGroups/test/providers/Radius.Data/redisCaches/cache",
+		},
+		{
+			Path:         "database",
+			ResourceType: "Radius.Data/postgreSqlDatabases",
+			ID:           "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db",
+		},
+		{
+			Path:         "queues.orders.source",
+			ResourceType: "Radius.Messaging/queues",
+			ID:           "/planes/radius/local/resourceGroups/test/providers/Radius.Messaging/queues/orders",
+		},
+	}
+
+	require.Equal(t, expected, ExtractReferences(properties, testReferenceSchema))
+}
+
+func TestExtractReferences_Variants(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"storage": map[string]any{
+				"oneOf": []any{
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind":     map[string]any{"type": "string", "enum": []any{"database"}},
+							"database": map[string]any{"type": "string", "x-radius-reference": "Radius.Data/postgreSqlDatabases"},
+						},
+					},
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind": map[string]any{"type": "string", "enum": []any{"memory"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	properties := map[string]any{
+		"storage": map[string]any{
+			"kind":     "database",
+			"database": "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db",
+		},
+	}
+
+	expected := []ResourceReference{
+		{
+			Path:         "storage.database",
+			ResourceType: "Radius.Data/postgreSqlDatabases",
+			ID:           "/planes/radius/local/resourceGroups/test/providers/Radius.Data/postgreSqlDatabases/db",
+		},
+	}
+
+	require.Equal(t, expected, ExtractReferences(properties, schema))
+}
+
+func TestExtractReferences_NoReferences(t *testing.T) {
+	require.Empty(t, ExtractReferences(map[string]any{"name": "test"}, testReferenceSchema))
+	require.Empty(t, ExtractReferences(nil, testReferenceSchema))
+	require.Empty(t, ExtractReferences(map[string]any{"database": "test"}, nil))
+}
+
+func TestValidateOutputValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		value  any
+		errMsg string
+	}{
+		{
+			name:   "matching type",
+			output: "port",
+			value:  5432,
+		},
+		{
+			name:   "mismatched type",
+			output: "port",
+			value:  "5432",
+			errMsg: `output "port" does not match the schema of the resource type`,
+		},
+		{
+			name:   "undeclared output",
+			output: "host",
+			value:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOutputValue(tt.output, tt.value, testReferenceSchema)
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
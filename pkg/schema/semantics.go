@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ApplyDefaults sets the default value declared by the schema for each property that is missing from the resource
+// properties. Nested objects, array items and map values that are present in the properties are visited recursively.
+// Defaults are not applied to readOnly properties, which are set by the server.
+func ApplyDefaults(properties map[string]any, schema map[string]any) {
+	if properties == nil || schema == nil {
+		return
+	}
+
+	schemaProperties, _ := schema["properties"].(map[string]any)
+	for name, value := range schemaProperties {
+		propertySchema, ok := value.(map[string]any)
+		if !ok || isReadOnly(propertySchema) {
+			continue
+		}
+
+		current, exists := properties[name]
+		if !exists {
+			if defaultValue, ok := propertySchema["default"]; ok {
+				properties[name] = deepCopyValue(defaultValue)
+			}
+			continue
+		}
+
+		applyNestedDefaults(current, propertySchema)
+	}
+}
+
+func applyNestedDefaults(value any, schema map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		ApplyDefaults(v, schema)
+		if additionalProperties, ok := schema["additionalProperties"].(map[string]any); ok {
+			declared, _ := schema["properties"].(map[string]any)
+			for key, item := range v {
+				if _, ok := declared[key]; !ok {
+					applyNestedDefaults(item, additionalProperties)
+				}
+			}
+		}
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for _, item := range v {
+				applyNestedDefaults(item, items)
+			}
+		}
+	}
+}
+
+// ExtractReadOnlyFieldPaths recursively walks the object properties of the schema and returns the dot-separated paths
+// of fields marked with readOnly. The nested properties of a readOnly field are not visited.
+func ExtractReadOnlyFieldPaths(schema map[string]any, prefix string) []string {
+	return extractAnnotatedFieldPaths(schema, prefix, isReadOnly)
+}
+
+// ExtractImmutableFieldPaths recursively walks the object properties of the schema and returns the dot-separated paths
+// of fields marked with x-radius-immutable. The nested properties of an immutable field are not visited.
+func ExtractImmutableFieldPaths(schema map[string]any, prefix string) []string {
+	return extractAnnotatedFieldPaths(schema, prefix, func(fieldSchema map[string]any) bool {
+		immutable, _ := fieldSchema[annotationRadiusImmutable].(bool)
+		return immutable
+	})
+}
+
+func extractAnnotatedFieldPaths(schema map[string]any, prefix string, annotated func(map[string]any) bool) []string {
+	paths := []string{}
+
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return paths
+	}
+
+	for name, value := range properties {
+		fieldSchema, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		fullPath := joinPath(prefix, name)
+		if annotated(fieldSchema) {
+			paths = append(paths, fullPath)
+			continue
+		}
+
+		paths = append(paths, extractAnnotatedFieldPaths(fieldSchema, fullPath, annotated)...)
+	}
+
+	slices.Sort(paths)
+	return paths
+}
+
+// PreserveReadOnlyFields replaces the readOnly fields in the new resource properties with their values from the old
+// resource properties. readOnly fields are set by the server, so values supplied by the client are ignored. The old
+// properties may be nil when the resource is being created.
+func PreserveReadOnlyFields(newProperties map[string]any, oldProperties map[string]any, schema map[string]any) {
+	if newProperties == nil || schema == nil {
+		return
+	}
+
+	for _, path := range ExtractReadOnlyFieldPaths(schema, "") {
+		removeField(newProperties, path)
+		if value, ok := getProperty(oldProperties, path); ok {
+			setProperty(newProperties, path, deepCopyValue(value))
+		}
+	}
+}
+
+// CheckImmutableFields compares the new resource properties with the old resource properties and returns the paths of
+// the fields marked with x-radius-immutable that were changed. A field changes when it is added, removed, or modified.
+func CheckImmutableFields(newProperties map[string]any, oldProperties map[string]any, schema map[string]any) []string {
+	changed := []string{}
+	if schema == nil {
+		return changed
+	}
+
+	for _, path := range ExtractImmutableFieldPaths(schema, "") {
+		oldValue, oldExists := getProperty(oldProperties, path)
+		newValue, newExists := getProperty(newProperties, path)
+		if oldExists != newExists || !reflect.DeepEqual(oldValue, newValue) {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}
+
+func isReadOnly(schema map[string]any) bool {
+	readOnly, _ := schema["readOnly"].(bool)
+	return readOnly
+}
+
+// removeField removes the field at the given dot-separated path. Unlike deleteProperty, parent objects are kept.
+func removeField(properties map[string]any, path string) {
+	segments := strings.Split(path, ".")
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return
+		}
+		current = next
+	}
+
+	delete(current, segments[len(segments)-1])
+}
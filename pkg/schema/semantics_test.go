@@ -0,0 +1,172 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testSemanticsSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"environment": map[string]any{"type": "string"},
+		"tier":        map[string]any{"type": "string", "default": "standard"},
+		"region":      map[string]any{"type": "string", annotationRadiusImmutable: true},
+		"endpoint":    map[string]any{"type": "string", "readOnly": true, "default": "unused"},
+		"network": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"port":   map[string]any{"type": "integer", "default": 80},
+				"subnet": map[string]any{"type": "string", annotationRadiusImmutable: true},
+				"status": map[string]any{"type": "string", "readOnly": true},
+			},
+		},
+		"routes": map[string]any{
+			"type": "array",
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"weight": map[string]any{"type": "integer", "default": 100},
+				},
+			},
+		},
+		"labels": map[string]any{
+			"type": "object",
+			"additionalProperties": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"visible": map[string]any{"type": "boolean", "default": true},
+				},
+			},
+		},
+	},
+}
+
+func TestApplyDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "top-level default",
+			properties: map[string]any{"environment": "env"},
+			expected:   map[string]any{"environment": "env", "tier": "standard"},
+		},
+		{
+			name:       "existing value is kept",
+			properties: map[string]any{"tier": "premium"},
+			expected:   map[string]any{"tier": "premium"},
+		},
+		{
+			name:       "nested object",
+			properties: map[string]any{"tier": "premium", "network": map[string]any{}},
+			expected:   map[string]any{"tier": "premium", "network": map[string]any{"port": 80}},
+		},
+		{
+			name: "array items and map values",
+			properties: map[string]any{
+				"tier":   "premium",
+				"routes": []any{map[string]any{}, map[string]any{"weight": 50}},
+				"labels": map[string]any{"team": map[string]any{}},
+			},
+			expected: map[string]any{
+				"tier":   "premium",
+				"routes": []any{map[string]any{"weight": 100}, map[string]any{"weight": 50}},
+				"labels": map[string]any{"team": map[string]any{"visible": true}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyDefaults(tt.properties, testSemanticsSchema)
+			require.Equal(t, tt.expected, tt.properties)
+		})
+	}
+}
+
+func TestExtractReadOnlyFieldPaths(t *testing.T) {
+	require.Equal(t, []string{"endpoint", "network.status"}, ExtractReadOnlyFieldPaths(testSemanticsSchema, ""))
+}
+
+func TestExtractImmutableFieldPaths(t *testing.T) {
+	require.Equal(t, []string{"network.subnet", "region"}, ExtractImmutableFieldPaths(testSemanticsSchema, ""))
+}
+
+func TestPreserveReadOnlyFields(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		properties := map[string]any{
+			"endpoint": "http://client",
+			"network":  map[string]any{"status": "client"},
+		}
+		PreserveReadOnlyFields(properties, nil, testSemanticsSchema)
+		require.Equal(t, map[string]any{"network": map[string]any{}}, properties)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		properties := map[string]any{
+			"endpoint": "http://client",
+		}
+		oldProperties := map[string]any{
+			"endpoint": "http://server",
+			"network":  map[string]any{"status": "ready"},
+		}
+		PreserveReadOnlyFields(properties, oldProperties, testSemanticsSchema)
+		require.Equal(t, map[string]any{
+			"endpoint": "http://server",
+			"network":  map[string]any{"status": "ready"},
+		}, properties)
+	})
+}
+
+func TestCheckImmutableFields(t *testing.T) {
+	oldProperties := map[string]any{
+		"region":  "westus",
+		"network": map[string]any{"subnet": "a"},
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   []string
+	}{
+		{
+			name:       "unchanged",
+			properties: map[string]any{"region": "westus", "tier": "premium", "network": map[string]any{"subnet": "a"}},
+			expected:   []string{},
+		},
+		{
+			name:       "modified",
+			properties: map[string]any{"region": "eastus", "network": map[string]any{"subnet": "a"}},
+			expected:   []string{"region"},
+		},
+		{
+			name:       "removed",
+			properties: map[string]any{"region": "westus"},
+			expected:   []string{"network.subnet"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, CheckImmutableFields(tt.properties, oldProperties, testSemanticsSchema))
+		})
+	}
+}
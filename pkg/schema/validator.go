@@ -40,6 +40,8 @@ const (
 // Constants for annotation names
 const (
 	annotationRadiusSensitive = "x-radius-sensitive"
+	annotationRadiusImmutable = "x-radius-immutable"
+	annotationRadiusReference = "x-radius-reference"
 )
 
 // joinPath concatenates two path segments with a dot separator for property path tracking.
@@ -110,6 +112,21 @@ func normalizePlatformOptionsAnyWithPath(schema *openapi3.Schema, path string) {
 	if schema.Items != nil && schema.Items.Value != nil {
 		normalizePlatformOptionsAnyWithPath(schema.Items.Value, joinPath(path, "items"))
 	}
+
+	// Variants describe the same value, so they share the path of the schema.
+	for _, variantRef := range variantSchemas(schema) {
+		if variantRef != nil && variantRef.Value != nil {
+			normalizePlatformOptionsAnyWithPath(variantRef.Value, path)
+		}
+	}
+}
+
+// variantSchemas returns the oneOf and anyOf variants of a schema.
+func variantSchemas(schema *openapi3.Schema) openapi3.SchemaRefs {
+	variants := openapi3.SchemaRefs{}
+	variants = append(variants, schema.OneOf...)
+	variants = append(variants, schema.AnyOf...)
+	return variants
 }
 
 // normalizeSensitiveFieldTypes normalizes the type constraints on sensitive fields
@@ -152,6 +169,13 @@ func normalizeSensitiveFieldTypes(schema *openapi3.Schema) {
 			normalizeSensitiveFieldTypes(schema.AdditionalProperties.Schema.Value)
 		}
 	}
+
+	// Handle oneOf and anyOf variants, which may declare their own sensitive properties.
+	for _, variantRef := range variantSchemas(schema) {
+		if variantRef != nil && variantRef.Value != nil {
+			normalizeSensitiveFieldTypes(variantRef.Value)
+		}
+	}
 }
 
 // normalizeSensitiveType checks whether a SchemaRef has a sensitive annotation and expands
@@ -349,6 +373,33 @@ func (v *Validator) validateRadiusConstraintsWithPath(schema *openapi3.Schema, p
 		}
 	}
 
+	// Check x-radius-immutable annotation constraints
+	if err := v.checkImmutableAnnotation(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
+	// Check x-radius-reference annotation constraints
+	if err := v.checkReferenceAnnotation(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
+	// Check discriminator constraints
+	if err := v.checkDiscriminator(schema, path); err != nil {
+		if valErr, ok := err.(*ValidationError); ok {
+			errors.Add(valErr)
+		} else {
+			errors.Add(NewConstraintError("", err.Error()))
+		}
+	}
+
 	// Validate type constraints
 	if err := v.validateTypeConstraints(schema, path); err != nil {
 		if valErr, ok := err.(*ValidationError); ok {
@@ -483,6 +534,45 @@ func (v *Validator) validateRadiusConstraintsWithPath(schema *openapi3.Schema, p
 		}
 	}
 
+	// Validate oneOf and anyOf variants if present
+	for _, xof := range []struct {
+		keyword  string
+		variants openapi3.SchemaRefs
+	}{{"oneOf", schema.OneOf}, {"anyOf", schema.AnyOf}} {
+		for i, variantRef := range xof.variants {
+			if variantRef == nil || variantRef.Ref != "" || variantRef.Value == nil {
+				// The $ref validation is already handled by checkRefUsage above
+				continue
+			}
+
+			variantPath := joinPath(path, fmt.Sprintf("%s[%d]", xof.keyword, i))
+			if err := v.validateRadiusConstraintsWithPath(variantRef.Value, variantPath); err != nil {
+				// Add context to error
+				if valErrs, ok := err.(*ValidationErrors); ok {
+					for _, ve := range valErrs.Errors {
+						// Clone the error to avoid modifying the original
+						contextualErr := &ValidationError{
+							Type:    ve.Type,
+							Field:   joinPath(variantPath, ve.Field),
+							Message: ve.Message,
+						}
+						errors.Add(contextualErr)
+					}
+				} else if valErr, ok := err.(*ValidationError); ok {
+					// Clone the error to avoid modifying the original
+					contextualErr := &ValidationError{
+						Type:    valErr.Type,
+						Field:   joinPath(variantPath, valErr.Field),
+						Message: valErr.Message,
+					}
+					errors.Add(contextualErr)
+				} else {
+					errors.Add(NewSchemaError(variantPath, err.Error()))
+				}
+			}
+		}
+	}
+
 	if errors.HasErrors() {
 		return &errors
 	}
@@ -540,22 +630,74 @@ func (v *Validator) checkProhibitedFeatures(schema *openapi3.Schema) error {
 	if len(schema.AllOf) > 0 {
 		return NewConstraintError("", "allOf is not supported")
 	}
-	if len(schema.AnyOf) > 0 {
-		return NewConstraintError("", "anyOf is not supported")
-	}
-	if len(schema.OneOf) > 0 {
-		return NewConstraintError("", "oneOf is not supported")
-	}
 	if schema.Not != nil {
 		return NewConstraintError("", "not is not supported")
 	}
-	if schema.Discriminator != nil {
-		return NewConstraintError("", "discriminator is not supported")
+	if len(schema.AnyOf) > 0 && len(schema.OneOf) > 0 {
+		return NewConstraintError("", "oneOf and anyOf cannot be combined in the same schema")
+	}
+
+	return nil
+}
+
+// checkDiscriminator validates that a discriminator is used with oneOf or anyOf, and that each variant declares a
+// distinct value for the discriminator property. Mappings are not supported because they require $ref, so each variant
+// must declare its discriminator value as a required property with a single-value enum.
+func (v *Validator) checkDiscriminator(schema *openapi3.Schema, path string) error {
+	if schema.Discriminator == nil {
+		return nil
+	}
+
+	variants := variantSchemas(schema)
+	if len(variants) == 0 {
+		return NewConstraintError(path, "discriminator requires oneOf or anyOf")
+	}
+
+	propertyName := schema.Discriminator.PropertyName
+	if propertyName == "" {
+		return NewConstraintError(path, "discriminator must specify a propertyName")
+	}
+
+	if len(schema.Discriminator.Mapping) > 0 {
+		return NewConstraintError(path, "discriminator mapping is not supported, declare the discriminator value as a single-value enum in each variant")
+	}
+
+	values := map[string]bool{}
+	for i, variantRef := range variants {
+		if variantRef == nil || variantRef.Value == nil {
+			continue
+		}
+
+		value, ok := discriminatorValue(variantRef.Value, propertyName)
+		if !ok {
+			return NewConstraintError(path, fmt.Sprintf("variant %d must declare the required discriminator property '%s' with a single-value string enum", i, propertyName))
+		}
+
+		if values[value] {
+			return NewConstraintError(path, fmt.Sprintf("discriminator value '%s' is declared by more than one variant", value))
+		}
+		values[value] = true
 	}
 
 	return nil
 }
 
+// discriminatorValue returns the value a variant declares for the discriminator property. The property must be
+// required and have a single-value string enum.
+func discriminatorValue(variant *openapi3.Schema, propertyName string) (string, bool) {
+	propRef, ok := variant.Properties[propertyName]
+	if !ok || propRef == nil || propRef.Value == nil || !slices.Contains(variant.Required, propertyName) {
+		return "", false
+	}
+
+	if len(propRef.Value.Enum) != 1 {
+		return "", false
+	}
+
+	value, ok := propRef.Value.Enum[0].(string)
+	return value, ok
+}
+
 // checkSensitiveAnnotation validates that x-radius-sensitive annotation is only used on string and object types
 func (v *Validator) checkSensitiveAnnotation(schema *openapi3.Schema, path string) error {
 	if schema.Extensions == nil {
@@ -594,6 +736,101 @@ func (v *Validator) checkSensitiveAnnotation(schema *openapi3.Schema, path strin
 	return nil
 }
 
+// checkImmutableAnnotation validates that x-radius-immutable annotation is a boolean value, is not combined with readOnly
+// or x-radius-sensitive, and is not used within array items or additionalProperties.
+func (v *Validator) checkImmutableAnnotation(schema *openapi3.Schema, path string) error {
+	if schema.Items != nil && schema.Items.Value != nil && hasImmutableAnnotation(schema.Items.Value) {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is not supported within array items", annotationRadiusImmutable))
+	}
+	if addPropSchema := schema.AdditionalProperties.Schema; addPropSchema != nil && addPropSchema.Value != nil && hasImmutableAnnotation(addPropSchema.Value) {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is not supported within additionalProperties", annotationRadiusImmutable))
+	}
+
+	if schema.Extensions == nil {
+		return nil
+	}
+
+	immutable, exists := schema.Extensions[annotationRadiusImmutable]
+	if !exists {
+		return nil
+	}
+
+	// Validate that the value is a boolean
+	boolVal, ok := immutable.(bool)
+	if !ok {
+		return NewConstraintError(path, fmt.Sprintf("%s must be a boolean value", annotationRadiusImmutable))
+	}
+
+	if boolVal {
+		// readOnly fields are set by the server, so they cannot be immutable input.
+		if schema.ReadOnly {
+			return NewConstraintError(path, fmt.Sprintf("%s annotation cannot be combined with readOnly", annotationRadiusImmutable))
+		}
+
+		// Sensitive fields are stored encrypted, so their values cannot be compared between updates.
+		if sensitive, ok := schema.Extensions[annotationRadiusSensitive].(bool); ok && sensitive {
+			return NewConstraintError(path, fmt.Sprintf("%s annotation cannot be combined with %s", annotationRadiusImmutable, annotationRadiusSensitive))
+		}
+	}
+
+	return nil
+}
+
+// checkReferenceAnnotation validates that x-radius-reference annotation is a fully qualified resource type, such as
+// 'Radius.Data/postgreSqlDatabases', and is only used on string properties that are not sensitive.
+func (v *Validator) checkReferenceAnnotation(schema *openapi3.Schema, path string) error {
+	if schema.Extensions == nil {
+		return nil
+	}
+
+	reference, exists := schema.Extensions[annotationRadiusReference]
+	if !exists {
+		return nil
+	}
+
+	resourceType, ok := reference.(string)
+	if !ok {
+		return NewConstraintError(path, fmt.Sprintf("%s must be a string value", annotationRadiusReference))
+	}
+
+	namespace, typeName, found := strings.Cut(resourceType, "/")
+	if !found || namespace == "" || typeName == "" || strings.Contains(typeName, "/") {
+		return NewConstraintError(path, fmt.Sprintf("%s must be a fully qualified resource type such as 'Radius.Data/postgreSqlDatabases', got '%s'", annotationRadiusReference, resourceType))
+	}
+
+	// References store resource IDs.
+	if schema.Type == nil || !schema.Type.Is("string") {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation is only supported on string types", annotationRadiusReference))
+	}
+
+	if sensitive, ok := schema.Extensions[annotationRadiusSensitive].(bool); ok && sensitive {
+		return NewConstraintError(path, fmt.Sprintf("%s annotation cannot be combined with %s", annotationRadiusReference, annotationRadiusSensitive))
+	}
+
+	return nil
+}
+
+// hasImmutableAnnotation checks if a schema or any of its nested schemas is marked with x-radius-immutable.
+func hasImmutableAnnotation(schema *openapi3.Schema) bool {
+	if immutable, ok := schema.Extensions[annotationRadiusImmutable].(bool); ok && immutable {
+		return true
+	}
+
+	for _, propRef := range schema.Properties {
+		if propRef != nil && propRef.Value != nil && hasImmutableAnnotation(propRef.Value) {
+			return true
+		}
+	}
+	if schema.Items != nil && schema.Items.Value != nil && hasImmutableAnnotation(schema.Items.Value) {
+		return true
+	}
+	if addPropSchema := schema.AdditionalProperties.Schema; addPropSchema != nil && addPropSchema.Value != nil {
+		return hasImmutableAnnotation(addPropSchema.Value)
+	}
+
+	return false
+}
+
 // isInternalRef checks if a $ref is an internal reference within the same document
 func (v *Validator) isInternalRef(ref string) bool {
 	// Internal references start with "#/" which means they reference within the same document
@@ -178,44 +178,45 @@ func TestValidator_checkProhibitedFeatures(t *testing.T) {
 			errMsg: "allOf is not supported",
 		},
 		{
-			name: "anyOf not allowed",
+			name: "anyOf allowed",
 			schema: &openapi3.Schema{
 				AnyOf: []*openapi3.SchemaRef{
 					{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
 				},
 			},
-			hasErr: true,
-			errMsg: "anyOf is not supported",
+			hasErr: false,
 		},
 		{
-			name: "oneOf not allowed",
+			name: "oneOf allowed",
 			schema: &openapi3.Schema{
 				OneOf: []*openapi3.SchemaRef{
 					{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
 				},
 			},
-			hasErr: true,
-			errMsg: "oneOf is not supported",
+			hasErr: false,
 		},
 		{
-			name: "not not allowed",
+			name: "oneOf and anyOf combined not allowed",
 			schema: &openapi3.Schema{
-				Not: &openapi3.SchemaRef{
-					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				OneOf: []*openapi3.SchemaRef{
+					{Value: &openapi3.Schema{Type: &openapi3.Types{"string"}}},
+				},
+				AnyOf: []*openapi3.SchemaRef{
+					{Value: &openapi3.Schema{Type: &openapi3.Types{"integer"}}},
 				},
 			},
 			hasErr: true,
-			errMsg: "not is not supported",
+			errMsg: "oneOf and anyOf cannot be combined in the same schema",
 		},
 		{
-			name: "discriminator not allowed",
+			name: "not not allowed",
 			schema: &openapi3.Schema{
-				Discriminator: &openapi3.Discriminator{
-					PropertyName: "type",
+				Not: &openapi3.SchemaRef{
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
 				},
 			},
 			hasErr: true,
-			errMsg: "discriminator is not supported",
+			errMsg: "not is not supported",
 		},
 		{
 			name: "valid schema without prohibited features",
@@ -2340,6 +2341,340 @@ func TestValidator_checkSensitiveAnnotation(t *testing.T) {
 	}
 }
 
+func TestValidator_checkImmutableAnnotation(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		path   string
+		hasErr bool
+		errMsg string
+	}{
+		{
+			name: "x-radius-immutable on string type - valid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+				},
+			},
+			path:   "region",
+			hasErr: false,
+		},
+		{
+			name: "x-radius-immutable false with readOnly - valid",
+			schema: &openapi3.Schema{
+				Type:     &openapi3.Types{"string"},
+				ReadOnly: true,
+				Extensions: map[string]any{
+					annotationRadiusImmutable: false,
+				},
+			},
+			path:   "endpoint",
+			hasErr: false,
+		},
+		{
+			name: "x-radius-immutable non-boolean - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: "true",
+				},
+			},
+			path:   "region",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s must be a boolean value", annotationRadiusImmutable),
+		},
+		{
+			name: "x-radius-immutable with readOnly - invalid",
+			schema: &openapi3.Schema{
+				Type:     &openapi3.Types{"string"},
+				ReadOnly: true,
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+				},
+			},
+			path:   "endpoint",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation cannot be combined with readOnly", annotationRadiusImmutable),
+		},
+		{
+			name: "x-radius-immutable with x-radius-sensitive - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusImmutable: true,
+					annotationRadiusSensitive: true,
+				},
+			},
+			path:   "password",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation cannot be combined with %s", annotationRadiusImmutable, annotationRadiusSensitive),
+		},
+		{
+			name: "x-radius-immutable within array items - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"array"},
+				Items: &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"object"},
+						Properties: openapi3.Schemas{
+							"name": {
+								Value: &openapi3.Schema{
+									Type: &openapi3.Types{"string"},
+									Extensions: map[string]any{
+										annotationRadiusImmutable: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			path:   "ports",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation is not supported within array items", annotationRadiusImmutable),
+		},
+		{
+			name: "x-radius-immutable within additionalProperties - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				AdditionalProperties: openapi3.AdditionalProperties{
+					Schema: &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: &openapi3.Types{"string"},
+							Extensions: map[string]any{
+								annotationRadiusImmutable: true,
+							},
+						},
+					},
+				},
+			},
+			path:   "labels",
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation is not supported within additionalProperties", annotationRadiusImmutable),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkImmutableAnnotation(tt.schema, tt.path)
+			if tt.hasErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				var constraintErr *ValidationError
+				require.ErrorAs(t, err, &constraintErr)
+				require.Equal(t, ErrorTypeConstraint, constraintErr.Type)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_WithImmutableAnnotation(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	t.Run("valid nested x-radius-immutable", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"network": {
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"object"},
+						Properties: openapi3.Schemas{
+							"region": {
+								Value: &openapi3.Schema{
+									Type: &openapi3.Types{"string"},
+									Extensions: map[string]any{
+										annotationRadiusImmutable: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid x-radius-immutable with readOnly", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"endpoint": {
+					Value: &openapi3.Schema{
+						Type:     &openapi3.Types{"string"},
+						ReadOnly: true,
+						Extensions: map[string]any{
+							annotationRadiusImmutable: true,
+						},
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "x-radius-immutable annotation cannot be combined with readOnly")
+	})
+}
+
+func TestValidator_checkReferenceAnnotation(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		hasErr bool
+		errMsg string
+	}{
+		{
+			name: "x-radius-reference on string type - valid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusReference: "Radius.Data/postgreSqlDatabases",
+				},
+			},
+			hasErr: false,
+		},
+		{
+			name: "x-radius-reference non-string - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusReference: true,
+				},
+			},
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s must be a string value", annotationRadiusReference),
+		},
+		{
+			name: "x-radius-reference without type name - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusReference: "Radius.Data",
+				},
+			},
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s must be a fully qualified resource type", annotationRadiusReference),
+		},
+		{
+			name: "x-radius-reference with nested type - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusReference: "Radius.Data/postgreSqlDatabases/tables",
+				},
+			},
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s must be a fully qualified resource type", annotationRadiusReference),
+		},
+		{
+			name: "x-radius-reference on object type - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				Extensions: map[string]any{
+					annotationRadiusReference: "Radius.Data/postgreSqlDatabases",
+				},
+			},
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation is only supported on string types", annotationRadiusReference),
+		},
+		{
+			name: "x-radius-reference with x-radius-sensitive - invalid",
+			schema: &openapi3.Schema{
+				Type: &openapi3.Types{"string"},
+				Extensions: map[string]any{
+					annotationRadiusReference: "Radius.Data/postgreSqlDatabases",
+					annotationRadiusSensitive: true,
+				},
+			},
+			hasErr: true,
+			errMsg: fmt.Sprintf("%s annotation cannot be combined with %s", annotationRadiusReference, annotationRadiusSensitive),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkReferenceAnnotation(tt.schema, "database")
+			if tt.hasErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				var constraintErr *ValidationError
+				require.ErrorAs(t, err, &constraintErr)
+				require.Equal(t, ErrorTypeConstraint, constraintErr.Type)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_WithReferenceAnnotation(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	t.Run("valid x-radius-reference in array items", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"databases": {
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"array"},
+						Items: &openapi3.SchemaRef{
+							Value: &openapi3.Schema{
+								Type: &openapi3.Types{"string"},
+								Extensions: map[string]any{
+									annotationRadiusReference: "Radius.Data/postgreSqlDatabases",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid x-radius-reference on integer", func(t *testing.T) {
+		schema := &openapi3.Schema{
+			Type: &openapi3.Types{"object"},
+			Properties: openapi3.Schemas{
+				"environment": {
+					Value: &openapi3.Schema{Type: &openapi3.Types{"string"}},
+				},
+				"database": {
+					Value: &openapi3.Schema{
+						Type: &openapi3.Types{"integer"},
+						Extensions: map[string]any{
+							annotationRadiusReference: "Radius.Data/postgreSqlDatabases",
+						},
+					},
+				},
+			},
+		}
+		err := validator.ValidateSchema(ctx, schema)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "x-radius-reference annotation is only supported on string types")
+	})
+}
+
 func TestValidator_ValidateSchema_WithSensitiveAnnotation(t *testing.T) {
 	validator := NewValidator()
 	ctx := context.Background()
@@ -3441,3 +3776,209 @@ func TestValidateResourceAgainstSchema_EncryptedSensitiveFields(t *testing.T) {
 	})
 
 }
+
+// polymorphicStorageSchema returns a storage schema whose backend is either S3 or Azure Blob.
+func polymorphicStorageSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"backend": map[string]any{
+				"type": "object",
+				"discriminator": map[string]any{
+					"propertyName": "kind",
+				},
+				"oneOf": []any{
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind":   map[string]any{"type": "string", "enum": []any{"s3"}},
+							"bucket": map[string]any{"type": "string"},
+							"region": map[string]any{"type": "string"},
+						},
+						"required": []any{"kind", "bucket", "region"},
+					},
+					map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"kind":        map[string]any{"type": "string", "enum": []any{"azureBlob"}},
+							"container":   map[string]any{"type": "string"},
+							"accountKey":  map[string]any{"type": "string", annotationRadiusSensitive: true},
+							"accountName": map[string]any{"type": "string"},
+						},
+						"required": []any{"kind", "container", "accountName"},
+					},
+				},
+			},
+		},
+		"required": []any{"environment", "backend"},
+	}
+}
+
+func TestValidator_checkDiscriminator(t *testing.T) {
+	validator := NewValidator()
+
+	variant := func(propertyName string, enum ...any) *openapi3.SchemaRef {
+		return &openapi3.SchemaRef{
+			Value: &openapi3.Schema{
+				Type: &openapi3.Types{"object"},
+				Properties: openapi3.Schemas{
+					propertyName: {Value: &openapi3.Schema{Type: &openapi3.Types{"string"}, Enum: enum}},
+				},
+				Required: []string{propertyName},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		schema *openapi3.Schema
+		errMsg string
+	}{
+		{
+			name:   "no discriminator",
+			schema: &openapi3.Schema{Type: &openapi3.Types{"object"}},
+		},
+		{
+			name: "valid discriminator",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+				OneOf:         openapi3.SchemaRefs{variant("kind", "s3"), variant("kind", "azureBlob")},
+			},
+		},
+		{
+			name: "discriminator without variants",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+			},
+			errMsg: "discriminator requires oneOf or anyOf",
+		},
+		{
+			name: "discriminator without propertyName",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{},
+				OneOf:         openapi3.SchemaRefs{variant("kind", "s3")},
+			},
+			errMsg: "discriminator must specify a propertyName",
+		},
+		{
+			name: "discriminator with mapping",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{
+					PropertyName: "kind",
+					Mapping:      openapi3.StringMap[openapi3.MappingRef]{"s3": {Ref: "#/components/schemas/S3"}},
+				},
+				OneOf: openapi3.SchemaRefs{variant("kind", "s3")},
+			},
+			errMsg: "discriminator mapping is not supported",
+		},
+		{
+			name: "variant without discriminator value",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+				AnyOf:         openapi3.SchemaRefs{variant("kind", "s3"), variant("kind", "s3", "azureBlob")},
+			},
+			errMsg: "variant 1 must declare the required discriminator property 'kind' with a single-value string enum",
+		},
+		{
+			name: "duplicate discriminator value",
+			schema: &openapi3.Schema{
+				Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+				OneOf:         openapi3.SchemaRefs{variant("kind", "s3"), variant("kind", "s3")},
+			},
+			errMsg: "discriminator value 's3' is declared by more than one variant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.checkDiscriminator(tt.schema, "backend")
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_Polymorphic(t *testing.T) {
+	validator := NewValidator()
+	ctx := context.Background()
+
+	t.Run("valid oneOf with discriminator", func(t *testing.T) {
+		schema, err := ConvertToOpenAPISchema(polymorphicStorageSchema())
+		require.NoError(t, err)
+
+		err = validator.ValidateSchema(ctx, schema)
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid variant", func(t *testing.T) {
+		data := polymorphicStorageSchema()
+		backend := data["properties"].(map[string]any)["backend"].(map[string]any)
+		s3 := backend["oneOf"].([]any)[0].(map[string]any)
+		s3["properties"].(map[string]any)["retries"] = map[string]any{"type": "integer", annotationRadiusSensitive: true}
+
+		schema, err := ConvertToOpenAPISchema(data)
+		require.NoError(t, err)
+
+		err = validator.ValidateSchema(ctx, schema)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "backend.oneOf[0].retries")
+	})
+}
+
+func TestValidateResourceAgainstSchema_Polymorphic(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		backend map[string]any
+		errMsg  string
+	}{
+		{
+			name:    "s3 backend",
+			backend: map[string]any{"kind": "s3", "bucket": "b", "region": "us-east-1"},
+		},
+		{
+			name:    "azure blob backend with encrypted key",
+			backend: map[string]any{"kind": "azureBlob", "container": "c", "accountName": "a", "accountKey": map[string]any{"encrypted": "x", "nonce": "y"}},
+		},
+		{
+			name:    "missing required field of variant",
+			backend: map[string]any{"kind": "s3", "bucket": "b"},
+			errMsg:  "resource data validation failed",
+		},
+		{
+			name:    "unknown discriminator value",
+			backend: map[string]any{"kind": "gcs", "bucket": "b"},
+			errMsg:  "resource data validation failed",
+		},
+		{
+			name:    "missing discriminator",
+			backend: map[string]any{"bucket": "b", "region": "us-east-1"},
+			errMsg:  "discriminator property",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceData := map[string]any{
+				"properties": map[string]any{
+					"environment": "env",
+					"backend":     tt.backend,
+				},
+			}
+
+			err := ValidateResourceAgainstSchema(ctx, resourceData, polymorphicStorageSchema())
+			if tt.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
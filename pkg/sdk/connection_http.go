@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+var _ Connection = (*httpConnection)(nil)
+
+// HTTPConnectionOptions configures how a connection made with NewHTTPConnection secures and authenticates
+// its requests.
+type HTTPConnectionOptions struct {
+	// RootCAs is the set of certificate authorities trusted when verifying the server. When nil, the system
+	// certificate pool is used.
+	RootCAs *x509.CertPool
+
+	// Certificates are the client certificates presented to the server for mutual TLS authentication.
+	Certificates []tls.Certificate
+
+	// Token returns the bearer token sent in the Authorization header of each request. When nil, no
+	// Authorization header is sent.
+	Token func(ctx context.Context) (string, error)
+
+	// Proxy returns the proxy used for a request. When nil, the proxy is read from the environment.
+	Proxy func(*http.Request) (*url.URL, error)
+}
+
+// httpConnection represents a connection to a Radius API endpoint that is reached directly over HTTP(S)
+// rather than through the Kubernetes API server.
+type httpConnection struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewHTTPConnection parses the given endpoint string and returns a connection that sends requests directly to the
+// endpoint using the given options. The endpoint must use the http or https scheme.
+func NewHTTPConnection(endpoint string, options HTTPConnectionOptions) (Connection, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("the endpoint must use the http or https scheme (got %q)", endpoint)
+	}
+
+	if parsed.Scheme == "http" && options.Token != nil {
+		return nil, fmt.Errorf("bearer token authentication requires the https scheme (got %q)", endpoint)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      options.RootCAs,
+		Certificates: options.Certificates,
+	}
+	if options.Proxy != nil {
+		transport.Proxy = options.Proxy
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if options.Token != nil {
+		roundTripper = &bearerTokenTransport{token: options.Token, next: transport}
+	}
+
+	return &httpConnection{
+		endpoint: endpoint,
+		client:   &http.Client{Transport: otelhttp.NewTransport(roundTripper)},
+	}, nil
+}
+
+// Client returns an http.Client for communicating with Radius. This satisfies both the
+// autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
+// (autorest Track2 Go SDK).
+func (c *httpConnection) Client() *http.Client {
+	return c.client
+}
+
+// Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
+// the URL scheme and authority, and may include path segments.
+func (c *httpConnection) Endpoint() string {
+	return c.endpoint
+}
+
+// bearerTokenTransport adds an Authorization header with a bearer token to each request.
+type bearerTokenTransport struct {
+	token func(ctx context.Context) (string, error)
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a bearer token: %w", err)
+	}
+
+	// RoundTrippers must not modify the original request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewHTTPConnection_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		options  HTTPConnectionOptions
+		err      string
+	}{
+		{
+			name:     "invalid url",
+			endpoint: ":",
+			err:      "failed to parse endpoint",
+		},
+		{
+			name:     "missing scheme",
+			endpoint: "/just/a/path",
+			err:      "the endpoint must use the http or https scheme",
+		},
+		{
+			name:     "token over http",
+			endpoint: "http://example.com",
+			options: HTTPConnectionOptions{
+				Token: func(ctx context.Context) (string, error) { return "token", nil },
+			},
+			err: "bearer token authentication requires the https scheme",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection, err := NewHTTPConnection(tt.endpoint, tt.options)
+			require.ErrorContains(t, err, tt.err)
+			require.Nil(t, connection)
+		})
+	}
+}
+
+func Test_NewHTTPConnection_TLS(t *testing.T) {
+	var authorization string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	t.Run("pinned CA with bearer token", func(t *testing.T) {
+		connection, err := NewHTTPConnection(server.URL, HTTPConnectionOptions{
+			RootCAs: pool,
+			Token:   func(ctx context.Context) (string, error) { return "my-token", nil },
+		})
+		require.NoError(t, err)
+		require.Equal(t, server.URL, connection.Endpoint())
+
+		response, err := connection.Client().Get(server.URL)
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "Bearer my-token", authorization)
+	})
+
+	t.Run("untrusted server", func(t *testing.T) {
+		connection, err := NewHTTPConnection(server.URL, HTTPConnectionOptions{})
+		require.NoError(t, err)
+
+		_, err = connection.Client().Get(server.URL)
+		require.Error(t, err)
+	})
+
+	t.Run("token error", func(t *testing.T) {
+		connection, err := NewHTTPConnection(server.URL, HTTPConnectionOptions{
+			RootCAs: pool,
+			Token:   func(ctx context.Context) (string, error) { return "", errors.New("expired") },
+		})
+		require.NoError(t, err)
+
+		_, err = connection.Client().Get(server.URL)
+		require.ErrorContains(t, err, "failed to get a bearer token: expired")
+	})
+}
@@ -37,6 +37,10 @@ func NewClientOptions(connection Connection) *arm.ClientOptions {
 					},
 				},
 			},
+			PerCallPolicies: []policy.Policy{
+				// Report warnings returned by Radius, such as the use of a deprecated API version.
+				&warningPolicy{},
+			},
 			PerRetryPolicies: []policy.Policy{
 				// Autorest will inject an empty bearer token, which conflicts with bearer auth
 				// when its used by Kubernetes. We don't *ever* need Autorest to handle auth for us
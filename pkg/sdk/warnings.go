@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+// WarningHeader is the HTTP header used by Radius to return warnings with a response, such as the use of a deprecated
+// resource type or API version. Values use the format '299 - "<message>"'.
+const WarningHeader = "Warning"
+
+// WarningHandler handles the message of a warning returned by Radius.
+type WarningHandler func(message string)
+
+var warningHandler atomic.Pointer[WarningHandler]
+
+// SetWarningHandler sets the handler called for each warning returned to clients created with NewClientOptions.
+// Warnings are discarded when the handler is nil, which is the default.
+func SetWarningHandler(handler WarningHandler) {
+	if handler == nil {
+		warningHandler.Store(nil)
+		return
+	}
+
+	warningHandler.Store(&handler)
+}
+
+// NewWarningWriter returns a WarningHandler that writes each distinct warning to the writer once.
+func NewWarningWriter(w io.Writer) WarningHandler {
+	mutex := sync.Mutex{}
+	written := map[string]bool{}
+	return func(message string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if written[message] {
+			return
+		}
+
+		written[message] = true
+		_, _ = fmt.Fprintf(w, "WARNING: %s\n", message)
+	}
+}
+
+// parseWarning returns the message of a Warning header value. Values that don't use the '<code> <agent> "<message>"'
+// format are returned as-is.
+func parseWarning(value string) string {
+	parts := strings.SplitN(strings.TrimSpace(value), " ", 3)
+	if len(parts) != 3 {
+		return value
+	}
+
+	message, err := strconv.Unquote(parts[2])
+	if err != nil {
+		return value
+	}
+
+	return message
+}
+
+var _ policy.Policy = (*warningPolicy)(nil)
+
+type warningPolicy struct {
+}
+
+// Do passes the warnings of the response to the current WarningHandler.
+func (p *warningPolicy) Do(req *policy.Request) (*http.Response, error) {
+	resp, err := req.Next()
+	if resp == nil {
+		return resp, err
+	}
+
+	handler := warningHandler.Load()
+	if handler == nil {
+		return resp, err
+	}
+
+	for _, value := range resp.Header.Values(WarningHeader) {
+		(*handler)(parseWarning(value))
+	}
+
+	return resp, err
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type warningTransport struct {
+	warnings []string
+}
+
+func (t *warningTransport) Do(req *http.Request) (*http.Response, error) {
+	header := http.Header{}
+	for _, warning := range t.warnings {
+		header.Add(WarningHeader, warning)
+	}
+
+	return &http.Response{StatusCode: http.StatusOK, Header: header, Body: http.NoBody, Request: req}, nil
+}
+
+func Test_WarningPolicy(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	SetWarningHandler(NewWarningWriter(buffer))
+	t.Cleanup(func() { SetWarningHandler(nil) })
+
+	transport := &warningTransport{
+		warnings: []string{
+			`299 - "API version \"2025-01-01\" is deprecated."`,
+			`299 - "API version \"2025-01-01\" is deprecated."`,
+			"not a formatted warning",
+		},
+	}
+	pipeline := runtime.NewPipeline("test", "v1", runtime.PipelineOptions{PerCall: []policy.Policy{&warningPolicy{}}}, &policy.ClientOptions{Transport: transport})
+
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, "http://localhost")
+	require.NoError(t, err)
+
+	_, err = pipeline.Do(req)
+	require.NoError(t, err)
+
+	require.Equal(t, "WARNING: API version \"2025-01-01\" is deprecated.\nWARNING: not a formatted warning\n", buffer.String())
+}
+
+func Test_WarningPolicy_NoHandler(t *testing.T) {
+	transport := &warningTransport{warnings: []string{`299 - "deprecated"`}}
+	pipeline := runtime.NewPipeline("test", "v1", runtime.PipelineOptions{PerCall: []policy.Policy{&warningPolicy{}}}, &policy.ClientOptions{Transport: transport})
+
+	req, err := runtime.NewRequest(context.Background(), http.MethodGet, "http://localhost")
+	require.NoError(t, err)
+
+	resp, err := pipeline.Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
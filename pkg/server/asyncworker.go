@@ -29,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
 	"github.com/radius-project/radius/pkg/corerp/backend/deployment"
 	"github.com/radius-project/radius/pkg/corerp/model"
+	"github.com/radius-project/radius/pkg/docker"
 	"github.com/radius-project/radius/pkg/kubeutil"
 )
 
@@ -99,7 +100,15 @@ func (w *AsyncWorker) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize kubernetes clients: %w", err)
 	}
 
-	appModel, err := model.NewApplicationModel(w.options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient)
+	// The Docker runtime is only enabled when DOCKER_HOST is set. Otherwise deployments to environments using the
+	// Docker runtime fail because the docker provider is not configured. The client connects lazily, so they also
+	// fail at deployment time when the Docker host is not reachable.
+	dockerClient, err := docker.NewClientFromEnv()
+	if err != nil {
+		return fmt.Errorf("failed to initialize docker client: %w", err)
+	}
+
+	appModel, err := model.NewApplicationModel(w.options.Arm, k8s.RuntimeClient, k8s.ClientSet, k8s.DiscoveryClient, k8s.DynamicClient, dockerClient)
 	if err != nil {
 		return fmt.Errorf("failed to initialize application model: %w", err)
 	}
@@ -17,6 +17,10 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -43,9 +47,126 @@ func (src *APIVersionResource) ConvertTo() (v1.DataModelInterface, error) {
 		Schema: src.Properties.Schema,
 	}
 
+	if len(src.Properties.Actions) > 0 {
+		dst.Properties.Actions = map[string]datamodel.APIVersionAction{}
+		for name, action := range src.Properties.Actions {
+			converted, err := toAPIVersionActionDataModel(name, action)
+			if err != nil {
+				return nil, err
+			}
+			dst.Properties.Actions[name] = converted
+		}
+	}
+
+	for i, conversion := range src.Properties.Conversions {
+		converted, err := toAPIVersionConversionDataModel(i, conversion)
+		if err != nil {
+			return nil, err
+		}
+		dst.Properties.Conversions = append(dst.Properties.Conversions, converted)
+	}
+
+	lifecycle, err := toLifecycleDataModel(src.Properties.Lifecycle)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Lifecycle = lifecycle
+
 	return dst, nil
 }
 
+func toAPIVersionConversionDataModel(index int, conversion *APIVersionConversion) (datamodel.APIVersionConversion, error) {
+	propertyName := fmt.Sprintf("$.properties.conversions[%d]", index)
+	if conversion == nil || to.String(conversion.From) == "" {
+		return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: propertyName + ".from", ValidValue: "not empty"}
+	}
+
+	result := datamodel.APIVersionConversion{
+		From: to.String(conversion.From),
+	}
+
+	for i, rule := range conversion.Rules {
+		rulePropertyName := fmt.Sprintf("%s.rules[%d]", propertyName, i)
+		if rule == nil {
+			return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName, ValidValue: "not nil"}
+		}
+
+		converted := datamodel.APIVersionConversionRule{
+			Kind:  to.String(rule.Kind),
+			From:  to.String(rule.From),
+			To:    to.String(rule.To),
+			Path:  to.String(rule.Path),
+			Value: rule.Value,
+		}
+
+		switch converted.Kind {
+		case datamodel.ConversionRuleKindRename, datamodel.ConversionRuleKindMove:
+			if converted.From == "" {
+				return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName + ".from", ValidValue: "not empty"}
+			}
+			if converted.To == "" {
+				return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName + ".to", ValidValue: "not empty"}
+			}
+			if converted.Kind == datamodel.ConversionRuleKindRename && parentPath(converted.From) != parentPath(converted.To) {
+				return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName + ".to", ValidValue: "a path with the same parent as 'from'"}
+			}
+		case datamodel.ConversionRuleKindDefault:
+			if converted.Path == "" {
+				return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName + ".path", ValidValue: "not empty"}
+			}
+		default:
+			return datamodel.APIVersionConversion{}, &v1.ErrModelConversion{PropertyName: rulePropertyName + ".kind", ValidValue: fmt.Sprintf("one of %q", datamodel.ConversionRuleKinds)}
+		}
+
+		result.Rules = append(result.Rules, converted)
+	}
+
+	return result, nil
+}
+
+// parentPath returns the path of the object containing the property at the given dot-separated path.
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+func toAPIVersionActionDataModel(name string, action *APIVersionAction) (datamodel.APIVersionAction, error) {
+	propertyName := fmt.Sprintf("$.properties.actions.%s", name)
+	if action == nil || action.Handler == nil {
+		return datamodel.APIVersionAction{}, &v1.ErrModelConversion{PropertyName: propertyName + ".handler", ValidValue: "not nil"}
+	}
+
+	handler := datamodel.APIVersionActionHandler{
+		Kind:       to.String(action.Handler.Kind),
+		RecipeName: to.String(action.Handler.RecipeName),
+		URL:        to.String(action.Handler.URL),
+	}
+
+	switch handler.Kind {
+	case datamodel.ActionHandlerKindSensitiveFields:
+	case datamodel.ActionHandlerKindRecipe:
+		if handler.RecipeName == "" {
+			return datamodel.APIVersionAction{}, &v1.ErrModelConversion{PropertyName: propertyName + ".handler.recipeName", ValidValue: "not empty"}
+		}
+	case datamodel.ActionHandlerKindWebhook:
+		u, err := url.Parse(handler.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return datamodel.APIVersionAction{}, &v1.ErrModelConversion{PropertyName: propertyName + ".handler.url", ValidValue: "an absolute http or https URL"}
+		}
+	default:
+		return datamodel.APIVersionAction{}, &v1.ErrModelConversion{PropertyName: propertyName + ".handler.kind", ValidValue: fmt.Sprintf("one of %q", datamodel.ActionHandlerKinds)}
+	}
+
+	return datamodel.APIVersionAction{
+		Description: to.String(action.Description),
+		Input:       action.Input,
+		Output:      action.Output,
+		Handler:     handler,
+	}, nil
+}
+
 // ConvertFrom converts from version-agnostic datamodel to the versioned APIVersionResource resource.
 func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 	dm, ok := src.(*datamodel.APIVersion)
@@ -62,7 +183,58 @@ func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &APIVersionProperties{
 		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
 		Schema:            dm.Properties.Schema,
+		Lifecycle:         fromLifecycleDataModel(dm.Properties.Lifecycle),
+	}
+
+	if len(dm.Properties.Actions) > 0 {
+		dst.Properties.Actions = map[string]*APIVersionAction{}
+		for name, action := range dm.Properties.Actions {
+			dst.Properties.Actions[name] = fromAPIVersionActionDataModel(action)
+		}
+	}
+
+	for _, conversion := range dm.Properties.Conversions {
+		dst.Properties.Conversions = append(dst.Properties.Conversions, fromAPIVersionConversionDataModel(conversion))
 	}
 
 	return nil
 }
+
+func fromAPIVersionConversionDataModel(conversion datamodel.APIVersionConversion) *APIVersionConversion {
+	result := &APIVersionConversion{
+		From: new(conversion.From),
+	}
+
+	for _, rule := range conversion.Rules {
+		result.Rules = append(result.Rules, &APIVersionConversionRule{
+			Kind:  new(rule.Kind),
+			From:  optionalString(rule.From),
+			To:    optionalString(rule.To),
+			Path:  optionalString(rule.Path),
+			Value: rule.Value,
+		})
+	}
+
+	return result
+}
+
+func fromAPIVersionActionDataModel(action datamodel.APIVersionAction) *APIVersionAction {
+	return &APIVersionAction{
+		Description: optionalString(action.Description),
+		Input:       action.Input,
+		Output:      action.Output,
+		Handler: &APIVersionActionHandler{
+			Kind:       new(action.Handler.Kind),
+			RecipeName: optionalString(action.Handler.RecipeName),
+			URL:        optionalString(action.Handler.URL),
+		},
+	}
+}
+
+// optionalString returns nil for an empty string so that unset values are omitted from the response.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
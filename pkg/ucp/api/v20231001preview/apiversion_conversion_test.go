@@ -50,6 +50,89 @@ func Test_APIVersion_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.APIVersionProperties{},
 			},
 		},
+		{
+			filename: "apiversion_resource_actions.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01",
+						Name: "2025-01-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Actions: map[string]datamodel.APIVersionAction{
+						"listSecrets": {
+							Description: "Lists the secrets of the resource.",
+							Output:      map[string]any{"type": "object"},
+							Handler:     datamodel.APIVersionActionHandler{Kind: datamodel.ActionHandlerKindSensitiveFields},
+						},
+						"backup": {
+							Input: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"target": map[string]any{"type": "string"},
+								},
+							},
+							Handler: datamodel.APIVersionActionHandler{Kind: datamodel.ActionHandlerKindRecipe, RecipeName: "backup"},
+						},
+						"rotate": {
+							Handler: datamodel.APIVersionActionHandler{Kind: datamodel.ActionHandlerKindWebhook, URL: "https://example.com/rotate"},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "apiversion_resource_actions_invalidkind.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.actions.test.handler.kind", ValidValue: "one of [\"sensitiveFields\" \"recipe\" \"webhook\"]"},
+		},
+		{
+			filename: "apiversion_resource_actions_missingrecipe.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.actions.test.handler.recipeName", ValidValue: "not empty"},
+		},
+		{
+			filename: "apiversion_resource_actions_invalidurl.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.actions.test.handler.url", ValidValue: "an absolute http or https URL"},
+		},
+		{
+			filename: "apiversion_resource_conversions.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-06-01",
+						Name: "2025-06-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Conversions: []datamodel.APIVersionConversion{
+						{
+							From: "2025-01-01",
+							Rules: []datamodel.APIVersionConversionRule{
+								{Kind: datamodel.ConversionRuleKindRename, From: "size", To: "capacity"},
+								{Kind: datamodel.ConversionRuleKindMove, From: "config.port", To: "port"},
+								{Kind: datamodel.ConversionRuleKindDefault, Path: "tier", Value: "standard"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "apiversion_resource_conversions_invalidkind.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.conversions[0].rules[0].kind", ValidValue: "one of [\"rename\" \"move\" \"default\"]"},
+		},
+		{
+			filename: "apiversion_resource_conversions_invalidrename.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.conversions[0].rules[0].to", ValidValue: "a path with the same parent as 'from'"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -88,6 +171,46 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "apiversion_datamodel_actions.json",
+			expected: &APIVersionResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01"),
+				Type: to.Ptr(datamodel.APIVersionResourceType),
+				Name: new("2025-01-01"),
+				Properties: &APIVersionProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Actions: map[string]*APIVersionAction{
+						"listSecrets": {
+							Description: new("Lists the secrets of the resource."),
+							Handler:     &APIVersionActionHandler{Kind: new("sensitiveFields")},
+						},
+						"rotate": {
+							Handler: &APIVersionActionHandler{Kind: new("webhook"), URL: new("https://example.com/rotate")},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "apiversion_datamodel_conversions.json",
+			expected: &APIVersionResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-06-01"),
+				Type: to.Ptr(datamodel.APIVersionResourceType),
+				Name: new("2025-06-01"),
+				Properties: &APIVersionProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Conversions: []*APIVersionConversion{
+						{
+							From: new("2025-01-01"),
+							Rules: []*APIVersionConversionRule{
+								{Kind: new("rename"), From: new("size"), To: new("capacity")},
+								{Kind: new("default"), Path: new("tier"), Value: "standard"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -42,6 +42,16 @@ func (src *ResourceGroupResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	if src.Properties != nil {
+		for _, policy := range src.Properties.Policies {
+			if policy == nil {
+				continue
+			}
+
+			converted.Properties.Policies = append(converted.Properties.Policies, toResourceGroupPolicyDataModel(policy))
+		}
+	}
+
 	return converted, nil
 }
 
@@ -58,5 +68,60 @@ func (dst *ResourceGroupResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Location = new(rg.Location)
 	dst.Tags = *to.StringMapPtr(rg.Tags)
 
+	if len(rg.Properties.Policies) > 0 {
+		dst.Properties = &ResourceGroupProperties{}
+		for _, policy := range rg.Properties.Policies {
+			dst.Properties.Policies = append(dst.Properties.Policies, fromResourceGroupPolicyDataModel(policy))
+		}
+	}
+
 	return nil
 }
+
+func toResourceGroupPolicyDataModel(src *ResourceGroupPolicy) datamodel.ResourceGroupPolicy {
+	converted := datamodel.ResourceGroupPolicy{
+		Name:        to.String(src.Name),
+		Description: to.String(src.Description),
+		Language:    to.String(src.Language),
+		Rule:        to.String(src.Rule),
+		Message:     to.String(src.Message),
+		Mode:        to.String(src.Mode),
+	}
+
+	if src.Scope != nil {
+		converted.Scope = datamodel.ResourceGroupPolicyScope{
+			ResourceTypes: to.StringArray(src.Scope.ResourceTypes),
+			Environments:  to.StringArray(src.Scope.Environments),
+		}
+	}
+
+	return converted
+}
+
+func fromResourceGroupPolicyDataModel(src datamodel.ResourceGroupPolicy) *ResourceGroupPolicy {
+	converted := &ResourceGroupPolicy{
+		Name: new(src.Name),
+		Rule: new(src.Rule),
+	}
+
+	if src.Description != "" {
+		converted.Description = new(src.Description)
+	}
+	if src.Language != "" {
+		converted.Language = new(src.Language)
+	}
+	if src.Message != "" {
+		converted.Message = new(src.Message)
+	}
+	if src.Mode != "" {
+		converted.Mode = new(src.Mode)
+	}
+	if len(src.Scope.ResourceTypes) > 0 || len(src.Scope.Environments) > 0 {
+		converted.Scope = &ResourceGroupPolicyScope{
+			ResourceTypes: to.ArrayofStringPtrs(src.Scope.ResourceTypes),
+			Environments:  to.ArrayofStringPtrs(src.Scope.Environments),
+		}
+	}
+
+	return converted
+}
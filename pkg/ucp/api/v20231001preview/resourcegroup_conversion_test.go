@@ -51,6 +51,35 @@ func TestResourceGroupConvertVersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcegroup_policies.json",
+			expected: &datamodel.ResourceGroup{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/radius/local/resourceGroups/test-rg",
+						Name:     "test-rg",
+						Type:     resources.ResourceGroupType,
+						Location: v1.LocationGlobal,
+						Tags:     map[string]string{},
+					},
+				},
+				Properties: datamodel.ResourceGroupProperties{
+					Policies: []datamodel.ResourceGroupPolicy{
+						{
+							Name:        "backup-retention",
+							Description: "Databases must keep backups for a week.",
+							Rule:        "resource.properties.backupRetention >= 7",
+							Message:     "Databases must set backupRetention to at least 7.",
+							Mode:        "audit",
+							Scope: datamodel.ResourceGroupPolicyScope{
+								ResourceTypes: []string{"Applications.Datastores/sqlDatabases"},
+								Environments:  []string{"prod"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -89,6 +118,26 @@ func TestResourceGroupConvertDataModelToVersioned(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "/planes/radius/local/resourceGroups/test-rg", r.TrackedResource.ID)
 	require.Equal(t, "test-rg", r.TrackedResource.Name)
+	require.Nil(t, versioned.Properties)
+}
+
+func TestResourceGroupConvertDataModelToVersioned_Policies(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("resourcegroup_policies.json")
+	original := &ResourceGroupResource{}
+	err := json.Unmarshal(rawPayload, original)
+	require.NoError(t, err)
+
+	dm, err := original.ConvertTo()
+	require.NoError(t, err)
+
+	// act
+	versioned := &ResourceGroupResource{}
+	err = versioned.ConvertFrom(dm)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, original.Properties, versioned.Properties)
 }
 
 func TestResourceGroupConvertFromValidation(t *testing.T) {
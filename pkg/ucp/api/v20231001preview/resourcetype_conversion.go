@@ -18,6 +18,7 @@ package v20231001preview
 
 import (
 	"fmt"
+	"slices"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
@@ -58,6 +59,12 @@ func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 
 	dst.Properties.Description = src.Properties.Description
 
+	lifecycle, err := toLifecycleDataModel(src.Properties.Lifecycle)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Lifecycle = lifecycle
+
 	return dst, nil
 }
 
@@ -79,6 +86,7 @@ func (dst *ResourceTypeResource) ConvertFrom(src v1.DataModelInterface) error {
 		Capabilities:      to.SliceOfPtrs(dm.Properties.Capabilities...),
 		DefaultAPIVersion: dm.Properties.DefaultAPIVersion,
 		Description:       dm.Properties.Description,
+		Lifecycle:         fromLifecycleDataModel(dm.Properties.Lifecycle),
 	}
 
 	return nil
@@ -95,3 +103,40 @@ func validateCapability(input *string) error {
 
 	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s", *input, datamodel.CapabilityManualResourceProvisioning))
 }
+
+func toLifecycleDataModel(lifecycle *ResourceTypeLifecycle) (*datamodel.Lifecycle, error) {
+	if lifecycle == nil {
+		return nil, nil
+	}
+
+	state := to.String(lifecycle.State)
+	if !slices.Contains(datamodel.LifecycleStates, state) {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.lifecycle.state", ValidValue: fmt.Sprintf("one of %q", datamodel.LifecycleStates)}
+	}
+
+	if lifecycle.SunsetDate != nil && state != datamodel.LifecycleStateDeprecated && state != datamodel.LifecycleStateRetired {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("$.properties.lifecycle.sunsetDate can only be set when the state is %q or %q", datamodel.LifecycleStateDeprecated, datamodel.LifecycleStateRetired))
+	}
+
+	return &datamodel.Lifecycle{
+		State:      state,
+		SunsetDate: lifecycle.SunsetDate,
+		Message:    to.String(lifecycle.Message),
+	}, nil
+}
+
+func fromLifecycleDataModel(lifecycle *datamodel.Lifecycle) *ResourceTypeLifecycle {
+	if lifecycle == nil {
+		return nil
+	}
+
+	result := &ResourceTypeLifecycle{
+		State:      new(lifecycle.State),
+		SunsetDate: lifecycle.SunsetDate,
+	}
+	if lifecycle.Message != "" {
+		result.Message = new(lifecycle.Message)
+	}
+
+	return result
+}
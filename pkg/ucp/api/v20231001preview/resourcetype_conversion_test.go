@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -53,6 +54,34 @@ func Test_ResourceType_VersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcetype_resource_lifecycle.json",
+			expected: &datamodel.ResourceType{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources",
+						Name: "testResources",
+						Type: datamodel.ResourceTypeResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.ResourceTypeProperties{
+					Capabilities:      []string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Lifecycle: &datamodel.Lifecycle{
+						State:      datamodel.LifecycleStateDeprecated,
+						SunsetDate: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Message:    "Use Applications.Test/newTestResources instead.",
+					},
+				},
+			},
+		},
+		{
+			filename: "resourcetype_resource_lifecycle_invalidstate.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.lifecycle.state", ValidValue: "one of [\"preview\" \"ga\" \"deprecated\" \"retired\"]"},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -93,6 +122,24 @@ func Test_ResourceType_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "resourcetype_datamodel_lifecycle.json",
+			expected: &ResourceTypeResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"),
+				Type: to.Ptr(datamodel.ResourceTypeResourceType),
+				Name: new("testResources"),
+				Properties: &ResourceTypeProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Capabilities:      []*string{},
+					DefaultAPIVersion: new("2025-01-01"),
+					Lifecycle: &ResourceTypeLifecycle{
+						State:      new(datamodel.LifecycleStateDeprecated),
+						SunsetDate: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Message:    new("Use Applications.Test/newTestResources instead."),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -150,3 +197,51 @@ func Test_validateCapability(t *testing.T) {
 		})
 	}
 }
+
+func Test_toLifecycleDataModel(t *testing.T) {
+	sunsetDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       *ResourceTypeLifecycle
+		expected    *datamodel.Lifecycle
+		expectedErr string
+	}{
+		{
+			name: "nil lifecycle",
+		},
+		{
+			name:     "ga",
+			input:    &ResourceTypeLifecycle{State: new("ga")},
+			expected: &datamodel.Lifecycle{State: datamodel.LifecycleStateGA},
+		},
+		{
+			name:     "deprecated with sunset date",
+			input:    &ResourceTypeLifecycle{State: new("deprecated"), SunsetDate: &sunsetDate, Message: new("Use 2025-06-01.")},
+			expected: &datamodel.Lifecycle{State: datamodel.LifecycleStateDeprecated, SunsetDate: &sunsetDate, Message: "Use 2025-06-01."},
+		},
+		{
+			name:        "missing state",
+			input:       &ResourceTypeLifecycle{},
+			expectedErr: "$.properties.lifecycle.state must be one of",
+		},
+		{
+			name:        "sunset date on preview",
+			input:       &ResourceTypeLifecycle{State: new("preview"), SunsetDate: &sunsetDate},
+			expectedErr: "$.properties.lifecycle.sunsetDate can only be set when the state is \"deprecated\" or \"retired\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifecycle, err := toLifecycleDataModel(tt.input)
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, lifecycle)
+		})
+	}
+}
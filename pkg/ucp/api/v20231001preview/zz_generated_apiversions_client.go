@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -176,7 +177,7 @@ func (client *APIVersionsClient) deleteOperation(ctx context.Context, planeName
 }
 
 // deleteCreateRequest creates the Delete request.
-func (client *APIVersionsClient) deleteCreateRequest(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, _ *APIVersionsClientBeginDeleteOptions) (*policy.Request, error) {
+func (client *APIVersionsClient) deleteCreateRequest(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, options *APIVersionsClientBeginDeleteOptions) (*policy.Request, error) {
 	urlPath := "/planes/radius/{planeName}/providers/System.Resources/resourceproviders/{resourceProviderName}/resourcetypes/{resourceTypeName}/apiversions/{apiVersionName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
@@ -200,6 +201,9 @@ func (client *APIVersionsClient) deleteCreateRequest(ctx context.Context, planeN
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.Force != nil {
+		reqQP.Set("force", strconv.FormatBool(*options.Force))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -6,8 +6,72 @@ package v20231001preview
 
 import "time"
 
+// APIVersionAction - An action that can be invoked on resources of a resource type.
+type APIVersionAction struct {
+	// REQUIRED; The handler that implements the action.
+	Handler *APIVersionActionHandler
+
+	// Description of the action.
+	Description *string
+
+	// Schema of the action input.
+	Input map[string]any
+
+	// Schema of the action output.
+	Output map[string]any
+}
+
+// APIVersionActionHandler - The handler that implements an action.
+type APIVersionActionHandler struct {
+	// REQUIRED; The kind of handler.
+	Kind *string
+
+	// The name of the recipe to run. Required for the 'recipe' handler kind.
+	RecipeName *string
+
+	// The URL of the webhook to call. Required for the 'webhook' handler kind.
+	URL *string
+}
+
+// APIVersionConversion - A conversion from another API version of the resource type to this API version.
+type APIVersionConversion struct {
+	// REQUIRED; The API version that resources are converted from.
+	From *string
+
+	// The rules applied in order to convert resources from the 'from' API version to this API version. The rules are inverted
+	// and applied in reverse order to convert resources back.
+	Rules []*APIVersionConversionRule
+}
+
+// APIVersionConversionRule - A rule that converts the properties of a resource between API versions.
+type APIVersionConversionRule struct {
+	// REQUIRED; The kind of rule.
+	Kind *string
+
+	// The property path to rename or move. Required for the 'rename' and 'move' rule kinds.
+	From *string
+
+	// The property path to set when it is missing. Required for the 'default' rule kind.
+	Path *string
+
+	// The new property path. Required for the 'rename' and 'move' rule kinds.
+	To *string
+
+	// The default value. Used by the 'default' rule kind.
+	Value any
+}
+
 // APIVersionProperties - The properties of an API version.
 type APIVersionProperties struct {
+	// Actions that can be invoked on resources of this type with a POST request, keyed by action name.
+	Actions map[string]*APIVersionAction
+
+	// Conversions from other API versions of the resource type to this API version.
+	Conversions []*APIVersionConversion
+
+	// The lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle
+
 	// Schema is the schema for the resource type.
 	Schema map[string]any
 
@@ -631,8 +695,47 @@ type Resource struct {
 	Type *string
 }
 
+// ResourceGroupPolicy - An organization policy evaluated against the resources of a resource group when they are created
+// or updated.
+type ResourceGroupPolicy struct {
+	// REQUIRED; The name of the policy.
+	Name *string
+
+	// REQUIRED; The rule that evaluates to true when the resource satisfies the policy. It can refer to 'resource', 'oldResource'
+	// and 'operation'.
+	Rule *string
+
+	// The description of the policy.
+	Description *string
+
+	// The language of the rule. Only 'cel' is supported. Defaults to 'cel'.
+	Language *string
+
+	// The message reported when the resource violates the policy.
+	Message *string
+
+	// The mode of the policy, 'enforce' or 'audit'. Defaults to 'enforce'.
+	Mode *string
+
+	// Selects the resources of the resource group the policy applies to.
+	Scope *ResourceGroupPolicyScope
+}
+
+// ResourceGroupPolicyScope - Selects the resources a policy applies to. A resource is selected when it matches every non-empty
+// list.
+type ResourceGroupPolicyScope struct {
+	// The environments, by name or resource ID, the policy applies to.
+	Environments []*string
+
+	// The fully-qualified resource types the policy applies to.
+	ResourceTypes []*string
+}
+
 // ResourceGroupProperties - The resource group resource properties
 type ResourceGroupProperties struct {
+	// The organization policies evaluated against the resources of the resource group when they are created or updated.
+	Policies []*ResourceGroupPolicy
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -741,6 +844,21 @@ type ResourceProviderSummaryResourceType struct {
 
 	// Description of the resource type.
 	Description *string
+
+	// The lifecycle of the resource type.
+	Lifecycle *ResourceTypeLifecycle
+}
+
+// ResourceTypeLifecycle - The lifecycle of a resource type or API version.
+type ResourceTypeLifecycle struct {
+	// REQUIRED; The lifecycle state.
+	State *string
+
+	// A message shown to users of a deprecated resource type or API version, such as the API version to migrate to.
+	Message *string
+
+	// The date when a deprecated resource type or API version is retired.
+	SunsetDate *time.Time
 }
 
 // ResourceTypeProperties - The properties of a resource type.
@@ -754,6 +872,9 @@ type ResourceTypeProperties struct {
 	// Description of the resource type.
 	Description *string
 
+	// The lifecycle of the resource type.
+	Lifecycle *ResourceTypeLifecycle
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -787,6 +908,9 @@ type ResourceTypeResourceListResult struct {
 
 // ResourceTypeSummaryResultAPIVersion - The configuration of a resource type API version.
 type ResourceTypeSummaryResultAPIVersion struct {
+	// The lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle
+
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any
 }
@@ -11,9 +11,160 @@ import (
 	"reflect"
 )
 
+// MarshalJSON implements the json.Marshaller interface for type APIVersionAction.
+func (a APIVersionAction) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "description", a.Description)
+	populate(objectMap, "handler", a.Handler)
+	populate(objectMap, "input", a.Input)
+	populate(objectMap, "output", a.Output)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type APIVersionAction.
+func (a *APIVersionAction) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "description":
+			err = unpopulate(val, "Description", &a.Description)
+			delete(rawMsg, key)
+		case "handler":
+			err = unpopulate(val, "Handler", &a.Handler)
+			delete(rawMsg, key)
+		case "input":
+			err = unpopulate(val, "Input", &a.Input)
+			delete(rawMsg, key)
+		case "output":
+			err = unpopulate(val, "Output", &a.Output)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type APIVersionActionHandler.
+func (a APIVersionActionHandler) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "kind", a.Kind)
+	populate(objectMap, "recipeName", a.RecipeName)
+	populate(objectMap, "url", a.URL)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type APIVersionActionHandler.
+func (a *APIVersionActionHandler) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "kind":
+			err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "recipeName":
+			err = unpopulate(val, "RecipeName", &a.RecipeName)
+			delete(rawMsg, key)
+		case "url":
+			err = unpopulate(val, "URL", &a.URL)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type APIVersionConversion.
+func (a APIVersionConversion) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "from", a.From)
+	populate(objectMap, "rules", a.Rules)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type APIVersionConversion.
+func (a *APIVersionConversion) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "from":
+			err = unpopulate(val, "From", &a.From)
+			delete(rawMsg, key)
+		case "rules":
+			err = unpopulate(val, "Rules", &a.Rules)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type APIVersionConversionRule.
+func (a APIVersionConversionRule) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "from", a.From)
+	populate(objectMap, "kind", a.Kind)
+	populate(objectMap, "path", a.Path)
+	populate(objectMap, "to", a.To)
+	populateAny(objectMap, "value", a.Value)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type APIVersionConversionRule.
+func (a *APIVersionConversionRule) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", a, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "from":
+			err = unpopulate(val, "From", &a.From)
+			delete(rawMsg, key)
+		case "kind":
+			err = unpopulate(val, "Kind", &a.Kind)
+			delete(rawMsg, key)
+		case "path":
+			err = unpopulate(val, "Path", &a.Path)
+			delete(rawMsg, key)
+		case "to":
+			err = unpopulate(val, "To", &a.To)
+			delete(rawMsg, key)
+		case "value":
+			err = unpopulate(val, "Value", &a.Value)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", a, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type APIVersionProperties.
 func (a APIVersionProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "actions", a.Actions)
+	populate(objectMap, "conversions", a.Conversions)
+	populate(objectMap, "lifecycle", a.Lifecycle)
 	populate(objectMap, "provisioningState", a.ProvisioningState)
 	populate(objectMap, "schema", a.Schema)
 	return json.Marshal(objectMap)
@@ -28,6 +179,15 @@ func (a *APIVersionProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "actions":
+			err = unpopulate(val, "Actions", &a.Actions)
+			delete(rawMsg, key)
+		case "conversions":
+			err = unpopulate(val, "Conversions", &a.Conversions)
+			delete(rawMsg, key)
+		case "lifecycle":
+			err = unpopulate(val, "Lifecycle", &a.Lifecycle)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &a.ProvisioningState)
 			delete(rawMsg, key)
@@ -1641,9 +1801,92 @@ func (r *Resource) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type ResourceGroupPolicy.
+func (r ResourceGroupPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "description", r.Description)
+	populate(objectMap, "language", r.Language)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "mode", r.Mode)
+	populate(objectMap, "name", r.Name)
+	populate(objectMap, "rule", r.Rule)
+	populate(objectMap, "scope", r.Scope)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceGroupPolicy.
+func (r *ResourceGroupPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "description":
+			err = unpopulate(val, "Description", &r.Description)
+			delete(rawMsg, key)
+		case "language":
+			err = unpopulate(val, "Language", &r.Language)
+			delete(rawMsg, key)
+		case "message":
+			err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "mode":
+			err = unpopulate(val, "Mode", &r.Mode)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &r.Name)
+			delete(rawMsg, key)
+		case "rule":
+			err = unpopulate(val, "Rule", &r.Rule)
+			delete(rawMsg, key)
+		case "scope":
+			err = unpopulate(val, "Scope", &r.Scope)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ResourceGroupPolicyScope.
+func (r ResourceGroupPolicyScope) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "environments", r.Environments)
+	populate(objectMap, "resourceTypes", r.ResourceTypes)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceGroupPolicyScope.
+func (r *ResourceGroupPolicyScope) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "environments":
+			err = unpopulate(val, "Environments", &r.Environments)
+			delete(rawMsg, key)
+		case "resourceTypes":
+			err = unpopulate(val, "ResourceTypes", &r.ResourceTypes)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ResourceGroupProperties.
 func (r ResourceGroupProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "policies", r.Policies)
 	populate(objectMap, "provisioningState", r.ProvisioningState)
 	return json.Marshal(objectMap)
 }
@@ -1657,6 +1900,9 @@ func (r *ResourceGroupProperties) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "policies":
+			err = unpopulate(val, "Policies", &r.Policies)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &r.ProvisioningState)
 			delete(rawMsg, key)
@@ -1928,6 +2174,7 @@ func (r ResourceProviderSummaryResourceType) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "capabilities", r.Capabilities)
 	populate(objectMap, "defaultApiVersion", r.DefaultAPIVersion)
 	populate(objectMap, "description", r.Description)
+	populate(objectMap, "lifecycle", r.Lifecycle)
 	return json.Marshal(objectMap)
 }
 
@@ -1952,6 +2199,44 @@ func (r *ResourceProviderSummaryResourceType) UnmarshalJSON(data []byte) error {
 		case "description":
 			err = unpopulate(val, "Description", &r.Description)
 			delete(rawMsg, key)
+		case "lifecycle":
+			err = unpopulate(val, "Lifecycle", &r.Lifecycle)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", r, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type ResourceTypeLifecycle.
+func (r ResourceTypeLifecycle) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "message", r.Message)
+	populate(objectMap, "state", r.State)
+	populateDateTimeRFC3339(objectMap, "sunsetDate", r.SunsetDate)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type ResourceTypeLifecycle.
+func (r *ResourceTypeLifecycle) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", r, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "message":
+			err = unpopulate(val, "Message", &r.Message)
+			delete(rawMsg, key)
+		case "state":
+			err = unpopulate(val, "State", &r.State)
+			delete(rawMsg, key)
+		case "sunsetDate":
+			err = unpopulateDateTimeRFC3339(val, "SunsetDate", &r.SunsetDate)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", r, err)
@@ -1966,6 +2251,7 @@ func (r ResourceTypeProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "capabilities", r.Capabilities)
 	populate(objectMap, "defaultApiVersion", r.DefaultAPIVersion)
 	populate(objectMap, "description", r.Description)
+	populate(objectMap, "lifecycle", r.Lifecycle)
 	populate(objectMap, "provisioningState", r.ProvisioningState)
 	return json.Marshal(objectMap)
 }
@@ -1988,6 +2274,9 @@ func (r *ResourceTypeProperties) UnmarshalJSON(data []byte) error {
 		case "description":
 			err = unpopulate(val, "Description", &r.Description)
 			delete(rawMsg, key)
+		case "lifecycle":
+			err = unpopulate(val, "Lifecycle", &r.Lifecycle)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &r.ProvisioningState)
 			delete(rawMsg, key)
@@ -2076,6 +2365,7 @@ func (r *ResourceTypeResourceListResult) UnmarshalJSON(data []byte) error {
 // MarshalJSON implements the json.Marshaller interface for type ResourceTypeSummaryResultAPIVersion.
 func (r ResourceTypeSummaryResultAPIVersion) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
+	populate(objectMap, "lifecycle", r.Lifecycle)
 	populate(objectMap, "schema", r.Schema)
 	return json.Marshal(objectMap)
 }
@@ -2089,6 +2379,9 @@ func (r *ResourceTypeSummaryResultAPIVersion) UnmarshalJSON(data []byte) error {
 	for key, val := range rawMsg {
 		var err error
 		switch key {
+		case "lifecycle":
+			err = unpopulate(val, "Lifecycle", &r.Lifecycle)
+			delete(rawMsg, key)
 		case "schema":
 			err = unpopulate(val, "Schema", &r.Schema)
 			delete(rawMsg, key)
@@ -2204,6 +2497,16 @@ func populate(m map[string]any, k string, v any) {
 	}
 }
 
+func populateAny(m map[string]any, k string, v any) {
+	if v == nil {
+		return
+	} else if azcore.IsNullValue(v) {
+		m[k] = nil
+	} else {
+		m[k] = v
+	}
+}
+
 func unpopulate(data json.RawMessage, fn string, v any) error {
 	if data == nil || string(data) == "null" {
 		return nil
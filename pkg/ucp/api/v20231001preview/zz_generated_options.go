@@ -13,6 +13,9 @@ type APIVersionsClientBeginCreateOrUpdateOptions struct {
 
 // APIVersionsClientBeginDeleteOptions contains the optional parameters for the APIVersionsClient.BeginDelete method.
 type APIVersionsClientBeginDeleteOptions struct {
+	// Delete the API version even if resources still use it.
+	Force *bool
+
 	// Resumes the long-running operation from the provided token.
 	ResumeToken string
 }
@@ -265,6 +268,9 @@ type ResourceTypesClientBeginCreateOrUpdateOptions struct {
 
 // ResourceTypesClientBeginDeleteOptions contains the optional parameters for the ResourceTypesClient.BeginDelete method.
 type ResourceTypesClientBeginDeleteOptions struct {
+	// Delete the resource type even if resources still use it.
+	Force *bool
+
 	// Resumes the long-running operation from the provided token.
 	ResumeToken string
 }
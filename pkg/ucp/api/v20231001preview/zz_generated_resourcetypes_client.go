@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -171,7 +172,7 @@ func (client *ResourceTypesClient) deleteOperation(ctx context.Context, planeNam
 }
 
 // deleteCreateRequest creates the Delete request.
-func (client *ResourceTypesClient) deleteCreateRequest(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, _ *ResourceTypesClientBeginDeleteOptions) (*policy.Request, error) {
+func (client *ResourceTypesClient) deleteCreateRequest(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *ResourceTypesClientBeginDeleteOptions) (*policy.Request, error) {
 	urlPath := "/planes/radius/{planeName}/providers/System.Resources/resourceproviders/{resourceProviderName}/resourcetypes/{resourceTypeName}"
 	if planeName == "" {
 		return nil, errors.New("parameter planeName cannot be empty")
@@ -191,6 +192,9 @@ func (client *ResourceTypesClient) deleteCreateRequest(ctx context.Context, plan
 	}
 	reqQP := req.Raw().URL.Query()
 	reqQP.Set("api-version", "2023-10-01-preview")
+	if options != nil && options.Force != nil {
+		reqQP.Set("force", strconv.FormatBool(*options.Force))
+	}
 	req.Raw().URL.RawQuery = reqQP.Encode()
 	req.Raw().Header["Accept"] = []string{"application/json"}
 	return req, nil
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/radius-project/radius/pkg/components/trace"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const (
+	// TypeNameAttrKey is the attribute key for the AWS resource type name.
+	TypeNameAttrKey = attribute.Key("aws.type_name")
+	// IdentifierAttrKey is the attribute key for the AWS resource identifier.
+	IdentifierAttrKey = attribute.Key("aws.identifier")
+	// RequestTokenAttrKey is the attribute key for the AWS Cloud Control request token.
+	RequestTokenAttrKey = attribute.Key("aws.request_token")
+)
+
+var _ AWSCloudControlClient = (*tracingCloudControlClient)(nil)
+var _ AWSCloudFormationClient = (*tracingCloudFormationClient)(nil)
+
+// NewTracingCloudControlClient wraps the given client so that every call to AWS Cloud Control creates a client span.
+func NewTracingCloudControlClient(inner AWSCloudControlClient) AWSCloudControlClient {
+	return &tracingCloudControlClient{inner: inner}
+}
+
+// NewTracingCloudFormationClient wraps the given client so that every call to AWS CloudFormation creates a client span.
+func NewTracingCloudFormationClient(inner AWSCloudFormationClient) AWSCloudFormationClient {
+	return &tracingCloudFormationClient{inner: inner}
+}
+
+type tracingCloudControlClient struct {
+	inner AWSCloudControlClient
+}
+
+type tracingCloudFormationClient struct {
+	inner AWSCloudFormationClient
+}
+
+// traceCall runs call inside a client span named after the AWS service and operation.
+func traceCall[T any](ctx context.Context, service string, operation string, attrs []attribute.KeyValue, call func(ctx context.Context) (T, error)) (T, error) {
+	attrs = append(attrs, semconv.RPCSystemKey.String("aws-api"), semconv.RPCService(service), semconv.RPCMethod(operation))
+	ctx, span := trace.StartClientSpan(ctx, "aws."+service+"."+operation, trace.ProxyTracerName, attrs...)
+	result, err := call(ctx)
+	trace.EndSpan(span, err)
+	return result, err
+}
+
+// resourceAttributes returns the span attributes for the given type name and identifier, skipping empty values.
+func resourceAttributes(typeName *string, identifier *string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{}
+	if typeName != nil {
+		attrs = append(attrs, TypeNameAttrKey.String(aws.ToString(typeName)))
+	}
+	if identifier != nil {
+		attrs = append(attrs, IdentifierAttrKey.String(aws.ToString(identifier)))
+	}
+	return attrs
+}
+
+func requestAttributes(requestToken *string) []attribute.KeyValue {
+	if requestToken == nil {
+		return nil
+	}
+	return []attribute.KeyValue{RequestTokenAttrKey.String(aws.ToString(requestToken))}
+}
+
+// GetResource implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) GetResource(ctx context.Context, params *cloudcontrol.GetResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "GetResource", resourceAttributes(params.TypeName, params.Identifier), func(ctx context.Context) (*cloudcontrol.GetResourceOutput, error) {
+		return c.inner.GetResource(ctx, params, optFns...)
+	})
+}
+
+// ListResources implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) ListResources(ctx context.Context, params *cloudcontrol.ListResourcesInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourcesOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "ListResources", resourceAttributes(params.TypeName, nil), func(ctx context.Context) (*cloudcontrol.ListResourcesOutput, error) {
+		return c.inner.ListResources(ctx, params, optFns...)
+	})
+}
+
+// DeleteResource implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) DeleteResource(ctx context.Context, params *cloudcontrol.DeleteResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.DeleteResourceOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "DeleteResource", resourceAttributes(params.TypeName, params.Identifier), func(ctx context.Context) (*cloudcontrol.DeleteResourceOutput, error) {
+		return c.inner.DeleteResource(ctx, params, optFns...)
+	})
+}
+
+// UpdateResource implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) UpdateResource(ctx context.Context, params *cloudcontrol.UpdateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.UpdateResourceOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "UpdateResource", resourceAttributes(params.TypeName, params.Identifier), func(ctx context.Context) (*cloudcontrol.UpdateResourceOutput, error) {
+		return c.inner.UpdateResource(ctx, params, optFns...)
+	})
+}
+
+// CreateResource implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) CreateResource(ctx context.Context, params *cloudcontrol.CreateResourceInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CreateResourceOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "CreateResource", resourceAttributes(params.TypeName, nil), func(ctx context.Context) (*cloudcontrol.CreateResourceOutput, error) {
+		return c.inner.CreateResource(ctx, params, optFns...)
+	})
+}
+
+// GetResourceRequestStatus implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) GetResourceRequestStatus(ctx context.Context, params *cloudcontrol.GetResourceRequestStatusInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "GetResourceRequestStatus", requestAttributes(params.RequestToken), func(ctx context.Context) (*cloudcontrol.GetResourceRequestStatusOutput, error) {
+		return c.inner.GetResourceRequestStatus(ctx, params, optFns...)
+	})
+}
+
+// CancelResourceRequest implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) CancelResourceRequest(ctx context.Context, params *cloudcontrol.CancelResourceRequestInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.CancelResourceRequestOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "CancelResourceRequest", requestAttributes(params.RequestToken), func(ctx context.Context) (*cloudcontrol.CancelResourceRequestOutput, error) {
+		return c.inner.CancelResourceRequest(ctx, params, optFns...)
+	})
+}
+
+// ListResourceRequests implements AWSCloudControlClient.
+func (c *tracingCloudControlClient) ListResourceRequests(ctx context.Context, params *cloudcontrol.ListResourceRequestsInput, optFns ...func(*cloudcontrol.Options)) (*cloudcontrol.ListResourceRequestsOutput, error) {
+	return traceCall(ctx, "cloudcontrol", "ListResourceRequests", nil, func(ctx context.Context) (*cloudcontrol.ListResourceRequestsOutput, error) {
+		return c.inner.ListResourceRequests(ctx, params, optFns...)
+	})
+}
+
+// DescribeType implements AWSCloudFormationClient.
+func (c *tracingCloudFormationClient) DescribeType(ctx context.Context, params *cloudformation.DescribeTypeInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeTypeOutput, error) {
+	return traceCall(ctx, "cloudformation", "DescribeType", resourceAttributes(params.TypeName, nil), func(ctx context.Context) (*cloudformation.DescribeTypeOutput, error) {
+		return c.inner.DescribeType(ctx, params, optFns...)
+	})
+}
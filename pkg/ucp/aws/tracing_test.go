@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_TracingCloudControlClient(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctrl := gomock.NewController(t)
+	inner := NewMockAWSCloudControlClient(ctrl)
+	client := NewTracingCloudControlClient(inner)
+
+	t.Run("success", func(t *testing.T) {
+		inner.EXPECT().
+			GetResource(gomock.Any(), gomock.Any()).
+			Return(&cloudcontrol.GetResourceOutput{}, nil)
+
+		_, err := client.GetResource(context.Background(), &cloudcontrol.GetResourceInput{
+			TypeName:   aws.String("AWS::S3::Bucket"),
+			Identifier: aws.String("my-bucket"),
+		})
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.NotEmpty(t, spans)
+		span := spans[len(spans)-1]
+		require.Equal(t, "aws.cloudcontrol.GetResource", span.Name())
+		require.Equal(t, trace.SpanKindClient, span.SpanKind())
+		require.Equal(t, codes.Ok, span.Status().Code)
+		require.Contains(t, span.Attributes(), TypeNameAttrKey.String("AWS::S3::Bucket"))
+		require.Contains(t, span.Attributes(), IdentifierAttrKey.String("my-bucket"))
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		inner.EXPECT().
+			DeleteResource(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("failed"))
+
+		_, err := client.DeleteResource(context.Background(), &cloudcontrol.DeleteResourceInput{
+			TypeName:   aws.String("AWS::S3::Bucket"),
+			Identifier: aws.String("my-bucket"),
+		})
+		require.Error(t, err)
+
+		spans := recorder.Ended()
+		require.NotEmpty(t, spans)
+		span := spans[len(spans)-1]
+		require.Equal(t, "aws.cloudcontrol.DeleteResource", span.Name())
+		require.Equal(t, codes.Error, span.Status().Code)
+		require.Equal(t, "failed", span.Status().Description)
+	})
+}
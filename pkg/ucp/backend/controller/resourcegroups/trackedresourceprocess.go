@@ -73,7 +73,7 @@ func (c *TrackedResourceProcessController) Run(ctx context.Context, request *ctr
 		return ctrl.Result{}, err
 	}
 
-	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, c.DatabaseClient(), originalID, v1.LocationGlobal, resource.Properties.APIVersion)
+	downstreamURL, _, err := resourcegroups.ValidateDownstream(ctx, c.DatabaseClient(), originalID, v1.LocationGlobal, resource.Properties.APIVersion)
 	if errors.Is(err, &resourcegroups.NotFoundError{}) {
 		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeNotFound, Message: err.Error(), Target: request.ResourceID}), nil
 	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
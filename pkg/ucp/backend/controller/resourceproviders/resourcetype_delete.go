@@ -149,7 +149,8 @@ func (c *ResourceTypeDeleteController) deleteApiVersion(ctx context.Context, api
 		id.TypeSegments()[0].Name,
 		id.TypeSegments()[1].Name,
 		id.Name(),
-		nil)
+		// Resources of the resource type were checked before the resource type delete was accepted.
+		&v20231001preview.APIVersionsClientBeginDeleteOptions{Force: new(true)})
 	if err != nil {
 		return fmt.Errorf("failed to delete API version %s: %w", id.String(), err)
 	}
@@ -40,4 +40,96 @@ func (r *APIVersion) ResourceTypeName() string {
 type APIVersionProperties struct {
 	// Schema is the schema for the resource type.
 	Schema map[string]any
+
+	// Actions are the actions that can be invoked on resources of the resource type, keyed by action name.
+	Actions map[string]APIVersionAction `json:"actions,omitempty"`
+
+	// Conversions are the conversions from other API versions of the resource type to this API version.
+	Conversions []APIVersionConversion `json:"conversions,omitempty"`
+
+	// Lifecycle is the lifecycle of the API version. A nil lifecycle is generally available.
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
+}
+
+const (
+	// ActionHandlerKindSensitiveFields is the handler kind for actions that return the sensitive fields of a resource decrypted.
+	ActionHandlerKindSensitiveFields = "sensitiveFields"
+
+	// ActionHandlerKindRecipe is the handler kind for actions that run a recipe and return its output values.
+	ActionHandlerKindRecipe = "recipe"
+
+	// ActionHandlerKindWebhook is the handler kind for actions that call a webhook and return its response.
+	ActionHandlerKindWebhook = "webhook"
+)
+
+// ActionHandlerKinds is the list of supported action handler kinds.
+var ActionHandlerKinds = []string{ActionHandlerKindSensitiveFields, ActionHandlerKindRecipe, ActionHandlerKindWebhook}
+
+// APIVersionAction represents an action that can be invoked on resources of a resource type.
+type APIVersionAction struct {
+	// Description of the action.
+	Description string `json:"description,omitempty"`
+
+	// Input is the schema of the action input. The input is optional when nil.
+	Input map[string]any `json:"input,omitempty"`
+
+	// Output is the schema of the action output.
+	Output map[string]any `json:"output,omitempty"`
+
+	// Handler is the handler that implements the action.
+	Handler APIVersionActionHandler `json:"handler"`
+}
+
+// APIVersionActionHandler represents the handler that implements an action.
+type APIVersionActionHandler struct {
+	// Kind is the kind of handler. See ActionHandlerKinds for the supported values.
+	Kind string `json:"kind"`
+
+	// RecipeName is the name of the recipe to run for the recipe handler kind.
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// URL is the URL of the webhook to call for the webhook handler kind.
+	URL string `json:"url,omitempty"`
+}
+
+const (
+	// ConversionRuleKindRename is the rule kind for a property that was renamed within the same object.
+	ConversionRuleKindRename = "rename"
+
+	// ConversionRuleKindMove is the rule kind for a property that was moved to a different path.
+	ConversionRuleKindMove = "move"
+
+	// ConversionRuleKindDefault is the rule kind for a property that was added with a default value.
+	ConversionRuleKindDefault = "default"
+)
+
+// ConversionRuleKinds is the list of supported conversion rule kinds.
+var ConversionRuleKinds = []string{ConversionRuleKindRename, ConversionRuleKindMove, ConversionRuleKindDefault}
+
+// APIVersionConversion represents a conversion from another API version of a resource type to this API version.
+type APIVersionConversion struct {
+	// From is the API version that resources are converted from.
+	From string `json:"from"`
+
+	// Rules are applied in order to convert resources from the From API version. They are inverted and applied
+	// in reverse order to convert resources back to the From API version.
+	Rules []APIVersionConversionRule `json:"rules,omitempty"`
+}
+
+// APIVersionConversionRule represents a single rule of an API version conversion.
+type APIVersionConversionRule struct {
+	// Kind is the kind of rule. See ConversionRuleKinds for the supported values.
+	Kind string `json:"kind"`
+
+	// From is the property path to rename or move.
+	From string `json:"from,omitempty"`
+
+	// To is the new property path of a renamed or moved property.
+	To string `json:"to,omitempty"`
+
+	// Path is the property path that is set to Value when it is missing.
+	Path string `json:"path,omitempty"`
+
+	// Value is the default value of the property at Path.
+	Value any `json:"value,omitempty"`
 }
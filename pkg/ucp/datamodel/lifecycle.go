@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import "time"
+
+const (
+	// LifecycleStatePreview is the lifecycle state of a resource type or API version that is available for early use
+	// and may change.
+	LifecycleStatePreview = "preview"
+
+	// LifecycleStateGA is the lifecycle state of a resource type or API version that is generally available.
+	LifecycleStateGA = "ga"
+
+	// LifecycleStateDeprecated is the lifecycle state of a resource type or API version that is still served, but
+	// will be retired. Requests return a warning.
+	LifecycleStateDeprecated = "deprecated"
+
+	// LifecycleStateRetired is the lifecycle state of a resource type or API version that is no longer served.
+	// Only requests to delete resources are accepted.
+	LifecycleStateRetired = "retired"
+)
+
+// LifecycleStates is the list of supported lifecycle states.
+var LifecycleStates = []string{LifecycleStatePreview, LifecycleStateGA, LifecycleStateDeprecated, LifecycleStateRetired}
+
+// Lifecycle represents the lifecycle of a resource type or API version.
+type Lifecycle struct {
+	// State is the lifecycle state. See LifecycleStates for the supported values.
+	State string `json:"state"`
+
+	// SunsetDate is the date when a deprecated resource type or API version is retired.
+	SunsetDate *time.Time `json:"sunsetDate,omitempty"`
+
+	// Message is shown to users of a deprecated resource type or API version, such as the API version to migrate to.
+	Message string `json:"message,omitempty"`
+}
+
+// EffectiveState returns the lifecycle state at the given time. A deprecated resource type or API version is retired
+// once its sunset date has passed. A nil lifecycle is generally available.
+func (l *Lifecycle) EffectiveState(now time.Time) string {
+	if l == nil || l.State == "" {
+		return LifecycleStateGA
+	}
+
+	if l.State == LifecycleStateDeprecated && l.SunsetDate != nil && !now.Before(*l.SunsetDate) {
+		return LifecycleStateRetired
+	}
+
+	return l.State
+}
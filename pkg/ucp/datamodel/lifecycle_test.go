@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lifecycle_EffectiveState(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-24 * time.Hour)
+	future := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		lifecycle *Lifecycle
+		expected  string
+	}{
+		{
+			name:     "nil lifecycle",
+			expected: LifecycleStateGA,
+		},
+		{
+			name:      "preview",
+			lifecycle: &Lifecycle{State: LifecycleStatePreview},
+			expected:  LifecycleStatePreview,
+		},
+		{
+			name:      "deprecated without sunset date",
+			lifecycle: &Lifecycle{State: LifecycleStateDeprecated},
+			expected:  LifecycleStateDeprecated,
+		},
+		{
+			name:      "deprecated before sunset date",
+			lifecycle: &Lifecycle{State: LifecycleStateDeprecated, SunsetDate: &future},
+			expected:  LifecycleStateDeprecated,
+		},
+		{
+			name:      "deprecated after sunset date",
+			lifecycle: &Lifecycle{State: LifecycleStateDeprecated, SunsetDate: &past},
+			expected:  LifecycleStateRetired,
+		},
+		{
+			name:      "retired",
+			lifecycle: &Lifecycle{State: LifecycleStateRetired},
+			expected:  LifecycleStateRetired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.lifecycle.EffectiveState(now))
+		})
+	}
+}
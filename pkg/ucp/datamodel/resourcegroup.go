@@ -26,6 +26,49 @@ const (
 // ResourceGroup represents UCP ResourceGroup.
 type ResourceGroup struct {
 	v1.BaseResource
+
+	// Properties is the properties of the resource group.
+	Properties ResourceGroupProperties `json:"properties"`
+}
+
+// ResourceGroupProperties represents the properties of a UCP ResourceGroup.
+type ResourceGroupProperties struct {
+	// Policies are the organization policies evaluated against the resources of the resource group when they are
+	// created or updated.
+	Policies []ResourceGroupPolicy `json:"policies,omitempty"`
+}
+
+// ResourceGroupPolicy is an organization policy evaluated against the resources of a resource group.
+type ResourceGroupPolicy struct {
+	// Name is the name of the policy.
+	Name string `json:"name"`
+
+	// Description is the description of the policy.
+	Description string `json:"description,omitempty"`
+
+	// Language is the language of the rule.
+	Language string `json:"language,omitempty"`
+
+	// Rule evaluates to true when the resource satisfies the policy.
+	Rule string `json:"rule"`
+
+	// Message is reported when the resource violates the policy.
+	Message string `json:"message,omitempty"`
+
+	// Mode is the mode of the policy, 'enforce' or 'audit'.
+	Mode string `json:"mode,omitempty"`
+
+	// Scope selects the resources of the resource group the policy applies to.
+	Scope ResourceGroupPolicyScope `json:"scope,omitempty"`
+}
+
+// ResourceGroupPolicyScope selects the resources of a resource group a policy applies to.
+type ResourceGroupPolicyScope struct {
+	// ResourceTypes is a list of fully-qualified resource types.
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+
+	// Environments is a list of environments, by name or resource ID.
+	Environments []string `json:"environments,omitempty"`
 }
 
 // ResourceTypeName returns a string representing the resource type name of the ResourceGroup object.
@@ -80,10 +80,16 @@ type ResourceProviderSummaryPropertiesResourceType struct {
 
 	// APIVersions is the list of API versions available for the resource type.
 	APIVersions map[string]ResourceProviderSummaryPropertiesAPIVersion `json:"apiVersions,omitempty"`
+
+	// Lifecycle is the lifecycle of the resource type.
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 }
 
 // ResourceProviderSummaryAPIVersion represents an API version available in a resource provider.
 type ResourceProviderSummaryPropertiesAPIVersion struct {
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any `json:"schema,omitempty"`
+
+	// Lifecycle is the lifecycle of the API version.
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 }
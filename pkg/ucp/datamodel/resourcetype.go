@@ -49,4 +49,7 @@ type ResourceTypeProperties struct {
 
 	// Description of the resource type.
 	Description *string `json:"description,omitempty"`
+
+	// Lifecycle is the lifecycle of the resource type. A nil lifecycle is generally available.
+	Lifecycle *Lifecycle `json:"lifecycle,omitempty"`
 }
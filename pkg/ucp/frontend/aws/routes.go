@@ -73,11 +73,11 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		}
 
 		if m.AWSClients.CloudControl == nil {
-			m.AWSClients.CloudControl = cloudcontrol.NewFromConfig(awsConfig)
+			m.AWSClients.CloudControl = ucp_aws.NewTracingCloudControlClient(cloudcontrol.NewFromConfig(awsConfig))
 		}
 
 		if m.AWSClients.CloudFormation == nil {
-			m.AWSClients.CloudFormation = cloudformation.NewFromConfig(awsConfig)
+			m.AWSClients.CloudFormation = ucp_aws.NewTracingCloudFormationClient(cloudformation.NewFromConfig(awsConfig))
 		}
 	}
 
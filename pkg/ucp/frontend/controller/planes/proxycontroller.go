@@ -24,12 +24,14 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/trace"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 )
 
 const (
@@ -136,13 +138,29 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	logger.Info("setting referer header", "value", refererURL.String())
 	req.Header.Set(v1.RefererHeader, refererURL.String())
 
+	// proxyErr records the failure of the downstream request, if any, on the span.
+	var proxyErr error
 	sender := proxy.NewARMProxy(options, downstream, func(builder *proxy.ReverseProxyBuilder) {
 		// Since we're proxying to Azure then remove the planes prefix.
 		builder.Directors = append(builder.Directors, trimPlanesPrefix)
+
+		builder.Responders = append(builder.Responders, func(resp *http.Response) error {
+			if resp.StatusCode >= http.StatusInternalServerError {
+				proxyErr = fmt.Errorf("the downstream request failed with status code %d", resp.StatusCode)
+			}
+			return nil
+		})
+		builder.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+			proxyErr = err
+			w.WriteHeader(http.StatusBadGateway)
+		}
 	})
 
 	logger.Info(fmt.Sprintf("proxying request target: %s", proxyURL))
+	ctx, span := trace.StartInternalSpan(ctx, "azure.proxy", trace.ProxyTracerName,
+		trace.ResourceIDAttrKey.String(resourceID.String()), semconv.HTTPMethod(req.Method))
 	sender.ServeHTTP(w, req.WithContext(ctx))
+	trace.EndSpan(span, proxyErr)
 	// The upstream response has already been sent at this point. Therefore, return nil response here
 	return nil, nil
 }
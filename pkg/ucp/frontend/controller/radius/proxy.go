@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -113,7 +114,7 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return armrpc_rest.NewBadRequestARMResponse(response), nil
 	}
 
-	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, p.DatabaseClient(), id, v1.LocationGlobal, apiVersion)
+	downstreamURL, resourceType, err := resourcegroups.ValidateDownstream(ctx, p.DatabaseClient(), id, v1.LocationGlobal, apiVersion)
 	if errors.Is(err, &resourcegroups.NotFoundError{}) {
 		return armrpc_rest.NewNotFoundResponseWithCause(id, err.Error()), nil
 	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
@@ -123,6 +124,19 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, fmt.Errorf("failed to validate downstream: %w", err)
 	}
 
+	warnings, err := resourcegroups.ValidateLifecycle(ctx, p.DatabaseClient(), id, resourceType, apiVersion, req.Method, time.Now())
+	if errors.Is(err, &resourcegroups.InvalidError{}) {
+		response := v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: err.Error(), Target: id.String()}}
+		return armrpc_rest.NewBadRequestARMResponse(response), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to validate lifecycle: %w", err)
+	}
+
+	// Warnings are added before proxying so they are returned along with the headers of the downstream response.
+	for _, warning := range warnings {
+		w.Header().Add(v1.WarningHeader, "299 - "+strconv.Quote(warning))
+	}
+
 	if downstreamURL == nil {
 		downstreamURL = p.defaultDownstream
 	}
@@ -118,6 +118,113 @@ func Test_Run(t *testing.T) {
 		},
 	}
 
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: "apiVersions", Name: "2025-01-01"})
+
+	apiVersionResource := &datamodel.APIVersion{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				Name: "2025-01-01",
+				ID:   apiVersionID.String(),
+			},
+		},
+	}
+
+	t.Run("success (deprecated API version)", func(t *testing.T) {
+		p, databaseClient, _, roundTripper, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+
+		// Not a mutating request
+		req := httptest.NewRequest(http.MethodGet, id.String()+"?api-version="+apiVersion, nil)
+
+		deprecatedAPIVersion := &datamodel.APIVersion{
+			Properties: datamodel.APIVersionProperties{
+				Lifecycle: &datamodel.Lifecycle{State: datamodel.LifecycleStateDeprecated},
+			},
+		}
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeResource.ID).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: deprecatedAPIVersion}, nil).Times(1)
+
+		downstreamResponse := httptest.NewRecorder()
+		downstreamResponse.WriteHeader(http.StatusOK)
+		roundTripper.Response = downstreamResponse.Result()
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, []string{`299 - "API version \"2025-01-01\" of resource type \"Applications.Test/testResources\" is deprecated."`}, w.Header().Values(v1.WarningHeader))
+	})
+
+	t.Run("failure (retired API version)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, id.String()+"?api-version="+apiVersion, nil)
+
+		retiredAPIVersion := &datamodel.APIVersion{
+			Properties: datamodel.APIVersionProperties{
+				Lifecycle: &datamodel.Lifecycle{State: datamodel.LifecycleStateRetired},
+			},
+		}
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeResource.ID).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: retiredAPIVersion}, nil).Times(1)
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Equal(t, "API version \"2025-01-01\" of resource type \"Applications.Test/testResources\" has been retired. Only requests to delete resources are accepted.", response.(*rest.BadRequestResponse).Body.Error.Message)
+	})
+
 	t.Run("success (non-tracked)", func(t *testing.T) {
 		p, databaseClient, _, roundTripper, _ := createController(t)
 
@@ -149,6 +256,10 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+
 		downstreamResponse := httptest.NewRecorder()
 		downstreamResponse.WriteHeader(http.StatusOK)
 		roundTripper.Response = downstreamResponse.Result()
@@ -189,6 +300,10 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+
 		downstreamResponse := httptest.NewRecorder()
 		downstreamResponse.WriteHeader(http.StatusOK)
 		roundTripper.Response = downstreamResponse.Result()
@@ -232,6 +347,10 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+
 		// Tracking entry created
 		databaseClient.EXPECT().
 			Get(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -287,6 +406,10 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String()).
+			Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+
 		// Tracking entry created
 		existingEntry := &database.Object{
 			Data: &datamodel.GenericResource{
@@ -0,0 +1,37 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/policy"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ValidatePolicies is an UpdateFilter that rejects resource groups with policies that are invalid or have rules that
+// don't compile. The policies are evaluated by the resource providers when resources of the resource group are
+// created or updated.
+func ValidatePolicies(ctx context.Context, newResource *datamodel.ResourceGroup, oldResource *datamodel.ResourceGroup, options *controller.Options) (rest.Response, error) {
+	if _, err := policy.NewEngine(policy.FromResourceGroup(newResource)); err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	return nil, nil
+}
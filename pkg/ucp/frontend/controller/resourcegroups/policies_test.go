@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidatePolicies(t *testing.T) {
+	t.Run("valid policies", func(t *testing.T) {
+		resourceGroup := &datamodel.ResourceGroup{
+			Properties: datamodel.ResourceGroupProperties{
+				Policies: []datamodel.ResourceGroupPolicy{
+					{Name: "small-only", Rule: "resource.properties.size == 'S'", Mode: "audit"},
+				},
+			},
+		}
+
+		response, err := ValidatePolicies(testcontext.New(t), resourceGroup, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("no policies", func(t *testing.T) {
+		response, err := ValidatePolicies(testcontext.New(t), &datamodel.ResourceGroup{}, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		resourceGroup := &datamodel.ResourceGroup{
+			Properties: datamodel.ResourceGroupProperties{
+				Policies: []datamodel.ResourceGroupPolicy{
+					{Name: "small-only", Rule: "resource.properties.size =="},
+				},
+			},
+		}
+
+		response, err := ValidatePolicies(testcontext.New(t), resourceGroup, nil, nil)
+		require.NoError(t, err)
+		require.IsType(t, &rest.BadRequestResponse{}, response)
+		require.Contains(t, response.(*rest.BadRequestResponse).Body.Error.Message, `policy "small-only" has an invalid rule`)
+	})
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/components/database"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -104,41 +106,43 @@ func ValidateResourceGroup(ctx context.Context, client database.Client, id resou
 }
 
 // ValidateResourceType performs semantic validation of a proxy request against registered
-// resource types.
+// resource types. Returns the downstream URL, and the resource type unless the request targets an
+// operation resource type.
 //
 // Returns NotFoundError if the resource type does not exist.
 // Returns InvalidError if the request cannot be routed due to an invalid configuration.
-func ValidateResourceType(ctx context.Context, client database.Client, id resources.ID, locationName string, apiVersion string) (*url.URL, error) {
+func ValidateResourceType(ctx context.Context, client database.Client, id resources.ID, locationName string, apiVersion string) (*url.URL, *datamodel.ResourceType, error) {
 	// The strategy is to:
 	// - Look up the resource type and validate that it exists .. then
 	// - Look up the location resource, and validate that it supports the requested resource type and API version.
 
 	// We need to do both because they may not be in sync. This can be be the case if a resource type is being added or deleted.
 
+	var resourceType *datamodel.ResourceType
 	if !isOperationResourceType(id) {
 		resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		_, err = database.GetResource[datamodel.ResourceType](ctx, client, resourceTypeID.String())
+		resourceType, err = database.GetResource[datamodel.ResourceType](ctx, client, resourceTypeID.String())
 		if errors.Is(err, &database.ErrNotFound{}) {
-			return nil, &InvalidError{Message: fmt.Sprintf("resource type %q not found", id.Type())}
+			return nil, nil, &InvalidError{Message: fmt.Sprintf("resource type %q not found", id.Type())}
 		} else if err != nil {
-			return nil, fmt.Errorf("failed to fetch resource type %q: %w", id.Type(), err)
+			return nil, nil, fmt.Errorf("failed to fetch resource type %q: %w", id.Type(), err)
 		}
 	}
 
 	locationID, err := datamodel.ResourceProviderLocationIDFromResourceID(id, locationName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	location, err := database.GetResource[datamodel.Location](ctx, client, locationID.String())
 	if errors.Is(err, &database.ErrNotFound{}) {
-		return nil, &InvalidError{Message: fmt.Sprintf("location %q not found for resource provider %q", locationName, id.ProviderNamespace())}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("location %q not found for resource provider %q", locationName, id.ProviderNamespace())}
 	} else if err != nil {
-		return nil, fmt.Errorf("failed to fetch location %q: %w", locationID.String(), err)
+		return nil, nil, fmt.Errorf("failed to fetch location %q: %w", locationID.String(), err)
 	}
 
 	// Check if the location supports the resource type.
@@ -170,28 +174,93 @@ func ValidateResourceType(ctx context.Context, client database.Client, id resour
 
 	// Now check if the location supports the resource type and API version. If it does, we can return the downstream URL.
 	if locationResourceType == nil {
-		return nil, &InvalidError{Message: fmt.Sprintf("resource type %q not supported by location %q", id.Type(), locationName)}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("resource type %q not supported by location %q", id.Type(), locationName)}
 	}
 
 	_, ok := locationResourceType.APIVersions[apiVersion]
 	if !ok {
-		return nil, &InvalidError{Message: fmt.Sprintf("api version %q is not supported for resource type %q by location %q", apiVersion, id.Type(), locationName)}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("api version %q is not supported for resource type %q by location %q", apiVersion, id.Type(), locationName)}
 	}
 
 	// If we get to here, then we're all good.
 	//
 	// The address might be nil which means that we're using the default address (dynamic RP)
 	if location.Properties.Address == nil {
-		return nil, nil
+		return nil, resourceType, nil
 	}
 
 	// If the address was provided, then use that instead.
 	u, err := url.Parse(*location.Properties.Address)
 	if err != nil {
-		return nil, &InvalidError{Message: fmt.Sprintf("failed to parse location address: %v", err.Error())}
+		return nil, nil, &InvalidError{Message: fmt.Sprintf("failed to parse location address: %v", err.Error())}
 	}
 
-	return u, nil
+	return u, resourceType, nil
+}
+
+// ValidateLifecycle validates the lifecycle of the resource type and API version of a proxy request. The resource type
+// is the one returned by ValidateDownstream, and is nil for operation resource types. Returns a warning for each
+// deprecated resource type or API version.
+//
+// Returns InvalidError if the resource type or API version is retired, unless the request deletes a resource.
+func ValidateLifecycle(ctx context.Context, client database.Client, id resources.ID, resourceType *datamodel.ResourceType, apiVersion string, method string, now time.Time) ([]string, error) {
+	if resourceType == nil {
+		return nil, nil
+	}
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: "apiVersions", Name: apiVersion})
+
+	// The API version is validated against the location, so an API version that is not registered as a resource
+	// is generally available.
+	var apiVersionLifecycle *datamodel.Lifecycle
+	apiVersionResource, err := database.GetResource[datamodel.APIVersion](ctx, client, apiVersionID.String())
+	if err == nil {
+		apiVersionLifecycle = apiVersionResource.Properties.Lifecycle
+	} else if !errors.Is(err, &database.ErrNotFound{}) {
+		return nil, fmt.Errorf("failed to fetch API version %q: %w", apiVersionID.String(), err)
+	}
+
+	subjects := []struct {
+		description string
+		lifecycle   *datamodel.Lifecycle
+	}{
+		{description: fmt.Sprintf("Resource type %q", id.Type()), lifecycle: resourceType.Properties.Lifecycle},
+		{description: fmt.Sprintf("API version %q of resource type %q", apiVersion, id.Type()), lifecycle: apiVersionLifecycle},
+	}
+
+	warnings := []string{}
+	for _, subject := range subjects {
+		switch subject.lifecycle.EffectiveState(now) {
+		case datamodel.LifecycleStateRetired:
+			if strings.EqualFold(method, http.MethodDelete) {
+				continue
+			}
+
+			return nil, &InvalidError{Message: lifecycleMessage(subject.description+" has been retired. Only requests to delete resources are accepted.", subject.lifecycle)}
+		case datamodel.LifecycleStateDeprecated:
+			message := subject.description + " is deprecated."
+			if subject.lifecycle.SunsetDate != nil {
+				message += fmt.Sprintf(" It will be retired on %s.", subject.lifecycle.SunsetDate.Format(time.DateOnly))
+			}
+			warnings = append(warnings, lifecycleMessage(message, subject.lifecycle))
+		}
+	}
+
+	return warnings, nil
+}
+
+// lifecycleMessage appends the message of a lifecycle, if any, to the given message.
+func lifecycleMessage(message string, lifecycle *datamodel.Lifecycle) string {
+	if lifecycle.Message == "" {
+		return message
+	}
+
+	return message + " " + lifecycle.Message
 }
 
 // isOperationResourceType returns true if the resource type is an operation resource type (operationResults/operationStatuses).
@@ -220,10 +289,11 @@ func isOperationResourceType(id resources.ID) bool {
 	return false
 }
 
-// ValidateDownstream can be used to find and validate the downstream URL for a resource.
+// ValidateDownstream can be used to find and validate the downstream URL for a resource. Also returns the resource
+// type of the resource, unless it is an operation resource type.
 // Returns NotFoundError for the case where the plane or resource group does not exist.
 // Returns InvalidError for cases where the data is invalid, like when the resource provider is not configured.
-func ValidateDownstream(ctx context.Context, client database.Client, id resources.ID, location string, apiVersion string) (*url.URL, error) {
+func ValidateDownstream(ctx context.Context, client database.Client, id resources.ID, location string, apiVersion string) (*url.URL, *datamodel.ResourceType, error) {
 	// There are a few steps to validation:
 	//
 	// - The plane exists
@@ -235,20 +305,15 @@ func ValidateDownstream(ctx context.Context, client database.Client, id resource
 	// The plane exists.
 	_, err := ValidateRadiusPlane(ctx, client, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// The resource group exists (if applicable).
 	err = ValidateResourceGroup(ctx, client, id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// If this returns success, it means the resource type is configured using new/UDT routing.
-	downstreamURL, err := ValidateResourceType(ctx, client, id, location, apiVersion)
-	if err != nil {
-		return nil, err
-	}
-
-	return downstreamURL, nil
+	return ValidateResourceType(ctx, client, id, location, apiVersion)
 }
@@ -18,8 +18,10 @@ package resourcegroups
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/components/database"
@@ -115,9 +117,10 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, resourceType, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
+		require.Equal(t, resourceTypeResource.ID, resourceType.ID)
 	})
 
 	t.Run("success (non resource group)", func(t *testing.T) {
@@ -129,7 +132,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, idWithoutResourceGroup, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, idWithoutResourceGroup, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -145,7 +148,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, operationStatusID, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, operationStatusID, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -161,7 +164,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, operationStatusID, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, operationStatusID, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -177,7 +180,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, operationResultID, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, operationResultID, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -193,7 +196,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, operationResultID, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, operationResultID, location, apiVersion)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -202,7 +205,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient := setup(t)
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(nil, &database.ErrNotFound{}).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, &NotFoundError{Message: "plane \"/planes/radius/local\" not found"}, err)
 		require.Nil(t, downstreamURL)
@@ -214,7 +217,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expected := fmt.Errorf("failed to fetch plane \"/planes/radius/local\": %w", errors.New("test error"))
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -225,7 +228,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(nil, &database.ErrNotFound{}).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, &NotFoundError{Message: "resource group \"/planes/radius/local/resourceGroups/test-group\" not found"}, err)
 		require.Nil(t, downstreamURL)
@@ -237,7 +240,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, "failed to fetch resource group \"/planes/radius/local/resourceGroups/test-group\": test error", err.Error())
 		require.Nil(t, downstreamURL)
@@ -259,7 +262,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&database.Object{Data: resourceGroup}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -282,7 +285,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -322,7 +325,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "resource type \"System.TestRP/testResources\" not supported by location \"east\""}, err)
 		require.Nil(t, downstreamURL)
@@ -362,7 +365,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "api version \"2025-01-01\" is not supported for resource type \"System.TestRP/testResources\" by location \"east\""}, err)
 		require.Nil(t, downstreamURL)
@@ -402,9 +405,107 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
+		downstreamURL, _, err := ValidateDownstream(testcontext.New(t), databaseClient, id, location, apiVersion)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "failed to parse location address: parse \"\\ninvalid\": net/url: invalid control character in URL"}, err)
 		require.Nil(t, downstreamURL)
 	})
 }
+
+func Test_ValidateLifecycle(t *testing.T) {
+	id, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-group/providers/System.TestRP/testResources/name")
+	require.NoError(t, err)
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	require.NoError(t, err)
+
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: "apiVersions", Name: apiVersion})
+
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	sunsetDate := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	resourceType := func(lifecycle *datamodel.Lifecycle) *datamodel.ResourceType {
+		return &datamodel.ResourceType{Properties: datamodel.ResourceTypeProperties{Lifecycle: lifecycle}}
+	}
+
+	tests := []struct {
+		name                string
+		resourceType        *datamodel.ResourceType
+		apiVersionLifecycle *datamodel.Lifecycle
+		apiVersionNotFound  bool
+		method              string
+		expectedWarnings    []string
+		expectedErr         string
+	}{
+		{
+			name:   "operation resource type",
+			method: http.MethodGet,
+		},
+		{
+			name:               "API version not found",
+			resourceType:       resourceType(nil),
+			apiVersionNotFound: true,
+			method:             http.MethodPut,
+			expectedWarnings:   []string{},
+		},
+		{
+			name:                "generally available",
+			resourceType:        resourceType(nil),
+			apiVersionLifecycle: &datamodel.Lifecycle{State: datamodel.LifecycleStateGA},
+			method:              http.MethodPut,
+			expectedWarnings:    []string{},
+		},
+		{
+			name:                "deprecated resource type and API version",
+			resourceType:        resourceType(&datamodel.Lifecycle{State: datamodel.LifecycleStateDeprecated}),
+			apiVersionLifecycle: &datamodel.Lifecycle{State: datamodel.LifecycleStateDeprecated, SunsetDate: &sunsetDate, Message: "Use 2025-06-01 instead."},
+			method:              http.MethodGet,
+			expectedWarnings: []string{
+				"Resource type \"System.TestRP/testResources\" is deprecated.",
+				"API version \"2025-01-01\" of resource type \"System.TestRP/testResources\" is deprecated. It will be retired on 2025-12-01. Use 2025-06-01 instead.",
+			},
+		},
+		{
+			name:                "retired API version",
+			resourceType:        resourceType(nil),
+			apiVersionLifecycle: &datamodel.Lifecycle{State: datamodel.LifecycleStateRetired, Message: "Use 2025-06-01 instead."},
+			method:              http.MethodPut,
+			expectedErr:         "API version \"2025-01-01\" of resource type \"System.TestRP/testResources\" has been retired. Only requests to delete resources are accepted. Use 2025-06-01 instead.",
+		},
+		{
+			name:         "deprecated resource type past sunset date",
+			resourceType: resourceType(&datamodel.Lifecycle{State: datamodel.LifecycleStateDeprecated, SunsetDate: new(now.Add(-time.Hour))}),
+			method:       http.MethodPatch,
+			expectedErr:  "Resource type \"System.TestRP/testResources\" has been retired. Only requests to delete resources are accepted.",
+		},
+		{
+			name:             "delete retired resource type",
+			resourceType:     resourceType(&datamodel.Lifecycle{State: datamodel.LifecycleStateRetired}),
+			method:           http.MethodDelete,
+			expectedWarnings: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			databaseClient := database.NewMockClient(gomock.NewController(t))
+			if tt.apiVersionNotFound {
+				databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(nil, &database.ErrNotFound{ID: apiVersionID.String()}).Times(1)
+			} else if tt.resourceType != nil {
+				apiVersionResource := &datamodel.APIVersion{Properties: datamodel.APIVersionProperties{Lifecycle: tt.apiVersionLifecycle}}
+				databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+			}
+
+			warnings, err := ValidateLifecycle(testcontext.New(t), databaseClient, id, tt.resourceType, apiVersion, tt.method, now)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				require.ErrorIs(t, err, &InvalidError{})
+				require.Equal(t, tt.expectedErr, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedWarnings, warnings)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ForceQueryParameter is the query parameter used to delete a resource type or API version that still has resources.
+const ForceQueryParameter = "force"
+
+// BlockResourceTypeDelete is a DeleteFilter that prevents deleting a resource type while resources of the type
+// still exist, unless the request sets the force query parameter.
+func BlockResourceTypeDelete(ctx context.Context, oldResource *datamodel.ResourceType, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	typeSegments := serviceCtx.ResourceID.TypeSegments()
+	resourceType := typeSegments[0].Name + "/" + typeSegments[1].Name
+
+	return blockDeleteWithResources(ctx, options.DatabaseClient, fmt.Sprintf("resource type %q", resourceType), resourceType, "")
+}
+
+// BlockAPIVersionDelete is a DeleteFilter that prevents deleting an API version of a resource type while resources
+// that were last written with the API version still exist, unless the request sets the force query parameter.
+func BlockAPIVersionDelete(ctx context.Context, oldResource *datamodel.APIVersion, options *armrpc_controller.Options) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	typeSegments := serviceCtx.ResourceID.TypeSegments()
+	resourceType := typeSegments[0].Name + "/" + typeSegments[1].Name
+	apiVersion := typeSegments[2].Name
+
+	return blockDeleteWithResources(ctx, options.DatabaseClient, fmt.Sprintf("API version %q of resource type %q", apiVersion, resourceType), resourceType, apiVersion)
+}
+
+// blockDeleteWithResources returns a 409 Conflict listing the tracked resources of the given resource type, and API version
+// if set, unless the request sets the force query parameter.
+func blockDeleteWithResources(ctx context.Context, client database.Client, description string, resourceType string, apiVersion string) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	if strings.EqualFold(serviceCtx.OriginalURL.Query().Get(ForceQueryParameter), "true") {
+		return nil, nil
+	}
+
+	// Tracked resources are stored in the resource group of the resource they track, so we need to search the whole plane.
+	query := database.Query{
+		RootScope:      serviceCtx.ResourceID.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.GenericResourceType,
+		Filters: []database.QueryFilter{
+			{Field: "properties.type", Value: resourceType},
+		},
+	}
+	if apiVersion != "" {
+		query.Filters = append(query.Filters, database.QueryFilter{Field: "properties.apiVersion", Value: apiVersion})
+	}
+
+	blockers := []string{}
+	paginationToken := ""
+	for {
+		result, err := client.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			tracked := datamodel.GenericResource{}
+			err := item.As(&tracked)
+			if err != nil {
+				return nil, err
+			}
+
+			blockers = append(blockers, tracked.Properties.ID)
+		}
+
+		paginationToken = result.PaginationToken
+		if paginationToken == "" {
+			break
+		}
+	}
+
+	if len(blockers) == 0 {
+		return nil, nil
+	}
+
+	sort.Strings(blockers)
+
+	details := []*v1.ErrorDetails{}
+	for _, blocker := range blockers {
+		details = append(details, &v1.ErrorDetails{
+			Code:    v1.CodeConflict,
+			Message: fmt.Sprintf("Resource %q still exists.", blocker),
+			Target:  blocker,
+		})
+	}
+
+	return &armrpc_rest.ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeConflict,
+				Message: fmt.Sprintf("Cannot delete %s because %d resource(s) still use it. Delete the resources first, or set the %q query parameter to 'true' to delete it anyway.", description, len(blockers), ForceQueryParameter),
+				Target:  serviceCtx.ResourceID.String(),
+				Details: details,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceTypeID = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	testAPIVersionID   = testResourceTypeID + "/apiVersions/2025-01-01"
+)
+
+func Test_BlockResourceTypeDelete(t *testing.T) {
+	t.Run("no resources", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg/providers/Applications.Test/otherResources/other", "2025-01-01")
+
+		response, err := BlockResourceTypeDelete(createDeleteContext(t, testResourceTypeID, ""), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("resources exist", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b", "2025-01-01")
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", "2024-01-01")
+
+		response, err := BlockResourceTypeDelete(createDeleteContext(t, testResourceTypeID, ""), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+
+		body := response.(*armrpc_rest.ConflictResponse).Body
+		require.Equal(t, v1.CodeConflict, body.Error.Code)
+		require.Equal(t, "Cannot delete resource type \"Applications.Test/testResources\" because 2 resource(s) still use it. Delete the resources first, or set the \"force\" query parameter to 'true' to delete it anyway.", body.Error.Message)
+		require.Len(t, body.Error.Details, 2)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", body.Error.Details[0].Target)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b", body.Error.Details[1].Target)
+	})
+
+	t.Run("resources across pages", func(t *testing.T) {
+		client := database.NewMockClient(gomock.NewController(t))
+		client.EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, query database.Query, options ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+				require.Equal(t, []database.QueryFilter{{Field: "properties.type", Value: "Applications.Test/testResources"}}, query.Filters)
+				return &database.ObjectQueryResult{
+					Items:           []database.Object{trackedResourceObject("/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", "2025-01-01")},
+					PaginationToken: "next",
+				}, nil
+			})
+		client.EXPECT().
+			Query(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{
+				Items: []database.Object{trackedResourceObject("/planes/radius/local/resourceGroups/rg2/providers/Applications.Test/testResources/b", "2025-01-01")},
+			}, nil)
+
+		response, err := BlockResourceTypeDelete(createDeleteContext(t, testResourceTypeID, ""), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+		require.Len(t, response.(*armrpc_rest.ConflictResponse).Body.Error.Details, 2)
+	})
+
+	t.Run("forced", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", "2025-01-01")
+
+		response, err := BlockResourceTypeDelete(createDeleteContext(t, testResourceTypeID, "true"), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+}
+
+func Test_BlockAPIVersionDelete(t *testing.T) {
+	t.Run("resources use other API versions", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", "2024-01-01")
+
+		response, err := BlockAPIVersionDelete(createDeleteContext(t, testAPIVersionID, ""), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("resources use API version", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", "2025-01-01")
+		saveTrackedResource(t, client, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/b", "2024-01-01")
+
+		response, err := BlockAPIVersionDelete(createDeleteContext(t, testAPIVersionID, ""), nil, &armrpc_controller.Options{DatabaseClient: client})
+		require.NoError(t, err)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+
+		body := response.(*armrpc_rest.ConflictResponse).Body
+		require.Equal(t, "Cannot delete API version \"2025-01-01\" of resource type \"Applications.Test/testResources\" because 1 resource(s) still use it. Delete the resources first, or set the \"force\" query parameter to 'true' to delete it anyway.", body.Error.Message)
+		require.Len(t, body.Error.Details, 1)
+		require.Equal(t, "/planes/radius/local/resourceGroups/rg1/providers/Applications.Test/testResources/a", body.Error.Details[0].Target)
+	})
+}
+
+func createDeleteContext(t *testing.T, id string, force string) context.Context {
+	originalURL := url.URL{Path: id, RawQuery: url.Values{"api-version": []string{"2023-10-01-preview"}}.Encode()}
+	if force != "" {
+		originalURL.RawQuery += "&" + ForceQueryParameter + "=" + force
+	}
+
+	return v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{
+		ResourceID:  resources.MustParse(id),
+		OriginalURL: originalURL,
+	})
+}
+
+func saveTrackedResource(t *testing.T, client database.Client, id string, apiVersion string) {
+	obj := trackedResourceObject(id, apiVersion)
+	err := client.Save(testcontext.New(t), &obj)
+	require.NoError(t, err)
+}
+
+func trackedResourceObject(id string, apiVersion string) database.Object {
+	originalID := resources.MustParse(id)
+	trackingID := trackedresource.IDFor(originalID)
+
+	entry := datamodel.GenericResourceFromID(originalID, trackingID)
+	entry.Properties.APIVersion = apiVersion
+
+	return database.Object{Metadata: database.Metadata{ID: trackingID.String()}, Data: entry}
+}
@@ -204,6 +204,9 @@ func radiusPlaneDeleteHandler(ctx context.Context, ctrlOptions controller.Option
 var resourceGroupResourceOptions = controller.ResourceOptions[datamodel.ResourceGroup]{
 	RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
 	ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.ResourceGroup]{
+		resourcegroups_ctrl.ValidatePolicies,
+	},
 }
 
 func resourceGroupListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
@@ -280,6 +283,9 @@ var resourceTypeResourceOptions = controller.ResourceOptions[datamodel.ResourceT
 	RequestConverter:         converter.ResourceTypeDataModelFromVersioned,
 	ResponseConverter:        converter.ResourceTypeDataModelToVersioned,
 	AsyncOperationRetryAfter: operationRetryAfter,
+	DeleteFilters: []controller.DeleteFilter[datamodel.ResourceType]{
+		resourceproviders_ctrl.BlockResourceTypeDelete,
+	},
 }
 
 func resourceTypeListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
@@ -310,6 +316,9 @@ var apiVersionResourceOptions = controller.ResourceOptions[datamodel.APIVersion]
 	RequestConverter:         converter.APIVersionDataModelFromVersioned,
 	ResponseConverter:        converter.APIVersionDataModelToVersioned,
 	AsyncOperationRetryAfter: operationRetryAfter,
+	DeleteFilters: []controller.DeleteFilter[datamodel.APIVersion]{
+		resourceproviders_ctrl.BlockAPIVersionDelete,
+	},
 }
 
 func apiVersionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
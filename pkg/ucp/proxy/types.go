@@ -76,7 +76,9 @@ func (builder *ReverseProxyBuilder) Build() ReverseProxy {
 	// We don't consider workaround28169 optional :-/ the default behavior is just broken.
 	//
 	// We don't want to propagate the Kubernetes authentication headers to the downstream server.
-	directors := []DirectorFunc{workaround28169, filterKubernetesAPIServerHeaders}
+	//
+	// We always propagate the trace context so that downstream resource providers join the same trace.
+	directors := []DirectorFunc{workaround28169, filterKubernetesAPIServerHeaders, propagateTraceContext}
 	directors = append(directors, builder.Directors...)
 
 	responders := builder.Responders
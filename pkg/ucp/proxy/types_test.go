@@ -24,9 +24,12 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/radius-project/radius/pkg/components/trace"
 	"github.com/radius-project/radius/test/testcontext"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type transportFunc struct {
@@ -230,4 +233,32 @@ func Test_ReverseProxyBuilder_Build(t *testing.T) {
 			assert.Equal(t, http.StatusTeapot, w.Code)
 		})
 	})
+	t.Run("with trace context", func(t *testing.T) {
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+		builder := &ReverseProxyBuilder{Downstream: downstream}
+		builder.Transport = &transportFunc{
+			Func: func(req *http.Request) (*http.Response, error) {
+				response := &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Traceparent": req.Header["Traceparent"]},
+					Body:       http.NoBody,
+					Request:    req,
+				}
+
+				return response, nil
+			},
+		}
+		proxy := builder.Build()
+
+		req := httptest.NewRequest(http.MethodGet, "http://localhost", nil)
+		req = req.WithContext(trace.WithTraceparent(testcontext.New(t), traceparent))
+		w := httptest.NewRecorder()
+		proxy.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, traceparent, w.Header().Get("Traceparent"))
+	})
 }
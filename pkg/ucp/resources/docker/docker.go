@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// PlaneTypeDocker defines the type name of the Docker plane.
+	PlaneTypeDocker = "docker"
+
+	// PlaneNameTODO is the name of the Docker plane to use when the plane name is not known. Radius only supports
+	// the Docker host configured for the control plane.
+	PlaneNameTODO = "local"
+
+	// ResourceTypeContainer is the resource type of a Docker container.
+	ResourceTypeContainer = "Docker.Engine/containers"
+
+	// ResourceTypeVolume is the resource type of a Docker volume.
+	ResourceTypeVolume = "Docker.Engine/volumes"
+)
+
+// IDFromParts returns the UCP resource ID for the Docker object with the given resource type and name.
+func IDFromParts(planeName string, resourceType string, name string) resources.ID {
+	scopes := []resources.ScopeSegment{
+		{
+			Type: PlaneTypeDocker,
+			Name: planeName,
+		},
+	}
+
+	types := []resources.TypeSegment{
+		{
+			Type: resourceType,
+			Name: name,
+		},
+	}
+
+	return resources.MustParse(resources.MakeUCPID(scopes, types, nil))
+}
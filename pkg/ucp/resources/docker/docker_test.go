@@ -0,0 +1,34 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IDFromParts(t *testing.T) {
+	id := IDFromParts(PlaneNameTODO, ResourceTypeContainer, "frontend")
+	require.Equal(t, "/planes/docker/local/providers/Docker.Engine/containers/frontend", id.String())
+	require.Equal(t, ResourceTypeContainer, id.Type())
+	require.Equal(t, "frontend", id.Name())
+	require.Equal(t, PlaneTypeDocker, id.ScopeSegments()[0].Type)
+
+	id = IDFromParts(PlaneNameTODO, ResourceTypeVolume, "frontend-data")
+	require.Equal(t, "/planes/docker/local/providers/Docker.Engine/volumes/frontend-data", id.String())
+}
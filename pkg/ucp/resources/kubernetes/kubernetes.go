@@ -42,6 +42,9 @@ const (
 // Lookup map to get the group/Kind information from kubernetes resource kind.
 var providerLookup map[string]string = map[string]string{
 	strings.ToLower(KindDeployment):          ResourceTypeDeployment,
+	strings.ToLower(KindStatefulSet):         ResourceTypeStatefulSet,
+	strings.ToLower(KindJob):                 ResourceTypeJob,
+	strings.ToLower(KindCronJob):             ResourceTypeCronJob,
 	strings.ToLower(KindService):             ResourceTypeService,
 	strings.ToLower(KindSecret):              ResourceTypeSecret,
 	strings.ToLower(KindServiceAccount):      ResourceTypeServiceAccount,
@@ -49,6 +52,10 @@ var providerLookup map[string]string = map[string]string{
 	strings.ToLower(KindRoleBinding):         ResourceTypeRoleBinding,
 	strings.ToLower(KindSecretProviderClass): ResourceTypeSecretProviderClass,
 	strings.ToLower(KindContourHTTPProxy):    ResourceTypeContourHTTPProxy,
+
+	strings.ToLower(KindHorizontalPodAutoscaler): ResourceTypeHorizontalPodAutoscaler,
+	strings.ToLower(KindPodDisruptionBudget):     ResourceTypePodDisruptionBudget,
+	strings.ToLower(KindNetworkPolicy):           ResourceTypeNetworkPolicy,
 }
 
 // ToParts returns the component parts of the given UCP resource ID.
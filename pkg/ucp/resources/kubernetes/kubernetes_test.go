@@ -33,6 +33,16 @@ func Test_ToUCPResourceID(t *testing.T) {
 		require.Equal(t, expectedID, ucpID)
 	})
 
+	t.Run("kubernetes resource type : cronjob", func(t *testing.T) {
+		namespace := "default"
+		resourceType := "cronjob"
+		resourceName := "nightly-report"
+		expectedID := "/planes/kubernetes/local/namespaces/default/providers/batch/CronJob/nightly-report"
+		ucpID, err := ToUCPResourceID(namespace, resourceType, resourceName, "")
+		require.NoError(t, err)
+		require.Equal(t, expectedID, ucpID)
+	})
+
 	t.Run("kubernetes resource type: dapr component", func(t *testing.T) {
 		namespace := "test-dapr"
 		resourceType := "Component"
@@ -23,6 +23,18 @@ const (
 	KindDeployment = "Deployment"
 	// ResourceTypeDeployment is the resource type of a Kubernetes Deployment.
 	ResourceTypeDeployment = "apps/Deployment"
+	// KindStatefulSet is the kind of a Kubernetes StatefulSet.
+	KindStatefulSet = "StatefulSet"
+	// ResourceTypeStatefulSet is the resource type of a Kubernetes StatefulSet.
+	ResourceTypeStatefulSet = "apps/StatefulSet"
+	// KindJob is the kind of a Kubernetes Job.
+	KindJob = "Job"
+	// ResourceTypeJob is the resource type of a Kubernetes Job.
+	ResourceTypeJob = "batch/Job"
+	// KindCronJob is the kind of a Kubernetes CronJob.
+	KindCronJob = "CronJob"
+	// ResourceTypeCronJob is the resource type of a Kubernetes CronJob.
+	ResourceTypeCronJob = "batch/CronJob"
 	// KindSecret is the kind of a Kubernetes Secret.
 	KindSecret = "Secret"
 	// ResourceTypeSecret is the resource type of a Kubernetes Secret.
@@ -47,6 +59,18 @@ const (
 	KindSecretProviderClass = "SecretProviderClass"
 	// ResourceTypeSecretProviderClass is the resource type of a Kubernetes SecretProviderClass.
 	ResourceTypeSecretProviderClass = "secrets-store.csi.x-k8s.io/SecretProviderClass"
+	// KindHorizontalPodAutoscaler is the kind of a Kubernetes HorizontalPodAutoscaler.
+	KindHorizontalPodAutoscaler = "HorizontalPodAutoscaler"
+	// ResourceTypeHorizontalPodAutoscaler is the resource type of a Kubernetes HorizontalPodAutoscaler.
+	ResourceTypeHorizontalPodAutoscaler = "autoscaling/HorizontalPodAutoscaler"
+	// KindPodDisruptionBudget is the kind of a Kubernetes PodDisruptionBudget.
+	KindPodDisruptionBudget = "PodDisruptionBudget"
+	// ResourceTypePodDisruptionBudget is the resource type of a Kubernetes PodDisruptionBudget.
+	ResourceTypePodDisruptionBudget = "policy/PodDisruptionBudget"
+	// KindNetworkPolicy is the kind of a Kubernetes NetworkPolicy.
+	KindNetworkPolicy = "NetworkPolicy"
+	// ResourceTypeNetworkPolicy is the resource type of a Kubernetes NetworkPolicy.
+	ResourceTypeNetworkPolicy = "networking.k8s.io/NetworkPolicy"
 
 	// KindContourHTTPProxy is the kind of a Contour HTTPProxy.
 	KindContourHTTPProxy = "HTTPProxy"
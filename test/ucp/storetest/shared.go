@@ -313,6 +313,56 @@ func RunTest(t *testing.T, client database.Client, clear func(t *testing.T)) {
 		require.Nil(t, obj1Get)
 	})
 
+	t.Run("save_cannot_update_stale_etag", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+		staleETag := obj1.ETag
+
+		obj1.Data = Data2
+		err = client.Save(ctx, &obj1, database.WithETag(staleETag))
+		require.NoError(t, err)
+		require.NotEqual(t, staleETag, obj1.ETag)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		require.Equal(t, obj1.ETag, obj1Get.ETag)
+
+		obj1.Data = Data1
+		err = client.Save(ctx, &obj1, database.WithETag(staleETag))
+		require.ErrorIs(t, err, &database.ErrConcurrency{})
+	})
+
+	t.Run("save_if_not_exists_can_create", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1, database.WithIfNotExists())
+		require.NoError(t, err)
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
+	t.Run("save_if_not_exists_cannot_update", func(t *testing.T) {
+		clear(t)
+
+		obj1 := createObject(Resource1ID, Data1)
+		err := client.Save(ctx, &obj1)
+		require.NoError(t, err)
+
+		obj2 := createObject(Resource1ID, Data2)
+		err = client.Save(ctx, &obj2, database.WithIfNotExists())
+		require.ErrorIs(t, err, &database.ErrConcurrency{})
+
+		obj1Get, err := client.Get(ctx, Resource1ID.String())
+		require.NoError(t, err)
+		compareObjects(t, &obj1, obj1Get)
+	})
+
 	t.Run("save_and_get_scope_only", func(t *testing.T) {
 		clear(t)
 